@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"database/sql"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
@@ -14,7 +15,11 @@ import (
 	"github.com/gorilla/mux"
 	_ "github.com/lib/pq"
 
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/migration"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/storage"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/api/handler"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/interactor"
 	"github.com/FUJI0130/go-ddd-ca/pkg/config"
 	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
 )
@@ -68,14 +73,73 @@ func main() {
 		log.Println("---------------------------------------------------")
 	}
 
-	// データベース接続の初期化（新しいファクトリを使用）
-	db, err = cfg.NewDatabaseConnection()
+	// 永続化の実装（--storage=memory でデータベースなしに起動できる）
+	storageFlag := flag.String("storage", string(storage.BackendPostgres), "Storage backend (postgres, memory); postgres uses the database selected by database.driver")
+	flag.Parse()
+	backend, err := storage.ParseBackend(*storageFlag)
 	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+		log.Fatalf("Invalid storage backend: %v", err)
 	}
-	defer db.Close()
+	if flag.Arg(0) == "migrate" && backend != storage.BackendPostgres {
+		log.Fatalf("The migrate command requires a database storage backend")
+	}
+
+	idOptions, err := idgen.NewOptions(cfg.ID.Strategy, cfg.ID.Prefix)
+	if err != nil {
+		log.Fatalf("Invalid ID generation config: %v", err)
+	}
+
+	var repos *storage.Repositories
+	if backend == storage.BackendMemory {
+		repos = storage.NewMemoryRepositories(idOptions)
+		log.Println("Using in-memory storage (all data is lost when the server stops)")
+	} else {
+		// データベースの種類（database.driverでpostgresとsqliteを切り替える）
+		dialect, err := migration.ParseDialect(cfg.Database.Driver)
+		if err != nil {
+			log.Fatalf("Invalid database config: %v", err)
+		}
 
-	log.Println("Successfully connected to database")
+		// データベース接続の初期化（新しいファクトリを使用）
+		db, err = cfg.NewDatabaseConnection()
+		if err != nil {
+			log.Fatalf("Failed to initialize database: %v", err)
+		}
+		defer db.Close()
+
+		log.Println("Successfully connected to database")
+
+		// マイグレーション（migrate サブコマンド、または database.autoMigrate による起動時の適用）
+		if flag.Arg(0) == "migrate" || cfg.Database.AutoMigrate {
+			migrator, err := migration.NewEmbeddedMigrator(db, dialect)
+			if err != nil {
+				log.Fatalf("Failed to load migrations: %v", err)
+			}
+			if flag.Arg(0) == "migrate" {
+				if err := migration.RunCommand(context.Background(), migrator, flag.Args()[1:], os.Stdout); err != nil {
+					log.Fatalf("Migration failed: %v", err)
+				}
+				return
+			}
+			applied, err := migrator.Up(context.Background())
+			if err != nil {
+				log.Fatalf("Failed to apply migrations: %v", err)
+			}
+			log.Printf("Database schema is up to date (%d migrations applied)", len(applied))
+		}
+
+		repos = storage.NewDatabaseRepositories(db, dialect, idOptions)
+	}
+
+	testSuiteInteractor := interactor.NewTestSuiteInteractor(
+		repos.TestSuite,
+		repos.TestGroup,
+		repos.TestCase,
+		repos.User,
+		repos.TestSuiteIDGenerator,
+		nil, // REST APIではイベントの配信先がないため発行しない
+		repos.TxManager,
+	)
 
 	// バージョン情報（環境変数から取得またはデフォルト値）
 	version := os.Getenv("APP_VERSION")
@@ -94,7 +158,7 @@ func main() {
 	apiRouter := router.PathPrefix("/api/v1").Subrouter()
 
 	// ルートハンドラーの登録
-	registerHandlers(apiRouter)
+	registerHandlers(apiRouter, &testSuiteUseCase{interactor: testSuiteInteractor})
 
 	// サーバーの設定
 	srv := &http.Server{
@@ -130,7 +194,7 @@ func main() {
 }
 
 // ルートハンドラーの登録
-func registerHandlers(router *mux.Router) {
+func registerHandlers(router *mux.Router, testSuiteUseCase handler.TestSuiteUseCase) {
 	// グローバルミドルウェアの設定
 	router.Use(errors.ErrorHandler)
 	router.Use(commonMiddleware)
@@ -145,9 +209,12 @@ func registerHandlers(router *mux.Router) {
 	// healthHandler := handler.NewHealthHandler(version)
 	// router.HandleFunc("/health", healthHandler.Check).Methods(http.MethodGet)
 
-	// 依存関係の注入は後で実装
-	testSuiteHandler := handler.NewTestSuiteHandler(nil)
+	testSuiteHandler := handler.NewTestSuiteHandler(testSuiteUseCase)
+	router.HandleFunc("/test-suites", testSuiteHandler.List).Methods(http.MethodGet)
 	router.HandleFunc("/test-suites", testSuiteHandler.Create).Methods(http.MethodPost)
+	router.HandleFunc("/test-suites/{id}", testSuiteHandler.GetTestSuite).Methods(http.MethodGet)
+	router.HandleFunc("/test-suites/{id}", testSuiteHandler.Update).Methods(http.MethodPut)
+	router.HandleFunc("/test-suites/{id}/status", testSuiteHandler.UpdateStatus).Methods(http.MethodPatch)
 }
 
 // 共通のミドルウェア設定
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/api/handler"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/interactor"
+)
+
+// インターフェース実装の確認
+var _ handler.TestSuiteUseCase = (*testSuiteUseCase)(nil)
+
+// testSuiteUseCase はcontextを受け取らないRESTハンドラーのユースケースインターフェースを
+// TestSuiteInteractorに合わせるためのアダプター
+type testSuiteUseCase struct {
+	interactor *interactor.TestSuiteInteractor
+}
+
+func (u *testSuiteUseCase) CreateTestSuite(createDTO *dto.TestSuiteCreateDTO) (*dto.TestSuiteResponseDTO, error) {
+	return u.interactor.CreateTestSuite(context.Background(), createDTO)
+}
+
+func (u *testSuiteUseCase) GetTestSuite(id string) (*dto.TestSuiteResponseDTO, error) {
+	return u.interactor.GetTestSuite(context.Background(), id)
+}
+
+func (u *testSuiteUseCase) ListTestSuites(params *dto.TestSuiteQueryParamDTO) (*dto.TestSuiteListResponseDTO, error) {
+	return u.interactor.ListTestSuites(context.Background(), params)
+}
+
+func (u *testSuiteUseCase) UpdateTestSuite(id string, updateDTO *dto.TestSuiteUpdateDTO) (*dto.TestSuiteResponseDTO, error) {
+	return u.interactor.UpdateTestSuite(context.Background(), id, updateDTO)
+}
+
+func (u *testSuiteUseCase) UpdateTestSuiteStatus(id string, statusDTO *dto.TestSuiteStatusUpdateDTO) (*dto.TestSuiteResponseDTO, error) {
+	return u.interactor.UpdateTestSuiteStatus(context.Background(), id, statusDTO)
+}
@@ -4,6 +4,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"net/http"
 	"os"
@@ -16,12 +17,21 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
 	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/auth"
-	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/postgres"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/messaging"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/migration"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/storage"
 	graphqlauth "github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/auth"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/dataloader"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/errorpresenter"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/generated"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/querylimit"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/requestid"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/resolver"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/job"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/interactor"
 	"github.com/FUJI0130/go-ddd-ca/pkg/config"
 	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
@@ -49,59 +59,110 @@ func main() {
 		port = defaultPort
 	}
 
-	// 環境変数があれば優先（既存の挙動を維持）
-	if envUser := os.Getenv("DB_USER"); envUser != "" {
-		cfg.Database.User = envUser
-	}
-	if envPass := os.Getenv("DB_PASS"); envPass != "" {
-		cfg.Database.Password = envPass
-	}
-	if envHost := os.Getenv("DB_HOST"); envHost != "" {
-		cfg.Database.Host = envHost
-	}
-	if envPort := os.Getenv("DB_PORT"); envPort != "" {
-		portNum, err := strconv.Atoi(envPort)
-		if err == nil {
-			cfg.Database.Port = portNum
-		}
+	// 永続化の実装（--storage=memory でデータベースなしに起動できる）
+	storageFlag := flag.String("storage", string(storage.BackendPostgres), "Storage backend (postgres, memory); postgres uses the database selected by database.driver")
+	flag.Parse()
+	backend, err := storage.ParseBackend(*storageFlag)
+	if err != nil {
+		log.Fatalf("Invalid storage backend: %v", err)
 	}
-	if envName := os.Getenv("DB_NAME"); envName != "" {
-		cfg.Database.DBName = envName
+	if flag.Arg(0) == "migrate" && backend != storage.BackendPostgres {
+		log.Fatalf("The migrate command requires a database storage backend")
 	}
 
-	// データベース接続（新しいファクトリを使用）
-	db, err := cfg.NewDatabaseConnection()
+	// IDジェネレーターの採番方式（設定のid.strategyで切り替える）
+	idOptions, err := idgen.NewOptions(cfg.ID.Strategy, cfg.ID.Prefix)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		log.Fatalf("Invalid ID generation config: %v", err)
 	}
-	defer db.Close()
 
-	// 接続確認のログ（既存コードを維持）
-	log.Println("Successfully connected to database")
+	var repos *storage.Repositories
+	if backend == storage.BackendMemory {
+		repos = storage.NewMemoryRepositories(idOptions)
+		if err := seedMemoryAdmin(context.Background(), repos.User); err != nil {
+			log.Fatalf("Failed to seed in-memory admin user: %v", err)
+		}
+		log.Println("Using in-memory storage (all data is lost when the server stops)")
+	} else {
+		// データベースの種類（database.driverでpostgresとsqliteを切り替える）
+		dialect, err := migration.ParseDialect(cfg.Database.Driver)
+		if err != nil {
+			log.Fatalf("Invalid database config: %v", err)
+		}
+
+		// 環境変数があれば優先（既存の挙動を維持）
+		if envUser := os.Getenv("DB_USER"); envUser != "" {
+			cfg.Database.User = envUser
+		}
+		if envPass := os.Getenv("DB_PASS"); envPass != "" {
+			cfg.Database.Password = envPass
+		}
+		if envHost := os.Getenv("DB_HOST"); envHost != "" {
+			cfg.Database.Host = envHost
+		}
+		if envPort := os.Getenv("DB_PORT"); envPort != "" {
+			portNum, err := strconv.Atoi(envPort)
+			if err == nil {
+				cfg.Database.Port = portNum
+			}
+		}
+		if envName := os.Getenv("DB_NAME"); envName != "" {
+			cfg.Database.DBName = envName
+		}
+
+		// データベース接続（新しいファクトリを使用）
+		db, err := cfg.NewDatabaseConnection()
+		if err != nil {
+			log.Fatalf("Failed to connect to database: %v", err)
+		}
+		defer db.Close()
+
+		// コネクションプールの設定
+		db.SetMaxOpenConns(25)
+		db.SetMaxIdleConns(25)
+		db.SetConnMaxLifetime(5 * time.Minute)
 
-	// コネクションプールの設定
-	db.SetMaxOpenConns(25)
-	db.SetMaxIdleConns(25)
-	db.SetConnMaxLifetime(5 * time.Minute)
+		// 接続確認
+		if err = db.Ping(); err != nil {
+			log.Fatalf("Failed to ping database: %v", err)
+		}
+		log.Println("Successfully connected to database")
+
+		// マイグレーション（migrate サブコマンド、または database.autoMigrate による起動時の適用）
+		if flag.Arg(0) == "migrate" || cfg.Database.AutoMigrate {
+			migrator, err := migration.NewEmbeddedMigrator(db, dialect)
+			if err != nil {
+				log.Fatalf("Failed to load migrations: %v", err)
+			}
+			if flag.Arg(0) == "migrate" {
+				if err := migration.RunCommand(context.Background(), migrator, flag.Args()[1:], os.Stdout); err != nil {
+					log.Fatalf("Migration failed: %v", err)
+				}
+				return
+			}
+			applied, err := migrator.Up(context.Background())
+			if err != nil {
+				log.Fatalf("Failed to apply migrations: %v", err)
+			}
+			log.Printf("Database schema is up to date (%d migrations applied)", len(applied))
+		}
 
-	// 接続確認
-	if err = db.Ping(); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+		repos = storage.NewDatabaseRepositories(db, dialect, idOptions)
 	}
-	log.Println("Successfully connected to database")
 
-	// リポジトリの初期化
-	testSuiteRepo := postgres.NewTestSuiteRepository(db)
-	testGroupRepo := postgres.NewTestGroupRepository(db)
-	testCaseRepo := postgres.NewTestCaseRepository(db)
-	userRepo := postgres.NewUserRepository(db)
-	refreshTokenRepo := postgres.NewPostgresRefreshTokenRepository(db)
-
-	// IDジェネレーターの初期化
-	testSuiteIDGenerator := postgres.NewTestSuiteIDGenerator(db)
-	testGroupIDGenerator := postgres.NewTestGroupIDGenerator(db)
-	testCaseIDGenerator := postgres.NewTestCaseIDGenerator(db)
-	userIDGenerator := postgres.NewUserIDGenerator(db)
+	// リポジトリとIDジェネレーター
+	testSuiteRepo := repos.TestSuite
+	testGroupRepo := repos.TestGroup
+	testCaseRepo := repos.TestCase
+	userRepo := repos.User
+	refreshTokenRepo := repos.RefreshToken
+	effortRecordRepo := repos.EffortRecord
+	statusHistoryRepo := repos.StatusHistory
+	txManager := repos.TxManager
+	testSuiteIDGenerator := repos.TestSuiteIDGenerator
+	testGroupIDGenerator := repos.TestGroupIDGenerator
+	testCaseIDGenerator := repos.TestCaseIDGenerator
+	userIDGenerator := repos.UserIDGenerator
 
 	// 認証関連サービスの初期化
 	jwtSecret := os.Getenv("JWT_SECRET")
@@ -122,10 +183,14 @@ func main() {
 		refreshTokenRepo,
 	)
 
+	// ドメインイベントのブローカー（サブスクリプションへの配信に使用）
+	eventBroker := messaging.NewInMemoryBroker()
+	defer eventBroker.Close()
+
 	// ユースケースの初期化
-	testSuiteUseCase := interactor.NewTestSuiteInteractor(testSuiteRepo, testSuiteIDGenerator)
-	testGroupUseCase := interactor.NewTestGroupInteractor(testGroupRepo, testGroupIDGenerator)
-	testCaseUseCase := interactor.NewTestCaseInteractor(testCaseRepo, testCaseIDGenerator)
+	testSuiteUseCase := interactor.NewTestSuiteInteractor(testSuiteRepo, testGroupRepo, testCaseRepo, userRepo, testSuiteIDGenerator, eventBroker, txManager)
+	testGroupUseCase := interactor.NewTestGroupInteractor(testGroupRepo, testCaseRepo, testGroupIDGenerator, eventBroker, txManager)
+	testCaseUseCase := interactor.NewTestCaseInteractor(testCaseRepo, testGroupRepo, statusHistoryRepo, userRepo, testCaseIDGenerator, eventBroker, txManager)
 	authUseCase := interactor.NewAuthInteractor(
 		userRepo,
 		jwtService,
@@ -140,8 +205,66 @@ func main() {
 		passwordService,
 	)
 
-	// DataLoaderの初期化
-	loaders := dataloader.NewDataLoaders(testGroupUseCase, testCaseUseCase)
+	// 工数記録のユースケース
+	effortUseCase := interactor.NewEffortInteractor(
+		effortRecordRepo,
+		testSuiteRepo,
+		testGroupRepo,
+		testCaseRepo,
+		userRepo,
+		eventBroker,
+		txManager,
+	)
+
+	// ゴミ箱（論理削除したスイート・グループ・ケース）のユースケース
+	trashUseCase := interactor.NewTrashInteractor(testSuiteRepo, testGroupRepo, testCaseRepo, eventBroker, txManager)
+
+	// バックグラウンドジョブはサーバー終了時にまとめて停止する
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	// テストケースの遅延検出ジョブ
+	// DELAY_DETECTION_INTERVAL（例: 30m）で実行間隔を指定でき、0を指定すると無効になる
+	delayDetectionInterval := job.DefaultDelayDetectionInterval
+	if envInterval := os.Getenv("DELAY_DETECTION_INTERVAL"); envInterval != "" {
+		interval, err := time.ParseDuration(envInterval)
+		if err != nil {
+			log.Printf("WARNING: Invalid DELAY_DETECTION_INTERVAL %q, using default %s", envInterval, delayDetectionInterval)
+		} else {
+			delayDetectionInterval = interval
+		}
+	}
+	if delayDetectionInterval > 0 {
+		delayDetectionUseCase := interactor.NewDelayDetectionInteractor(testSuiteRepo, testGroupRepo, testCaseRepo, eventBroker)
+		go job.NewDelayDetectionJob(delayDetectionUseCase, delayDetectionInterval).Run(jobCtx)
+		log.Printf("Delay detection job started (interval: %s)", delayDetectionInterval)
+	}
+
+	// ゴミ箱の完全削除ジョブ
+	// TRASH_PURGE_INTERVAL（例: 12h）で実行間隔を指定でき、0を指定すると無効になる
+	// TRASH_RETENTION（例: 720h）でゴミ箱に残す期間を指定する
+	trashPurgeInterval := job.DefaultTrashPurgeInterval
+	if envInterval := os.Getenv("TRASH_PURGE_INTERVAL"); envInterval != "" {
+		interval, err := time.ParseDuration(envInterval)
+		if err != nil {
+			log.Printf("WARNING: Invalid TRASH_PURGE_INTERVAL %q, using default %s", envInterval, trashPurgeInterval)
+		} else {
+			trashPurgeInterval = interval
+		}
+	}
+	trashRetention := job.DefaultTrashRetention
+	if envRetention := os.Getenv("TRASH_RETENTION"); envRetention != "" {
+		retention, err := time.ParseDuration(envRetention)
+		if err != nil || retention <= 0 {
+			log.Printf("WARNING: Invalid TRASH_RETENTION %q, using default %s", envRetention, trashRetention)
+		} else {
+			trashRetention = retention
+		}
+	}
+	if trashPurgeInterval > 0 {
+		go job.NewTrashPurgeJob(trashUseCase, trashPurgeInterval, trashRetention).Run(jobCtx)
+		log.Printf("Trash purge job started (interval: %s, retention: %s)", trashPurgeInterval, trashRetention)
+	}
 
 	// リゾルバーの初期化（AuthUseCaseとUserManagementUseCaseを追加）
 	resolverObj := resolver.NewResolver(
@@ -150,6 +273,9 @@ func main() {
 		testCaseUseCase,
 		authUseCase,
 		userManagementInteractor,
+		effortUseCase,
+		trashUseCase,
+		eventBroker,
 	)
 
 	// GraphQLサーバーの設定
@@ -157,6 +283,9 @@ func main() {
 		Resolvers: resolverObj,
 	}
 
+	// 一覧フィールドのコストを取得件数に応じて計算する
+	querylimit.SetFieldCosts(&c.Complexity)
+
 	// ディレクティブを登録（コードが生成された後のDirectiveRoot構造体に合わせる）
 	c.Directives.Auth = func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error) {
 		// 認証済みかチェック
@@ -203,6 +332,16 @@ func main() {
 	srv.Use(extension.AutomaticPersistedQuery{
 		Cache: lru.New[string](100), // 型パラメータを明示
 	})
+	srv.Use(querylimit.DepthLimit{MaxDepth: cfg.GraphQL.MaxDepth})
+	srv.Use(&querylimit.ComplexityLimit{MaxComplexity: cfg.GraphQL.MaxComplexity})
+
+	// エラーをextensions.codeを持つエラーに変換する（本番環境では内部エラーの詳細を返さない）
+	srv.SetErrorPresenter(errorpresenter.New(cfg.Environment == "production"))
+
+	// DataLoaderは応答ごとに作成する（WebSocketの接続やサブスクリプションのイベント間でキャッシュを共有しない）
+	srv.AroundResponses(dataloader.ResponseMiddleware(testGroupUseCase, testCaseUseCase))
+	// ミューテーションで変更したグループ・ケースをDataLoaderのキャッシュから返さないようにする
+	srv.AroundFields(dataloader.InvalidateAfterMutation)
 
 	// 認証ミドルウェアの初期化
 	authMiddleware := graphqlauth.AuthMiddleware(authUseCase)
@@ -216,8 +355,8 @@ func main() {
 	})
 
 	// GraphQL PlaygroundのUIを設定（DataLoaderミドルウェアと認証ミドルウェアを追加）
-	// http.Handle("/", dataloader.Middleware(loaders, playground.Handler("GraphQL playground", "/query")))
-	// http.Handle("/query", authMiddleware(dataloader.Middleware(loaders, srv)))
+	// http.Handle("/", playground.Handler("GraphQL playground", "/query"))
+	// http.Handle("/query", authMiddleware(srv))
 
 	// CORS設定
 	corsHandler := cors.New(cors.Options{
@@ -226,7 +365,8 @@ func main() {
 			"https://example-frontend.cloudfront.net", // 本番フロントエンド
 		},
 		AllowCredentials: true,
-		AllowedHeaders:   []string{"Content-Type", "Authorization"},
+		AllowedHeaders:   []string{"Content-Type", "Authorization", requestid.HeaderName},
+		ExposedHeaders:   []string{requestid.HeaderName},
 		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
 	})
 
@@ -239,8 +379,8 @@ func main() {
 	}
 
 	// ハンドラー設定
-	http.Handle("/", dataloader.Middleware(loaders, playground.Handler("GraphQL playground", "/query")))
-	http.Handle("/query", corsHandler.Handler(responseWriterMiddleware(authMiddleware(dataloader.Middleware(loaders, srv)))))
+	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
+	http.Handle("/query", corsHandler.Handler(requestid.Middleware(responseWriterMiddleware(authMiddleware(srv)))))
 
 	log.Fatal(http.ListenAndServe(":"+port, nil))
 
@@ -248,3 +388,16 @@ func main() {
 	log.Printf("Health check endpoint available at http://localhost:%s/health", port)
 	log.Fatal(http.ListenAndServe(":"+port, nil))
 }
+
+// memoryAdminPasswordHash はscripts/testdata/local-test-users.sqlのtest_adminと同じパスワード（password）のハッシュ
+const memoryAdminPasswordHash = "$2a$10$92IXUNpkjO0rOQ5byMi.Ye4oKoEa3Ro9llC/.og/at2.uheWG/igi"
+
+// seedMemoryAdmin はメモリ内ストレージで起動した場合に、ログインとユーザー作成に使う管理者を登録します
+// ローカル環境のテストユーザーと同じtest_admin / passwordでログインできます
+func seedMemoryAdmin(ctx context.Context, userRepo repository.UserRepository) error {
+	admin, err := entity.NewUser("USER001", "test_admin", memoryAdminPasswordHash, entity.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	return userRepo.Create(ctx, admin)
+}
@@ -2,14 +2,17 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
-	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
-	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/postgres"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/messaging"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/migration"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/storage"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/grpc/handler"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/grpc/server"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/interactor"
@@ -29,31 +32,89 @@ func main() {
 	// 環境情報のログ出力
 	log.Printf("Starting gRPC server in %s environment", cfg.Environment)
 
-	// データベース接続（新しいファクトリを使用）
-	db, err := cfg.NewDatabaseConnection()
+	// 永続化の実装（--storage=memory でデータベースなしに起動できる）
+	storageFlag := flag.String("storage", string(storage.BackendPostgres), "Storage backend (postgres, memory); postgres uses the database selected by database.driver")
+	flag.Parse()
+	backend, err := storage.ParseBackend(*storageFlag)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		log.Fatalf("Invalid storage backend: %v", err)
+	}
+	if flag.Arg(0) == "migrate" && backend != storage.BackendPostgres {
+		log.Fatalf("The migrate command requires a database storage backend")
 	}
-	defer db.Close()
 
-	// 接続確認のログ
-	log.Println("Successfully connected to database")
+	// IDジェネレーターの採番方式（設定のid.strategyで切り替える）
+	idOptions, err := idgen.NewOptions(cfg.ID.Strategy, cfg.ID.Prefix)
+	if err != nil {
+		log.Fatalf("Invalid ID generation config: %v", err)
+	}
 
 	// リポジトリの作成
-	var testSuiteRepo repository.TestSuiteRepository
-	testSuiteRepo = postgres.NewTestSuiteRepository(db)
+	var repos *storage.Repositories
+	if backend == storage.BackendMemory {
+		repos = storage.NewMemoryRepositories(idOptions)
+		log.Println("Using in-memory storage (all data is lost when the server stops)")
+	} else {
+		// データベースの種類（database.driverでpostgresとsqliteを切り替える）
+		dialect, err := migration.ParseDialect(cfg.Database.Driver)
+		if err != nil {
+			log.Fatalf("Invalid database config: %v", err)
+		}
 
-	// IDジェネレーターの初期化
-	testSuiteIDGenerator := postgres.NewTestSuiteIDGenerator(db)
+		// データベース接続（新しいファクトリを使用）
+		db, err := cfg.NewDatabaseConnection()
+		if err != nil {
+			log.Fatalf("Failed to connect to database: %v", err)
+		}
+		defer db.Close()
+
+		// 接続確認のログ
+		log.Println("Successfully connected to database")
+
+		// マイグレーション（migrate サブコマンド、または database.autoMigrate による起動時の適用）
+		if flag.Arg(0) == "migrate" || cfg.Database.AutoMigrate {
+			migrator, err := migration.NewEmbeddedMigrator(db, dialect)
+			if err != nil {
+				log.Fatalf("Failed to load migrations: %v", err)
+			}
+			if flag.Arg(0) == "migrate" {
+				if err := migration.RunCommand(context.Background(), migrator, flag.Args()[1:], os.Stdout); err != nil {
+					log.Fatalf("Migration failed: %v", err)
+				}
+				return
+			}
+			applied, err := migrator.Up(context.Background())
+			if err != nil {
+				log.Fatalf("Failed to apply migrations: %v", err)
+			}
+			log.Printf("Database schema is up to date (%d migrations applied)", len(applied))
+		}
+
+		repos = storage.NewDatabaseRepositories(db, dialect, idOptions)
+	}
+	testSuiteRepo := repos.TestSuite
+	testGroupRepo := repos.TestGroup
+	testCaseRepo := repos.TestCase
+	userRepo := repos.User
+	effortRecordRepo := repos.EffortRecord
+	txManager := repos.TxManager
+	testSuiteIDGenerator := repos.TestSuiteIDGenerator
+
+	// ドメインイベントのブローカー（Watch系ストリームへの配信に使用）
+	eventBroker := messaging.NewInMemoryBroker()
+	defer eventBroker.Close()
 
 	// インタラクターの作成（IDジェネレーターを追加）
-	testSuiteInteractor := interactor.NewTestSuiteInteractor(testSuiteRepo, testSuiteIDGenerator)
+	testSuiteInteractor := interactor.NewTestSuiteInteractor(testSuiteRepo, testGroupRepo, testCaseRepo, userRepo, testSuiteIDGenerator, eventBroker, txManager)
+	trashInteractor := interactor.NewTrashInteractor(testSuiteRepo, testGroupRepo, testCaseRepo, eventBroker, txManager)
+	effortInteractor := interactor.NewEffortInteractor(effortRecordRepo, testSuiteRepo, testGroupRepo, testCaseRepo, userRepo, eventBroker, txManager)
 
 	// gRPCハンドラーの作成
-	testSuiteServer := handler.NewTestSuiteServer(testSuiteInteractor)
+	testSuiteServer := handler.NewTestSuiteServer(testSuiteInteractor, trashInteractor, eventBroker)
+	effortServer := handler.NewEffortServer(effortInteractor)
 
 	// gRPCサーバーの設定
-	grpcServer, err := server.NewGrpcServer(50051, testSuiteServer)
+	grpcServer, err := server.NewGrpcServer(50051, testSuiteServer, effortServer)
 	if err != nil {
 		log.Fatalf("Failed to create gRPC server: %v", err)
 	}
@@ -67,6 +128,7 @@ func main() {
 
 	// 特定のサービスの状態を設定（必要に応じてカスタマイズ）
 	healthServer.SetServingStatus("testsuite.v1.TestSuiteService", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("testsuite.v1.EffortService", healthpb.HealthCheckResponse_SERVING)
 
 	// 追加: ALBのgRPCネイティブヘルスチェック用のサービス名を設定
 	healthServer.SetServingStatus("grpc.health.v1.Health", healthpb.HealthCheckResponse_SERVING)
@@ -118,6 +180,8 @@ func main() {
 	// シグナルを待つ
 	<-ctx.Done()
 	log.Println("Shutting down gRPC server...")
+	// Watch系ストリームを終了させてからグレースフルに停止する
+	eventBroker.Close()
 	grpcServer.Stop()
 	log.Println("gRPC server stopped")
 }
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+// EffortRecord はテストケースに対する工数記録を表すエンティティです
+type EffortRecord struct {
+	ID           string // DBではSERIAL型だがRefreshTokenと同様にstring型で保持
+	TestCaseID   string
+	RecordDate   time.Time
+	EffortAmount float64
+	IsAdditional bool
+	Comment      string
+	RecordedBy   string // 記録したユーザーのID
+	CreatedAt    time.Time
+}
+
+// NewEffortRecord は新しいEffortRecordを作成します
+// 記録日は日付単位で管理するため、時刻部分は切り捨てます
+func NewEffortRecord(testCaseID string, recordDate time.Time, effortAmount float64, isAdditional bool, comment, recordedBy string) *EffortRecord {
+	return &EffortRecord{
+		TestCaseID:   testCaseID,
+		RecordDate:   TruncateToDate(recordDate),
+		EffortAmount: effortAmount,
+		IsAdditional: isAdditional,
+		Comment:      comment,
+		RecordedBy:   recordedBy,
+		CreatedAt:    time.Now(),
+	}
+}
+
+// Correct は工数記録の工数とコメントを訂正し、訂正前との差分を返します
+// 差分はテストケースの実績工数への反映に使用します
+func (r *EffortRecord) Correct(effortAmount float64, comment string) float64 {
+	delta := effortAmount - r.EffortAmount
+	r.EffortAmount = effortAmount
+	r.Comment = comment
+	return delta
+}
+
+// IDAsInt はID文字列を整数として返す。変換エラー時は0を返す
+func (r *EffortRecord) IDAsInt() int {
+	id, err := strconv.Atoi(r.ID)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// SetIDFromInt は整数IDを文字列に変換してセットする
+func (r *EffortRecord) SetIDFromInt(id int) {
+	r.ID = strconv.Itoa(id)
+}
+
+// TruncateToDate は時刻部分を切り捨てて日付のみにします
+func TruncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
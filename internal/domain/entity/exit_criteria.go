@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// ExitCriteria はテストスイートを完了にするための条件です
+// 完了への遷移時に配下のテストケースを評価し、満たさない場合は遷移を拒否します
+type ExitCriteria struct {
+	// MinCompletionRate は完了したテストケースの割合の下限（0〜100）で、100の場合はすべてのケースの完了が必要です
+	MinCompletionRate float64
+	// AllowOpenCritical がfalseの場合、完了率に関わらず未完了のCriticalケースが残っていれば完了にできません
+	AllowOpenCritical bool
+}
+
+// DefaultExitCriteria は新規作成したテストスイートに設定する完了条件（全ケース完了）を返します
+func DefaultExitCriteria() ExitCriteria {
+	return ExitCriteria{MinCompletionRate: 100}
+}
+
+// Validate は完了条件の値を検証します
+func (c ExitCriteria) Validate() error {
+	if c.MinCompletionRate < 0 || c.MinCompletionRate > 100 {
+		return customerrors.NewValidationError("完了率の下限は0から100の範囲で指定してください", map[string]string{
+			"minCompletionRate": "0から100の範囲で指定してください",
+		})
+	}
+	return nil
+}
+
+// ExitCriteriaEvaluation は完了条件の評価結果です
+type ExitCriteriaEvaluation struct {
+	CompletionRate float64     // 完了したテストケースの割合（ケースがない場合は100）
+	BlockingCases  []*TestCase // 完了を妨げている未完了のテストケース
+}
+
+// Satisfied は完了条件を満たしているかを返します
+func (e *ExitCriteriaEvaluation) Satisfied() bool {
+	return len(e.BlockingCases) == 0
+}
+
+// Evaluate はスイート配下のテストケースが完了条件を満たすかを評価します
+// 完了率が下限に届かない場合は未完了のケースすべてを、届いている場合は未完了のCriticalケースを阻害要因とします
+func (c ExitCriteria) Evaluate(cases []*TestCase) *ExitCriteriaEvaluation {
+	var open, openCritical []*TestCase
+	total := 0
+	for _, tc := range cases {
+		if tc == nil {
+			continue
+		}
+		total++
+		if tc.Status == TestStatusCompleted {
+			continue
+		}
+		open = append(open, tc)
+		if tc.Priority == PriorityCritical {
+			openCritical = append(openCritical, tc)
+		}
+	}
+
+	evaluation := &ExitCriteriaEvaluation{CompletionRate: 100}
+	if total > 0 {
+		evaluation.CompletionRate = float64(total-len(open)) / float64(total) * 100
+	}
+
+	switch {
+	case evaluation.CompletionRate < c.MinCompletionRate:
+		evaluation.BlockingCases = open
+	case !c.AllowOpenCritical:
+		evaluation.BlockingCases = openCritical
+	}
+	return evaluation
+}
+
+// ExitCriteriaOverride は完了条件を満たさないままAdminがテストスイートを完了にした記録です
+type ExitCriteriaOverride struct {
+	Reason       string
+	OverriddenBy string // 完了にしたAdminのユーザーID
+	OverriddenAt time.Time
+}
@@ -0,0 +1,98 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+)
+
+func TestExitCriteria_Evaluate(t *testing.T) {
+	tests := []struct {
+		name         string
+		criteria     entity.ExitCriteria
+		cases        []*entity.TestCase
+		wantRate     float64
+		wantBlocking []string
+	}{
+		{
+			name:     "ケースが存在しない場合は満たす",
+			criteria: entity.DefaultExitCriteria(),
+			wantRate: 100,
+		},
+		{
+			name:     "全ケース完了が必要な場合は未完了のケースすべてが阻害要因",
+			criteria: entity.DefaultExitCriteria(),
+			cases: []*entity.TestCase{
+				{ID: "TC1", Status: entity.TestStatusCompleted, Priority: entity.PriorityHigh},
+				{ID: "TC2", Status: entity.TestStatusTesting, Priority: entity.PriorityLow},
+				{ID: "TC3", Status: entity.TestStatusReviewing, Priority: entity.PriorityMedium},
+				{ID: "TC4", Status: entity.TestStatusCompleted, Priority: entity.PriorityLow},
+			},
+			wantRate:     50,
+			wantBlocking: []string{"TC2", "TC3"},
+		},
+		{
+			name:     "完了率を満たしていても未完了のCriticalケースがあれば阻害要因",
+			criteria: entity.ExitCriteria{MinCompletionRate: 50},
+			cases: []*entity.TestCase{
+				{ID: "TC1", Status: entity.TestStatusCompleted, Priority: entity.PriorityHigh},
+				{ID: "TC2", Status: entity.TestStatusFixing, Priority: entity.PriorityCritical},
+				{ID: "TC3", Status: entity.TestStatusTesting, Priority: entity.PriorityLow},
+				{ID: "TC4", Status: entity.TestStatusCompleted, Priority: entity.PriorityLow},
+			},
+			wantRate:     50,
+			wantBlocking: []string{"TC2"},
+		},
+		{
+			name:     "Criticalケースの残存を許可する場合は完了率のみで判定",
+			criteria: entity.ExitCriteria{MinCompletionRate: 50, AllowOpenCritical: true},
+			cases: []*entity.TestCase{
+				{ID: "TC1", Status: entity.TestStatusCompleted, Priority: entity.PriorityHigh},
+				{ID: "TC2", Status: entity.TestStatusFixing, Priority: entity.PriorityCritical},
+			},
+			wantRate: 50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.criteria.Evaluate(tt.cases)
+			if got.CompletionRate != tt.wantRate {
+				t.Errorf("CompletionRate = %v, want %v", got.CompletionRate, tt.wantRate)
+			}
+			if len(got.BlockingCases) != len(tt.wantBlocking) {
+				t.Fatalf("BlockingCases = %d件, want %d件", len(got.BlockingCases), len(tt.wantBlocking))
+			}
+			for i, tc := range got.BlockingCases {
+				if tc.ID != tt.wantBlocking[i] {
+					t.Errorf("BlockingCases[%d] = %s, want %s", i, tc.ID, tt.wantBlocking[i])
+				}
+			}
+			if got.Satisfied() != (len(tt.wantBlocking) == 0) {
+				t.Errorf("Satisfied() = %v, want %v", got.Satisfied(), len(tt.wantBlocking) == 0)
+			}
+		})
+	}
+}
+
+func TestExitCriteria_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rate    float64
+		wantErr bool
+	}{
+		{name: "0は有効", rate: 0},
+		{name: "100は有効", rate: 100},
+		{name: "負の値はエラー", rate: -1, wantErr: true},
+		{name: "100を超える値はエラー", rate: 100.5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := entity.ExitCriteria{MinCompletionRate: tt.rate}.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+// StatusHistory はテストケースのステータス変更履歴を表すエンティティです
+type StatusHistory struct {
+	ID         string // DBではSERIAL型だがRefreshTokenと同様にstring型で保持
+	TestCaseID string
+	OldStatus  TestStatus
+	NewStatus  TestStatus
+	ChangedAt  time.Time
+	ChangedBy  string // 変更したユーザーのID
+	Reason     string
+}
+
+// NewStatusHistory は新しいStatusHistoryを作成します
+func NewStatusHistory(testCaseID string, oldStatus, newStatus TestStatus, changedBy, reason string) *StatusHistory {
+	return &StatusHistory{
+		TestCaseID: testCaseID,
+		OldStatus:  oldStatus,
+		NewStatus:  newStatus,
+		ChangedAt:  time.Now(),
+		ChangedBy:  changedBy,
+		Reason:     reason,
+	}
+}
+
+// SetIDFromInt は整数IDを文字列に変換してセットする
+func (h *StatusHistory) SetIDFromInt(id int) {
+	h.ID = strconv.Itoa(id)
+}
@@ -18,6 +18,50 @@ const (
 	TestStatusRetesting     TestStatus = "再テスト"
 )
 
+// TestStatuses はすべてのテストケースステータスを返します
+func TestStatuses() []TestStatus {
+	return []TestStatus{
+		TestStatusCreated, TestStatusTesting, TestStatusFixing, TestStatusReviewWaiting,
+		TestStatusReviewing, TestStatusCompleted, TestStatusRetesting,
+	}
+}
+
+// testStatusTransitions はテストケースのステータス遷移ルールを定義します
+// 作成 → テスト → レビュー待ち → レビュー中 → 完了 を基本とし、
+// 不具合があれば 修正 → 再テスト を経てレビューに戻ります
+var testStatusTransitions = map[TestStatus][]TestStatus{
+	TestStatusCreated:       {TestStatusTesting},
+	TestStatusTesting:       {TestStatusFixing, TestStatusReviewWaiting},
+	TestStatusFixing:        {TestStatusRetesting},
+	TestStatusRetesting:     {TestStatusFixing, TestStatusReviewWaiting},
+	TestStatusReviewWaiting: {TestStatusReviewing},
+	TestStatusReviewing:     {TestStatusCompleted, TestStatusFixing},
+	TestStatusCompleted:     {TestStatusRetesting},
+}
+
+// IsValid は有効なステータス値かどうかを検証します
+func (s TestStatus) IsValid() bool {
+	_, ok := testStatusTransitions[s]
+	return ok
+}
+
+// CanTransitionTo は指定されたステータスへ遷移可能かどうかを判定します
+func (s TestStatus) CanTransitionTo(next TestStatus) bool {
+	for _, allowed := range testStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedTransitions は現在のステータスから遷移可能なステータス一覧を返します
+func (s TestStatus) AllowedTransitions() []TestStatus {
+	allowed := make([]TestStatus, len(testStatusTransitions[s]))
+	copy(allowed, testStatusTransitions[s])
+	return allowed
+}
+
 // Priority はテストケースの優先度を表す列挙型です
 type Priority string
 
@@ -28,6 +72,21 @@ const (
 	PriorityLow      Priority = "Low"
 )
 
+// Priorities はすべての優先度を返します
+func Priorities() []Priority {
+	return []Priority{PriorityCritical, PriorityHigh, PriorityMedium, PriorityLow}
+}
+
+// IsValid は有効な優先度かどうかを検証します
+func (p Priority) IsValid() bool {
+	switch p {
+	case PriorityCritical, PriorityHigh, PriorityMedium, PriorityLow:
+		return true
+	default:
+		return false
+	}
+}
+
 // PriorityWeight は優先度の重みを返します
 func (p Priority) Weight() float64 {
 	switch p {
@@ -66,6 +125,10 @@ func (s TestStatus) BaseProgressRate() float64 {
 	}
 }
 
+// DefaultLockLease は編集ロックのデフォルトのリース期間です
+// 編集中のクライアントはこの期間内にハートビートでロックを延長する必要があります
+const DefaultLockLease = 2 * time.Minute
+
 // TestCase はテストケースを表すエンティティです
 type TestCase struct {
 	ID            string
@@ -76,10 +139,14 @@ type TestCase struct {
 	Priority      Priority
 	PlannedEffort float64
 	ActualEffort  float64
+	DueDate       time.Time // テストケース個別の期限（未設定の場合はゼロ値でスイートの終了予定日を使用）
 	IsDelayed     bool
 	DelayDays     int
 	CurrentEditor string
 	IsLocked      bool
+	LockExpiresAt time.Time // 編集ロックのリース期限（ロックされていない場合はゼロ値）
+	Version       int       // 楽観的排他制御のバージョン（更新のたびに1ずつ増加、編集ロックの操作では変化しない）
+	DeletedAt     time.Time // 論理削除された日時（グループと一緒に削除された場合はグループと同じ日時）
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -99,6 +166,7 @@ func NewTestCase(id, groupID, title, description string, status TestStatus, prio
 		IsDelayed:     false,
 		DelayDays:     0,
 		IsLocked:      false,
+		Version:       InitialVersion,
 		CreatedAt:     currentTime,
 		UpdatedAt:     currentTime,
 	}
@@ -110,30 +178,68 @@ func (c *TestCase) CalculateProgress() float64 {
 	return c.Status.BaseProgressRate() * 100
 }
 
+// IsDeleted はテストケースが論理削除されているかを返します
+func (c *TestCase) IsDeleted() bool {
+	return !c.DeletedAt.IsZero()
+}
+
 // UpdateStatus はテストケースのステータスを更新します
 func (c *TestCase) UpdateStatus(status TestStatus) {
 	c.Status = status
 	c.UpdatedAt = time.Now()
 }
 
+// TransitionStatus はステータス遷移ルールに従ってステータスを変更し、変更履歴を返します
+// 遷移が許可されていない場合は変更せずにnilを返します
+func (c *TestCase) TransitionStatus(next TestStatus, changedBy, reason string) *StatusHistory {
+	if !c.Status.CanTransitionTo(next) {
+		return nil
+	}
+	history := NewStatusHistory(c.ID, c.Status, next, changedBy, reason)
+	c.UpdateStatus(next)
+	return history
+}
+
 // AddEffort はテストケースに工数を追加します
 func (c *TestCase) AddEffort(effort float64) {
 	c.ActualEffort += effort
 	c.UpdatedAt = time.Now()
 }
 
-// Lock はテストケースをロックします
-func (c *TestCase) Lock(editor string) {
+// Lock はテストケースをexpiresAtまで有効な編集ロックでロックします
+// ロックの取得・延長は内容の更新ではないため、UpdatedAtは変更しません
+func (c *TestCase) Lock(editor string, expiresAt time.Time) {
 	c.IsLocked = true
 	c.CurrentEditor = editor
-	c.UpdatedAt = time.Now()
+	c.LockExpiresAt = expiresAt
 }
 
 // Unlock はテストケースのロックを解除します
 func (c *TestCase) Unlock() {
 	c.IsLocked = false
 	c.CurrentEditor = ""
-	c.UpdatedAt = time.Now()
+	c.LockExpiresAt = time.Time{}
+}
+
+// IsLockActive は指定時刻において有効な編集ロックが存在するかを返します
+// リース期限を過ぎたロックは無効として扱います
+func (c *TestCase) IsLockActive(now time.Time) bool {
+	return c.IsLocked && c.CurrentEditor != "" && now.Before(c.LockExpiresAt)
+}
+
+// LockHolder は有効な編集ロックを保持しているユーザーのIDを返します
+// 有効なロックが存在しない場合は空文字を返します
+func (c *TestCase) LockHolder(now time.Time) string {
+	if !c.IsLockActive(now) {
+		return ""
+	}
+	return c.CurrentEditor
+}
+
+// CanBeEditedBy は指定されたユーザーがテストケースを編集できるかを返します
+// 有効なロックが存在しないか、ロックを保持している本人の場合に編集できます
+func (c *TestCase) CanBeEditedBy(editor string, now time.Time) bool {
+	return !c.IsLockActive(now) || c.CurrentEditor == editor
 }
 
 // MarkDelayed はテストケースを遅延としてマークします
@@ -150,6 +256,55 @@ func (c *TestCase) ClearDelay() {
 	c.UpdatedAt = time.Now()
 }
 
+// Deadline はテストケースの期限を返します
+// 個別の期限が設定されていない場合はスイートの終了予定日を期限とします
+func (c *TestCase) Deadline(suiteEndDate time.Time) time.Time {
+	if !c.DueDate.IsZero() {
+		return c.DueDate
+	}
+	return suiteEndDate
+}
+
+// RefreshDelay は期限と現在日時から遅延状態を再評価し、状態が変化した場合にtrueを返します
+// 完了したテストケースと期限が設定されていないテストケースは遅延として扱いません
+func (c *TestCase) RefreshDelay(deadline, now time.Time) bool {
+	delayDays := 0
+	if c.Status != TestStatusCompleted {
+		delayDays = DelayDaysAt(deadline, now)
+	}
+
+	if delayDays > 0 {
+		if c.IsDelayed && c.DelayDays == delayDays {
+			return false
+		}
+		c.MarkDelayed(delayDays)
+		return true
+	}
+
+	if !c.IsDelayed && c.DelayDays == 0 {
+		return false
+	}
+	c.ClearDelay()
+	return true
+}
+
+// DelayDaysAt は指定日時時点で期限を何日過ぎているかを返します
+// 期限当日までは遅延とせず、期限が設定されていない場合は0を返します
+func DelayDaysAt(deadline, now time.Time) int {
+	if deadline.IsZero() {
+		return 0
+	}
+	// 日付単位で比較するため、タイムゾーンを揃えた上で暦日の差を求める
+	deadlineDate := deadline.In(now.Location())
+	from := time.Date(deadlineDate.Year(), deadlineDate.Month(), deadlineDate.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(to.Sub(from).Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
 // MoveToGroup はテストケースを別のグループに移動します
 func (c *TestCase) MoveToGroup(targetGroupID string) {
 	c.GroupID = targetGroupID
@@ -0,0 +1,157 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+)
+
+func TestTestStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		current entity.TestStatus
+		next    entity.TestStatus
+		want    bool
+	}{
+		{"作成からテストへ", entity.TestStatusCreated, entity.TestStatusTesting, true},
+		{"作成から完了へは直接遷移できない", entity.TestStatusCreated, entity.TestStatusCompleted, false},
+		{"レビュー待ちからレビュー中へ", entity.TestStatusReviewWaiting, entity.TestStatusReviewing, true},
+		{"レビュー中から完了へ", entity.TestStatusReviewing, entity.TestStatusCompleted, true},
+		{"レビュー中から修正へ差し戻し", entity.TestStatusReviewing, entity.TestStatusFixing, true},
+		{"修正から再テストへ", entity.TestStatusFixing, entity.TestStatusRetesting, true},
+		{"修正からレビュー待ちへは直接遷移できない", entity.TestStatusFixing, entity.TestStatusReviewWaiting, false},
+		{"完了から再テストへ", entity.TestStatusCompleted, entity.TestStatusRetesting, true},
+		{"同じステータスへの遷移は不可", entity.TestStatusTesting, entity.TestStatusTesting, false},
+		{"無効なステータスからは遷移できない", entity.TestStatus("不明"), entity.TestStatusTesting, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.current.CanTransitionTo(tt.next); got != tt.want {
+				t.Errorf("CanTransitionTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTestCase_TransitionStatus(t *testing.T) {
+	tc := entity.NewTestCase("TS001TG01TC001-202501", "TS001TG01-202501", "ログイン", "", entity.TestStatusReviewing, entity.PriorityHigh, 1)
+
+	if history := tc.TransitionStatus(entity.TestStatusCreated, "user-1", ""); history != nil {
+		t.Fatalf("許可されていない遷移で履歴が作成されました: %+v", history)
+	}
+	if tc.Status != entity.TestStatusReviewing {
+		t.Fatalf("許可されていない遷移でステータスが変更されました: %v", tc.Status)
+	}
+
+	history := tc.TransitionStatus(entity.TestStatusCompleted, "user-1", "レビュー指摘なし")
+	if history == nil {
+		t.Fatal("履歴が作成されませんでした")
+	}
+	if tc.Status != entity.TestStatusCompleted {
+		t.Errorf("Status = %v, want %v", tc.Status, entity.TestStatusCompleted)
+	}
+	if history.OldStatus != entity.TestStatusReviewing || history.NewStatus != entity.TestStatusCompleted {
+		t.Errorf("履歴のステータスが不正です: %v -> %v", history.OldStatus, history.NewStatus)
+	}
+	if history.ChangedBy != "user-1" || history.Reason != "レビュー指摘なし" || history.TestCaseID != tc.ID {
+		t.Errorf("履歴の内容が不正です: %+v", history)
+	}
+}
+
+func TestTestCase_EditLock(t *testing.T) {
+	now := time.Now()
+	tc := entity.NewTestCase("TS001TG01TC001-202501", "TS001TG01-202501", "ログイン", "", entity.TestStatusCreated, entity.PriorityMedium, 1)
+
+	// ロックされていない場合は誰でも編集できる
+	if tc.IsLockActive(now) || !tc.CanBeEditedBy("user-1", now) || tc.LockHolder(now) != "" {
+		t.Fatalf("ロックされていないテストケースの状態が不正です: %+v", tc)
+	}
+
+	// ロック中は保持者のみ編集できる
+	tc.Lock("user-1", now.Add(entity.DefaultLockLease))
+	if holder := tc.LockHolder(now); holder != "user-1" {
+		t.Errorf("LockHolder() = %q, want %q", holder, "user-1")
+	}
+	if !tc.CanBeEditedBy("user-1", now) {
+		t.Error("ロックの保持者が編集できません")
+	}
+	if tc.CanBeEditedBy("user-2", now) {
+		t.Error("ロックの保持者以外が編集できます")
+	}
+
+	// リース期限を過ぎたロックは無効
+	expired := now.Add(entity.DefaultLockLease + time.Second)
+	if tc.IsLockActive(expired) || tc.LockHolder(expired) != "" {
+		t.Error("期限切れのロックが有効として扱われています")
+	}
+	if !tc.CanBeEditedBy("user-2", expired) {
+		t.Error("期限切れのロックで他のユーザーが編集できません")
+	}
+
+	tc.Unlock()
+	if tc.IsLocked || tc.CurrentEditor != "" || !tc.LockExpiresAt.IsZero() {
+		t.Errorf("ロック解除後の状態が不正です: %+v", tc)
+	}
+}
+
+func TestDelayDaysAt(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	deadline := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		deadline time.Time
+		now      time.Time
+		want     int
+	}{
+		{"期限前", deadline, time.Date(2025, 3, 30, 23, 0, 0, 0, time.UTC), 0},
+		{"期限当日", deadline, time.Date(2025, 3, 31, 23, 59, 0, 0, time.UTC), 0},
+		{"期限の翌日", deadline, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), 1},
+		{"月をまたいで10日超過", deadline, time.Date(2025, 4, 10, 12, 0, 0, 0, time.UTC), 10},
+		{"タイムゾーンが異なる場合は現在日時の暦日で比較", time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC), time.Date(2025, 4, 1, 10, 0, 0, 0, jst), 0},
+		{"期限未設定", time.Time{}, time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := entity.DelayDaysAt(tt.deadline, tt.now); got != tt.want {
+				t.Errorf("DelayDaysAt() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTestCase_RefreshDelay(t *testing.T) {
+	suiteEnd := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 4, 3, 9, 0, 0, 0, time.UTC)
+	tc := entity.NewTestCase("TS001TG01TC001-202501", "TS001TG01-202501", "ログイン", "", entity.TestStatusTesting, entity.PriorityMedium, 1)
+
+	// 個別の期限がなければスイートの終了予定日で判定する
+	if !tc.RefreshDelay(tc.Deadline(suiteEnd), now) {
+		t.Fatal("遅延の検出で変更ありと判定されません")
+	}
+	if !tc.IsDelayed || tc.DelayDays != 3 {
+		t.Errorf("遅延状態が不正です: IsDelayed=%v, DelayDays=%d", tc.IsDelayed, tc.DelayDays)
+	}
+
+	// 状態が同じなら変更なし
+	if tc.RefreshDelay(tc.Deadline(suiteEnd), now) {
+		t.Error("遅延日数が変わらないのに変更ありと判定されました")
+	}
+
+	// 個別の期限はスイートの終了予定日より優先される
+	tc.DueDate = time.Date(2025, 4, 5, 0, 0, 0, 0, time.UTC)
+	if !tc.RefreshDelay(tc.Deadline(suiteEnd), now) || tc.IsDelayed || tc.DelayDays != 0 {
+		t.Errorf("個別の期限内なのに遅延が解除されません: IsDelayed=%v, DelayDays=%d", tc.IsDelayed, tc.DelayDays)
+	}
+
+	// 完了したテストケースは期限を過ぎていても遅延としない
+	tc.DueDate = time.Time{}
+	tc.MarkDelayed(3)
+	tc.Status = entity.TestStatusCompleted
+	if !tc.RefreshDelay(tc.Deadline(suiteEnd), now) || tc.IsDelayed {
+		t.Error("完了したテストケースの遅延が解除されません")
+	}
+}
@@ -15,6 +15,9 @@ type TestGroup struct {
 	Description  string
 	DisplayOrder int
 	Status       valueobject.SuiteStatus
+	StatusLocked bool      // trueの場合、ステータスを手動で固定し、配下のケースの変更による再計算を行わない
+	Version      int       // 楽観的排他制御のバージョン（更新のたびに1ずつ増加）
+	DeletedAt    time.Time // 論理削除された日時（スイートと一緒に削除された場合はスイートと同じ日時）
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
@@ -29,6 +32,7 @@ func NewTestGroup(id, suiteID, name, description string, displayOrder int, statu
 		Description:  description,
 		DisplayOrder: displayOrder,
 		Status:       status,
+		Version:      InitialVersion,
 		CreatedAt:    currentTime,
 		UpdatedAt:    currentTime,
 	}
@@ -41,21 +45,48 @@ type ProgressSummary struct {
 	TotalCount         int
 }
 
-// CalculateProgress はテストグループの進捗率を計算します
-// この実装ではダミーの値を返していますが、実際にはテストケースの状態に基づいて計算します
-func (g *TestGroup) CalculateProgress() float64 {
-	// 後ほど詳細実装
-	return 0.0
+// NewProgressSummary はテストケース一覧から進捗サマリーを集計します
+// 進捗率は各ケースの状態別基本進捗率を優先度の重みで加重平均した値です
+func NewProgressSummary(cases []*TestCase) *ProgressSummary {
+	summary := &ProgressSummary{}
+
+	var weightedProgress, totalWeight float64
+	for _, tc := range cases {
+		if tc == nil {
+			continue
+		}
+		weight := tc.Priority.Weight()
+		weightedProgress += tc.Status.BaseProgressRate() * weight
+		totalWeight += weight
+
+		summary.TotalCount++
+		if tc.Status == TestStatusCompleted {
+			summary.CompletedCount++
+		}
+	}
+
+	if totalWeight > 0 {
+		summary.ProgressPercentage = weightedProgress / totalWeight * 100
+	}
+
+	return summary
+}
+
+// CalculateProgress はグループに属するテストケースからテストグループの進捗率を計算します
+func (g *TestGroup) CalculateProgress(cases []*TestCase) float64 {
+	return g.GetProgressSummary(cases).ProgressPercentage
 }
 
-// GetProgressSummary はテストグループの進捗サマリーを取得します
-func (g *TestGroup) GetProgressSummary() *ProgressSummary {
-	// 後ほど詳細実装
-	return &ProgressSummary{
-		ProgressPercentage: g.CalculateProgress(),
-		CompletedCount:     0,
-		TotalCount:         0,
+// GetProgressSummary はグループに属するテストケースからテストグループの進捗サマリーを取得します
+// 他のグループに属するケースは集計対象外です
+func (g *TestGroup) GetProgressSummary(cases []*TestCase) *ProgressSummary {
+	own := make([]*TestCase, 0, len(cases))
+	for _, tc := range cases {
+		if tc != nil && tc.GroupID == g.ID {
+			own = append(own, tc)
+		}
 	}
+	return NewProgressSummary(own)
 }
 
 // UpdateDisplayOrder はテストグループの表示順序を更新します
@@ -64,8 +95,76 @@ func (g *TestGroup) UpdateDisplayOrder(newOrder int) {
 	g.UpdatedAt = time.Now()
 }
 
+// IsDeleted はテストグループが論理削除されているかを返します
+func (g *TestGroup) IsDeleted() bool {
+	return !g.DeletedAt.IsZero()
+}
+
 // UpdateStatus はテストグループのステータスを更新します
 func (g *TestGroup) UpdateStatus(status valueobject.SuiteStatus) {
 	g.Status = status
 	g.UpdatedAt = time.Now()
 }
+
+// DeriveGroupStatus はテストケースの状態からグループのステータスを算出します
+// ケースが存在しない、またはすべて「作成」の場合は準備中、すべて完了の場合は完了、それ以外は実行中となります
+// 中断は自動的には算出されないため、必要な場合はLockStatusで固定します
+func DeriveGroupStatus(cases []*TestCase) valueobject.SuiteStatus {
+	total, created, completed := 0, 0, 0
+	for _, tc := range cases {
+		if tc == nil {
+			continue
+		}
+		total++
+		switch tc.Status {
+		case TestStatusCreated:
+			created++
+		case TestStatusCompleted:
+			completed++
+		}
+	}
+
+	switch {
+	case created == total:
+		return valueobject.SuiteStatusPreparation
+	case completed == total:
+		return valueobject.SuiteStatusCompleted
+	default:
+		return valueobject.SuiteStatusInProgress
+	}
+}
+
+// RecalculateStatus はグループに属するテストケースからステータスを再計算し、変更があった場合にtrueを返します
+// ステータスが固定されている場合は何もしません
+func (g *TestGroup) RecalculateStatus(cases []*TestCase) bool {
+	if g.StatusLocked {
+		return false
+	}
+
+	own := make([]*TestCase, 0, len(cases))
+	for _, tc := range cases {
+		if tc != nil && tc.GroupID == g.ID {
+			own = append(own, tc)
+		}
+	}
+
+	status := DeriveGroupStatus(own)
+	if status == g.Status {
+		return false
+	}
+	g.UpdateStatus(status)
+	return true
+}
+
+// LockStatus はステータスを指定した値に固定します
+func (g *TestGroup) LockStatus(status valueobject.SuiteStatus) {
+	g.StatusLocked = true
+	g.UpdateStatus(status)
+}
+
+// UnlockStatus はステータスの固定を解除し、テストケースからステータスを再計算します
+func (g *TestGroup) UnlockStatus(cases []*TestCase) {
+	g.StatusLocked = false
+	g.RecalculateStatus(cases)
+	g.UpdatedAt = time.Now()
+}
@@ -0,0 +1,166 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+)
+
+func TestTestGroup_GetProgressSummary(t *testing.T) {
+	group := entity.NewTestGroup("TS001TG01-202501", "TS001-202501", "機能テスト", "", 1, valueobject.SuiteStatusInProgress)
+
+	tests := []struct {
+		name          string
+		cases         []*entity.TestCase
+		wantProgress  float64
+		wantCompleted int
+		wantTotal     int
+	}{
+		{
+			name:         "ケースが存在しない場合は0",
+			cases:        nil,
+			wantProgress: 0,
+		},
+		{
+			name: "すべて完了している場合は100",
+			cases: []*entity.TestCase{
+				{ID: "TC1", GroupID: group.ID, Status: entity.TestStatusCompleted, Priority: entity.PriorityLow},
+				{ID: "TC2", GroupID: group.ID, Status: entity.TestStatusCompleted, Priority: entity.PriorityCritical},
+			},
+			wantProgress:  100,
+			wantCompleted: 2,
+			wantTotal:     2,
+		},
+		{
+			name: "優先度の重みで加重平均する",
+			cases: []*entity.TestCase{
+				{ID: "TC1", GroupID: group.ID, Status: entity.TestStatusCompleted, Priority: entity.PriorityCritical},
+				{ID: "TC2", GroupID: group.ID, Status: entity.TestStatusCreated, Priority: entity.PriorityLow},
+			},
+			wantProgress:  80, // (1.0*4 + 0*1) / 5
+			wantCompleted: 1,
+			wantTotal:     2,
+		},
+		{
+			name: "他のグループのケースは集計対象外",
+			cases: []*entity.TestCase{
+				{ID: "TC1", GroupID: group.ID, Status: entity.TestStatusReviewing, Priority: entity.PriorityMedium},
+				{ID: "TC2", GroupID: "TS001TG02-202501", Status: entity.TestStatusCompleted, Priority: entity.PriorityMedium},
+			},
+			wantProgress: 75,
+			wantTotal:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := group.GetProgressSummary(tt.cases)
+			if got.ProgressPercentage != tt.wantProgress {
+				t.Errorf("ProgressPercentage = %v, want %v", got.ProgressPercentage, tt.wantProgress)
+			}
+			if got.CompletedCount != tt.wantCompleted {
+				t.Errorf("CompletedCount = %v, want %v", got.CompletedCount, tt.wantCompleted)
+			}
+			if got.TotalCount != tt.wantTotal {
+				t.Errorf("TotalCount = %v, want %v", got.TotalCount, tt.wantTotal)
+			}
+			if p := group.CalculateProgress(tt.cases); p != tt.wantProgress {
+				t.Errorf("CalculateProgress() = %v, want %v", p, tt.wantProgress)
+			}
+		})
+	}
+}
+
+func TestDeriveGroupStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		cases []*entity.TestCase
+		want  valueobject.SuiteStatus
+	}{
+		{
+			name:  "ケースが存在しない場合は準備中",
+			cases: nil,
+			want:  valueobject.SuiteStatusPreparation,
+		},
+		{
+			name: "すべて作成の場合は準備中",
+			cases: []*entity.TestCase{
+				{ID: "TC1", Status: entity.TestStatusCreated},
+				{ID: "TC2", Status: entity.TestStatusCreated},
+			},
+			want: valueobject.SuiteStatusPreparation,
+		},
+		{
+			name: "いずれかが開始されていれば実行中",
+			cases: []*entity.TestCase{
+				{ID: "TC1", Status: entity.TestStatusCreated},
+				{ID: "TC2", Status: entity.TestStatusTesting},
+			},
+			want: valueobject.SuiteStatusInProgress,
+		},
+		{
+			name: "一部のみ完了の場合は実行中",
+			cases: []*entity.TestCase{
+				{ID: "TC1", Status: entity.TestStatusCompleted},
+				{ID: "TC2", Status: entity.TestStatusCreated},
+			},
+			want: valueobject.SuiteStatusInProgress,
+		},
+		{
+			name: "すべて完了の場合は完了",
+			cases: []*entity.TestCase{
+				{ID: "TC1", Status: entity.TestStatusCompleted},
+				{ID: "TC2", Status: entity.TestStatusCompleted},
+			},
+			want: valueobject.SuiteStatusCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := entity.DeriveGroupStatus(tt.cases); got != tt.want {
+				t.Errorf("DeriveGroupStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTestGroup_RecalculateStatus(t *testing.T) {
+	cases := []*entity.TestCase{
+		{ID: "TC1", GroupID: "TS001TG01-202501", Status: entity.TestStatusCompleted},
+		{ID: "TC2", GroupID: "TS001TG02-202501", Status: entity.TestStatusTesting},
+	}
+
+	t.Run("固定されていない場合はケースから再計算する", func(t *testing.T) {
+		group := entity.NewTestGroup("TS001TG01-202501", "TS001-202501", "機能テスト", "", 1, valueobject.SuiteStatusPreparation)
+		if !group.RecalculateStatus(cases) {
+			t.Fatal("RecalculateStatus() = false, want true")
+		}
+		if group.Status != valueobject.SuiteStatusCompleted {
+			t.Errorf("Status = %v, want %v", group.Status, valueobject.SuiteStatusCompleted)
+		}
+		if group.RecalculateStatus(cases) {
+			t.Error("変更がない場合はfalseを返す必要があります")
+		}
+	})
+
+	t.Run("固定されている場合は再計算しない", func(t *testing.T) {
+		group := entity.NewTestGroup("TS001TG01-202501", "TS001-202501", "機能テスト", "", 1, valueobject.SuiteStatusPreparation)
+		group.LockStatus(valueobject.SuiteStatusSuspended)
+		if group.RecalculateStatus(cases) {
+			t.Error("RecalculateStatus() = true, want false")
+		}
+		if group.Status != valueobject.SuiteStatusSuspended {
+			t.Errorf("Status = %v, want %v", group.Status, valueobject.SuiteStatusSuspended)
+		}
+
+		group.UnlockStatus(cases)
+		if group.StatusLocked {
+			t.Error("固定が解除されていません")
+		}
+		if group.Status != valueobject.SuiteStatusCompleted {
+			t.Errorf("固定解除後のStatus = %v, want %v", group.Status, valueobject.SuiteStatusCompleted)
+		}
+	})
+}
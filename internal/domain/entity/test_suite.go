@@ -16,10 +16,18 @@ type TestSuite struct {
 	EstimatedStartDate   time.Time
 	EstimatedEndDate     time.Time
 	RequireEffortComment bool
+	ExitCriteria         ExitCriteria
+	ExitOverride         *ExitCriteriaOverride // 完了条件を満たさずに完了にした場合の記録（通常の完了ではnil）
+	Version              int                   // 楽観的排他制御のバージョン（更新のたびに1ずつ増加）
+	DeletedAt            time.Time             // 論理削除された日時（削除されていない場合はゼロ値）
+	CreatedBy            string                // 作成したユーザーのID（不明な場合は空文字）
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 }
 
+// InitialVersion は新規作成されたエンティティのバージョンです
+const InitialVersion = 1
+
 // エラーメッセージ定数
 const (
 	MsgTestSuiteIDRequired       = "テストスイートIDは必須です"
@@ -65,11 +73,32 @@ func NewTestSuite(
 		EstimatedStartDate:   estimatedStartDate,
 		EstimatedEndDate:     estimatedEndDate,
 		RequireEffortComment: requireEffortComment,
+		ExitCriteria:         DefaultExitCriteria(),
+		Version:              InitialVersion,
 		CreatedAt:            now,
 		UpdatedAt:            now,
 	}, nil
 }
 
+// GetProgressSummary はスイート配下のグループとテストケースから進捗サマリーを取得する
+// casesByGroup はグループIDをキーとしたテストケース一覧で、スイートに属さないグループは集計対象外です
+// 進捗はグループ単位ではなくケース単位で加重平均するため、ケース数の少ないグループに引きずられません
+func (ts *TestSuite) GetProgressSummary(groups []*TestGroup, casesByGroup map[string][]*TestCase) *ProgressSummary {
+	var cases []*TestCase
+	for _, group := range groups {
+		if group == nil || group.SuiteID != ts.ID {
+			continue
+		}
+		cases = append(cases, casesByGroup[group.ID]...)
+	}
+	return NewProgressSummary(cases)
+}
+
+// IsDeleted はテストスイートがゴミ箱に移動されているかを返す
+func (ts *TestSuite) IsDeleted() bool {
+	return !ts.DeletedAt.IsZero()
+}
+
 // UpdateStatus はテストスイートのステータスを更新する
 func (ts *TestSuite) UpdateStatus(newStatus valueobject.SuiteStatus) error {
 	if !newStatus.IsValid() {
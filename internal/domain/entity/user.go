@@ -130,3 +130,22 @@ func (u *User) CanUpdateTestCase() bool {
 func (u *User) CanRecordEffort() bool {
 	return true // すべてのユーザーが工数を記録可能
 }
+
+// CanForceUnlockTestCase は他のユーザーが保持する編集ロックの強制解除権限を持つかチェック
+func (u *User) CanForceUnlockTestCase() bool {
+	return u.Role == RoleAdmin || u.Role == RoleManager
+}
+
+// CanOverrideExitCriteria は完了条件を満たさないテストスイートを理由付きで完了にする権限を持つかチェック
+func (u *User) CanOverrideExitCriteria() bool {
+	return u.Role == RoleAdmin
+}
+
+// CanCorrectEffortRecord は工数記録の訂正権限を持つかチェック
+// 記録者本人に加え、AdminとManagerは他のユーザーの記録も訂正できる
+func (u *User) CanCorrectEffortRecord(record *EffortRecord) bool {
+	if u.Role == RoleAdmin || u.Role == RoleManager {
+		return true
+	}
+	return record.RecordedBy == u.ID
+}
@@ -0,0 +1,86 @@
+package event
+
+import (
+	"context"
+	"time"
+)
+
+// Type はドメインイベントの種類を表します
+type Type string
+
+// ドメインイベントの種類
+const (
+	TestSuiteCreated       Type = "TestSuiteCreated"
+	TestSuiteUpdated       Type = "TestSuiteUpdated"
+	TestSuiteStatusChanged Type = "TestSuiteStatusChanged"
+	TestSuiteDeleted       Type = "TestSuiteDeleted"
+	TestSuiteRestored      Type = "TestSuiteRestored"
+	TestGroupCreated       Type = "TestGroupCreated"
+	TestGroupUpdated       Type = "TestGroupUpdated"
+	TestGroupDeleted       Type = "TestGroupDeleted"
+	TestGroupRestored      Type = "TestGroupRestored"
+	TestCaseCreated        Type = "TestCaseCreated"
+	TestCaseUpdated        Type = "TestCaseUpdated"
+	TestCaseStatusChanged  Type = "TestCaseStatusChanged"
+	TestCaseDelayed        Type = "TestCaseDelayed"
+	TestCaseDeleted        Type = "TestCaseDeleted"
+	TestCaseRestored       Type = "TestCaseRestored"
+	EffortRecorded         Type = "EffortRecorded"
+)
+
+// String はイベント種類の文字列表現を返します
+func (t Type) String() string {
+	return string(t)
+}
+
+// EntityType はイベントの発生元となったエンティティの種類を返します
+func (t Type) EntityType() string {
+	switch t {
+	case TestSuiteCreated, TestSuiteUpdated, TestSuiteStatusChanged, TestSuiteDeleted, TestSuiteRestored:
+		return "TestSuite"
+	case TestGroupCreated, TestGroupUpdated, TestGroupDeleted, TestGroupRestored:
+		return "TestGroup"
+	case TestCaseCreated, TestCaseUpdated, TestCaseStatusChanged, TestCaseDelayed, TestCaseDeleted, TestCaseRestored:
+		return "TestCase"
+	case EffortRecorded:
+		return "EffortRecord"
+	default:
+		return ""
+	}
+}
+
+// Event はテストスイート配下で発生したドメインイベントを表します
+// 購読者はSuiteIDで絞り込み、必要に応じてEntityIDから最新の状態を取得します
+type Event struct {
+	Type       Type
+	SuiteID    string // イベントが属するテストスイートのID
+	EntityID   string // イベントの発生元エンティティのID
+	TestCaseID string // 工数記録イベントの場合の対象テストケースID
+	ActorID    string // 操作したユーザーのID（不明な場合は空）
+	OccurredAt time.Time
+}
+
+// New は新しいEventを作成します
+func New(eventType Type, suiteID, entityID, actorID string) *Event {
+	return &Event{
+		Type:       eventType,
+		SuiteID:    suiteID,
+		EntityID:   entityID,
+		ActorID:    actorID,
+		OccurredAt: time.Now(),
+	}
+}
+
+// Publisher はドメインイベントを発行するインターフェースです
+// 配信はベストエフォートで行うため、発行に失敗しても呼び出し元の処理は継続します
+type Publisher interface {
+	Publish(ctx context.Context, e *Event)
+}
+
+// Subscriber はドメインイベントを購読するインターフェースです
+type Subscriber interface {
+	// Subscribe は指定されたスイートのイベントを受信するチャネルを返します
+	// suiteIDが空の場合はすべてのスイートのイベントを受信します
+	// チャネルはctxの終了時に閉じられます
+	Subscribe(ctx context.Context, suiteID string) (<-chan *Event, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+)
+
+// EffortRecordRepository は工数記録の永続化を担当するリポジトリインターフェース
+// 工数記録は履歴として残すため削除は提供せず、訂正はUpdateで行う
+type EffortRecordRepository interface {
+	// Create は工数記録を保存し、採番されたIDをエンティティに設定する
+	Create(ctx context.Context, record *entity.EffortRecord) error
+
+	// FindByID は指定されたIDの工数記録を取得する
+	FindByID(ctx context.Context, id string) (*entity.EffortRecord, error)
+
+	// Update は工数記録の工数とコメントを更新する
+	Update(ctx context.Context, record *entity.EffortRecord) error
+
+	// FindByTestCaseID は指定されたテストケースの工数記録一覧を取得する
+	FindByTestCaseID(ctx context.Context, testCaseID string) ([]*entity.EffortRecord, error)
+
+	// FindByRecorderAndDate は指定されたユーザーが指定日に記録した工数記録一覧を取得する
+	FindByRecorderAndDate(ctx context.Context, recordedBy string, recordDate time.Time) ([]*entity.EffortRecord, error)
+}
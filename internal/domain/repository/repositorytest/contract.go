@@ -0,0 +1,94 @@
+// Package repositorytest はinternal/domain/repositoryのインターフェースに対する契約テストを提供します
+// 永続化の実装ごとにテストを書き直すのではなく、同じテストをすべての実装で実行し、
+// 未検出エラー・絞り込みとページング・並び順・同時更新などの振る舞いが一致することを確認します
+//
+// 新しい実装を追加した場合は、空の状態のリポジトリを返すFactoryを用意してRunを呼び出してください
+//
+//	func TestRepositoryContract(t *testing.T) {
+//		repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+//			store := memory.NewStore()
+//			return repositorytest.Repositories{TestSuite: memory.NewMemoryTestSuiteRepository(store), ...}
+//		})
+//	}
+package repositorytest
+
+import (
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// Repositories は契約テストの対象とするリポジトリの一式です
+// グループ・ケースの作成には親のスイート・グループが必要なため、同じデータ領域を共有する実装を指定してください
+type Repositories struct {
+	TestSuite     repository.TestSuiteRepository
+	TestGroup     repository.TestGroupRepository
+	TestCase      repository.TestCaseRepository
+	User          repository.UserRepository
+	EffortRecord  repository.EffortRecordRepository
+	StatusHistory repository.StatusHistoryRepository
+}
+
+// Factory はテストごとに空の状態のリポジトリ一式を作成します
+// 後片付けが必要な場合はt.Cleanupで登録してください
+type Factory func(t *testing.T) Repositories
+
+// Run はすべての契約テストをサブテストとして実行します
+func Run(t *testing.T, newRepositories Factory) {
+	t.Run("TestSuiteRepository", func(t *testing.T) { RunTestSuiteRepository(t, newRepositories) })
+	t.Run("TestGroupRepository", func(t *testing.T) { RunTestGroupRepository(t, newRepositories) })
+	t.Run("TestCaseRepository", func(t *testing.T) { RunTestCaseRepository(t, newRepositories) })
+	t.Run("UserRepository", func(t *testing.T) { RunUserRepository(t, newRepositories) })
+	t.Run("EffortRecordRepository", func(t *testing.T) { RunEffortRecordRepository(t, newRepositories) })
+	t.Run("StatusHistoryRepository", func(t *testing.T) { RunStatusHistoryRepository(t, newRepositories) })
+}
+
+// 実装によってエラーの型はsupport/customerrorsとpkg/errorsに分かれているため、
+// 契約としてはエラーの種類（未検出・競合・検証）だけを確認します
+
+// IsNotFound はエラーが対象の未検出を表すかを返します
+func IsNotFound(err error) bool {
+	return customerrors.IsNotFoundError(err) || hasDomainCode(err, "NOT_FOUND") || hasAPIStatus(err, http.StatusNotFound)
+}
+
+// IsConflict はエラーが一意制約違反・関連データの存在などによる競合を表すかを返します
+func IsConflict(err error) bool {
+	return customerrors.IsConflictError(err) || hasDomainCode(err, "CONFLICT") || hasAPIStatus(err, http.StatusConflict)
+}
+
+// IsValidation はエラーが入力値や関連リソースの検証エラーを表すかを返します
+func IsValidation(err error) bool {
+	return customerrors.IsValidationError(err) || hasDomainCode(err, "VALIDATION_ERROR") || hasAPIStatus(err, http.StatusBadRequest)
+}
+
+// IsConcurrentModification はエラーがバージョン不一致による同時更新の競合を表すかを返します
+func IsConcurrentModification(err error) bool {
+	var conflict *errors.ConcurrentModificationError
+	return stderrors.As(err, &conflict)
+}
+
+// IsEditLockConflict はエラーが他のユーザーが保持している編集ロックとの競合を表すかを返します
+func IsEditLockConflict(err error) bool {
+	var conflict *errors.EditLockConflictError
+	return stderrors.As(err, &conflict)
+}
+
+// IsStatusTransitionConflict はエラーが読み込み後のステータスの変更による遷移の競合を表すかを返します
+func IsStatusTransitionConflict(err error) bool {
+	var conflict *errors.StatusTransitionConflictError
+	return stderrors.As(err, &conflict)
+}
+
+func hasDomainCode(err error, code string) bool {
+	var domainErr errors.DomainError
+	return stderrors.As(err, &domainErr) && domainErr.ErrorCode() == code
+}
+
+func hasAPIStatus(err error, status int) bool {
+	var apiErr *errors.APIError
+	return stderrors.As(err, &apiErr) && apiErr.Status == status
+}
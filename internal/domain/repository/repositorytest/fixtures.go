@@ -0,0 +1,145 @@
+package repositorytest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+)
+
+// baseTime はテストデータの基準日時
+// PostgreSQLのTIMESTAMP列（マイクロ秒精度）・DATE列でも往復で値が変わらないよう、UTCの0時を使用します
+var baseTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newSuite(id string, createdAt time.Time) *entity.TestSuite {
+	return &entity.TestSuite{
+		ID:                 id,
+		Name:               "スイート " + id,
+		Description:        "契約テスト用のスイート",
+		Status:             valueobject.SuiteStatusPreparation,
+		EstimatedStartDate: createdAt,
+		EstimatedEndDate:   createdAt.AddDate(0, 1, 0),
+		ExitCriteria:       entity.DefaultExitCriteria(),
+		Version:            entity.InitialVersion,
+		CreatedAt:          createdAt,
+		UpdatedAt:          createdAt,
+	}
+}
+
+func newGroup(id, suiteID string, displayOrder int) *entity.TestGroup {
+	return &entity.TestGroup{
+		ID:           id,
+		SuiteID:      suiteID,
+		Name:         "グループ " + id,
+		Description:  "契約テスト用のグループ",
+		DisplayOrder: displayOrder,
+		Status:       valueobject.SuiteStatusPreparation,
+		Version:      entity.InitialVersion,
+		CreatedAt:    baseTime,
+		UpdatedAt:    baseTime,
+	}
+}
+
+func newCase(id, groupID string) *entity.TestCase {
+	return &entity.TestCase{
+		ID:            id,
+		GroupID:       groupID,
+		Title:         "ケース " + id,
+		Description:   "契約テスト用のケース",
+		Status:        entity.TestStatusCreated,
+		Priority:      entity.PriorityMedium,
+		PlannedEffort: 2,
+		Version:       entity.InitialVersion,
+		CreatedAt:     baseTime,
+		UpdatedAt:     baseTime,
+	}
+}
+
+func mustCreateSuite(t *testing.T, repos Repositories, suite *entity.TestSuite) {
+	t.Helper()
+	if err := repos.TestSuite.Create(context.Background(), suite); err != nil {
+		t.Fatalf("failed to create suite %s: %v", suite.ID, err)
+	}
+}
+
+func mustCreateGroup(t *testing.T, repos Repositories, group *entity.TestGroup) {
+	t.Helper()
+	if err := repos.TestGroup.Create(context.Background(), group); err != nil {
+		t.Fatalf("failed to create group %s: %v", group.ID, err)
+	}
+}
+
+func mustCreateCase(t *testing.T, repos Repositories, tc *entity.TestCase) {
+	t.Helper()
+	if err := repos.TestCase.Create(context.Background(), tc); err != nil {
+		t.Fatalf("failed to create case %s: %v", tc.ID, err)
+	}
+}
+
+// seedCase はケースとその親のスイート・グループを作成します
+func seedCase(t *testing.T, repos Repositories, suiteID, groupID, caseID string) {
+	t.Helper()
+	mustCreateSuite(t, repos, newSuite(suiteID, baseTime))
+	mustCreateGroup(t, repos, newGroup(groupID, suiteID, 1))
+	mustCreateCase(t, repos, newCase(caseID, groupID))
+}
+
+// runConcurrently はfnをn個のゴルーチンで同時に実行し、成功（trueを返した）件数を返します
+func runConcurrently(n int, fn func(i int) bool) int {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	start := make(chan struct{})
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			if fn(i) {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	return succeeded
+}
+
+// ids はエンティティのIDを順に取り出します
+func ids[T any](items []*T, id func(*T) string) []string {
+	result := make([]string, len(items))
+	for i, item := range items {
+		result[i] = id(item)
+	}
+	return result
+}
+
+func suiteIDs(suites []*entity.TestSuite) []string {
+	return ids(suites, func(s *entity.TestSuite) string { return s.ID })
+}
+
+func groupIDs(groups []*entity.TestGroup) []string {
+	return ids(groups, func(g *entity.TestGroup) string { return g.ID })
+}
+
+func caseIDs(cases []*entity.TestCase) []string {
+	return ids(cases, func(c *entity.TestCase) string { return c.ID })
+}
+
+// sortedIDs は並び順を問わない比較のためにIDを整列します
+func sortedIDs(ids []string) []string {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func equalIDs(got, want []string) bool {
+	return fmt.Sprint(got) == fmt.Sprint(want)
+}
@@ -0,0 +1,204 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+)
+
+func newEffortRecord(caseID string, recordDate time.Time, amount float64) *entity.EffortRecord {
+	return &entity.EffortRecord{
+		TestCaseID:   caseID,
+		RecordDate:   recordDate,
+		EffortAmount: amount,
+		Comment:      "契約テスト用の工数",
+		RecordedBy:   "user_1",
+		CreatedAt:    baseTime,
+	}
+}
+
+func newStatusHistory(caseID string, changedAt time.Time) *entity.StatusHistory {
+	return &entity.StatusHistory{
+		TestCaseID: caseID,
+		OldStatus:  entity.TestStatusCreated,
+		NewStatus:  entity.TestStatusTesting,
+		ChangedAt:  changedAt,
+		ChangedBy:  "user_1",
+		Reason:     "契約テスト",
+	}
+}
+
+// RunEffortRecordRepository はEffortRecordRepositoryの契約テストを実行します
+func RunEffortRecordRepository(t *testing.T, newRepositories Factory) {
+	ctx := context.Background()
+
+	t.Run("作成時に採番されたIDで取得・更新できる", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+
+		first := newEffortRecord("TS001TG01TC001", baseTime, 1.5)
+		second := newEffortRecord("TS001TG01TC001", baseTime, 2)
+		for _, record := range []*entity.EffortRecord{first, second} {
+			if err := repos.EffortRecord.Create(ctx, record); err != nil {
+				t.Fatalf("Create failed: %v", err)
+			}
+		}
+		if first.ID == "" || first.ID == second.ID {
+			t.Fatalf("expected distinct generated ids, got %q and %q", first.ID, second.ID)
+		}
+
+		got, err := repos.EffortRecord.FindByID(ctx, first.ID)
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if got.TestCaseID != "TS001TG01TC001" || got.EffortAmount != 1.5 || got.RecordedBy != "user_1" || !got.RecordDate.Equal(baseTime) {
+			t.Errorf("unexpected record: %+v", got)
+		}
+
+		got.EffortAmount = 3
+		got.Comment = "修正後"
+		if err := repos.EffortRecord.Update(ctx, got); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		updated, _ := repos.EffortRecord.FindByID(ctx, first.ID)
+		if updated.EffortAmount != 3 || updated.Comment != "修正後" {
+			t.Errorf("unexpected record after update: %+v", updated)
+		}
+	})
+
+	t.Run("存在しない工数記録・ケースはエラーになる", func(t *testing.T) {
+		repos := newRepositories(t)
+
+		for _, id := range []string{"999999", "abc"} {
+			if _, err := repos.EffortRecord.FindByID(ctx, id); !IsNotFound(err) {
+				t.Errorf("FindByID(%q): expected not found, got %v", id, err)
+			}
+		}
+		missing := newEffortRecord("TS001TG01TC001", baseTime, 1)
+		missing.ID = "999999"
+		if err := repos.EffortRecord.Update(ctx, missing); !IsNotFound(err) {
+			t.Errorf("Update: expected not found, got %v", err)
+		}
+		if err := repos.EffortRecord.Create(ctx, newEffortRecord("TS999TG01TC001", baseTime, 1)); !IsValidation(err) {
+			t.Errorf("Create: expected validation error for missing case, got %v", err)
+		}
+	})
+
+	t.Run("ケース別は記録日順、記録者別は指定日の記録を返す", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		mustCreateCase(t, repos, newCase("TS001TG01TC002", "TS001TG01"))
+
+		day1 := baseTime
+		day2 := baseTime.AddDate(0, 0, 1)
+		records := []*entity.EffortRecord{
+			newEffortRecord("TS001TG01TC001", day2, 1),
+			newEffortRecord("TS001TG01TC001", day1, 2),
+			newEffortRecord("TS001TG01TC001", day2, 3),
+			newEffortRecord("TS001TG01TC002", day2, 4),
+		}
+		records[3].RecordedBy = "user_2"
+		for _, record := range records {
+			if err := repos.EffortRecord.Create(ctx, record); err != nil {
+				t.Fatalf("Create failed: %v", err)
+			}
+		}
+
+		byCase, err := repos.EffortRecord.FindByTestCaseID(ctx, "TS001TG01TC001")
+		if err != nil {
+			t.Fatalf("FindByTestCaseID failed: %v", err)
+		}
+		// 同じ記録日の中では作成順
+		if got, want := amounts(byCase), []float64{2, 1, 3}; !equalAmounts(got, want) {
+			t.Errorf("amounts = %v, want %v", got, want)
+		}
+
+		// 時刻を含む日時を指定しても日付単位で検索する
+		byRecorder, err := repos.EffortRecord.FindByRecorderAndDate(ctx, "user_1", day2.Add(15*time.Hour))
+		if err != nil {
+			t.Fatalf("FindByRecorderAndDate failed: %v", err)
+		}
+		if got, want := amounts(byRecorder), []float64{1, 3}; !equalAmounts(got, want) {
+			t.Errorf("amounts = %v, want %v", got, want)
+		}
+	})
+}
+
+// RunStatusHistoryRepository はStatusHistoryRepositoryの契約テストを実行します
+func RunStatusHistoryRepository(t *testing.T, newRepositories Factory) {
+	ctx := context.Background()
+
+	t.Run("ケースの変更履歴を変更日時順に返す", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		mustCreateCase(t, repos, newCase("TS001TG01TC002", "TS001TG01"))
+
+		histories := []*entity.StatusHistory{
+			newStatusHistory("TS001TG01TC001", baseTime.Add(2*time.Hour)),
+			newStatusHistory("TS001TG01TC001", baseTime),
+			newStatusHistory("TS001TG01TC001", baseTime.Add(2*time.Hour)),
+			newStatusHistory("TS001TG01TC002", baseTime),
+		}
+		histories[0].Reason = "2件目"
+		histories[1].Reason = "1件目"
+		histories[2].Reason = "3件目"
+		for _, history := range histories {
+			if err := repos.StatusHistory.Create(ctx, history); err != nil {
+				t.Fatalf("Create failed: %v", err)
+			}
+		}
+		if histories[0].ID == "" || histories[0].ID == histories[1].ID {
+			t.Fatalf("expected distinct generated ids, got %q and %q", histories[0].ID, histories[1].ID)
+		}
+
+		got, err := repos.StatusHistory.FindByTestCaseID(ctx, "TS001TG01TC001")
+		if err != nil {
+			t.Fatalf("FindByTestCaseID failed: %v", err)
+		}
+		reasons := ids(got, func(h *entity.StatusHistory) string { return h.Reason })
+		if want := []string{"1件目", "2件目", "3件目"}; !equalIDs(reasons, want) {
+			t.Errorf("reasons = %v, want %v", reasons, want)
+		}
+		if got[0].OldStatus != entity.TestStatusCreated || got[0].NewStatus != entity.TestStatusTesting || got[0].ChangedBy != "user_1" {
+			t.Errorf("unexpected history: %+v", got[0])
+		}
+
+		none, err := repos.StatusHistory.FindByTestCaseID(ctx, "TS999TG01TC001")
+		if err != nil {
+			t.Fatalf("FindByTestCaseID failed: %v", err)
+		}
+		if len(none) != 0 {
+			t.Errorf("expected no histories, got %d", len(none))
+		}
+	})
+
+	t.Run("存在しないケースの履歴は作成できない", func(t *testing.T) {
+		repos := newRepositories(t)
+
+		if err := repos.StatusHistory.Create(ctx, newStatusHistory("TS999TG01TC001", baseTime)); !IsValidation(err) {
+			t.Errorf("expected validation error for missing case, got %v", err)
+		}
+	})
+}
+
+func amounts(records []*entity.EffortRecord) []float64 {
+	result := make([]float64, len(records))
+	for i, record := range records {
+		result[i] = record.EffortAmount
+	}
+	return result
+}
+
+func equalAmounts(got, want []float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
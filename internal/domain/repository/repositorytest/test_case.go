@@ -0,0 +1,554 @@
+package repositorytest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+)
+
+// RunTestCaseRepository はTestCaseRepositoryの契約テストを実行します
+func RunTestCaseRepository(t *testing.T, newRepositories Factory) {
+	ctx := context.Background()
+
+	t.Run("作成したケースをIDで取得できる", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+		tc := newCase("TS001TG01TC001", "TS001TG01")
+		tc.Priority = entity.PriorityCritical
+		tc.DueDate = baseTime.AddDate(0, 0, 10)
+		mustCreateCase(t, repos, tc)
+
+		got, err := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if got.GroupID != "TS001TG01" || got.Title != tc.Title || got.Status != tc.Status || got.Priority != entity.PriorityCritical {
+			t.Errorf("unexpected case: %+v", got)
+		}
+		if got.PlannedEffort != tc.PlannedEffort || !got.DueDate.Equal(tc.DueDate) {
+			t.Errorf("effort/due date mismatch: %+v", got)
+		}
+		if got.IsLocked || got.CurrentEditor != "" || got.Version != entity.InitialVersion {
+			t.Errorf("new case should be unlocked with the initial version: %+v", got)
+		}
+
+		if err := repos.TestCase.Create(ctx, newCase("TS001TG01TC001", "TS001TG01")); !IsConflict(err) {
+			t.Errorf("expected conflict for duplicate id, got %v", err)
+		}
+		if err := repos.TestCase.Create(ctx, newCase("TS001TG99TC001", "TS001TG99")); !IsValidation(err) {
+			t.Errorf("expected validation error for missing group, got %v", err)
+		}
+	})
+
+	t.Run("存在しないケースは未検出エラーになる", func(t *testing.T) {
+		repos := newRepositories(t)
+
+		if _, err := repos.TestCase.FindByID(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("FindByID: expected not found, got %v", err)
+		}
+		if err := repos.TestCase.Update(ctx, newCase("missing", "TS001TG01")); !IsNotFound(err) {
+			t.Errorf("Update: expected not found, got %v", err)
+		}
+		if err := repos.TestCase.UpdateStatus(ctx, "missing", entity.TestStatusCreated, entity.TestStatusTesting, entity.InitialVersion); !IsNotFound(err) {
+			t.Errorf("UpdateStatus: expected not found, got %v", err)
+		}
+		if err := repos.TestCase.AddEffort(ctx, "missing", 1); !IsNotFound(err) {
+			t.Errorf("AddEffort: expected not found, got %v", err)
+		}
+		if err := repos.TestCase.UpdateDelay(ctx, "missing", entity.TestStatusCreated, true, 1); !IsNotFound(err) {
+			t.Errorf("UpdateDelay: expected not found, got %v", err)
+		}
+		if _, err := repos.TestCase.AcquireLock(ctx, "missing", "user_1", baseTime); !IsNotFound(err) {
+			t.Errorf("AcquireLock: expected not found, got %v", err)
+		}
+		if err := repos.TestCase.ReleaseLock(ctx, "missing", "user_1"); !IsNotFound(err) {
+			t.Errorf("ReleaseLock: expected not found, got %v", err)
+		}
+		if err := repos.TestCase.Delete(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("Delete: expected not found, got %v", err)
+		}
+		if err := repos.TestCase.SoftDelete(ctx, "missing", baseTime); !IsNotFound(err) {
+			t.Errorf("SoftDelete: expected not found, got %v", err)
+		}
+		if err := repos.TestCase.Restore(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("Restore: expected not found, got %v", err)
+		}
+		if _, err := repos.TestCase.FindDeletedByID(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("FindDeletedByID: expected not found, got %v", err)
+		}
+	})
+
+	t.Run("FindByGroupIDは削除されていないケースをID順に返す", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+		mustCreateGroup(t, repos, newGroup("TS001TG02", "TS001", 2))
+		for _, id := range []string{"TS001TG01TC003", "TS001TG01TC001", "TS001TG01TC004", "TS001TG01TC002"} {
+			mustCreateCase(t, repos, newCase(id, "TS001TG01"))
+		}
+		mustCreateCase(t, repos, newCase("TS001TG02TC001", "TS001TG02"))
+		if err := repos.TestCase.SoftDelete(ctx, "TS001TG01TC004", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		got, err := repos.TestCase.FindByGroupID(ctx, "TS001TG01")
+		if err != nil {
+			t.Fatalf("FindByGroupID failed: %v", err)
+		}
+		if want := []string{"TS001TG01TC001", "TS001TG01TC002", "TS001TG01TC003"}; !equalIDs(caseIDs(got), want) {
+			t.Errorf("ids = %v, want %v", caseIDs(got), want)
+		}
+	})
+
+	t.Run("FindByGroupIDsは複数グループのケースをグループID・ID順に返す", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		for i, id := range []string{"TS001TG01", "TS001TG02", "TS001TG03"} {
+			mustCreateGroup(t, repos, newGroup(id, "TS001", i+1))
+		}
+		for _, id := range []string{"TS001TG02TC002", "TS001TG02TC001", "TS001TG01TC001", "TS001TG01TC002"} {
+			mustCreateCase(t, repos, newCase(id, id[:9]))
+		}
+		mustCreateCase(t, repos, newCase("TS001TG03TC001", "TS001TG03"))
+		if err := repos.TestCase.SoftDelete(ctx, "TS001TG01TC002", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		got, err := repos.TestCase.FindByGroupIDs(ctx, []string{"TS001TG02", "TS001TG01"})
+		if err != nil {
+			t.Fatalf("FindByGroupIDs failed: %v", err)
+		}
+		if want := []string{"TS001TG01TC001", "TS001TG02TC001", "TS001TG02TC002"}; !equalIDs(caseIDs(got), want) {
+			t.Errorf("ids = %v, want %v", caseIDs(got), want)
+		}
+
+		empty, err := repos.TestCase.FindByGroupIDs(ctx, []string{})
+		if err != nil {
+			t.Fatalf("FindByGroupIDs failed: %v", err)
+		}
+		if len(empty) != 0 {
+			t.Errorf("expected no cases, got %v", caseIDs(empty))
+		}
+	})
+
+	t.Run("更新は編集ロックを変更せずバージョンを進める", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		if ok, err := repos.TestCase.AcquireLock(ctx, "TS001TG01TC001", "user_1", time.Now().Add(time.Hour)); err != nil || !ok {
+			t.Fatalf("AcquireLock failed: ok=%v err=%v", ok, err)
+		}
+
+		loaded, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+		loaded.Title = "更新後"
+		loaded.Status = entity.TestStatusTesting
+		loaded.IsLocked = false
+		loaded.CurrentEditor = ""
+		if err := repos.TestCase.Update(ctx, loaded); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		if loaded.Version != entity.InitialVersion+1 {
+			t.Errorf("updated entity should carry the new version, got %d", loaded.Version)
+		}
+		if err := repos.TestCase.Update(ctx, newCase("TS001TG01TC001", "TS001TG01")); !IsConcurrentModification(err) {
+			t.Errorf("expected concurrent modification for stale version, got %v", err)
+		}
+
+		got, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+		if got.Title != "更新後" || got.Status != entity.TestStatusTesting {
+			t.Errorf("unexpected case after update: %+v", got)
+		}
+		if !got.IsLocked || got.CurrentEditor != "user_1" {
+			t.Errorf("lock should be unchanged: locked=%v editor=%q", got.IsLocked, got.CurrentEditor)
+		}
+		if got.Version != entity.InitialVersion+1 {
+			t.Errorf("expected version %d, got %d", entity.InitialVersion+1, got.Version)
+		}
+	})
+
+	t.Run("読み込み後に他のユーザーが編集ロックを取得した場合は保持者以外の更新は競合エラーになる", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		read, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+
+		// ロックの取得ではバージョンは進まないため、バージョンの比較だけでは検出できない
+		expiresAt := time.Now().Add(time.Hour)
+		if ok, err := repos.TestCase.AcquireLock(ctx, read.ID, "user_2", expiresAt); err != nil || !ok {
+			t.Fatalf("AcquireLock failed: ok=%v err=%v", ok, err)
+		}
+		stale := *read
+		stale.Title = "ロックを持たないユーザーの更新"
+		if err := repos.TestCase.UpdateAsEditor(ctx, &stale, "user_1"); !IsEditLockConflict(err) {
+			t.Errorf("expected edit lock conflict, got %v", err)
+		}
+
+		holder := *read
+		holder.Title = "ロックを保持するユーザーの更新"
+		if err := repos.TestCase.UpdateAsEditor(ctx, &holder, "user_2"); err != nil {
+			t.Fatalf("UpdateAsEditor by lock holder failed: %v", err)
+		}
+		// バージョンが進んだ後の古い読み込みは同時更新の競合エラーになる
+		if err := repos.TestCase.UpdateAsEditor(ctx, &stale, "user_2"); !IsConcurrentModification(err) {
+			t.Errorf("expected concurrent modification for stale version, got %v", err)
+		}
+
+		got, _ := repos.TestCase.FindByID(ctx, read.ID)
+		if got.Title != "ロックを保持するユーザーの更新" || got.Version != read.Version+1 {
+			t.Errorf("only the lock holder's update should be applied: %+v", got)
+		}
+		if !got.IsLocked || got.CurrentEditor != "user_2" {
+			t.Errorf("lock should be unchanged: locked=%v editor=%q", got.IsLocked, got.CurrentEditor)
+		}
+		if err := repos.TestCase.UpdateAsEditor(ctx, newCase("missing", "TS001TG01"), "user_1"); !IsNotFound(err) {
+			t.Errorf("expected not found, got %v", err)
+		}
+	})
+
+	t.Run("期限切れの編集ロックは他のユーザーの更新を妨げない", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		if ok, err := repos.TestCase.AcquireLock(ctx, "TS001TG01TC001", "user_2", time.Now().Add(-time.Minute)); err != nil || !ok {
+			t.Fatalf("AcquireLock failed: ok=%v err=%v", ok, err)
+		}
+
+		read, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+		read.Title = "更新後"
+		if err := repos.TestCase.UpdateAsEditor(ctx, read, "user_1"); err != nil {
+			t.Fatalf("UpdateAsEditor failed: %v", err)
+		}
+	})
+
+	t.Run("同じバージョンからの同時更新は1件だけ成功する", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+
+		succeeded := runConcurrently(8, func(i int) bool {
+			tc := newCase("TS001TG01TC001", "TS001TG01")
+			tc.Title = fmt.Sprintf("更新 %d", i)
+			err := repos.TestCase.Update(ctx, tc)
+			if err != nil && !IsConcurrentModification(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			return err == nil
+		})
+		if succeeded != 1 {
+			t.Errorf("expected exactly one successful update, got %d", succeeded)
+		}
+	})
+
+	t.Run("工数の加算・遅延・ステータスの更新", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+
+		for _, effort := range []float64{1.5, 2.25} {
+			if err := repos.TestCase.AddEffort(ctx, "TS001TG01TC001", effort); err != nil {
+				t.Fatalf("AddEffort failed: %v", err)
+			}
+		}
+		if err := repos.TestCase.UpdateDelay(ctx, "TS001TG01TC001", entity.TestStatusCreated, true, 3); err != nil {
+			t.Fatalf("UpdateDelay failed: %v", err)
+		}
+		// 遅延状態の更新ではバージョンは進まない
+		if got, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001"); got.Version != entity.InitialVersion+2 {
+			t.Errorf("expected version %d after UpdateDelay, got %d", entity.InitialVersion+2, got.Version)
+		}
+		if err := repos.TestCase.UpdateStatus(ctx, "TS001TG01TC001", entity.TestStatusCreated, entity.TestStatusFixing, entity.InitialVersion+2); err != nil {
+			t.Fatalf("UpdateStatus failed: %v", err)
+		}
+
+		got, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+		if got.ActualEffort != 3.75 {
+			t.Errorf("ActualEffort = %v, want 3.75", got.ActualEffort)
+		}
+		if !got.IsDelayed || got.DelayDays != 3 || got.Status != entity.TestStatusFixing {
+			t.Errorf("unexpected case: %+v", got)
+		}
+		if got.Version != entity.InitialVersion+3 {
+			t.Errorf("expected version %d, got %d", entity.InitialVersion+3, got.Version)
+		}
+	})
+
+	t.Run("読み込み後に遅延状態が更新されても編集は競合せず遅延状態も上書きしない", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		read, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+
+		if err := repos.TestCase.UpdateDelay(ctx, read.ID, read.Status, true, 2); err != nil {
+			t.Fatalf("UpdateDelay failed: %v", err)
+		}
+		read.Title = "編集後のタイトル"
+		if err := repos.TestCase.Update(ctx, read); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+
+		got, _ := repos.TestCase.FindByID(ctx, read.ID)
+		if got.Title != "編集後のタイトル" || !got.IsDelayed || got.DelayDays != 2 {
+			t.Errorf("unexpected case: %+v", got)
+		}
+	})
+
+	t.Run("遅延状態の判定後にステータスが変わっていた場合は遅延状態を更新しない", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		read, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+
+		// 遅延検知が読み込んだ後にユーザーがケースを完了した
+		if err := repos.TestCase.UpdateStatus(ctx, read.ID, read.Status, entity.TestStatusCompleted, read.Version); err != nil {
+			t.Fatalf("UpdateStatus failed: %v", err)
+		}
+		if err := repos.TestCase.UpdateDelay(ctx, read.ID, read.Status, true, 3); !IsStatusTransitionConflict(err) {
+			t.Errorf("expected status transition conflict, got %v", err)
+		}
+
+		got, _ := repos.TestCase.FindByID(ctx, read.ID)
+		if got.IsDelayed || got.DelayDays != 0 || got.Status != entity.TestStatusCompleted {
+			t.Errorf("completed case should not be marked delayed: %+v", got)
+		}
+	})
+
+	t.Run("同じ状態から行った2つのステータス遷移は後の方が競合エラーになる", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		read, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+
+		if err := repos.TestCase.UpdateStatus(ctx, read.ID, read.Status, entity.TestStatusTesting, read.Version); err != nil {
+			t.Fatalf("first UpdateStatus failed: %v", err)
+		}
+		if err := repos.TestCase.UpdateStatus(ctx, read.ID, read.Status, entity.TestStatusCompleted, read.Version); !IsStatusTransitionConflict(err) {
+			t.Errorf("expected status transition conflict, got %v", err)
+		}
+
+		got, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+		if got.Status != entity.TestStatusTesting || got.Version != read.Version+1 {
+			t.Errorf("second transition should not be applied: %+v", got)
+		}
+	})
+
+	t.Run("読み込み後にステータス以外が更新されていた場合は同時更新の競合エラーになる", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		read, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+
+		if err := repos.TestCase.AddEffort(ctx, read.ID, 1); err != nil {
+			t.Fatalf("AddEffort failed: %v", err)
+		}
+		if err := repos.TestCase.UpdateStatus(ctx, read.ID, read.Status, entity.TestStatusTesting, read.Version); !IsConcurrentModification(err) {
+			t.Errorf("expected concurrent modification, got %v", err)
+		}
+
+		got, _ := repos.TestCase.FindByID(ctx, "TS001TG01TC001")
+		if got.Status != entity.TestStatusCreated {
+			t.Errorf("status should not be updated: %+v", got)
+		}
+	})
+
+	t.Run("FindByStatusは指定したステータスの削除されていないケースを返す", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		for _, id := range []string{"TS001TG01TC002", "TS001TG01TC003", "TS001TG01TC004"} {
+			mustCreateCase(t, repos, newCase(id, "TS001TG01"))
+		}
+		for _, id := range []string{"TS001TG01TC001", "TS001TG01TC003", "TS001TG01TC004"} {
+			if err := repos.TestCase.UpdateStatus(ctx, id, entity.TestStatusCreated, entity.TestStatusCompleted, entity.InitialVersion); err != nil {
+				t.Fatalf("UpdateStatus failed: %v", err)
+			}
+		}
+		if err := repos.TestCase.SoftDelete(ctx, "TS001TG01TC004", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		got, err := repos.TestCase.FindByStatus(ctx, entity.TestStatusCompleted)
+		if err != nil {
+			t.Fatalf("FindByStatus failed: %v", err)
+		}
+		// 更新日時は実装（DBのトリガー等）が設定するため、並び順ではなく件数と内容のみ確認する
+		if want := []string{"TS001TG01TC001", "TS001TG01TC003"}; !equalIDs(sortedIDs(caseIDs(got)), want) {
+			t.Errorf("ids = %v, want %v", caseIDs(got), want)
+		}
+	})
+
+	t.Run("編集ロックは保持者・期限に従って取得できる", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		id := "TS001TG01TC001"
+		later := time.Now().Add(time.Hour)
+
+		steps := []struct {
+			name   string
+			editor string
+			want   bool
+		}{
+			{name: "ロックなしなら取得できる", editor: "user_1", want: true},
+			{name: "保持者は延長できる", editor: "user_1", want: true},
+			{name: "他のユーザーは取得できない", editor: "user_2", want: false},
+		}
+		for _, step := range steps {
+			ok, err := repos.TestCase.AcquireLock(ctx, id, step.editor, later)
+			if err != nil {
+				t.Fatalf("%s: AcquireLock failed: %v", step.name, err)
+			}
+			if ok != step.want {
+				t.Errorf("%s: got %v, want %v", step.name, ok, step.want)
+			}
+		}
+
+		// 保持者以外による解除は何もしない
+		if err := repos.TestCase.ReleaseLock(ctx, id, "user_2"); err != nil {
+			t.Fatalf("ReleaseLock failed: %v", err)
+		}
+		got, _ := repos.TestCase.FindByID(ctx, id)
+		if !got.IsLocked || got.CurrentEditor != "user_1" {
+			t.Errorf("lock should still be held by user_1: %+v", got)
+		}
+		if got.Version != entity.InitialVersion {
+			t.Errorf("lock operations should not change the version, got %d", got.Version)
+		}
+
+		if err := repos.TestCase.ReleaseLock(ctx, id, "user_1"); err != nil {
+			t.Fatalf("ReleaseLock failed: %v", err)
+		}
+		got, _ = repos.TestCase.FindByID(ctx, id)
+		if got.IsLocked || got.CurrentEditor != "" {
+			t.Errorf("lock should be released: %+v", got)
+		}
+
+		// 期限切れのロックは他のユーザーが取得できる
+		if ok, err := repos.TestCase.AcquireLock(ctx, id, "user_1", time.Now().Add(-time.Minute)); err != nil || !ok {
+			t.Fatalf("AcquireLock failed: ok=%v err=%v", ok, err)
+		}
+		if ok, err := repos.TestCase.AcquireLock(ctx, id, "user_2", later); err != nil || !ok {
+			t.Errorf("expired lock should be taken over: ok=%v err=%v", ok, err)
+		}
+	})
+
+	t.Run("同時に編集ロックを取得できるのは1人だけ", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		expiresAt := time.Now().Add(time.Hour)
+
+		succeeded := runConcurrently(8, func(i int) bool {
+			ok, err := repos.TestCase.AcquireLock(ctx, "TS001TG01TC001", fmt.Sprintf("user_%d", i), expiresAt)
+			if err != nil {
+				t.Errorf("AcquireLock failed: %v", err)
+			}
+			return ok
+		})
+		if succeeded != 1 {
+			t.Errorf("expected exactly one editor to acquire the lock, got %d", succeeded)
+		}
+	})
+
+	t.Run("グループ単位の論理削除と復元・ゴミ箱", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+		mustCreateGroup(t, repos, newGroup("TS001TG02", "TS001", 2))
+		for _, id := range []string{"TS001TG01TC001", "TS001TG01TC002", "TS001TG01TC003"} {
+			mustCreateCase(t, repos, newCase(id, "TS001TG01"))
+		}
+		mustCreateCase(t, repos, newCase("TS001TG02TC001", "TS001TG02"))
+
+		individually := baseTime.AddDate(0, 0, 1)
+		withGroup := baseTime.AddDate(0, 0, 2)
+		if err := repos.TestCase.SoftDelete(ctx, "TS001TG01TC001", individually); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+		if err := repos.TestCase.SoftDelete(ctx, "TS001TG02TC001", individually); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+		if err := repos.TestCase.SoftDeleteByGroupID(ctx, "TS001TG01", withGroup); err != nil {
+			t.Fatalf("SoftDeleteByGroupID failed: %v", err)
+		}
+		if err := repos.TestGroup.SoftDelete(ctx, "TS001TG01", withGroup); err != nil {
+			t.Fatalf("SoftDelete group failed: %v", err)
+		}
+
+		// 削除されたグループに属するケースはゴミ箱に表示しない
+		trash, err := repos.TestCase.FindDeleted(ctx)
+		if err != nil {
+			t.Fatalf("FindDeleted failed: %v", err)
+		}
+		if want := []string{"TS001TG02TC001"}; !equalIDs(caseIDs(trash), want) {
+			t.Errorf("trash = %v, want %v", caseIDs(trash), want)
+		}
+
+		if err := repos.TestGroup.Restore(ctx, "TS001TG01"); err != nil {
+			t.Fatalf("Restore group failed: %v", err)
+		}
+		if err := repos.TestCase.RestoreByGroupID(ctx, "TS001TG01", withGroup); err != nil {
+			t.Fatalf("RestoreByGroupID failed: %v", err)
+		}
+		active, _ := repos.TestCase.FindByGroupID(ctx, "TS001TG01")
+		if want := []string{"TS001TG01TC002", "TS001TG01TC003"}; !equalIDs(caseIDs(active), want) {
+			t.Errorf("restored = %v, want %v", caseIDs(active), want)
+		}
+
+		trash, _ = repos.TestCase.FindDeleted(ctx)
+		if want := []string{"TS001TG01TC001", "TS001TG02TC001"}; !equalIDs(sortedIDs(caseIDs(trash)), want) {
+			t.Errorf("trash = %v, want %v", caseIDs(trash), want)
+		}
+		if err := repos.TestCase.Restore(ctx, "TS001TG01TC001"); err != nil {
+			t.Fatalf("Restore failed: %v", err)
+		}
+		if err := repos.TestCase.Restore(ctx, "TS001TG01TC001"); !IsNotFound(err) {
+			t.Errorf("restoring an active case should be not found, got %v", err)
+		}
+	})
+
+	t.Run("完全削除は工数記録と変更履歴もあわせて削除する", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		mustCreateCase(t, repos, newCase("TS001TG01TC002", "TS001TG01"))
+		id := "TS001TG01TC001"
+
+		record := newEffortRecord(id, baseTime, 1)
+		if err := repos.EffortRecord.Create(ctx, record); err != nil {
+			t.Fatalf("failed to create effort record: %v", err)
+		}
+		if err := repos.StatusHistory.Create(ctx, newStatusHistory(id, baseTime)); err != nil {
+			t.Fatalf("failed to create status history: %v", err)
+		}
+		if err := repos.TestCase.SoftDelete(ctx, id, baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+		if err := repos.TestCase.SoftDelete(ctx, "TS001TG01TC002", baseTime.AddDate(0, 1, 0)); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		purged, err := repos.TestCase.PurgeDeletedBefore(ctx, baseTime.AddDate(0, 0, 1))
+		if err != nil {
+			t.Fatalf("PurgeDeletedBefore failed: %v", err)
+		}
+		if purged != 1 {
+			t.Errorf("purged = %d, want 1", purged)
+		}
+		if _, err := repos.TestCase.FindDeletedByID(ctx, id); !IsNotFound(err) {
+			t.Errorf("case should be purged, got %v", err)
+		}
+		if _, err := repos.EffortRecord.FindByID(ctx, record.ID); !IsNotFound(err) {
+			t.Errorf("effort record should be purged, got %v", err)
+		}
+		histories, _ := repos.StatusHistory.FindByTestCaseID(ctx, id)
+		if len(histories) != 0 {
+			t.Errorf("status histories should be purged, got %d", len(histories))
+		}
+		if _, err := repos.TestCase.FindDeletedByID(ctx, "TS001TG01TC002"); err != nil {
+			t.Errorf("recently deleted case should remain: %v", err)
+		}
+	})
+
+	t.Run("工数記録が残っているケースは物理削除できない", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+		if err := repos.EffortRecord.Create(ctx, newEffortRecord("TS001TG01TC001", baseTime, 1)); err != nil {
+			t.Fatalf("failed to create effort record: %v", err)
+		}
+
+		if err := repos.TestCase.Delete(ctx, "TS001TG01TC001"); !IsConflict(err) {
+			t.Errorf("expected conflict, got %v", err)
+		}
+	})
+}
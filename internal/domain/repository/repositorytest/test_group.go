@@ -0,0 +1,313 @@
+package repositorytest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+)
+
+// RunTestGroupRepository はTestGroupRepositoryの契約テストを実行します
+func RunTestGroupRepository(t *testing.T, newRepositories Factory) {
+	ctx := context.Background()
+
+	t.Run("作成したグループをIDで取得できる", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		group := newGroup("TS001TG01", "TS001", 3)
+		group.StatusLocked = true
+		mustCreateGroup(t, repos, group)
+
+		got, err := repos.TestGroup.FindByID(ctx, "TS001TG01")
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if got.SuiteID != "TS001" || got.Name != group.Name || got.DisplayOrder != 3 || got.Status != group.Status {
+			t.Errorf("unexpected group: %+v", got)
+		}
+		if !got.StatusLocked || got.Version != entity.InitialVersion {
+			t.Errorf("unexpected lock/version: locked=%v version=%d", got.StatusLocked, got.Version)
+		}
+		if forUpdate, err := repos.TestGroup.FindByIDForUpdate(ctx, "TS001TG01"); err != nil || forUpdate.Version != got.Version || forUpdate.Status != got.Status {
+			t.Errorf("FindByIDForUpdate: unexpected group %+v, err %v", forUpdate, err)
+		}
+
+		if err := repos.TestGroup.Create(ctx, newGroup("TS001TG01", "TS001", 1)); !IsConflict(err) {
+			t.Errorf("expected conflict for duplicate id, got %v", err)
+		}
+		if err := repos.TestGroup.Create(ctx, newGroup("TS999TG01", "TS999", 1)); !IsValidation(err) {
+			t.Errorf("expected validation error for missing suite, got %v", err)
+		}
+	})
+
+	t.Run("存在しないグループは未検出エラーになる", func(t *testing.T) {
+		repos := newRepositories(t)
+
+		if _, err := repos.TestGroup.FindByID(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("FindByID: expected not found, got %v", err)
+		}
+		if _, err := repos.TestGroup.FindByIDForUpdate(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("FindByIDForUpdate: expected not found, got %v", err)
+		}
+		if err := repos.TestGroup.Update(ctx, newGroup("missing", "TS001", 1)); !IsNotFound(err) {
+			t.Errorf("Update: expected not found, got %v", err)
+		}
+		if err := repos.TestGroup.UpdateStatus(ctx, "missing", valueobject.SuiteStatusInProgress); !IsNotFound(err) {
+			t.Errorf("UpdateStatus: expected not found, got %v", err)
+		}
+		if err := repos.TestGroup.UpdateDisplayOrder(ctx, "missing", 2); !IsNotFound(err) {
+			t.Errorf("UpdateDisplayOrder: expected not found, got %v", err)
+		}
+		if err := repos.TestGroup.Delete(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("Delete: expected not found, got %v", err)
+		}
+		if err := repos.TestGroup.SoftDelete(ctx, "missing", baseTime); !IsNotFound(err) {
+			t.Errorf("SoftDelete: expected not found, got %v", err)
+		}
+		if err := repos.TestGroup.Restore(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("Restore: expected not found, got %v", err)
+		}
+		if _, err := repos.TestGroup.FindDeletedByID(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("FindDeletedByID: expected not found, got %v", err)
+		}
+	})
+
+	t.Run("FindBySuiteIDは削除されていないグループを表示順に返す", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateSuite(t, repos, newSuite("TS002", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 3))
+		mustCreateGroup(t, repos, newGroup("TS001TG02", "TS001", 1))
+		mustCreateGroup(t, repos, newGroup("TS001TG03", "TS001", 2))
+		mustCreateGroup(t, repos, newGroup("TS001TG04", "TS001", 4))
+		mustCreateGroup(t, repos, newGroup("TS002TG01", "TS002", 1))
+		if err := repos.TestGroup.SoftDelete(ctx, "TS001TG04", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		got, err := repos.TestGroup.FindBySuiteID(ctx, "TS001")
+		if err != nil {
+			t.Fatalf("FindBySuiteID failed: %v", err)
+		}
+		if want := []string{"TS001TG02", "TS001TG03", "TS001TG01"}; !equalIDs(groupIDs(got), want) {
+			t.Errorf("ids = %v, want %v", groupIDs(got), want)
+		}
+
+		empty, err := repos.TestGroup.FindBySuiteID(ctx, "TS999")
+		if err != nil {
+			t.Fatalf("FindBySuiteID failed: %v", err)
+		}
+		if len(empty) != 0 {
+			t.Errorf("expected no groups, got %v", groupIDs(empty))
+		}
+	})
+
+	t.Run("FindBySuiteIDsは複数スイートのグループをスイートID・表示順に返す", func(t *testing.T) {
+		repos := newRepositories(t)
+		for _, id := range []string{"TS001", "TS002", "TS003"} {
+			mustCreateSuite(t, repos, newSuite(id, baseTime))
+		}
+		mustCreateGroup(t, repos, newGroup("TS002TG01", "TS002", 2))
+		mustCreateGroup(t, repos, newGroup("TS002TG02", "TS002", 1))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+		mustCreateGroup(t, repos, newGroup("TS001TG02", "TS001", 2))
+		mustCreateGroup(t, repos, newGroup("TS003TG01", "TS003", 1))
+		if err := repos.TestGroup.SoftDelete(ctx, "TS001TG02", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		got, err := repos.TestGroup.FindBySuiteIDs(ctx, []string{"TS002", "TS001", "TS999"})
+		if err != nil {
+			t.Fatalf("FindBySuiteIDs failed: %v", err)
+		}
+		if want := []string{"TS001TG01", "TS002TG02", "TS002TG01"}; !equalIDs(groupIDs(got), want) {
+			t.Errorf("ids = %v, want %v", groupIDs(got), want)
+		}
+
+		empty, err := repos.TestGroup.FindBySuiteIDs(ctx, nil)
+		if err != nil {
+			t.Fatalf("FindBySuiteIDs failed: %v", err)
+		}
+		if len(empty) != 0 {
+			t.Errorf("expected no groups, got %v", groupIDs(empty))
+		}
+	})
+
+	t.Run("更新・ステータス変更・表示順変更はバージョンを進める", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+
+		loaded, _ := repos.TestGroup.FindByID(ctx, "TS001TG01")
+		loaded.Name = "更新後"
+		loaded.StatusLocked = true
+		if err := repos.TestGroup.Update(ctx, loaded); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		if loaded.Version != entity.InitialVersion+1 {
+			t.Errorf("updated entity should carry the new version, got %d", loaded.Version)
+		}
+		if err := repos.TestGroup.Update(ctx, newGroup("TS001TG01", "TS001", 1)); !IsConcurrentModification(err) {
+			t.Errorf("expected concurrent modification for stale version, got %v", err)
+		}
+		if err := repos.TestGroup.UpdateStatus(ctx, "TS001TG01", valueobject.SuiteStatusCompleted); err != nil {
+			t.Fatalf("UpdateStatus failed: %v", err)
+		}
+		if err := repos.TestGroup.UpdateDisplayOrder(ctx, "TS001TG01", 5); err != nil {
+			t.Fatalf("UpdateDisplayOrder failed: %v", err)
+		}
+
+		got, _ := repos.TestGroup.FindByID(ctx, "TS001TG01")
+		if got.Name != "更新後" || !got.StatusLocked || got.Status != valueobject.SuiteStatusCompleted || got.DisplayOrder != 5 {
+			t.Errorf("unexpected group after update: %+v", got)
+		}
+		if got.Version != entity.InitialVersion+3 {
+			t.Errorf("expected version %d, got %d", entity.InitialVersion+3, got.Version)
+		}
+	})
+
+	t.Run("同じバージョンからの同時更新は1件だけ成功する", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+
+		succeeded := runConcurrently(8, func(i int) bool {
+			group := newGroup("TS001TG01", "TS001", i)
+			err := repos.TestGroup.Update(ctx, group)
+			if err != nil && !IsConcurrentModification(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			return err == nil
+		})
+		if succeeded != 1 {
+			t.Errorf("expected exactly one successful update, got %d", succeeded)
+		}
+	})
+
+	t.Run("スイート単位の論理削除と復元は同じ日時に削除されたグループのみ対象", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		for i := 1; i <= 3; i++ {
+			mustCreateGroup(t, repos, newGroup(fmt.Sprintf("TS001TG%02d", i), "TS001", i))
+		}
+
+		// TG01は先に個別に削除しておく
+		individually := baseTime.AddDate(0, 0, 1)
+		withSuite := baseTime.AddDate(0, 0, 2)
+		if err := repos.TestGroup.SoftDelete(ctx, "TS001TG01", individually); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+		if err := repos.TestGroup.SoftDeleteBySuiteID(ctx, "TS001", withSuite); err != nil {
+			t.Fatalf("SoftDeleteBySuiteID failed: %v", err)
+		}
+
+		active, _ := repos.TestGroup.FindBySuiteID(ctx, "TS001")
+		if len(active) != 0 {
+			t.Errorf("expected all groups deleted, got %v", groupIDs(active))
+		}
+		first, err := repos.TestGroup.FindDeletedByID(ctx, "TS001TG01")
+		if err != nil {
+			t.Fatalf("FindDeletedByID failed: %v", err)
+		}
+		if !first.DeletedAt.Equal(individually) {
+			t.Errorf("individually deleted group should keep its DeletedAt, got %v", first.DeletedAt)
+		}
+
+		if err := repos.TestGroup.RestoreBySuiteID(ctx, "TS001", withSuite); err != nil {
+			t.Fatalf("RestoreBySuiteID failed: %v", err)
+		}
+		restored, _ := repos.TestGroup.FindBySuiteID(ctx, "TS001")
+		if want := []string{"TS001TG02", "TS001TG03"}; !equalIDs(groupIDs(restored), want) {
+			t.Errorf("restored = %v, want %v", groupIDs(restored), want)
+		}
+		if restored[0].Version != entity.InitialVersion+2 {
+			t.Errorf("expected version %d after delete and restore, got %d", entity.InitialVersion+2, restored[0].Version)
+		}
+	})
+
+	t.Run("ゴミ箱には削除されたスイートに属するグループを含めない", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateSuite(t, repos, newSuite("TS002", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+		mustCreateGroup(t, repos, newGroup("TS001TG02", "TS001", 2))
+		mustCreateGroup(t, repos, newGroup("TS002TG01", "TS002", 1))
+
+		if err := repos.TestGroup.SoftDelete(ctx, "TS001TG01", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+		if err := repos.TestGroup.SoftDelete(ctx, "TS001TG02", baseTime.Add(time.Hour)); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+		if err := repos.TestSuite.SoftDelete(ctx, "TS002", baseTime); err != nil {
+			t.Fatalf("SoftDelete suite failed: %v", err)
+		}
+		if err := repos.TestGroup.SoftDeleteBySuiteID(ctx, "TS002", baseTime); err != nil {
+			t.Fatalf("SoftDeleteBySuiteID failed: %v", err)
+		}
+
+		trash, err := repos.TestGroup.FindDeleted(ctx)
+		if err != nil {
+			t.Fatalf("FindDeleted failed: %v", err)
+		}
+		if want := []string{"TS001TG02", "TS001TG01"}; !equalIDs(groupIDs(trash), want) {
+			t.Errorf("trash = %v, want %v", groupIDs(trash), want)
+		}
+
+		if err := repos.TestGroup.Restore(ctx, "TS001TG01"); err != nil {
+			t.Fatalf("Restore failed: %v", err)
+		}
+		if err := repos.TestGroup.Restore(ctx, "TS001TG01"); !IsNotFound(err) {
+			t.Errorf("restoring an active group should be not found, got %v", err)
+		}
+	})
+
+	t.Run("完全削除はケースが残っていないグループのみ対象", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+		mustCreateGroup(t, repos, newGroup("TS001TG02", "TS001", 2))
+		mustCreateCase(t, repos, newCase("TS001TG02TC001", "TS001TG02"))
+
+		for _, id := range []string{"TS001TG01", "TS001TG02"} {
+			if err := repos.TestGroup.SoftDelete(ctx, id, baseTime); err != nil {
+				t.Fatalf("SoftDelete failed: %v", err)
+			}
+		}
+
+		if purged, err := repos.TestGroup.PurgeDeletedBefore(ctx, baseTime); err != nil || purged != 0 {
+			t.Errorf("nothing should be purged before the deletion time, got %d (%v)", purged, err)
+		}
+		purged, err := repos.TestGroup.PurgeDeletedBefore(ctx, baseTime.AddDate(0, 1, 0))
+		if err != nil {
+			t.Fatalf("PurgeDeletedBefore failed: %v", err)
+		}
+		if purged != 1 {
+			t.Errorf("purged = %d, want 1", purged)
+		}
+		if _, err := repos.TestGroup.FindDeletedByID(ctx, "TS001TG01"); !IsNotFound(err) {
+			t.Errorf("TS001TG01 should be purged, got %v", err)
+		}
+		if _, err := repos.TestGroup.FindDeletedByID(ctx, "TS001TG02"); err != nil {
+			t.Errorf("TS001TG02 should remain in trash: %v", err)
+		}
+	})
+
+	t.Run("ケースが残っているグループは物理削除できない", func(t *testing.T) {
+		repos := newRepositories(t)
+		seedCase(t, repos, "TS001", "TS001TG01", "TS001TG01TC001")
+
+		if err := repos.TestGroup.Delete(ctx, "TS001TG01"); !IsConflict(err) {
+			t.Errorf("expected conflict, got %v", err)
+		}
+		if err := repos.TestCase.Delete(ctx, "TS001TG01TC001"); err != nil {
+			t.Fatalf("failed to delete case: %v", err)
+		}
+		if err := repos.TestGroup.Delete(ctx, "TS001TG01"); err != nil {
+			t.Errorf("Delete failed: %v", err)
+		}
+	})
+}
@@ -0,0 +1,671 @@
+package repositorytest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+)
+
+// RunTestSuiteRepository はTestSuiteRepositoryの契約テストを実行します
+func RunTestSuiteRepository(t *testing.T, newRepositories Factory) {
+	ctx := context.Background()
+
+	t.Run("作成したスイートをIDで取得できる", func(t *testing.T) {
+		repos := newRepositories(t)
+		suite := newSuite("TS001", baseTime)
+		suite.RequireEffortComment = true
+		suite.ExitCriteria = entity.ExitCriteria{MinCompletionRate: 80, AllowOpenCritical: true}
+		suite.CreatedBy = "U001"
+		mustCreateSuite(t, repos, suite)
+
+		got, err := repos.TestSuite.FindByID(ctx, "TS001")
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if got.Name != suite.Name || got.Description != suite.Description || got.Status != suite.Status || got.CreatedBy != suite.CreatedBy {
+			t.Errorf("unexpected suite: %+v", got)
+		}
+		if !got.EstimatedStartDate.Equal(suite.EstimatedStartDate) || !got.EstimatedEndDate.Equal(suite.EstimatedEndDate) {
+			t.Errorf("dates mismatch: got %v - %v", got.EstimatedStartDate, got.EstimatedEndDate)
+		}
+		if !got.RequireEffortComment || got.ExitCriteria != suite.ExitCriteria || got.ExitOverride != nil {
+			t.Errorf("settings mismatch: %+v", got)
+		}
+		if got.Version != entity.InitialVersion {
+			t.Errorf("expected initial version, got %d", got.Version)
+		}
+
+		if err := repos.TestSuite.Create(ctx, newSuite("TS001", baseTime)); !IsConflict(err) {
+			t.Errorf("expected conflict for duplicate id, got %v", err)
+		}
+	})
+
+	t.Run("存在しないスイートは未検出エラーになる", func(t *testing.T) {
+		repos := newRepositories(t)
+		missing := newSuite("missing", baseTime)
+
+		if _, err := repos.TestSuite.FindByID(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("FindByID: expected not found, got %v", err)
+		}
+		if err := repos.TestSuite.Update(ctx, missing); !IsNotFound(err) {
+			t.Errorf("Update: expected not found, got %v", err)
+		}
+		if err := repos.TestSuite.UpdateStatus(ctx, "missing", valueobject.SuiteStatusInProgress); !IsNotFound(err) {
+			t.Errorf("UpdateStatus: expected not found, got %v", err)
+		}
+		if err := repos.TestSuite.Delete(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("Delete: expected not found, got %v", err)
+		}
+		if err := repos.TestSuite.SoftDelete(ctx, "missing", baseTime); !IsNotFound(err) {
+			t.Errorf("SoftDelete: expected not found, got %v", err)
+		}
+		if err := repos.TestSuite.Restore(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("Restore: expected not found, got %v", err)
+		}
+		if _, err := repos.TestSuite.FindDeletedByID(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("FindDeletedByID: expected not found, got %v", err)
+		}
+	})
+
+	t.Run("更新はバージョンを進め古いバージョンでは競合する", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+
+		loaded, err := repos.TestSuite.FindByID(ctx, "TS001")
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		loaded.Name = "更新後"
+		loaded.ExitOverride = &entity.ExitCriteriaOverride{Reason: "承認済み", OverriddenBy: "user_1", OverriddenAt: baseTime}
+		if err := repos.TestSuite.Update(ctx, loaded); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+
+		got, _ := repos.TestSuite.FindByID(ctx, "TS001")
+		if got.Name != "更新後" || got.Version != entity.InitialVersion+1 {
+			t.Errorf("unexpected suite after update: name=%s version=%d", got.Name, got.Version)
+		}
+		if got.ExitOverride == nil || got.ExitOverride.Reason != "承認済み" || got.ExitOverride.OverriddenBy != "user_1" {
+			t.Errorf("exit override not stored: %+v", got.ExitOverride)
+		}
+
+		if loaded.Version != entity.InitialVersion+1 {
+			t.Errorf("updated entity should carry the new version, got %d", loaded.Version)
+		}
+
+		// 更新前のバージョンのままのエンティティでは競合する
+		stale := newSuite("TS001", baseTime)
+		if err := repos.TestSuite.Update(ctx, stale); !IsConcurrentModification(err) {
+			t.Errorf("expected concurrent modification, got %v", err)
+		}
+
+		if err := repos.TestSuite.UpdateStatus(ctx, "TS001", valueobject.SuiteStatusInProgress); err != nil {
+			t.Fatalf("UpdateStatus failed: %v", err)
+		}
+		got, _ = repos.TestSuite.FindByID(ctx, "TS001")
+		if got.Status != valueobject.SuiteStatusInProgress || got.Version != entity.InitialVersion+2 {
+			t.Errorf("unexpected suite after status update: status=%s version=%d", got.Status, got.Version)
+		}
+	})
+
+	t.Run("同じバージョンからの同時更新は1件だけ成功する", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+
+		succeeded := runConcurrently(8, func(i int) bool {
+			suite := newSuite("TS001", baseTime)
+			suite.Name = fmt.Sprintf("更新 %d", i)
+			err := repos.TestSuite.Update(ctx, suite)
+			if err != nil && !IsConcurrentModification(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			return err == nil
+		})
+		if succeeded != 1 {
+			t.Errorf("expected exactly one successful update, got %d", succeeded)
+		}
+
+		got, _ := repos.TestSuite.FindByID(ctx, "TS001")
+		if got.Version != entity.InitialVersion+1 {
+			t.Errorf("expected version %d, got %d", entity.InitialVersion+1, got.Version)
+		}
+	})
+
+	t.Run("FindWithFiltersは絞り込み・並び順・ページングを適用する", func(t *testing.T) {
+		repos := newRepositories(t)
+		// TS001〜TS012を1日ずつずらして作成し、偶数番号を実行中にする
+		for i := 1; i <= 12; i++ {
+			suite := newSuite(fmt.Sprintf("TS%03d", i), baseTime.AddDate(0, 0, i))
+			if i%2 == 0 {
+				suite.Status = valueobject.SuiteStatusInProgress
+			}
+			mustCreateSuite(t, repos, suite)
+		}
+		if err := repos.TestSuite.SoftDelete(ctx, "TS012", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		tests := []struct {
+			name      string
+			params    dto.TestSuiteQueryParamDTO
+			wantIDs   []string
+			wantTotal int
+		}{
+			{
+				name:      "指定なしは作成日時の新しい順に10件",
+				wantIDs:   []string{"TS011", "TS010", "TS009", "TS008", "TS007", "TS006", "TS005", "TS004", "TS003", "TS002"},
+				wantTotal: 11,
+			},
+			{
+				name:      "2ページ目",
+				params:    dto.TestSuiteQueryParamDTO{Page: intPtr(2), PageSize: intPtr(4)},
+				wantIDs:   []string{"TS007", "TS006", "TS005", "TS004"},
+				wantTotal: 11,
+			},
+			{
+				name:      "範囲外のページは空",
+				params:    dto.TestSuiteQueryParamDTO{Page: intPtr(5), PageSize: intPtr(4)},
+				wantIDs:   []string{},
+				wantTotal: 11,
+			},
+			{
+				name:      "ステータスで絞り込み（削除済みは含めない）",
+				params:    dto.TestSuiteQueryParamDTO{Status: stringPtr(string(valueobject.SuiteStatusInProgress))},
+				wantIDs:   []string{"TS010", "TS008", "TS006", "TS004", "TS002"},
+				wantTotal: 5,
+			},
+			{
+				name: "開始予定日と終了予定日の範囲で絞り込み",
+				params: dto.TestSuiteQueryParamDTO{
+					StartDate: timePtr(baseTime.AddDate(0, 0, 3)),
+					EndDate:   timePtr(baseTime.AddDate(0, 1, 5)),
+				},
+				wantIDs:   []string{"TS005", "TS004", "TS003"},
+				wantTotal: 3,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				params := tt.params
+				got, total, err := repos.TestSuite.FindWithFilters(ctx, &params)
+				if err != nil {
+					t.Fatalf("FindWithFilters failed: %v", err)
+				}
+				if total != tt.wantTotal {
+					t.Errorf("total = %d, want %d", total, tt.wantTotal)
+				}
+				if !equalIDs(suiteIDs(got), tt.wantIDs) {
+					t.Errorf("ids = %v, want %v", suiteIDs(got), tt.wantIDs)
+				}
+			})
+		}
+
+		if _, _, err := repos.TestSuite.FindWithFilters(ctx, &dto.TestSuiteQueryParamDTO{Status: stringPtr("unknown")}); !IsValidation(err) {
+			t.Errorf("expected validation error for invalid status, got %v", err)
+		}
+	})
+
+	t.Run("FindWithFiltersはキーセット方式でカーソルの前後を取得する", func(t *testing.T) {
+		repos := newRepositories(t)
+		// TS001〜TS006を1日ずつずらして作成し、TS007はTS006と同じ作成日時にする（IDの降順で後ろに並ぶ）
+		for i := 1; i <= 6; i++ {
+			mustCreateSuite(t, repos, newSuite(fmt.Sprintf("TS%03d", i), baseTime.AddDate(0, 0, i)))
+		}
+		mustCreateSuite(t, repos, newSuite("TS007", baseTime.AddDate(0, 0, 6)))
+		if err := repos.TestSuite.SoftDelete(ctx, "TS003", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		cursorOf := func(id string, day int) *dto.TestSuiteCursor {
+			return &dto.TestSuiteCursor{Values: []any{baseTime.AddDate(0, 0, day)}, ID: id}
+		}
+
+		tests := []struct {
+			name    string
+			keyset  dto.TestSuiteKeyset
+			wantIDs []string
+		}{
+			{
+				name:    "先頭から取得",
+				keyset:  dto.TestSuiteKeyset{Limit: 3},
+				wantIDs: []string{"TS007", "TS006", "TS005"},
+			},
+			{
+				name:    "同じ作成日時のスイートもIDで続きを取得する",
+				keyset:  dto.TestSuiteKeyset{Limit: 2, After: cursorOf("TS007", 6)},
+				wantIDs: []string{"TS006", "TS005"},
+			},
+			{
+				name:    "削除済みのスイートを飛ばして続きを取得する",
+				keyset:  dto.TestSuiteKeyset{Limit: 3, After: cursorOf("TS004", 4)},
+				wantIDs: []string{"TS002", "TS001"},
+			},
+			{
+				name:    "末尾から取得しても並び順は変わらない",
+				keyset:  dto.TestSuiteKeyset{Limit: 2, FromLast: true},
+				wantIDs: []string{"TS002", "TS001"},
+			},
+			{
+				name:    "beforeより前を末尾から取得",
+				keyset:  dto.TestSuiteKeyset{Limit: 2, FromLast: true, Before: cursorOf("TS004", 4)},
+				wantIDs: []string{"TS006", "TS005"},
+			},
+			{
+				name:    "afterとbeforeの間",
+				keyset:  dto.TestSuiteKeyset{Limit: 10, After: cursorOf("TS007", 6), Before: cursorOf("TS002", 2)},
+				wantIDs: []string{"TS006", "TS005", "TS004"},
+			},
+			{
+				name:    "削除済みのスイートのカーソルからも続きを取得できる",
+				keyset:  dto.TestSuiteKeyset{Limit: 10, After: cursorOf("TS003", 3)},
+				wantIDs: []string{"TS002", "TS001"},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				keyset := tt.keyset
+				got, total, err := repos.TestSuite.FindWithFilters(ctx, &dto.TestSuiteQueryParamDTO{Keyset: &keyset})
+				if err != nil {
+					t.Fatalf("FindWithFilters failed: %v", err)
+				}
+				// 総件数はカーソルによらず絞り込み条件のみで数える
+				if total != 6 {
+					t.Errorf("total = %d, want 6", total)
+				}
+				if !equalIDs(suiteIDs(got), tt.wantIDs) {
+					t.Errorf("ids = %v, want %v", suiteIDs(got), tt.wantIDs)
+				}
+			})
+		}
+	})
+
+	t.Run("FindWithFiltersは検索語・作成者・期間・進捗率・遅延ケースで絞り込む", func(t *testing.T) {
+		repos := newRepositories(t)
+
+		// TS001: 1/1〜1/10、作成者U001、Criticalの完了ケース（遅延あり）で進捗100%
+		ts1 := newSuite("TS001", baseTime.AddDate(0, 0, 1))
+		ts1.Name, ts1.CreatedBy = "Login regression", "U001"
+		ts1.EstimatedStartDate, ts1.EstimatedEndDate = baseTime, baseTime.AddDate(0, 0, 9)
+		mustCreateSuite(t, repos, ts1)
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+		tc := newCase("TS001TG01TC001", "TS001TG01")
+		tc.Status, tc.Priority, tc.IsDelayed = entity.TestStatusCompleted, entity.PriorityCritical, true
+		mustCreateCase(t, repos, tc)
+
+		// TS002: 1/15〜1/31、作成者U002、完了と作成のケースで進捗50%
+		ts2 := newSuite("TS002", baseTime.AddDate(0, 0, 2))
+		ts2.Description, ts2.CreatedBy = "Checkout after LOGIN", "U002"
+		ts2.EstimatedStartDate, ts2.EstimatedEndDate = baseTime.AddDate(0, 0, 14), baseTime.AddDate(0, 0, 30)
+		mustCreateSuite(t, repos, ts2)
+		mustCreateGroup(t, repos, newGroup("TS002TG01", "TS002", 1))
+		tc = newCase("TS002TG01TC001", "TS002TG01")
+		tc.Status = entity.TestStatusCompleted
+		mustCreateCase(t, repos, tc)
+		mustCreateCase(t, repos, newCase("TS002TG01TC002", "TS002TG01"))
+
+		// TS003: 2/1〜2/28、作成者なし、削除済みのケースのみで進捗0%
+		ts3 := newSuite("TS003", baseTime.AddDate(0, 0, 3))
+		ts3.EstimatedStartDate, ts3.EstimatedEndDate = baseTime.AddDate(0, 1, 0), baseTime.AddDate(0, 1, 27)
+		mustCreateSuite(t, repos, ts3)
+		mustCreateGroup(t, repos, newGroup("TS003TG01", "TS003", 1))
+		tc = newCase("TS003TG01TC001", "TS003TG01")
+		tc.Status, tc.IsDelayed = entity.TestStatusCompleted, true
+		mustCreateCase(t, repos, tc)
+		if err := repos.TestCase.SoftDelete(ctx, "TS003TG01TC001", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		tests := []struct {
+			name    string
+			params  dto.TestSuiteQueryParamDTO
+			wantIDs []string
+		}{
+			{
+				name:    "名前または説明の部分一致（大文字・小文字を区別しない）",
+				params:  dto.TestSuiteQueryParamDTO{Search: stringPtr("login")},
+				wantIDs: []string{"TS002", "TS001"},
+			},
+			{
+				name:    "ワイルドカード文字は文字として扱う",
+				params:  dto.TestSuiteQueryParamDTO{Search: stringPtr("%")},
+				wantIDs: nil,
+			},
+			{
+				name:    "作成者",
+				params:  dto.TestSuiteQueryParamDTO{CreatedBy: stringPtr("U001")},
+				wantIDs: []string{"TS001"},
+			},
+			{
+				name: "予定期間が範囲と重なる（境界の日を含む）",
+				params: dto.TestSuiteQueryParamDTO{
+					PeriodFrom: timePtr(baseTime.AddDate(0, 0, 9)),
+					PeriodTo:   timePtr(baseTime.AddDate(0, 0, 20)),
+				},
+				wantIDs: []string{"TS002", "TS001"},
+			},
+			{
+				name:    "予定期間の開始のみ指定",
+				params:  dto.TestSuiteQueryParamDTO{PeriodFrom: timePtr(baseTime.AddDate(0, 0, 31))},
+				wantIDs: []string{"TS003"},
+			},
+			{
+				name:    "進捗率の下限",
+				params:  dto.TestSuiteQueryParamDTO{MinProgress: floatPtr(50)},
+				wantIDs: []string{"TS002", "TS001"},
+			},
+			{
+				name:    "進捗率の範囲",
+				params:  dto.TestSuiteQueryParamDTO{MinProgress: floatPtr(10), MaxProgress: floatPtr(50)},
+				wantIDs: []string{"TS002"},
+			},
+			{
+				name:    "遅延ケースあり（削除済みのケースは対象外）",
+				params:  dto.TestSuiteQueryParamDTO{HasDelayedCases: boolPtr(true)},
+				wantIDs: []string{"TS001"},
+			},
+			{
+				name:    "遅延ケースなし",
+				params:  dto.TestSuiteQueryParamDTO{HasDelayedCases: boolPtr(false)},
+				wantIDs: []string{"TS003", "TS002"},
+			},
+			{
+				name:    "複数の条件",
+				params:  dto.TestSuiteQueryParamDTO{Search: stringPtr("LOGIN"), MaxProgress: floatPtr(99), HasDelayedCases: boolPtr(false)},
+				wantIDs: []string{"TS002"},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				params := tt.params
+				got, total, err := repos.TestSuite.FindWithFilters(ctx, &params)
+				if err != nil {
+					t.Fatalf("FindWithFilters failed: %v", err)
+				}
+				if total != len(tt.wantIDs) {
+					t.Errorf("total = %d, want %d", total, len(tt.wantIDs))
+				}
+				if !equalIDs(suiteIDs(got), tt.wantIDs) {
+					t.Errorf("ids = %v, want %v", suiteIDs(got), tt.wantIDs)
+				}
+			})
+		}
+	})
+
+	t.Run("FindWithFiltersは指定された並び順で取得する", func(t *testing.T) {
+		repos := newRepositories(t)
+		// 名前: TS001=Beta, TS002=Alpha, TS003=Gamma, TS004=Alpha
+		// 進捗率: TS001=50, TS002=100, TS003=50, TS004=0
+		// 終了予定日: TS001のみ3か月後で、それ以外は作成日の1か月後
+		names := map[string]string{"TS001": "Beta", "TS002": "Alpha", "TS003": "Gamma", "TS004": "Alpha"}
+		statuses := map[string][]entity.TestStatus{
+			"TS001": {entity.TestStatusCompleted, entity.TestStatusCreated},
+			"TS002": {entity.TestStatusCompleted},
+			"TS003": {entity.TestStatusReviewWaiting},
+		}
+		for i := 1; i <= 4; i++ {
+			id := fmt.Sprintf("TS%03d", i)
+			suite := newSuite(id, baseTime.AddDate(0, 0, i))
+			suite.Name = names[id]
+			if id == "TS001" {
+				suite.EstimatedEndDate = baseTime.AddDate(0, 3, 0)
+			}
+			mustCreateSuite(t, repos, suite)
+			mustCreateGroup(t, repos, newGroup(id+"TG01", id, 1))
+			for j, status := range statuses[id] {
+				tc := newCase(fmt.Sprintf("%sTG01TC%03d", id, j+1), id+"TG01")
+				tc.Status = status
+				mustCreateCase(t, repos, tc)
+			}
+		}
+
+		byProgress := []dto.TestSuiteSortDTO{
+			{Field: dto.TestSuiteSortByProgress, Desc: true},
+			{Field: dto.TestSuiteSortByName},
+		}
+		cursorOf := func(id string, progress float64) *dto.TestSuiteCursor {
+			return &dto.TestSuiteCursor{Values: []any{progress, names[id]}, ID: id}
+		}
+
+		tests := []struct {
+			name    string
+			sort    []dto.TestSuiteSortDTO
+			keyset  *dto.TestSuiteKeyset
+			wantIDs []string
+		}{
+			{
+				name:    "名前の昇順（同じ名前はIDの昇順）",
+				sort:    []dto.TestSuiteSortDTO{{Field: dto.TestSuiteSortByName}},
+				wantIDs: []string{"TS002", "TS004", "TS001", "TS003"},
+			},
+			{
+				name:    "名前の降順（同じ名前はIDの降順）",
+				sort:    []dto.TestSuiteSortDTO{{Field: dto.TestSuiteSortByName, Desc: true}},
+				wantIDs: []string{"TS003", "TS001", "TS004", "TS002"},
+			},
+			{
+				name:    "終了予定日の昇順",
+				sort:    []dto.TestSuiteSortDTO{{Field: dto.TestSuiteSortByEstimatedEndDate}},
+				wantIDs: []string{"TS002", "TS003", "TS004", "TS001"},
+			},
+			{
+				name:    "進捗率の降順・名前の昇順",
+				sort:    byProgress,
+				wantIDs: []string{"TS002", "TS001", "TS003", "TS004"},
+			},
+			{
+				name:    "異なる方向の並び順でカーソルの続きを取得する",
+				sort:    byProgress,
+				keyset:  &dto.TestSuiteKeyset{Limit: 2, After: cursorOf("TS001", 50)},
+				wantIDs: []string{"TS003", "TS004"},
+			},
+			{
+				name:    "異なる方向の並び順でカーソルより前を末尾から取得する",
+				sort:    byProgress,
+				keyset:  &dto.TestSuiteKeyset{Limit: 2, FromLast: true, Before: cursorOf("TS003", 50)},
+				wantIDs: []string{"TS002", "TS001"},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, total, err := repos.TestSuite.FindWithFilters(ctx, &dto.TestSuiteQueryParamDTO{Sort: tt.sort, Keyset: tt.keyset})
+				if err != nil {
+					t.Fatalf("FindWithFilters failed: %v", err)
+				}
+				if total != 4 {
+					t.Errorf("total = %d, want 4", total)
+				}
+				if !equalIDs(suiteIDs(got), tt.wantIDs) {
+					t.Errorf("ids = %v, want %v", suiteIDs(got), tt.wantIDs)
+				}
+			})
+		}
+	})
+
+	t.Run("割り切れない進捗率でもカーソルの続きを重複・欠落なく取得する", func(t *testing.T) {
+		repos := newRepositories(t)
+		// 3つのスイートは同じ構成のケースを持ち、進捗率は小数点以下が割り切れない同じ値になる
+		var cases []*entity.TestCase
+		for i := 1; i <= 3; i++ {
+			id := fmt.Sprintf("TS%03d", i)
+			mustCreateSuite(t, repos, newSuite(id, baseTime.AddDate(0, 0, i)))
+			mustCreateGroup(t, repos, newGroup(id+"TG01", id, 1))
+			cases = nil
+			for j, tc := range []struct {
+				status   entity.TestStatus
+				priority entity.Priority
+			}{
+				{entity.TestStatusCompleted, entity.PriorityCritical},
+				{entity.TestStatusReviewWaiting, entity.PriorityMedium},
+				{entity.TestStatusCreated, entity.PriorityLow},
+			} {
+				c := newCase(fmt.Sprintf("%sTG01TC%03d", id, j+1), id+"TG01")
+				c.Status, c.Priority = tc.status, tc.priority
+				mustCreateCase(t, repos, c)
+				cases = append(cases, c)
+			}
+		}
+		// カーソルはユースケースと同じく、ケースから計算した丸める前の進捗率から作成する
+		progress := entity.NewProgressSummary(cases).ProgressPercentage
+		byProgress := []dto.TestSuiteSortDTO{{Field: dto.TestSuiteSortByProgress, Desc: true}}
+
+		var gotIDs []string
+		keyset := dto.TestSuiteKeyset{Limit: 1}
+		for page := 0; page < 4; page++ {
+			got, _, err := repos.TestSuite.FindWithFilters(ctx, &dto.TestSuiteQueryParamDTO{Sort: byProgress, Keyset: &keyset})
+			if err != nil {
+				t.Fatalf("FindWithFilters failed: %v", err)
+			}
+			if len(got) == 0 {
+				break
+			}
+			gotIDs = append(gotIDs, got[0].ID)
+			cursor, err := dto.ParseTestSuiteCursor(dto.NewTestSuiteCursor(&dto.TestSuiteResponseDTO{ID: got[0].ID, Progress: progress}, byProgress), byProgress)
+			if err != nil {
+				t.Fatalf("ParseTestSuiteCursor failed: %v", err)
+			}
+			keyset.After = cursor
+		}
+		if want := []string{"TS003", "TS002", "TS001"}; !equalIDs(gotIDs, want) {
+			t.Errorf("ids = %v, want %v", gotIDs, want)
+		}
+	})
+
+	t.Run("FindByStatusは削除されていないスイートを作成日時の新しい順に返す", func(t *testing.T) {
+		repos := newRepositories(t)
+		for i := 1; i <= 3; i++ {
+			mustCreateSuite(t, repos, newSuite(fmt.Sprintf("TS%03d", i), baseTime.AddDate(0, 0, i)))
+		}
+		if err := repos.TestSuite.SoftDelete(ctx, "TS002", baseTime); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		got, err := repos.TestSuite.FindByStatus(ctx, valueobject.SuiteStatusPreparation)
+		if err != nil {
+			t.Fatalf("FindByStatus failed: %v", err)
+		}
+		if want := []string{"TS003", "TS001"}; !equalIDs(suiteIDs(got), want) {
+			t.Errorf("ids = %v, want %v", suiteIDs(got), want)
+		}
+	})
+
+	t.Run("論理削除したスイートはゴミ箱から取得・復元できる", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateSuite(t, repos, newSuite("TS002", baseTime.AddDate(0, 0, 1)))
+
+		olderDeletion := baseTime.AddDate(0, 2, 0)
+		newerDeletion := olderDeletion.Add(time.Hour)
+		if err := repos.TestSuite.SoftDelete(ctx, "TS001", olderDeletion); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+		if err := repos.TestSuite.SoftDelete(ctx, "TS002", newerDeletion); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		if _, err := repos.TestSuite.FindByID(ctx, "TS001"); !IsNotFound(err) {
+			t.Errorf("deleted suite should not be found, got %v", err)
+		}
+		if err := repos.TestSuite.SoftDelete(ctx, "TS001", newerDeletion); !IsNotFound(err) {
+			t.Errorf("deleting twice should be not found, got %v", err)
+		}
+		if err := repos.TestSuite.Update(ctx, newSuite("TS001", baseTime)); !IsNotFound(err) {
+			t.Errorf("updating a deleted suite should be not found, got %v", err)
+		}
+
+		deleted, err := repos.TestSuite.FindDeletedByID(ctx, "TS001")
+		if err != nil {
+			t.Fatalf("FindDeletedByID failed: %v", err)
+		}
+		if !deleted.DeletedAt.Equal(olderDeletion) {
+			t.Errorf("DeletedAt = %v, want %v", deleted.DeletedAt, olderDeletion)
+		}
+
+		trash, err := repos.TestSuite.FindDeleted(ctx)
+		if err != nil {
+			t.Fatalf("FindDeleted failed: %v", err)
+		}
+		if want := []string{"TS002", "TS001"}; !equalIDs(suiteIDs(trash), want) {
+			t.Errorf("trash = %v, want %v", suiteIDs(trash), want)
+		}
+
+		if err := repos.TestSuite.Restore(ctx, "TS001"); err != nil {
+			t.Fatalf("Restore failed: %v", err)
+		}
+		if err := repos.TestSuite.Restore(ctx, "TS001"); !IsNotFound(err) {
+			t.Errorf("restoring an active suite should be not found, got %v", err)
+		}
+		restored, err := repos.TestSuite.FindByID(ctx, "TS001")
+		if err != nil {
+			t.Fatalf("restored suite should be found: %v", err)
+		}
+		// 論理削除と復元でそれぞれバージョンが進む
+		if restored.Version != entity.InitialVersion+2 {
+			t.Errorf("expected version %d, got %d", entity.InitialVersion+2, restored.Version)
+		}
+	})
+
+	t.Run("完全削除は保持期間を過ぎグループが残っていないスイートのみ対象", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateSuite(t, repos, newSuite("TS002", baseTime))
+		mustCreateSuite(t, repos, newSuite("TS003", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS002TG01", "TS002", 1))
+
+		before := baseTime.AddDate(0, 1, 0)
+		for _, id := range []string{"TS001", "TS002"} {
+			if err := repos.TestSuite.SoftDelete(ctx, id, baseTime); err != nil {
+				t.Fatalf("SoftDelete failed: %v", err)
+			}
+		}
+		if err := repos.TestSuite.SoftDelete(ctx, "TS003", before.Add(time.Hour)); err != nil {
+			t.Fatalf("SoftDelete failed: %v", err)
+		}
+
+		purged, err := repos.TestSuite.PurgeDeletedBefore(ctx, before)
+		if err != nil {
+			t.Fatalf("PurgeDeletedBefore failed: %v", err)
+		}
+		if purged != 1 {
+			t.Errorf("purged = %d, want 1", purged)
+		}
+		if _, err := repos.TestSuite.FindDeletedByID(ctx, "TS001"); !IsNotFound(err) {
+			t.Errorf("TS001 should be purged, got %v", err)
+		}
+		for _, id := range []string{"TS002", "TS003"} {
+			if _, err := repos.TestSuite.FindDeletedByID(ctx, id); err != nil {
+				t.Errorf("%s should remain in trash: %v", id, err)
+			}
+		}
+	})
+
+	t.Run("グループが残っているスイートは物理削除できない", func(t *testing.T) {
+		repos := newRepositories(t)
+		mustCreateSuite(t, repos, newSuite("TS001", baseTime))
+		mustCreateGroup(t, repos, newGroup("TS001TG01", "TS001", 1))
+
+		if err := repos.TestSuite.Delete(ctx, "TS001"); !IsConflict(err) {
+			t.Errorf("expected conflict, got %v", err)
+		}
+		if err := repos.TestGroup.Delete(ctx, "TS001TG01"); err != nil {
+			t.Fatalf("failed to delete group: %v", err)
+		}
+		if err := repos.TestSuite.Delete(ctx, "TS001"); err != nil {
+			t.Errorf("Delete failed: %v", err)
+		}
+	})
+}
+
+func stringPtr(s string) *string     { return &s }
+func intPtr(i int) *int              { return &i }
+func timePtr(t time.Time) *time.Time { return &t }
+func floatPtr(f float64) *float64    { return &f }
+func boolPtr(b bool) *bool           { return &b }
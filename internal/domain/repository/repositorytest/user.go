@@ -0,0 +1,154 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+)
+
+func newUser(id, username string, role entity.UserRole) *entity.User {
+	return &entity.User{
+		ID:           id,
+		Username:     username,
+		PasswordHash: "$2a$10$hash-of-" + username,
+		Role:         role,
+		CreatedAt:    baseTime,
+		UpdatedAt:    baseTime,
+	}
+}
+
+// RunUserRepository はUserRepositoryの契約テストを実行します
+func RunUserRepository(t *testing.T, newRepositories Factory) {
+	ctx := context.Background()
+
+	t.Run("作成したユーザーをIDとユーザー名で取得できる", func(t *testing.T) {
+		repos := newRepositories(t)
+		user := newUser("USER001", "alice", entity.RoleManager)
+		if err := repos.User.Create(ctx, user); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+
+		byID, err := repos.User.FindByID(ctx, "USER001")
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if byID.Username != "alice" || byID.PasswordHash != user.PasswordHash || byID.Role != entity.RoleManager {
+			t.Errorf("unexpected user: %+v", byID)
+		}
+		if byID.LastLoginAt != nil {
+			t.Errorf("new user should not have logged in: %v", byID.LastLoginAt)
+		}
+
+		byName, err := repos.User.FindByUsername(ctx, "alice")
+		if err != nil {
+			t.Fatalf("FindByUsername failed: %v", err)
+		}
+		if byName.ID != "USER001" {
+			t.Errorf("FindByUsername returned %s", byName.ID)
+		}
+	})
+
+	t.Run("存在しないユーザーは未検出エラーになる", func(t *testing.T) {
+		repos := newRepositories(t)
+
+		if _, err := repos.User.FindByID(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("FindByID: expected not found, got %v", err)
+		}
+		if _, err := repos.User.FindByUsername(ctx, "nobody"); !IsNotFound(err) {
+			t.Errorf("FindByUsername: expected not found, got %v", err)
+		}
+		if err := repos.User.Update(ctx, newUser("missing", "nobody", entity.RoleTester)); !IsNotFound(err) {
+			t.Errorf("Update: expected not found, got %v", err)
+		}
+		if err := repos.User.UpdateLastLogin(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("UpdateLastLogin: expected not found, got %v", err)
+		}
+		if err := repos.User.Delete(ctx, "missing"); !IsNotFound(err) {
+			t.Errorf("Delete: expected not found, got %v", err)
+		}
+	})
+
+	t.Run("IDとユーザー名の重複は競合エラーになる", func(t *testing.T) {
+		repos := newRepositories(t)
+		if err := repos.User.Create(ctx, newUser("USER001", "alice", entity.RoleTester)); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+
+		if err := repos.User.Create(ctx, newUser("USER001", "bob", entity.RoleTester)); !IsConflict(err) {
+			t.Errorf("duplicate id: expected conflict, got %v", err)
+		}
+		if err := repos.User.Create(ctx, newUser("USER002", "alice", entity.RoleTester)); !IsConflict(err) {
+			t.Errorf("duplicate username: expected conflict, got %v", err)
+		}
+	})
+
+	t.Run("更新と最終ログイン日時の記録", func(t *testing.T) {
+		repos := newRepositories(t)
+		if err := repos.User.Create(ctx, newUser("USER001", "alice", entity.RoleTester)); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+
+		updated := newUser("USER001", "alice2", entity.RoleAdmin)
+		updated.PasswordHash = "$2a$10$new-hash"
+		if err := repos.User.Update(ctx, updated); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		if err := repos.User.UpdateLastLogin(ctx, "USER001"); err != nil {
+			t.Fatalf("UpdateLastLogin failed: %v", err)
+		}
+
+		got, _ := repos.User.FindByID(ctx, "USER001")
+		if got.Username != "alice2" || got.Role != entity.RoleAdmin || got.PasswordHash != "$2a$10$new-hash" {
+			t.Errorf("unexpected user after update: %+v", got)
+		}
+		if got.LastLoginAt == nil {
+			t.Errorf("LastLoginAt should be set")
+		}
+		if _, err := repos.User.FindByUsername(ctx, "alice"); !IsNotFound(err) {
+			t.Errorf("old username should no longer be found, got %v", err)
+		}
+	})
+
+	t.Run("一覧・ロール別件数・削除", func(t *testing.T) {
+		repos := newRepositories(t)
+		users := []*entity.User{
+			newUser("USER001", "admin", entity.RoleAdmin),
+			newUser("USER002", "tester1", entity.RoleTester),
+			newUser("USER003", "tester2", entity.RoleTester),
+		}
+		for i, user := range users {
+			user.CreatedAt = baseTime.AddDate(0, 0, i)
+			if err := repos.User.Create(ctx, user); err != nil {
+				t.Fatalf("Create failed: %v", err)
+			}
+		}
+
+		all, err := repos.User.FindAll(ctx)
+		if err != nil {
+			t.Fatalf("FindAll failed: %v", err)
+		}
+		got := ids(all, func(u *entity.User) string { return u.ID })
+		if want := []string{"USER003", "USER002", "USER001"}; !equalIDs(got, want) {
+			t.Errorf("FindAll = %v, want %v", got, want)
+		}
+
+		counts := map[entity.UserRole]int{entity.RoleAdmin: 1, entity.RoleManager: 0, entity.RoleTester: 2}
+		for role, want := range counts {
+			count, err := repos.User.CountByRole(ctx, role)
+			if err != nil {
+				t.Fatalf("CountByRole failed: %v", err)
+			}
+			if count != want {
+				t.Errorf("CountByRole(%s) = %d, want %d", role, count, want)
+			}
+		}
+
+		if err := repos.User.Delete(ctx, "USER002"); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if _, err := repos.User.FindByID(ctx, "USER002"); !IsNotFound(err) {
+			t.Errorf("deleted user should not be found, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+)
+
+// StatusHistoryRepository はテストケースのステータス変更履歴の永続化を担当するリポジトリインターフェース
+// 監査用の履歴のため追記のみを提供する
+type StatusHistoryRepository interface {
+	// Create は変更履歴を保存し、採番されたIDをエンティティに設定する
+	Create(ctx context.Context, history *entity.StatusHistory) error
+
+	// FindByTestCaseID は指定されたテストケースの変更履歴を古い順に取得する
+	FindByTestCaseID(ctx context.Context, testCaseID string) ([]*entity.StatusHistory, error)
+}
@@ -3,23 +3,69 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
 )
 
 // TestCaseRepository はテストケースの永続化を担当するリポジトリインターフェース
+// Update・UpdateAsEditorはバージョンによる楽観的排他制御を行い、編集ロック・遅延状態は更新しない
+// 論理削除されたテストケースは存在しないものとして扱う
 type TestCaseRepository interface {
 	Repository[entity.TestCase]
 
 	// FindByGroupID は指定されたグループIDに属するテストケース一覧を取得する
 	FindByGroupID(ctx context.Context, groupID string) ([]*entity.TestCase, error)
 
-	// UpdateStatus は指定されたテストケースのステータスを更新する
-	UpdateStatus(ctx context.Context, id string, status entity.TestStatus) error
+	// FindByGroupIDs は複数のグループに属するテストケースをグループID・ID順に1回の問い合わせで取得する
+	FindByGroupIDs(ctx context.Context, groupIDs []string) ([]*entity.TestCase, error)
+
+	// UpdateStatus は指定されたテストケースのステータスを、現在のステータスがfrom・バージョンがexpectedVersionの場合のみtoに更新する
+	// 読み込み後に他の更新でステータスが変わっていた場合はステータス遷移の競合エラーを、
+	// ステータス以外が更新されていた場合は同時更新の競合エラーを返す
+	UpdateStatus(ctx context.Context, id string, from, to entity.TestStatus, expectedVersion int) error
 
 	// AddEffort は指定されたテストケースに工数を追加する
 	AddEffort(ctx context.Context, id string, effort float64) error
 
+	// UpdateDelay は指定されたテストケースの遅延状態を、現在のステータスがstatusの場合のみ更新する
+	// 遅延状態はバックグラウンドの遅延検知が更新するシステム管理の値のため、バージョンは進めない
+	// 遅延状態の判定後にステータスが変わっていた場合（完了した場合など）はステータス遷移の競合エラーを返す
+	UpdateDelay(ctx context.Context, id string, status entity.TestStatus, isDelayed bool, delayDays int) error
+
+	// UpdateAsEditor はeditor以外のユーザーが有効な編集ロックを保持していない場合のみテストケースを更新する
+	// 読み込み後に他のユーザーがロックを取得していた場合は、バージョンが一致していても編集ロックの競合エラーを返す
+	UpdateAsEditor(ctx context.Context, tc *entity.TestCase, editor string) error
+
 	// FindByStatus は指定されたステータスのテストケース一覧を取得する
 	FindByStatus(ctx context.Context, status entity.TestStatus) ([]*entity.TestCase, error)
+
+	// AcquireLock は指定されたテストケースの編集ロックをexpiresAtまで取得または延長する
+	// 他のユーザーが有効なロックを保持している場合は取得せずにfalseを返す
+	AcquireLock(ctx context.Context, id, editor string, expiresAt time.Time) (bool, error)
+
+	// ReleaseLock は指定されたテストケースの編集ロックを解除する
+	// editorが空の場合は保持者に関わらず解除する
+	ReleaseLock(ctx context.Context, id, editor string) error
+
+	// SoftDelete は指定されたテストケースをdeletedAtの日時で論理削除する
+	SoftDelete(ctx context.Context, id string, deletedAt time.Time) error
+
+	// SoftDeleteByGroupID は指定されたグループに属する削除されていないテストケースを論理削除する
+	SoftDeleteByGroupID(ctx context.Context, groupID string, deletedAt time.Time) error
+
+	// Restore は論理削除されたテストケースを復元する
+	Restore(ctx context.Context, id string) error
+
+	// RestoreByGroupID は指定されたグループに属し、deletedAtの日時に削除されたテストケースを復元する
+	RestoreByGroupID(ctx context.Context, groupID string, deletedAt time.Time) error
+
+	// FindDeletedByID は論理削除された指定IDのテストケースを取得する
+	FindDeletedByID(ctx context.Context, id string) (*entity.TestCase, error)
+
+	// FindDeleted は論理削除されたテストケースのうち、所属するグループが削除されていないものを取得する
+	FindDeleted(ctx context.Context) ([]*entity.TestCase, error)
+
+	// PurgeDeletedBefore はbeforeより前に論理削除されたテストケースを、工数記録・変更履歴とともに物理削除する
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
 }
@@ -3,21 +3,54 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
 )
 
 // TestGroupRepository はテストグループの永続化を担当するリポジトリインターフェース
+// UpdateはTestSuiteRepositoryと同様にバージョンによる楽観的排他制御を行う
+// 論理削除されたテストグループは検索・更新の対象外となる
 type TestGroupRepository interface {
 	Repository[entity.TestGroup]
 
 	// FindBySuiteID は指定されたスイートIDに属するテストグループ一覧を取得する
 	FindBySuiteID(ctx context.Context, suiteID string) ([]*entity.TestGroup, error)
 
+	// FindBySuiteIDs は複数のスイートに属するテストグループをスイートID・表示順に1回の問い合わせで取得する
+	FindBySuiteIDs(ctx context.Context, suiteIDs []string) ([]*entity.TestGroup, error)
+
+	// FindByIDForUpdate は指定されたテストグループを取得し、トランザクションが終わるまで他のトランザクションからの更新を待機させる
+	// 読み込んだ値をもとに同じトランザクションの中で更新する場合に使用する
+	FindByIDForUpdate(ctx context.Context, id string) (*entity.TestGroup, error)
+
 	// UpdateStatus は指定されたテストグループのステータスを更新する
 	UpdateStatus(ctx context.Context, id string, status valueobject.SuiteStatus) error
 
 	// UpdateDisplayOrder は指定されたテストグループの表示順序を更新する
 	UpdateDisplayOrder(ctx context.Context, id string, displayOrder int) error
+
+	// SoftDelete は指定されたテストグループをdeletedAtの日時で論理削除する
+	SoftDelete(ctx context.Context, id string, deletedAt time.Time) error
+
+	// SoftDeleteBySuiteID は指定されたスイートに属する削除されていないテストグループを論理削除する
+	SoftDeleteBySuiteID(ctx context.Context, suiteID string, deletedAt time.Time) error
+
+	// Restore は論理削除されたテストグループを復元する
+	Restore(ctx context.Context, id string) error
+
+	// RestoreBySuiteID は指定されたスイートに属し、deletedAtの日時に削除されたテストグループを復元する
+	// スイートより前に個別に削除されていたグループは復元しない
+	RestoreBySuiteID(ctx context.Context, suiteID string, deletedAt time.Time) error
+
+	// FindDeletedByID は論理削除された指定IDのテストグループを取得する
+	FindDeletedByID(ctx context.Context, id string) (*entity.TestGroup, error)
+
+	// FindDeleted は論理削除されたテストグループのうち、所属するスイートが削除されていないものを取得する
+	// スイートが削除されている場合は先にスイートを復元する必要があるため含めない
+	FindDeleted(ctx context.Context) ([]*entity.TestGroup, error)
+
+	// PurgeDeletedBefore はbeforeより前に論理削除されたテストグループのうち、配下のケースが残っていないものを物理削除する
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
@@ -9,6 +10,9 @@ import (
 )
 
 // TestSuiteRepository はテストスイートの永続化を担当するリポジトリインターフェース
+// Updateは読み込み時のVersionと一致する場合のみ更新してVersionを1つ進め、一致しない場合は同時更新の競合エラーを返す
+// 論理削除されたテストスイートはFindByID・FindByStatus・FindWithFiltersの対象外で、更新もできない
+// Deleteは行を物理削除するため、通常の削除にはSoftDeleteを使用する
 type TestSuiteRepository interface {
 	Repository[entity.TestSuite]
 
@@ -23,6 +27,34 @@ type TestSuiteRepository interface {
 	// status: 検索対象のステータス
 	FindByStatus(ctx context.Context, status valueobject.SuiteStatus) ([]*entity.TestSuite, error)
 
-	// TestSuiteRepository interfaceに追加
+	// FindWithFilters は絞り込み条件に一致するテストスイートを作成日時・IDの降順で1ページ分取得し、絞り込み後の総件数とともに返す
+	// ctx: コンテキスト
+	// params: 絞り込み条件（Keysetを指定した場合はキーセット方式、それ以外はPage・PageSizeのオフセット方式）
 	FindWithFilters(ctx context.Context, params *dto.TestSuiteQueryParamDTO) ([]*entity.TestSuite, int, error)
+
+	// SoftDelete は指定されたテストスイートをdeletedAtの日時で論理削除する
+	// ctx: コンテキスト
+	// id: テストスイートID
+	// deletedAt: 削除日時（配下のグループ・ケースにも同じ日時を設定する）
+	SoftDelete(ctx context.Context, id string, deletedAt time.Time) error
+
+	// Restore は論理削除されたテストスイートを復元する
+	// ctx: コンテキスト
+	// id: テストスイートID
+	Restore(ctx context.Context, id string) error
+
+	// FindDeletedByID は論理削除された指定IDのテストスイートを取得する
+	// ctx: コンテキスト
+	// id: テストスイートID
+	FindDeletedByID(ctx context.Context, id string) (*entity.TestSuite, error)
+
+	// FindDeleted は論理削除されたテストスイートを削除日時の新しい順に取得する
+	// ctx: コンテキスト
+	FindDeleted(ctx context.Context) ([]*entity.TestSuite, error)
+
+	// PurgeDeletedBefore はbeforeより前に論理削除されたテストスイートを物理削除し、削除件数を返す
+	// 配下のグループが残っているテストスイートは削除しない
+	// ctx: コンテキスト
+	// before: 保持期間の境界となる日時
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
 }
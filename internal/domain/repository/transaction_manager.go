@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+)
+
+// TransactionManager は複数のリポジトリ操作を1つのトランザクションとして実行するためのインターフェース
+// トランザクションはcontext.Contextを介して各リポジトリに引き継がれる
+type TransactionManager interface {
+	// RunInTransaction はfnをトランザクション内で実行する
+	// fnがエラーを返すかパニックした場合はロールバックし、正常に終了した場合はコミットする
+	// fnに渡されたcontextを使ったリポジトリ操作のみがトランザクションに含まれる
+	// 既にトランザクション内のcontextで呼び出された場合は、そのトランザクションに参加する
+	RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
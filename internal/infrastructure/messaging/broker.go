@@ -0,0 +1,15 @@
+package messaging
+
+import (
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+)
+
+// Broker はドメインイベントの発行と購読を仲介するインターフェースです
+// インメモリ実装のほか、外部のメッセージブローカーを使う実装に差し替えられます
+type Broker interface {
+	event.Publisher
+	event.Subscriber
+
+	// Close はブローカーを停止し、すべての購読チャネルを閉じます
+	Close() error
+}
@@ -0,0 +1,130 @@
+package messaging
+
+import (
+	"context"
+	"sync"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// defaultSubscriberBufferSize は購読チャネルのデフォルトのバッファサイズです
+const defaultSubscriberBufferSize = 64
+
+// subscription は1件の購読を表します
+type subscription struct {
+	suiteID string
+	ch      chan *event.Event
+}
+
+// InMemoryBroker は単一プロセス内でイベントを配信するBrokerの実装です
+// 購読者の受信が追いつかずバッファが溢れた場合、そのイベントは破棄されます
+type InMemoryBroker struct {
+	mu            sync.RWMutex
+	subscriptions map[*subscription]struct{}
+	bufferSize    int
+	closed        bool
+}
+
+// NewInMemoryBroker は新しいInMemoryBrokerを作成します
+func NewInMemoryBroker() *InMemoryBroker {
+	return NewInMemoryBrokerWithBufferSize(defaultSubscriberBufferSize)
+}
+
+// NewInMemoryBrokerWithBufferSize は購読チャネルのバッファサイズを指定してInMemoryBrokerを作成します
+func NewInMemoryBrokerWithBufferSize(bufferSize int) *InMemoryBroker {
+	if bufferSize <= 0 {
+		bufferSize = defaultSubscriberBufferSize
+	}
+	return &InMemoryBroker{
+		subscriptions: make(map[*subscription]struct{}),
+		bufferSize:    bufferSize,
+	}
+}
+
+// Publish はイベントを対象スイートの購読者とすべてのスイートの購読者に配信します
+// 配信はノンブロッキングで行い、発行元の処理を遅延させません
+func (b *InMemoryBroker) Publish(ctx context.Context, e *event.Event) {
+	if e == nil {
+		return
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return
+	}
+
+	for sub := range b.subscriptions {
+		if sub.suiteID != "" && sub.suiteID != e.SuiteID {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+			// バッファが溢れている購読者には配信しない
+		}
+	}
+}
+
+// Subscribe は指定されたスイートのイベントを受信するチャネルを返します
+// チャネルはctxの終了時またはブローカーの停止時に閉じられます
+func (b *InMemoryBroker) Subscribe(ctx context.Context, suiteID string) (<-chan *event.Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, errors.NewSystemError("イベントブローカーは停止しています", nil)
+	}
+
+	sub := &subscription{
+		suiteID: suiteID,
+		ch:      make(chan *event.Event, b.bufferSize),
+	}
+	b.subscriptions[sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(sub)
+	}()
+
+	return sub.ch, nil
+}
+
+// Close はブローカーを停止し、すべての購読チャネルを閉じます
+func (b *InMemoryBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+
+	for sub := range b.subscriptions {
+		close(sub.ch)
+		delete(b.subscriptions, sub)
+	}
+
+	return nil
+}
+
+// SubscriberCount は現在の購読数を返します
+func (b *InMemoryBroker) SubscriberCount() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscriptions)
+}
+
+// unsubscribe は購読を解除してチャネルを閉じます
+func (b *InMemoryBroker) unsubscribe(sub *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscriptions[sub]; !ok {
+		return
+	}
+	close(sub.ch)
+	delete(b.subscriptions, sub)
+}
@@ -0,0 +1,101 @@
+package messaging
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+)
+
+func receive(t *testing.T, ch <-chan *event.Event) *event.Event {
+	t.Helper()
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("イベントを受信できませんでした")
+		return nil
+	}
+}
+
+func assertNoEvent(t *testing.T, ch <-chan *event.Event) {
+	t.Helper()
+	select {
+	case e, ok := <-ch:
+		if ok {
+			t.Fatalf("想定外のイベントを受信しました: %+v", e)
+		}
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestInMemoryBroker_PublishFiltersBySuite(t *testing.T) {
+	broker := NewInMemoryBroker()
+	defer broker.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	suiteCh, err := broker.Subscribe(ctx, "TS001-202501")
+	require.NoError(t, err)
+	otherCh, err := broker.Subscribe(ctx, "TS002-202501")
+	require.NoError(t, err)
+	allCh, err := broker.Subscribe(ctx, "")
+	require.NoError(t, err)
+
+	broker.Publish(ctx, event.New(event.TestCaseUpdated, "TS001-202501", "TS001TG01TC001", "user1"))
+
+	e := receive(t, suiteCh)
+	assert.Equal(t, event.TestCaseUpdated, e.Type)
+	assert.Equal(t, "TS001TG01TC001", e.EntityID)
+	assert.Equal(t, "TS001TG01TC001", receive(t, allCh).EntityID)
+	assertNoEvent(t, otherCh)
+}
+
+func TestInMemoryBroker_UnsubscribeOnContextDone(t *testing.T) {
+	broker := NewInMemoryBroker()
+	defer broker.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := broker.Subscribe(ctx, "TS001-202501")
+	require.NoError(t, err)
+
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok, "チャネルが閉じられていません")
+	case <-time.After(time.Second):
+		t.Fatal("コンテキスト終了後にチャネルが閉じられませんでした")
+	}
+}
+
+func TestInMemoryBroker_DropsEventsForSlowSubscriber(t *testing.T) {
+	broker := NewInMemoryBrokerWithBufferSize(1)
+	defer broker.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := broker.Subscribe(ctx, "")
+	require.NoError(t, err)
+
+	// バッファを超えた発行でもブロックしないこと
+	broker.Publish(ctx, event.New(event.TestSuiteUpdated, "TS001-202501", "TS001-202501", ""))
+	broker.Publish(ctx, event.New(event.TestSuiteUpdated, "TS002-202501", "TS002-202501", ""))
+
+	assert.Equal(t, "TS001-202501", receive(t, ch).SuiteID)
+	assertNoEvent(t, ch)
+}
+
+func TestInMemoryBroker_SubscribeAfterClose(t *testing.T) {
+	broker := NewInMemoryBroker()
+	require.NoError(t, broker.Close())
+
+	_, err := broker.Subscribe(context.Background(), "")
+	assert.Error(t, err)
+}
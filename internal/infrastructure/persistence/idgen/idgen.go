@@ -0,0 +1,133 @@
+// Package idgen はテストスイート・グループ・ケース・ユーザーのID形式を採番方式ごとに定義します
+// 採番そのもの（シーケンスの払い出し）は各永続化実装のIDジェネレーターが担当し、
+// このパッケージは払い出された番号と親IDからID文字列を組み立てます
+package idgen
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Strategy はIDの採番方式を表します
+type Strategy string
+
+const (
+	// StrategySequence はシーケンス番号と年月によるID（TS001-202501、TS001TG01-202501など）
+	StrategySequence Strategy = "sequence"
+	// StrategyULID は時刻順にソート可能なULIDによるID（TS-01JH...など）
+	StrategyULID Strategy = "ulid"
+	// StrategyPrefix はプロジェクトごとのプレフィックスとシーケンス番号によるID（ACME-TS001-TG01など）
+	StrategyPrefix Strategy = "prefix"
+)
+
+// prefixPattern はプレフィックス方式で使用できるプレフィックスの形式
+// IDの列長（50文字）に収まるよう、英大文字と数字の10文字以内に制限します
+var prefixPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{0,9}$`)
+
+// Options はIDジェネレーターに共通の採番設定です
+// ゼロ値はシーケンス方式として扱います
+type Options struct {
+	Strategy Strategy
+	Prefix   string // プレフィックス方式でのみ使用
+}
+
+// NewOptions は設定値から採番設定を作成します
+// strategyが空の場合はシーケンス方式となり、プレフィックス方式ではprefixが必須です
+func NewOptions(strategy, prefix string) (Options, error) {
+	s := Strategy(strings.ToLower(strings.TrimSpace(strategy)))
+	switch s {
+	case "":
+		s = StrategySequence
+	case StrategySequence, StrategyULID:
+	case StrategyPrefix:
+		prefix = strings.ToUpper(strings.TrimSpace(prefix))
+		if !prefixPattern.MatchString(prefix) {
+			return Options{}, fmt.Errorf("invalid id prefix %q: must be 1-10 uppercase letters or digits starting with a letter", prefix)
+		}
+		return Options{Strategy: s, Prefix: prefix}, nil
+	default:
+		return Options{}, fmt.Errorf("unknown id strategy %q: must be one of sequence, ulid, prefix", strategy)
+	}
+	return Options{Strategy: s}, nil
+}
+
+// UsesSequence は採番にシーケンス番号が必要かどうかを返します
+// ULID方式ではシーケンスを消費しません
+func (o Options) UsesSequence() bool {
+	return o.Strategy != StrategyULID
+}
+
+// SuiteID はテストスイートのIDを組み立てます
+func (o Options) SuiteID(seq int64, now time.Time) (string, error) {
+	switch o.Strategy {
+	case StrategyULID:
+		return prefixedULID("TS-", now)
+	case StrategyPrefix:
+		return fmt.Sprintf("%s-TS%03d", o.Prefix, seq), nil
+	default:
+		return fmt.Sprintf("TS%03d-%s", seq, yearMonth(now)), nil
+	}
+}
+
+// GroupID はスイートIDに紐づくテストグループのIDを組み立てます
+func (o Options) GroupID(suiteID string, seq int64, now time.Time) (string, error) {
+	if suiteID == "" {
+		return "", fmt.Errorf("suite id is required")
+	}
+	switch o.Strategy {
+	case StrategyULID:
+		return prefixedULID("TG-", now)
+	case StrategyPrefix:
+		return fmt.Sprintf("%s-TG%02d", suiteID, seq), nil
+	default:
+		return fmt.Sprintf("%sTG%02d-%s", sequenceBase(suiteID), seq, yearMonth(now)), nil
+	}
+}
+
+// CaseID はグループIDに紐づくテストケースのIDを組み立てます
+func (o Options) CaseID(groupID string, seq int64, now time.Time) (string, error) {
+	if groupID == "" {
+		return "", fmt.Errorf("group id is required")
+	}
+	switch o.Strategy {
+	case StrategyULID:
+		return prefixedULID("TC-", now)
+	case StrategyPrefix:
+		return fmt.Sprintf("%s-TC%03d", groupID, seq), nil
+	default:
+		return fmt.Sprintf("%sTC%03d-%s", sequenceBase(groupID), seq, yearMonth(now)), nil
+	}
+}
+
+// UserID はユーザーのIDを組み立てます
+func (o Options) UserID(seq int64, now time.Time) (string, error) {
+	switch o.Strategy {
+	case StrategyULID:
+		return prefixedULID("user_", now)
+	case StrategyPrefix:
+		return fmt.Sprintf("%s-user_%d", o.Prefix, seq), nil
+	default:
+		return fmt.Sprintf("user_%d", seq), nil
+	}
+}
+
+// sequenceBase はシーケンス方式の親IDから年月部分を除いた部分（"TS001"、"TS001TG01"など）を返します
+// 番号が桁あふれしても正しく切り出せるよう、固定長ではなく区切り文字で分割します
+func sequenceBase(parentID string) string {
+	base, _, _ := strings.Cut(parentID, "-")
+	return base
+}
+
+func yearMonth(now time.Time) string {
+	return fmt.Sprintf("%04d%02d", now.Year(), now.Month())
+}
+
+func prefixedULID(prefix string, now time.Time) (string, error) {
+	id, err := NewULID(now)
+	if err != nil {
+		return "", err
+	}
+	return prefix + id, nil
+}
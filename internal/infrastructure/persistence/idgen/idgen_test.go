@@ -0,0 +1,120 @@
+package idgen
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy string
+		prefix   string
+		want     Options
+		wantErr  bool
+	}{
+		{name: "未指定はシーケンス方式", strategy: "", want: Options{Strategy: StrategySequence}},
+		{name: "大文字小文字を区別しない", strategy: "ULID", want: Options{Strategy: StrategyULID}},
+		{name: "プレフィックスは大文字に正規化する", strategy: "prefix", prefix: "acme", want: Options{Strategy: StrategyPrefix, Prefix: "ACME"}},
+		{name: "プレフィックス方式でプレフィックスが空", strategy: "prefix", wantErr: true},
+		{name: "プレフィックスに区切り文字を含む", strategy: "prefix", prefix: "AC-ME", wantErr: true},
+		{name: "不明な方式", strategy: "uuid", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOptions(tt.strategy, tt.prefix)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOptions_SequenceIDs(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	opts := Options{}
+
+	suiteID, err := opts.SuiteID(1, now)
+	require.NoError(t, err)
+	assert.Equal(t, "TS001-202501", suiteID)
+
+	groupID, err := opts.GroupID(suiteID, 1, now)
+	require.NoError(t, err)
+	assert.Equal(t, "TS001TG01-202501", groupID)
+
+	caseID, err := opts.CaseID(groupID, 7, now)
+	require.NoError(t, err)
+	assert.Equal(t, "TS001TG01TC007-202501", caseID)
+
+	userID, err := opts.UserID(3, now)
+	require.NoError(t, err)
+	assert.Equal(t, "user_3", userID)
+
+	// 番号が桁あふれしても親IDのプレフィックスを正しく引き継ぐ
+	groupID, err = opts.GroupID("TS1000-202501", 12, now)
+	require.NoError(t, err)
+	assert.Equal(t, "TS1000TG12-202501", groupID)
+}
+
+func TestOptions_PrefixIDs(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	opts, err := NewOptions("prefix", "ACME")
+	require.NoError(t, err)
+
+	suiteID, err := opts.SuiteID(2, now)
+	require.NoError(t, err)
+	assert.Equal(t, "ACME-TS002", suiteID)
+
+	groupID, err := opts.GroupID(suiteID, 3, now)
+	require.NoError(t, err)
+	assert.Equal(t, "ACME-TS002-TG03", groupID)
+
+	caseID, err := opts.CaseID(groupID, 4, now)
+	require.NoError(t, err)
+	assert.Equal(t, "ACME-TS002-TG03-TC004", caseID)
+
+	userID, err := opts.UserID(5, now)
+	require.NoError(t, err)
+	assert.Equal(t, "ACME-user_5", userID)
+}
+
+func TestOptions_ULIDIDs(t *testing.T) {
+	now := time.Now()
+	opts := Options{Strategy: StrategyULID}
+	assert.False(t, opts.UsesSequence())
+
+	ulidPattern := regexp.MustCompile(`^TS-[0-9A-HJKMNP-TV-Z]{26}$`)
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		id, err := opts.SuiteID(0, now)
+		require.NoError(t, err)
+		assert.Regexp(t, ulidPattern, id)
+		assert.False(t, seen[id], "同じミリ秒内でもIDが重複しないこと")
+		seen[id] = true
+	}
+
+	_, err := opts.GroupID("", 0, now)
+	assert.Error(t, err, "親IDは必須")
+}
+
+func TestEncodeCrockford(t *testing.T) {
+	var zero, max [16]byte
+	for i := range max {
+		max[i] = 0xFF
+	}
+	assert.Equal(t, "00000000000000000000000000", encodeCrockford(zero))
+	assert.Equal(t, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", encodeCrockford(max))
+
+	// ULID仕様の例（1469918176385ミリ秒 → 01ARYZ6S41）
+	id, err := NewULID(time.UnixMilli(1469918176385))
+	require.NoError(t, err)
+	assert.Equal(t, "01ARYZ6S41", id[:10])
+}
@@ -0,0 +1,47 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+)
+
+// crockfordAlphabet はULIDで使用するCrockford Base32の文字集合
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// NewULID はnowのミリ秒タイムスタンプ（48bit）と暗号学的乱数（80bit）から26文字のULIDを生成します
+// 同じミリ秒内で生成したULID同士の順序は保証しません
+func NewULID(now time.Time) (string, error) {
+	var data [16]byte
+
+	ms := uint64(now.UnixMilli())
+	for i := 5; i >= 0; i-- {
+		data[i] = byte(ms)
+		ms >>= 8
+	}
+	if _, err := rand.Read(data[6:]); err != nil {
+		return "", fmt.Errorf("failed to read random bytes for ulid: %w", err)
+	}
+
+	return encodeCrockford(data), nil
+}
+
+// encodeCrockford は128bitの値を先頭に2bitの0を補った130bitとして、5bitずつ26文字に符号化します
+func encodeCrockford(data [16]byte) string {
+	out := make([]byte, 26)
+	for i := range out {
+		// 130bit中の位置を128bitのデータ上の位置に換算する（先頭2bitは0）
+		start := i*5 - 2
+		var v byte
+		for b := 0; b < 5; b++ {
+			pos := start + b
+			if pos < 0 {
+				continue
+			}
+			bit := (data[pos/8] >> (7 - uint(pos%8))) & 1
+			v |= bit << (4 - uint(b))
+		}
+		out[i] = crockfordAlphabet[v]
+	}
+	return string(out)
+}
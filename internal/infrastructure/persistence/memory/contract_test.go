@@ -0,0 +1,22 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository/repositorytest"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/memory"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		store := memory.NewStore()
+		return repositorytest.Repositories{
+			TestSuite:     memory.NewMemoryTestSuiteRepository(store),
+			TestGroup:     memory.NewMemoryTestGroupRepository(store),
+			TestCase:      memory.NewMemoryTestCaseRepository(store),
+			User:          memory.NewMemoryUserRepository(store),
+			EffortRecord:  memory.NewMemoryEffortRecordRepository(store),
+			StatusHistory: memory.NewMemoryStatusHistoryRepository(store),
+		}
+	})
+}
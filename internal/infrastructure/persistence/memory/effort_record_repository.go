@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// MemoryEffortRecordRepository は工数記録をメモリ内に保存するリポジトリ実装
+// IDはSERIAL列と同様に1からの連番を文字列で採番する
+type MemoryEffortRecordRepository struct {
+	store *Store
+}
+
+// NewMemoryEffortRecordRepository は指定されたデータ領域を使用する工数記録リポジトリを作成
+func NewMemoryEffortRecordRepository(store *Store) repository.EffortRecordRepository {
+	return &MemoryEffortRecordRepository{store: store}
+}
+
+// Create は工数記録を保存し、採番されたIDを設定する
+func (r *MemoryEffortRecordRepository) Create(ctx context.Context, record *entity.EffortRecord) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, ok := r.store.cases[record.TestCaseID]; !ok {
+		return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+			"testCaseId": record.TestCaseID,
+		})
+	}
+
+	r.store.effortSeq++
+	record.SetIDFromInt(int(r.store.effortSeq))
+	r.store.efforts[record.ID] = clone(record)
+	return nil
+}
+
+// FindByID は工数記録を取得する
+func (r *MemoryEffortRecordRepository) FindByID(ctx context.Context, id string) (*entity.EffortRecord, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	record, ok := r.store.efforts[id]
+	if !ok {
+		return nil, errors.NewNotFoundError("EffortRecord", id)
+	}
+	return clone(record), nil
+}
+
+// Update は工数記録の工数とコメントを更新する
+func (r *MemoryEffortRecordRepository) Update(ctx context.Context, record *entity.EffortRecord) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	current, ok := r.store.efforts[record.ID]
+	if !ok {
+		return errors.NewNotFoundError("EffortRecord", record.ID)
+	}
+	current.EffortAmount = record.EffortAmount
+	current.Comment = record.Comment
+	return nil
+}
+
+// FindByTestCaseID はテストケースの工数記録を記録日順に取得する
+func (r *MemoryEffortRecordRepository) FindByTestCaseID(ctx context.Context, testCaseID string) ([]*entity.EffortRecord, error) {
+	records := r.find(func(record *entity.EffortRecord) bool {
+		return record.TestCaseID == testCaseID
+	})
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].RecordDate.Before(records[j].RecordDate)
+	})
+	return records, nil
+}
+
+// FindByRecorderAndDate は指定されたユーザーが指定日に記録した工数記録を取得する
+func (r *MemoryEffortRecordRepository) FindByRecorderAndDate(ctx context.Context, recordedBy string, recordDate time.Time) ([]*entity.EffortRecord, error) {
+	date := entity.TruncateToDate(recordDate)
+	return r.find(func(record *entity.EffortRecord) bool {
+		return record.RecordedBy == recordedBy && record.RecordDate.Equal(date)
+	}), nil
+}
+
+// find はmatchを満たす工数記録の複製をID順に返す
+func (r *MemoryEffortRecordRepository) find(match func(*entity.EffortRecord) bool) []*entity.EffortRecord {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	var records []*entity.EffortRecord
+	for _, record := range r.store.efforts {
+		if match(record) {
+			records = append(records, clone(record))
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].IDAsInt() < records[j].IDAsInt()
+	})
+	return records
+}
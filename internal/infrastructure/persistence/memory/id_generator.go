@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
+)
+
+// インターフェース実装の確認
+var (
+	_ repository.TestSuiteIDGenerator = (*TestSuiteIDGenerator)(nil)
+	_ repository.TestGroupIDGenerator = (*TestGroupIDGenerator)(nil)
+	_ repository.TestCaseIDGenerator  = (*TestCaseIDGenerator)(nil)
+	_ repository.UserIDGenerator      = (*UserIDGenerator)(nil)
+)
+
+// sequence はPostgreSQLのシーケンスに相当するプロセス内の連番
+// シーケンスと同様に、トランザクションがロールバックされても払い出した番号は戻さない
+type sequence struct {
+	value atomic.Int64
+}
+
+// next は採番方式がシーケンスを使用する場合にのみ次の番号を払い出す
+func (s *sequence) next(options idgen.Options) int64 {
+	if !options.UsesSequence() {
+		return 0
+	}
+	return s.value.Add(1)
+}
+
+// TestSuiteIDGenerator はメモリ内の連番でテストスイートIDを生成するジェネレーター
+type TestSuiteIDGenerator struct {
+	seq     sequence
+	options idgen.Options
+}
+
+// NewTestSuiteIDGenerator は指定された採番方式の新しいTestSuiteIDGeneratorを作成します
+func NewTestSuiteIDGenerator(options idgen.Options) *TestSuiteIDGenerator {
+	return &TestSuiteIDGenerator{options: options}
+}
+
+// GenerateID は新しいスイートIDを生成します
+func (g *TestSuiteIDGenerator) GenerateID() (string, error) {
+	return g.options.SuiteID(g.seq.next(g.options), time.Now())
+}
+
+// TestGroupIDGenerator はメモリ内の連番でテストグループIDを生成するジェネレーター
+type TestGroupIDGenerator struct {
+	seq     sequence
+	options idgen.Options
+}
+
+// NewTestGroupIDGenerator は指定された採番方式の新しいTestGroupIDGeneratorを作成します
+func NewTestGroupIDGenerator(options idgen.Options) *TestGroupIDGenerator {
+	return &TestGroupIDGenerator{options: options}
+}
+
+// GenerateID はスイートIDに紐づいた新しいグループIDを生成します
+func (g *TestGroupIDGenerator) GenerateID(suiteID string) (string, error) {
+	return g.options.GroupID(suiteID, g.seq.next(g.options), time.Now())
+}
+
+// TestCaseIDGenerator はメモリ内の連番でテストケースIDを生成するジェネレーター
+type TestCaseIDGenerator struct {
+	seq     sequence
+	options idgen.Options
+}
+
+// NewTestCaseIDGenerator は指定された採番方式の新しいTestCaseIDGeneratorを作成します
+func NewTestCaseIDGenerator(options idgen.Options) *TestCaseIDGenerator {
+	return &TestCaseIDGenerator{options: options}
+}
+
+// GenerateID はグループIDに紐づいた新しいケースIDを生成します
+func (g *TestCaseIDGenerator) GenerateID(groupID string) (string, error) {
+	return g.options.CaseID(groupID, g.seq.next(g.options), time.Now())
+}
+
+// UserIDGenerator はメモリ内の連番でユーザーIDを生成するジェネレーター
+type UserIDGenerator struct {
+	seq     sequence
+	options idgen.Options
+}
+
+// NewUserIDGenerator は指定された採番方式の新しいUserIDGeneratorインスタンスを作成する
+func NewUserIDGenerator(options idgen.Options) repository.UserIDGenerator {
+	return &UserIDGenerator{options: options}
+}
+
+// Generate は新しいユーザーIDを生成する
+func (g *UserIDGenerator) Generate(ctx context.Context) (string, error) {
+	return g.options.UserID(g.seq.next(g.options), time.Now())
+}
@@ -17,6 +17,7 @@ type MemoryRefreshTokenRepository struct {
 	tokenMap map[string]string               // tokenString -> tokenID のマップ
 	userMap  map[string][]string             // userID -> tokenIDs のマップ
 	mutex    sync.RWMutex
+	gate     writeGate
 }
 
 // NewMemoryRefreshTokenRepository は新しいメモリ内リポジトリインスタンスを作成
@@ -36,8 +37,8 @@ func (r *MemoryRefreshTokenRepository) Store(ctx context.Context, token *entity.
 		return customerrors.NewValidationError("token cannot be nil", nil)
 	}
 
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
+	unlock := r.lock(ctx)
+	defer unlock()
 
 	// 既存のトークン文字列をチェック
 	if _, exists := r.tokenMap[token.Token]; exists {
@@ -76,8 +77,8 @@ func (r *MemoryRefreshTokenRepository) GetByToken(ctx context.Context, tokenStri
 
 // Revoke はトークンを無効化する
 func (r *MemoryRefreshTokenRepository) Revoke(ctx context.Context, tokenID string) error {
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
+	unlock := r.lock(ctx)
+	defer unlock()
 
 	token, exists := r.tokens[tokenID]
 	if !exists {
@@ -116,8 +117,8 @@ func (r *MemoryRefreshTokenRepository) GetByUserID(ctx context.Context, userID s
 
 // UpdateLastUsed はトークンの最終使用日時を更新する
 func (r *MemoryRefreshTokenRepository) UpdateLastUsed(ctx context.Context, tokenID string, lastUsedAt time.Time) error {
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
+	unlock := r.lock(ctx)
+	defer unlock()
 
 	token, exists := r.tokens[tokenID]
 	if !exists {
@@ -130,8 +131,8 @@ func (r *MemoryRefreshTokenRepository) UpdateLastUsed(ctx context.Context, token
 
 // RevokeAllForUser はユーザーの全トークンを無効化する
 func (r *MemoryRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID string) error {
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
+	unlock := r.lock(ctx)
+	defer unlock()
 
 	tokenIDs, exists := r.userMap[userID]
 	if !exists {
@@ -149,8 +150,8 @@ func (r *MemoryRefreshTokenRepository) RevokeAllForUser(ctx context.Context, use
 
 // DeleteExpired は期限切れトークンを削除する（クリーンアップ用）
 func (r *MemoryRefreshTokenRepository) DeleteExpired(ctx context.Context) error {
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
+	unlock := r.lock(ctx)
+	defer unlock()
 
 	now := time.Now()
 	var expiredTokenIDs []string
@@ -223,3 +224,45 @@ func (r *MemoryRefreshTokenRepository) Count(ctx context.Context, userID string)
 
 	return count, nil
 }
+
+// lock は書き込みのためにトークンの状態をロックし、解除する関数を返す
+// トランザクション外からの書き込みは、実行中のトランザクションが終わるまで待機する
+func (r *MemoryRefreshTokenRepository) lock(ctx context.Context) (unlock func()) {
+	leave := r.gate.enter(ctx)
+	r.mutex.Lock()
+	return func() {
+		r.mutex.Unlock()
+		leave()
+	}
+}
+
+// Snapshot はトランザクション外からの書き込みを止め、現在のトークンの状態を保存する
+// MemoryTransactionManagerのロールバックに使用する
+func (r *MemoryRefreshTokenRepository) Snapshot() (restore func(), release func()) {
+	release = r.gate.close()
+
+	r.mutex.RLock()
+	tokens := make(map[string]*entity.RefreshToken, len(r.tokens))
+	for id, token := range r.tokens {
+		tokenCopy := *token
+		tokens[id] = &tokenCopy
+	}
+	tokenMap := make(map[string]string, len(r.tokenMap))
+	for tokenString, id := range r.tokenMap {
+		tokenMap[tokenString] = id
+	}
+	userMap := make(map[string][]string, len(r.userMap))
+	for userID, ids := range r.userMap {
+		userMap[userID] = append([]string(nil), ids...)
+	}
+	r.mutex.RUnlock()
+
+	restore = func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.tokens = tokens
+		r.tokenMap = tokenMap
+		r.userMap = userMap
+	}
+	return restore, release
+}
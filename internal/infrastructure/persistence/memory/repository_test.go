@@ -0,0 +1,337 @@
+package memory_test
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/memory"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+func newSuite(id string, createdAt time.Time, status valueobject.SuiteStatus) *entity.TestSuite {
+	return &entity.TestSuite{
+		ID:                 id,
+		Name:               "suite " + id,
+		Status:             status,
+		EstimatedStartDate: createdAt,
+		EstimatedEndDate:   createdAt.AddDate(0, 1, 0),
+		Version:            entity.InitialVersion,
+		CreatedAt:          createdAt,
+		UpdatedAt:          createdAt,
+	}
+}
+
+func TestMemoryTestSuiteRepository_UpdateAndConflict(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMemoryTestSuiteRepository(memory.NewStore())
+
+	suite := newSuite("TS001", time.Now(), valueobject.SuiteStatusPreparation)
+	if err := repo.Create(ctx, suite); err != nil {
+		t.Fatalf("failed to create suite: %v", err)
+	}
+	if err := repo.Create(ctx, suite); !customerrors.IsConflictError(err) {
+		t.Errorf("expected conflict for duplicate id, got %v", err)
+	}
+
+	// 取得したエンティティを書き換えても保存内容に影響しないこと
+	found, err := repo.FindByID(ctx, "TS001")
+	if err != nil {
+		t.Fatalf("failed to find suite: %v", err)
+	}
+	found.Name = "changed"
+	if err := repo.Update(ctx, found); err != nil {
+		t.Fatalf("failed to update suite: %v", err)
+	}
+
+	updated, _ := repo.FindByID(ctx, "TS001")
+	if updated.Name != "changed" || updated.Version != entity.InitialVersion+1 {
+		t.Errorf("unexpected suite after update: name=%s version=%d", updated.Name, updated.Version)
+	}
+
+	if found.Version != entity.InitialVersion+1 {
+		t.Errorf("updated entity should carry the new version, got %d", found.Version)
+	}
+
+	// 古いバージョンでの更新は同時更新の競合になる
+	var conflict *errors.ConcurrentModificationError
+	if err := repo.Update(ctx, suite); !stderrors.As(err, &conflict) {
+		t.Errorf("expected concurrent modification error, got %v", err)
+	}
+
+	if _, err := repo.FindByID(ctx, "missing"); !customerrors.IsNotFoundError(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestMemoryTestSuiteRepository_FindWithFilters(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMemoryTestSuiteRepository(memory.NewStore())
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= 5; i++ {
+		status := valueobject.SuiteStatusPreparation
+		if i%2 == 0 {
+			status = valueobject.SuiteStatusInProgress
+		}
+		if err := repo.Create(ctx, newSuite(fmt.Sprintf("TS%03d", i), base.AddDate(0, 0, i), status)); err != nil {
+			t.Fatalf("failed to create suite: %v", err)
+		}
+	}
+	if err := repo.SoftDelete(ctx, "TS005", time.Now()); err != nil {
+		t.Fatalf("failed to soft delete suite: %v", err)
+	}
+
+	page, pageSize := 2, 2
+	suites, total, err := repo.FindWithFilters(ctx, &dto.TestSuiteQueryParamDTO{Page: &page, PageSize: &pageSize})
+	if err != nil {
+		t.Fatalf("failed to find suites: %v", err)
+	}
+	// 削除済みを除いた4件を作成日時の新しい順に並べた2ページ目
+	if total != 4 || len(suites) != 2 || suites[0].ID != "TS002" || suites[1].ID != "TS001" {
+		t.Errorf("unexpected page: total=%d suites=%v", total, suiteIDs(suites))
+	}
+
+	status := string(valueobject.SuiteStatusInProgress)
+	suites, total, err = repo.FindWithFilters(ctx, &dto.TestSuiteQueryParamDTO{Status: &status})
+	if err != nil {
+		t.Fatalf("failed to find suites: %v", err)
+	}
+	if total != 2 || len(suites) != 2 || suites[0].ID != "TS004" {
+		t.Errorf("unexpected status filter result: total=%d suites=%v", total, suiteIDs(suites))
+	}
+
+	invalid := "unknown"
+	if _, _, err := repo.FindWithFilters(ctx, &dto.TestSuiteQueryParamDTO{Status: &invalid}); !customerrors.IsValidationError(err) {
+		t.Errorf("expected validation error for invalid status, got %v", err)
+	}
+}
+
+func TestMemoryRepositories_TrashAndPurge(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	suiteRepo := memory.NewMemoryTestSuiteRepository(store)
+	groupRepo := memory.NewMemoryTestGroupRepository(store)
+	caseRepo := memory.NewMemoryTestCaseRepository(store)
+	effortRepo := memory.NewMemoryEffortRecordRepository(store)
+
+	now := time.Now()
+	if err := suiteRepo.Create(ctx, newSuite("TS001", now, valueobject.SuiteStatusPreparation)); err != nil {
+		t.Fatalf("failed to create suite: %v", err)
+	}
+	if err := groupRepo.Create(ctx, entity.NewTestGroup("TG01", "missing", "group", "", 1, valueobject.SuiteStatusPreparation)); err == nil {
+		t.Errorf("expected error when suite does not exist")
+	}
+	if err := groupRepo.Create(ctx, entity.NewTestGroup("TG01", "TS001", "group", "", 1, valueobject.SuiteStatusPreparation)); err != nil {
+		t.Fatalf("failed to create group: %v", err)
+	}
+	if err := caseRepo.Create(ctx, entity.NewTestCase("TC001", "TG01", "case", "", entity.TestStatusCreated, entity.PriorityHigh, 1)); err != nil {
+		t.Fatalf("failed to create case: %v", err)
+	}
+	if err := effortRepo.Create(ctx, entity.NewEffortRecord("TC001", now, 1, false, "", "user_1")); err != nil {
+		t.Fatalf("failed to create effort record: %v", err)
+	}
+
+	// スイートと一緒に削除されたグループはゴミ箱の一覧に含めない
+	deletedAt := now.Add(-48 * time.Hour)
+	if err := caseRepo.SoftDeleteByGroupID(ctx, "TG01", deletedAt); err != nil {
+		t.Fatalf("failed to soft delete cases: %v", err)
+	}
+	if err := groupRepo.SoftDeleteBySuiteID(ctx, "TS001", deletedAt); err != nil {
+		t.Fatalf("failed to soft delete groups: %v", err)
+	}
+	if err := suiteRepo.SoftDelete(ctx, "TS001", deletedAt); err != nil {
+		t.Fatalf("failed to soft delete suite: %v", err)
+	}
+	if groups, _ := groupRepo.FindDeleted(ctx); len(groups) != 0 {
+		t.Errorf("groups of a deleted suite should not be listed, got %d", len(groups))
+	}
+
+	// 配下が残っている間は親を完全削除しない
+	if n, _ := suiteRepo.PurgeDeletedBefore(ctx, now); n != 0 {
+		t.Errorf("suite with groups should not be purged, purged %d", n)
+	}
+	if n, _ := caseRepo.PurgeDeletedBefore(ctx, now); n != 1 {
+		t.Errorf("expected 1 purged case, got %d", n)
+	}
+	if records, _ := effortRepo.FindByTestCaseID(ctx, "TC001"); len(records) != 0 {
+		t.Errorf("effort records should be purged with the case, got %d", len(records))
+	}
+	if n, _ := groupRepo.PurgeDeletedBefore(ctx, now); n != 1 {
+		t.Errorf("expected 1 purged group, got %d", n)
+	}
+	if n, _ := suiteRepo.PurgeDeletedBefore(ctx, now); n != 1 {
+		t.Errorf("expected 1 purged suite, got %d", n)
+	}
+}
+
+func TestMemoryTestCaseRepository_Lock(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	suiteRepo := memory.NewMemoryTestSuiteRepository(store)
+	groupRepo := memory.NewMemoryTestGroupRepository(store)
+	caseRepo := memory.NewMemoryTestCaseRepository(store)
+
+	_ = suiteRepo.Create(ctx, newSuite("TS001", time.Now(), valueobject.SuiteStatusPreparation))
+	_ = groupRepo.Create(ctx, entity.NewTestGroup("TG01", "TS001", "group", "", 1, valueobject.SuiteStatusPreparation))
+	if err := caseRepo.Create(ctx, entity.NewTestCase("TC001", "TG01", "case", "", entity.TestStatusCreated, entity.PriorityHigh, 1)); err != nil {
+		t.Fatalf("failed to create case: %v", err)
+	}
+
+	// 同時に取得を試みても1人だけが成功する
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	acquired := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(editor string) {
+			defer wg.Done()
+			ok, err := caseRepo.AcquireLock(ctx, "TC001", editor, time.Now().Add(time.Minute))
+			if err != nil {
+				t.Errorf("failed to acquire lock: %v", err)
+				return
+			}
+			if ok {
+				mu.Lock()
+				acquired++
+				mu.Unlock()
+			}
+		}(fmt.Sprintf("user_%d", i))
+	}
+	wg.Wait()
+	if acquired != 1 {
+		t.Errorf("expected exactly one editor to acquire the lock, got %d", acquired)
+	}
+
+	if err := caseRepo.ReleaseLock(ctx, "TC001", ""); err != nil {
+		t.Fatalf("failed to release lock: %v", err)
+	}
+	tc, _ := caseRepo.FindByID(ctx, "TC001")
+	if tc.IsLocked || tc.CurrentEditor != "" || tc.Version != entity.InitialVersion {
+		t.Errorf("unexpected lock state after release: %+v", tc)
+	}
+}
+
+func TestMemoryStore_TransactionRollback(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	suiteRepo := memory.NewMemoryTestSuiteRepository(store)
+	txManager := memory.NewMemoryTransactionManager(store)
+
+	errFailed := stderrors.New("failed")
+	err := txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := suiteRepo.Create(ctx, newSuite("TS001", time.Now(), valueobject.SuiteStatusPreparation)); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	if !stderrors.Is(err, errFailed) {
+		t.Fatalf("expected fn error, got %v", err)
+	}
+	if _, err := suiteRepo.FindByID(ctx, "TS001"); err == nil {
+		t.Errorf("suite created in rolled back transaction should not exist")
+	}
+}
+
+func TestMemoryStore_TransactionRollbackKeepsWritesOutsideTransaction(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	suiteRepo := memory.NewMemoryTestSuiteRepository(store)
+	txManager := memory.NewMemoryTransactionManager(store)
+
+	written := make(chan error, 1)
+	errFailed := stderrors.New("failed")
+	err := txManager.RunInTransaction(ctx, func(txCtx context.Context) error {
+		if err := suiteRepo.Create(txCtx, newSuite("TS001", time.Now(), valueobject.SuiteStatusPreparation)); err != nil {
+			return err
+		}
+		// トランザクション外からの書き込みはトランザクションの終了まで待機する
+		go func() {
+			written <- suiteRepo.Create(ctx, newSuite("TS002", time.Now(), valueobject.SuiteStatusPreparation))
+		}()
+		select {
+		case err := <-written:
+			t.Errorf("write outside the transaction should wait, got %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+		return errFailed
+	})
+	if !stderrors.Is(err, errFailed) {
+		t.Fatalf("expected fn error, got %v", err)
+	}
+	if err := <-written; err != nil {
+		t.Fatalf("failed to create suite outside the transaction: %v", err)
+	}
+
+	// ロールバックはトランザクション内の書き込みだけを取り消す
+	if _, err := suiteRepo.FindByID(ctx, "TS001"); err == nil {
+		t.Errorf("suite created in rolled back transaction should not exist")
+	}
+	if _, err := suiteRepo.FindByID(ctx, "TS002"); err != nil {
+		t.Errorf("suite created outside the transaction should remain: %v", err)
+	}
+}
+
+func TestMemoryUserRepository_UniqueUsername(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMemoryUserRepository(memory.NewStore())
+
+	first, _ := entity.NewUser("user_1", "alice", "hash", entity.RoleTester)
+	second, _ := entity.NewUser("user_2", "alice", "hash", entity.RoleManager)
+	if err := repo.Create(ctx, first); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	if err := repo.Create(ctx, second); !customerrors.IsConflictError(err) {
+		t.Errorf("expected conflict for duplicate username, got %v", err)
+	}
+
+	if err := repo.UpdateLastLogin(ctx, "user_1"); err != nil {
+		t.Fatalf("failed to update last login: %v", err)
+	}
+	user, err := repo.FindByUsername(ctx, "alice")
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	if user.LastLoginAt == nil {
+		t.Errorf("last login should be set")
+	}
+	if count, _ := repo.CountByRole(ctx, entity.RoleTester); count != 1 {
+		t.Errorf("expected 1 tester, got %d", count)
+	}
+}
+
+func TestMemoryIDGenerators(t *testing.T) {
+	now := time.Now()
+	suiteGen := memory.NewTestSuiteIDGenerator(idgen.Options{})
+	groupGen := memory.NewTestGroupIDGenerator(idgen.Options{})
+
+	first, _ := suiteGen.GenerateID()
+	second, _ := suiteGen.GenerateID()
+	ym := now.Format("200601")
+	if first != "TS001-"+ym || second != "TS002-"+ym {
+		t.Errorf("unexpected suite ids: %s, %s", first, second)
+	}
+	groupID, err := groupGen.GenerateID(second)
+	if err != nil {
+		t.Fatalf("failed to generate group id: %v", err)
+	}
+	if groupID != "TS002TG01-"+ym {
+		t.Errorf("unexpected group id: %s", groupID)
+	}
+}
+
+func suiteIDs(suites []*entity.TestSuite) []string {
+	ids := make([]string, len(suites))
+	for i, suite := range suites {
+		ids[i] = suite.ID
+	}
+	return ids
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// MemoryStatusHistoryRepository はステータス変更履歴をメモリ内に保存するリポジトリ実装
+type MemoryStatusHistoryRepository struct {
+	store *Store
+}
+
+// NewMemoryStatusHistoryRepository は指定されたデータ領域を使用する変更履歴リポジトリを作成
+func NewMemoryStatusHistoryRepository(store *Store) repository.StatusHistoryRepository {
+	return &MemoryStatusHistoryRepository{store: store}
+}
+
+// Create は変更履歴を保存し、採番されたIDを設定する
+func (r *MemoryStatusHistoryRepository) Create(ctx context.Context, history *entity.StatusHistory) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, ok := r.store.cases[history.TestCaseID]; !ok {
+		return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+			"testCaseId": history.TestCaseID,
+		})
+	}
+
+	r.store.historySeq++
+	history.SetIDFromInt(int(r.store.historySeq))
+	r.store.histories[history.ID] = clone(history)
+	return nil
+}
+
+// FindByTestCaseID はテストケースの変更履歴を古い順に取得する
+func (r *MemoryStatusHistoryRepository) FindByTestCaseID(ctx context.Context, testCaseID string) ([]*entity.StatusHistory, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	var histories []*entity.StatusHistory
+	for _, history := range r.store.histories {
+		if history.TestCaseID == testCaseID {
+			histories = append(histories, clone(history))
+		}
+	}
+	sort.Slice(histories, func(i, j int) bool {
+		if !histories[i].ChangedAt.Equal(histories[j].ChangedAt) {
+			return histories[i].ChangedAt.Before(histories[j].ChangedAt)
+		}
+		return historyIDAsInt(histories[i]) < historyIDAsInt(histories[j])
+	})
+	return histories, nil
+}
+
+// historyIDAsInt は採番済みのID文字列を整数として返す
+func historyIDAsInt(history *entity.StatusHistory) int {
+	id, _ := strconv.Atoi(history.ID)
+	return id
+}
@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+)
+
+// インターフェース実装の確認
+var _ Snapshotter = (*Store)(nil)
+
+// Store はメモリ内リポジトリが共有するデータ領域
+// スイート・グループ・ケースの親子関係（外部キー制約や完全削除の条件）を
+// PostgreSQL実装と同じように判定できるよう、全集約を1つのロックで保護します
+type Store struct {
+	suites    map[string]*entity.TestSuite
+	groups    map[string]*entity.TestGroup
+	cases     map[string]*entity.TestCase
+	users     map[string]*entity.User
+	efforts   map[string]*entity.EffortRecord
+	histories map[string]*entity.StatusHistory
+
+	// 工数記録・変更履歴のID採番（SERIAL列に相当し、ロールバックしても戻さない）
+	effortSeq  int64
+	historySeq int64
+
+	mutex sync.RWMutex
+	gate  writeGate
+}
+
+// NewStore は空のデータ領域を作成します
+func NewStore() *Store {
+	return &Store{
+		suites:    make(map[string]*entity.TestSuite),
+		groups:    make(map[string]*entity.TestGroup),
+		cases:     make(map[string]*entity.TestCase),
+		users:     make(map[string]*entity.User),
+		efforts:   make(map[string]*entity.EffortRecord),
+		histories: make(map[string]*entity.StatusHistory),
+	}
+}
+
+// lock は書き込みのためにデータ領域をロックし、解除する関数を返します
+// トランザクション外からの書き込みは、実行中のトランザクションが終わるまで待機します
+func (s *Store) lock(ctx context.Context) (unlock func()) {
+	leave := s.gate.enter(ctx)
+	s.mutex.Lock()
+	return func() {
+		s.mutex.Unlock()
+		leave()
+	}
+}
+
+// Snapshot はトランザクション外からの書き込みを止め、全集約の現在の状態を保存します
+// MemoryTransactionManagerのロールバックに使用します
+func (s *Store) Snapshot() (restore func(), release func()) {
+	release = s.gate.close()
+
+	s.mutex.RLock()
+	suites := cloneMapWith(s.suites, cloneSuite)
+	groups := cloneMap(s.groups)
+	cases := cloneMap(s.cases)
+	users := cloneMapWith(s.users, cloneUser)
+	efforts := cloneMap(s.efforts)
+	histories := cloneMap(s.histories)
+	s.mutex.RUnlock()
+
+	restore = func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.suites = suites
+		s.groups = groups
+		s.cases = cases
+		s.users = users
+		s.efforts = efforts
+		s.histories = histories
+	}
+	return restore, release
+}
+
+// cloneMap はエンティティのマップを値ごと複製します
+// 保存したエンティティを呼び出し元が書き換えても影響しないよう、読み書きのたびに複製を使います
+func cloneMap[T any](src map[string]*T) map[string]*T {
+	return cloneMapWith(src, clone[T])
+}
+
+// cloneMapWith はポインタのフィールドを持つエンティティのマップを、指定された関数で複製します
+func cloneMapWith[T any](src map[string]*T, cloneFn func(*T) *T) map[string]*T {
+	dst := make(map[string]*T, len(src))
+	for id, v := range src {
+		dst[id] = cloneFn(v)
+	}
+	return dst
+}
+
+func clone[T any](v *T) *T {
+	c := *v
+	return &c
+}
+
+// cloneSuite はテストスイートを複製します
+// 完了条件の上書き記録はポインタで保持しているため、別途複製します
+func cloneSuite(suite *entity.TestSuite) *entity.TestSuite {
+	c := clone(suite)
+	if suite.ExitOverride != nil {
+		c.ExitOverride = clone(suite.ExitOverride)
+	}
+	return c
+}
+
+// cloneUser はユーザーを複製します
+func cloneUser(user *entity.User) *entity.User {
+	c := clone(user)
+	if user.LastLoginAt != nil {
+		lastLoginAt := *user.LastLoginAt
+		c.LastLoginAt = &lastLoginAt
+	}
+	return c
+}
@@ -0,0 +1,426 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// MemoryTestCaseRepository はテストケースをメモリ内に保存するリポジトリ実装
+type MemoryTestCaseRepository struct {
+	store *Store
+}
+
+// NewMemoryTestCaseRepository は指定されたデータ領域を使用するテストケースリポジトリを作成
+func NewMemoryTestCaseRepository(store *Store) repository.TestCaseRepository {
+	return &MemoryTestCaseRepository{store: store}
+}
+
+// Create はテストケースを保存する
+func (r *MemoryTestCaseRepository) Create(ctx context.Context, tc *entity.TestCase) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, exists := r.store.cases[tc.ID]; exists {
+		return errors.NewAlreadyExistsError("TestCase", tc.ID)
+	}
+	if _, ok := r.store.groups[tc.GroupID]; !ok {
+		return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+			"id":      tc.ID,
+			"groupId": tc.GroupID,
+		})
+	}
+	r.store.cases[tc.ID] = clone(tc)
+	return nil
+}
+
+// FindByID は削除されていないテストケースを取得する
+func (r *MemoryTestCaseRepository) FindByID(ctx context.Context, id string) (*entity.TestCase, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	tc, ok := r.store.activeCase(id)
+	if !ok {
+		return nil, errors.NewNotFoundError("TestCase", id)
+	}
+	return clone(tc), nil
+}
+
+// Update はバージョンが一致する場合のみテストケースを更新し、引数とともにバージョンを1つ進める
+// 編集ロック・遅延状態は更新しない
+func (r *MemoryTestCaseRepository) Update(ctx context.Context, tc *entity.TestCase) error {
+	return r.update(ctx, tc, "", false)
+}
+
+// UpdateAsEditor はeditor以外のユーザーが有効な編集ロックを保持していない場合のみ、Updateと同様にテストケースを更新する
+func (r *MemoryTestCaseRepository) UpdateAsEditor(ctx context.Context, tc *entity.TestCase, editor string) error {
+	return r.update(ctx, tc, editor, true)
+}
+
+// update はテストケースを更新する
+// checkLockがtrueの場合は、バージョンに加えて編集ロックの保持者も確認する
+func (r *MemoryTestCaseRepository) update(ctx context.Context, tc *entity.TestCase, editor string, checkLock bool) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	current, ok := r.store.activeCase(tc.ID)
+	if !ok {
+		return errors.NewNotFoundError("TestCase", tc.ID)
+	}
+	if current.Version != tc.Version {
+		return errors.NewConcurrentModificationError("TestCase", tc.ID, int64(current.Version), int64(tc.Version))
+	}
+	if checkLock && !current.CanBeEditedBy(editor, time.Now()) {
+		return errors.NewEditLockConflictError("TestCase", tc.ID, current.CurrentEditor, current.LockExpiresAt)
+	}
+	if _, ok := r.store.groups[tc.GroupID]; !ok {
+		return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+			"id":      tc.ID,
+			"groupId": tc.GroupID,
+		})
+	}
+
+	current.Title = tc.Title
+	current.Description = tc.Description
+	current.Status = tc.Status
+	current.Priority = tc.Priority
+	current.PlannedEffort = tc.PlannedEffort
+	current.ActualEffort = tc.ActualEffort
+	current.DueDate = tc.DueDate
+	current.GroupID = tc.GroupID
+	current.UpdatedAt = time.Now()
+	current.Version++
+	tc.Version++
+	return nil
+}
+
+// Delete はテストケースを物理削除する
+// 工数記録・変更履歴が残っている場合は外部キー制約と同様に競合エラーを返す
+func (r *MemoryTestCaseRepository) Delete(ctx context.Context, id string) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, ok := r.store.cases[id]; !ok {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	if r.store.hasCaseRecords(id) {
+		return errors.NewDomainConflictError("TestCase", id, "関連する工数記録が存在するため削除できません")
+	}
+	delete(r.store.cases, id)
+	return nil
+}
+
+// FindByGroupID はグループに属するテストケースをID順に取得する
+func (r *MemoryTestCaseRepository) FindByGroupID(ctx context.Context, groupID string) ([]*entity.TestCase, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	cases := r.store.activeCases(func(tc *entity.TestCase) bool {
+		return tc.GroupID == groupID
+	})
+	sort.Slice(cases, func(i, j int) bool {
+		return cases[i].ID < cases[j].ID
+	})
+	return cases, nil
+}
+
+// FindByGroupIDs は複数のグループに属するテストケースをグループID・ID順に取得する
+func (r *MemoryTestCaseRepository) FindByGroupIDs(ctx context.Context, groupIDs []string) ([]*entity.TestCase, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	targets := make(map[string]bool, len(groupIDs))
+	for _, id := range groupIDs {
+		targets[id] = true
+	}
+
+	cases := r.store.activeCases(func(tc *entity.TestCase) bool {
+		return targets[tc.GroupID]
+	})
+	sort.Slice(cases, func(i, j int) bool {
+		if cases[i].GroupID != cases[j].GroupID {
+			return cases[i].GroupID < cases[j].GroupID
+		}
+		return cases[i].ID < cases[j].ID
+	})
+	return cases, nil
+}
+
+// UpdateStatus はテストケースのステータスを、現在のステータスがfrom・バージョンがexpectedVersionの場合のみ更新する
+func (r *MemoryTestCaseRepository) UpdateStatus(ctx context.Context, id string, from, to entity.TestStatus, expectedVersion int) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	tc, ok := r.store.activeCase(id)
+	if !ok {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	if tc.Status != from {
+		return errors.NewStatusTransitionConflictError("他の更新によりステータスが変更されています", string(tc.Status), string(to))
+	}
+	if tc.Version != expectedVersion {
+		return errors.NewConcurrentModificationError("TestCase", id, int64(tc.Version), int64(expectedVersion))
+	}
+	tc.Status = to
+	tc.UpdatedAt = time.Now()
+	tc.Version++
+	return nil
+}
+
+// AddEffort はテストケースの実績工数に加算する
+func (r *MemoryTestCaseRepository) AddEffort(ctx context.Context, id string, effort float64) error {
+	return r.modify(ctx, id, func(tc *entity.TestCase) {
+		tc.ActualEffort += effort
+	})
+}
+
+// UpdateDelay はテストケースの遅延状態を、ステータスがstatusのままの場合のみ更新する
+// 遅延状態はシステムが算出する値のため、バージョンは進めない
+func (r *MemoryTestCaseRepository) UpdateDelay(ctx context.Context, id string, status entity.TestStatus, isDelayed bool, delayDays int) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	tc, ok := r.store.activeCase(id)
+	if !ok {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	if tc.Status != status {
+		return errors.NewStatusTransitionConflictError("遅延状態の判定後にステータスが変更されています", string(tc.Status), string(status))
+	}
+	tc.IsDelayed = isDelayed
+	tc.DelayDays = delayDays
+	tc.UpdatedAt = time.Now()
+	return nil
+}
+
+// FindByStatus は指定されたステータスのテストケースを更新日時の新しい順に取得する
+func (r *MemoryTestCaseRepository) FindByStatus(ctx context.Context, status entity.TestStatus) ([]*entity.TestCase, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	cases := r.store.activeCases(func(tc *entity.TestCase) bool {
+		return tc.Status == status
+	})
+	sort.Slice(cases, func(i, j int) bool {
+		if !cases[i].UpdatedAt.Equal(cases[j].UpdatedAt) {
+			return cases[i].UpdatedAt.After(cases[j].UpdatedAt)
+		}
+		return cases[i].ID < cases[j].ID
+	})
+	return cases, nil
+}
+
+// AcquireLock はテストケースの編集ロックを取得または延長する
+// ロックが存在しない、期限切れ、または同じユーザーが保持している場合のみ取得できる
+func (r *MemoryTestCaseRepository) AcquireLock(ctx context.Context, id, editor string, expiresAt time.Time) (bool, error) {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	tc, ok := r.store.activeCase(id)
+	if !ok {
+		return false, errors.NewNotFoundError("TestCase", id)
+	}
+
+	available := !tc.IsLocked ||
+		tc.CurrentEditor == "" ||
+		tc.CurrentEditor == editor ||
+		tc.LockExpiresAt.IsZero() ||
+		!tc.LockExpiresAt.After(time.Now())
+	if !available {
+		return false, nil
+	}
+
+	tc.IsLocked = true
+	tc.CurrentEditor = editor
+	tc.LockExpiresAt = expiresAt
+	return true, nil
+}
+
+// ReleaseLock はテストケースの編集ロックを解除する
+// editorが指定された場合は、そのユーザーが保持しているロックのみ解除する
+func (r *MemoryTestCaseRepository) ReleaseLock(ctx context.Context, id, editor string) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	tc, ok := r.store.activeCase(id)
+	if !ok {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	if editor != "" && tc.CurrentEditor != editor {
+		return nil
+	}
+
+	tc.IsLocked = false
+	tc.CurrentEditor = ""
+	tc.LockExpiresAt = time.Time{}
+	return nil
+}
+
+// SoftDelete はテストケースを論理削除する
+func (r *MemoryTestCaseRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	tc, ok := r.store.activeCase(id)
+	if !ok {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	tc.DeletedAt = deletedAt
+	tc.Version++
+	return nil
+}
+
+// SoftDeleteByGroupID はグループに属する削除されていないテストケースを論理削除する
+func (r *MemoryTestCaseRepository) SoftDeleteByGroupID(ctx context.Context, groupID string, deletedAt time.Time) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	for _, tc := range r.store.cases {
+		if tc.GroupID == groupID && tc.DeletedAt.IsZero() {
+			tc.DeletedAt = deletedAt
+			tc.Version++
+		}
+	}
+	return nil
+}
+
+// Restore は論理削除されたテストケースを復元する
+func (r *MemoryTestCaseRepository) Restore(ctx context.Context, id string) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	tc, ok := r.store.cases[id]
+	if !ok || tc.DeletedAt.IsZero() {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	tc.DeletedAt = time.Time{}
+	tc.Version++
+	return nil
+}
+
+// RestoreByGroupID はグループと同じ日時に削除されたテストケースを復元する
+func (r *MemoryTestCaseRepository) RestoreByGroupID(ctx context.Context, groupID string, deletedAt time.Time) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	for _, tc := range r.store.cases {
+		if tc.GroupID == groupID && !tc.DeletedAt.IsZero() && tc.DeletedAt.Equal(deletedAt) {
+			tc.DeletedAt = time.Time{}
+			tc.Version++
+		}
+	}
+	return nil
+}
+
+// FindDeletedByID は論理削除されたテストケースを取得する
+func (r *MemoryTestCaseRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestCase, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	tc, ok := r.store.cases[id]
+	if !ok || tc.DeletedAt.IsZero() {
+		return nil, errors.NewNotFoundError("TestCase", id)
+	}
+	return clone(tc), nil
+}
+
+// FindDeleted は所属するグループが削除されていない、論理削除されたテストケースを取得する
+func (r *MemoryTestCaseRepository) FindDeleted(ctx context.Context) ([]*entity.TestCase, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	var cases []*entity.TestCase
+	for _, tc := range r.store.cases {
+		if tc.DeletedAt.IsZero() {
+			continue
+		}
+		if _, ok := r.store.activeGroup(tc.GroupID); !ok {
+			continue
+		}
+		cases = append(cases, clone(tc))
+	}
+	sort.Slice(cases, func(i, j int) bool {
+		return deletedFirst(cases[i].DeletedAt, cases[j].DeletedAt, cases[i].ID, cases[j].ID)
+	})
+	return cases, nil
+}
+
+// PurgeDeletedBefore はbeforeより前に論理削除されたテストケースを、工数記録・変更履歴とともに物理削除する
+func (r *MemoryTestCaseRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	purged := 0
+	for id, tc := range r.store.cases {
+		if tc.DeletedAt.IsZero() || !tc.DeletedAt.Before(before) {
+			continue
+		}
+		for recordID, record := range r.store.efforts {
+			if record.TestCaseID == id {
+				delete(r.store.efforts, recordID)
+			}
+		}
+		for historyID, history := range r.store.histories {
+			if history.TestCaseID == id {
+				delete(r.store.histories, historyID)
+			}
+		}
+		delete(r.store.cases, id)
+		purged++
+	}
+	return purged, nil
+}
+
+// modify は削除されていないテストケースにfnを適用し、更新日時とバージョンを進める
+func (r *MemoryTestCaseRepository) modify(ctx context.Context, id string, fn func(*entity.TestCase)) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	tc, ok := r.store.activeCase(id)
+	if !ok {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	fn(tc)
+	tc.UpdatedAt = time.Now()
+	tc.Version++
+	return nil
+}
+
+// activeCase は削除されていないテストケースを返す（呼び出し元でロックを取得すること）
+func (s *Store) activeCase(id string) (*entity.TestCase, bool) {
+	tc, ok := s.cases[id]
+	if !ok || !tc.DeletedAt.IsZero() {
+		return nil, false
+	}
+	return tc, true
+}
+
+// activeCases は削除されていないテストケースのうちmatchを満たすものの複製を返す
+func (s *Store) activeCases(match func(*entity.TestCase) bool) []*entity.TestCase {
+	var cases []*entity.TestCase
+	for _, tc := range s.cases {
+		if tc.DeletedAt.IsZero() && match(tc) {
+			cases = append(cases, clone(tc))
+		}
+	}
+	return cases
+}
+
+// hasCaseRecords は指定されたテストケースに工数記録または変更履歴が存在するかを返す
+func (s *Store) hasCaseRecords(caseID string) bool {
+	for _, record := range s.efforts {
+		if record.TestCaseID == caseID {
+			return true
+		}
+	}
+	for _, history := range s.histories {
+		if history.TestCaseID == caseID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,300 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// MemoryTestGroupRepository はテストグループをメモリ内に保存するリポジトリ実装
+type MemoryTestGroupRepository struct {
+	store *Store
+}
+
+// NewMemoryTestGroupRepository は指定されたデータ領域を使用するテストグループリポジトリを作成
+func NewMemoryTestGroupRepository(store *Store) repository.TestGroupRepository {
+	return &MemoryTestGroupRepository{store: store}
+}
+
+// Create はテストグループを保存する
+// 所属するスイートが存在しない場合は外部キー制約違反と同じ検証エラーを返す
+func (r *MemoryTestGroupRepository) Create(ctx context.Context, group *entity.TestGroup) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, exists := r.store.groups[group.ID]; exists {
+		return errors.NewAlreadyExistsError("TestGroup", group.ID)
+	}
+	if _, ok := r.store.suites[group.SuiteID]; !ok {
+		return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+			"id":      group.ID,
+			"suiteId": group.SuiteID,
+		})
+	}
+	r.store.groups[group.ID] = clone(group)
+	return nil
+}
+
+// FindByID は削除されていないテストグループを取得する
+func (r *MemoryTestGroupRepository) FindByID(ctx context.Context, id string) (*entity.TestGroup, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	group, ok := r.store.activeGroup(id)
+	if !ok {
+		return nil, errors.NewNotFoundError("TestGroup", id)
+	}
+	return clone(group), nil
+}
+
+// FindByIDForUpdate は削除されていないテストグループを取得する
+// トランザクションは直列に実行され、実行中はトランザクション外からの書き込みも待機するため、FindByIDと同じ結果を返す
+func (r *MemoryTestGroupRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.TestGroup, error) {
+	return r.FindByID(ctx, id)
+}
+
+// Update はバージョンが一致する場合のみテストグループを更新し、引数とともにバージョンを1つ進める
+// 所属するスイートは変更しない
+func (r *MemoryTestGroupRepository) Update(ctx context.Context, group *entity.TestGroup) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	current, ok := r.store.activeGroup(group.ID)
+	if !ok {
+		return errors.NewNotFoundError("TestGroup", group.ID)
+	}
+	if current.Version != group.Version {
+		return errors.NewConcurrentModificationError("TestGroup", group.ID, int64(current.Version), int64(group.Version))
+	}
+
+	current.Name = group.Name
+	current.Description = group.Description
+	current.DisplayOrder = group.DisplayOrder
+	current.Status = group.Status
+	current.StatusLocked = group.StatusLocked
+	current.UpdatedAt = time.Now()
+	current.Version++
+	group.Version++
+	return nil
+}
+
+// Delete はテストグループを物理削除する
+func (r *MemoryTestGroupRepository) Delete(ctx context.Context, id string) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, ok := r.store.groups[id]; !ok {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+	if r.store.hasCases(id) {
+		return errors.NewDomainConflictError("TestGroup", id, "関連するテストケースが存在するため削除できません")
+	}
+	delete(r.store.groups, id)
+	return nil
+}
+
+// FindBySuiteID はスイートに属するテストグループを表示順に取得する
+func (r *MemoryTestGroupRepository) FindBySuiteID(ctx context.Context, suiteID string) ([]*entity.TestGroup, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	var groups []*entity.TestGroup
+	for _, group := range r.store.groups {
+		if group.SuiteID == suiteID && group.DeletedAt.IsZero() {
+			groups = append(groups, clone(group))
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].DisplayOrder != groups[j].DisplayOrder {
+			return groups[i].DisplayOrder < groups[j].DisplayOrder
+		}
+		return groups[i].ID < groups[j].ID
+	})
+	return groups, nil
+}
+
+// FindBySuiteIDs は複数のスイートに属するテストグループをスイートID・表示順に取得する
+func (r *MemoryTestGroupRepository) FindBySuiteIDs(ctx context.Context, suiteIDs []string) ([]*entity.TestGroup, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	targets := make(map[string]bool, len(suiteIDs))
+	for _, id := range suiteIDs {
+		targets[id] = true
+	}
+
+	var groups []*entity.TestGroup
+	for _, group := range r.store.groups {
+		if targets[group.SuiteID] && group.DeletedAt.IsZero() {
+			groups = append(groups, clone(group))
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].SuiteID != groups[j].SuiteID {
+			return groups[i].SuiteID < groups[j].SuiteID
+		}
+		if groups[i].DisplayOrder != groups[j].DisplayOrder {
+			return groups[i].DisplayOrder < groups[j].DisplayOrder
+		}
+		return groups[i].ID < groups[j].ID
+	})
+	return groups, nil
+}
+
+// UpdateStatus はテストグループのステータスを更新する
+func (r *MemoryTestGroupRepository) UpdateStatus(ctx context.Context, id string, status valueobject.SuiteStatus) error {
+	return r.modify(ctx, id, func(group *entity.TestGroup) {
+		group.Status = status
+	})
+}
+
+// UpdateDisplayOrder はテストグループの表示順序を更新する
+func (r *MemoryTestGroupRepository) UpdateDisplayOrder(ctx context.Context, id string, displayOrder int) error {
+	return r.modify(ctx, id, func(group *entity.TestGroup) {
+		group.DisplayOrder = displayOrder
+	})
+}
+
+// SoftDelete はテストグループを論理削除する
+func (r *MemoryTestGroupRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	group, ok := r.store.activeGroup(id)
+	if !ok {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+	group.DeletedAt = deletedAt
+	group.Version++
+	return nil
+}
+
+// SoftDeleteBySuiteID はスイートに属する削除されていないテストグループを論理削除する
+func (r *MemoryTestGroupRepository) SoftDeleteBySuiteID(ctx context.Context, suiteID string, deletedAt time.Time) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	for _, group := range r.store.groups {
+		if group.SuiteID == suiteID && group.DeletedAt.IsZero() {
+			group.DeletedAt = deletedAt
+			group.Version++
+		}
+	}
+	return nil
+}
+
+// Restore は論理削除されたテストグループを復元する
+func (r *MemoryTestGroupRepository) Restore(ctx context.Context, id string) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	group, ok := r.store.groups[id]
+	if !ok || group.DeletedAt.IsZero() {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+	group.DeletedAt = time.Time{}
+	group.Version++
+	return nil
+}
+
+// RestoreBySuiteID はスイートと同じ日時に削除されたテストグループを復元する
+func (r *MemoryTestGroupRepository) RestoreBySuiteID(ctx context.Context, suiteID string, deletedAt time.Time) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	for _, group := range r.store.groups {
+		if group.SuiteID == suiteID && !group.DeletedAt.IsZero() && group.DeletedAt.Equal(deletedAt) {
+			group.DeletedAt = time.Time{}
+			group.Version++
+		}
+	}
+	return nil
+}
+
+// FindDeletedByID は論理削除されたテストグループを取得する
+func (r *MemoryTestGroupRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestGroup, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	group, ok := r.store.groups[id]
+	if !ok || group.DeletedAt.IsZero() {
+		return nil, errors.NewNotFoundError("TestGroup", id)
+	}
+	return clone(group), nil
+}
+
+// FindDeleted は所属するスイートが削除されていない、論理削除されたテストグループを取得する
+func (r *MemoryTestGroupRepository) FindDeleted(ctx context.Context) ([]*entity.TestGroup, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	var groups []*entity.TestGroup
+	for _, group := range r.store.groups {
+		if group.DeletedAt.IsZero() {
+			continue
+		}
+		if suite, ok := r.store.suites[group.SuiteID]; !ok || !suite.DeletedAt.IsZero() {
+			continue
+		}
+		groups = append(groups, clone(group))
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return deletedFirst(groups[i].DeletedAt, groups[j].DeletedAt, groups[i].ID, groups[j].ID)
+	})
+	return groups, nil
+}
+
+// PurgeDeletedBefore はbeforeより前に論理削除され、配下のケースが残っていないテストグループを物理削除する
+func (r *MemoryTestGroupRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	purged := 0
+	for id, group := range r.store.groups {
+		if group.DeletedAt.IsZero() || !group.DeletedAt.Before(before) || r.store.hasCases(id) {
+			continue
+		}
+		delete(r.store.groups, id)
+		purged++
+	}
+	return purged, nil
+}
+
+// modify は削除されていないテストグループにfnを適用し、更新日時とバージョンを進める
+func (r *MemoryTestGroupRepository) modify(ctx context.Context, id string, fn func(*entity.TestGroup)) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	group, ok := r.store.activeGroup(id)
+	if !ok {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+	fn(group)
+	group.UpdatedAt = time.Now()
+	group.Version++
+	return nil
+}
+
+// activeGroup は削除されていないテストグループを返す（呼び出し元でロックを取得すること）
+func (s *Store) activeGroup(id string) (*entity.TestGroup, bool) {
+	group, ok := s.groups[id]
+	if !ok || !group.DeletedAt.IsZero() {
+		return nil, false
+	}
+	return group, true
+}
+
+// hasCases は削除済みを含め、指定されたグループに属するケースが存在するかを返す
+func (s *Store) hasCases(groupID string) bool {
+	for _, tc := range s.cases {
+		if tc.GroupID == groupID {
+			return true
+		}
+	}
+	return false
+}
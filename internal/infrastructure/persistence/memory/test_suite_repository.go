@@ -0,0 +1,431 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// defaultPageSize はFindWithFiltersでページサイズが指定されなかった場合の件数（PostgreSQL実装と同じ）
+const defaultPageSize = 10
+
+// MemoryTestSuiteRepository はテストスイートをメモリ内に保存するリポジトリ実装
+// エラーの種類はPostgreSQL実装に合わせています
+type MemoryTestSuiteRepository struct {
+	store *Store
+}
+
+// NewMemoryTestSuiteRepository は指定されたデータ領域を使用するテストスイートリポジトリを作成
+func NewMemoryTestSuiteRepository(store *Store) repository.TestSuiteRepository {
+	return &MemoryTestSuiteRepository{store: store}
+}
+
+// Create はテストスイートを保存する
+func (r *MemoryTestSuiteRepository) Create(ctx context.Context, suite *entity.TestSuite) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, exists := r.store.suites[suite.ID]; exists {
+		return customerrors.Conflict("TestSuite", suite.ID, "は既に存在しています")
+	}
+	r.store.suites[suite.ID] = cloneSuite(suite)
+	return nil
+}
+
+// FindByID は削除されていないテストスイートを取得する
+func (r *MemoryTestSuiteRepository) FindByID(ctx context.Context, id string) (*entity.TestSuite, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	suite, ok := r.store.suites[id]
+	if !ok || !suite.DeletedAt.IsZero() {
+		return nil, customerrors.NotFound("TestSuite", id)
+	}
+	return cloneSuite(suite), nil
+}
+
+// Update はバージョンが一致する場合のみテストスイートを更新し、バージョンを1つ進める
+// PostgreSQLの実装と同様に、更新に成功した場合は引数のバージョンも進める
+func (r *MemoryTestSuiteRepository) Update(ctx context.Context, suite *entity.TestSuite) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	current, ok := r.store.suites[suite.ID]
+	if !ok || !current.DeletedAt.IsZero() {
+		return customerrors.NotFound("TestSuite", suite.ID)
+	}
+	if current.Version != suite.Version {
+		return errors.NewConcurrentModificationError("TestSuite", suite.ID, int64(current.Version), int64(suite.Version))
+	}
+
+	updated := cloneSuite(suite)
+	updated.Version = current.Version + 1
+	updated.CreatedAt = current.CreatedAt
+	updated.DeletedAt = time.Time{}
+	updated.UpdatedAt = time.Now()
+	r.store.suites[suite.ID] = updated
+	suite.Version++
+	return nil
+}
+
+// Delete はテストスイートを物理削除する
+// 配下にグループが残っている場合は外部キー制約と同様に競合エラーを返す
+func (r *MemoryTestSuiteRepository) Delete(ctx context.Context, id string) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, ok := r.store.suites[id]; !ok {
+		return customerrors.NotFound("TestSuite", id)
+	}
+	if r.store.hasGroups(id) {
+		return customerrors.Conflict("TestSuite", id, "は関連するデータが存在するため削除できません")
+	}
+	delete(r.store.suites, id)
+	return nil
+}
+
+// UpdateStatus はテストスイートのステータスを更新する
+func (r *MemoryTestSuiteRepository) UpdateStatus(ctx context.Context, id string, status valueobject.SuiteStatus) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	suite, ok := r.store.suites[id]
+	if !ok || !suite.DeletedAt.IsZero() {
+		return customerrors.NotFound("TestSuite", id)
+	}
+	suite.Status = status
+	suite.UpdatedAt = time.Now()
+	suite.Version++
+	return nil
+}
+
+// FindByStatus は指定されたステータスのテストスイートを作成日時の新しい順に取得する
+func (r *MemoryTestSuiteRepository) FindByStatus(ctx context.Context, status valueobject.SuiteStatus) ([]*entity.TestSuite, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	return r.store.activeSuites(func(suite *entity.TestSuite) bool {
+		return suite.Status == status
+	}), nil
+}
+
+// FindWithFilters は絞り込み条件に一致するテストスイートを指定された並び順のページ単位（オフセット方式またはキーセット方式）で取得し、絞り込み後の総件数とともに返す
+func (r *MemoryTestSuiteRepository) FindWithFilters(ctx context.Context, params *dto.TestSuiteQueryParamDTO) ([]*entity.TestSuite, int, error) {
+	var status valueobject.SuiteStatus
+	if params.Status != nil {
+		s, err := valueobject.NewSuiteStatus(*params.Status)
+		if err != nil {
+			return nil, 0, customerrors.Validation(
+				"無効なステータス値です",
+				map[string]string{
+					"status": *params.Status,
+					"error":  err.Error(),
+				},
+			)
+		}
+		status = s
+	}
+
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	// 進捗率は絞り込み・並び替えで複数回参照するため、スイートごとに1回だけ計算する
+	progress := make(map[string]float64)
+	progressOf := func(suite *entity.TestSuite) float64 {
+		if p, ok := progress[suite.ID]; ok {
+			return p
+		}
+		p := dto.RoundProgress(entity.NewProgressSummary(r.store.suiteCases(suite.ID)).ProgressPercentage)
+		progress[suite.ID] = p
+		return p
+	}
+
+	matched := r.store.activeSuites(func(suite *entity.TestSuite) bool {
+		if params.Status != nil && suite.Status != status {
+			return false
+		}
+		if params.StartDate != nil && suite.EstimatedStartDate.Before(*params.StartDate) {
+			return false
+		}
+		if params.EndDate != nil && suite.EstimatedEndDate.After(*params.EndDate) {
+			return false
+		}
+		if params.Search != nil && !containsFold(suite.Name, *params.Search) && !containsFold(suite.Description, *params.Search) {
+			return false
+		}
+		if params.CreatedBy != nil && suite.CreatedBy != *params.CreatedBy {
+			return false
+		}
+		if params.PeriodFrom != nil && suite.EstimatedEndDate.Before(*params.PeriodFrom) {
+			return false
+		}
+		if params.PeriodTo != nil && suite.EstimatedStartDate.After(*params.PeriodTo) {
+			return false
+		}
+		if params.MinProgress != nil && progressOf(suite) < *params.MinProgress {
+			return false
+		}
+		if params.MaxProgress != nil && progressOf(suite) > *params.MaxProgress {
+			return false
+		}
+		if params.HasDelayedCases != nil && r.store.hasDelayedCases(suite.ID) != *params.HasDelayedCases {
+			return false
+		}
+		return true
+	})
+
+	order := params.SortOrder()
+	values := make(map[string][]any, len(matched))
+	for _, suite := range matched {
+		values[suite.ID] = sortValues(suite, order, progressOf)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return compareSortKeys(order, values[matched[i].ID], matched[i].ID, values[matched[j].ID], matched[j].ID) < 0
+	})
+
+	if keyset := params.Keyset; keyset != nil {
+		return keysetPage(matched, keyset, func(suite *entity.TestSuite, cursor *dto.TestSuiteCursor) int {
+			return compareSortKeys(order, values[suite.ID], suite.ID, cursor.Values, cursor.ID)
+		}), len(matched), nil
+	}
+
+	limit := defaultPageSize
+	offset := 0
+	if params.PageSize != nil {
+		limit = *params.PageSize
+	}
+	if params.Page != nil {
+		offset = (*params.Page - 1) * limit
+	}
+
+	return paginate(matched, offset, limit), len(matched), nil
+}
+
+// SoftDelete はテストスイートを論理削除する
+func (r *MemoryTestSuiteRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	suite, ok := r.store.suites[id]
+	if !ok || !suite.DeletedAt.IsZero() {
+		return customerrors.NotFound("TestSuite", id)
+	}
+	suite.DeletedAt = deletedAt
+	suite.Version++
+	return nil
+}
+
+// Restore は論理削除されたテストスイートを復元する
+func (r *MemoryTestSuiteRepository) Restore(ctx context.Context, id string) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	suite, ok := r.store.suites[id]
+	if !ok || suite.DeletedAt.IsZero() {
+		return customerrors.NotFound("TestSuite", id)
+	}
+	suite.DeletedAt = time.Time{}
+	suite.Version++
+	return nil
+}
+
+// FindDeletedByID は論理削除されたテストスイートを取得する
+func (r *MemoryTestSuiteRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestSuite, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	suite, ok := r.store.suites[id]
+	if !ok || suite.DeletedAt.IsZero() {
+		return nil, customerrors.NotFound("TestSuite", id)
+	}
+	return cloneSuite(suite), nil
+}
+
+// FindDeleted は論理削除されたテストスイートを削除日時の新しい順に取得する
+func (r *MemoryTestSuiteRepository) FindDeleted(ctx context.Context) ([]*entity.TestSuite, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	var suites []*entity.TestSuite
+	for _, suite := range r.store.suites {
+		if !suite.DeletedAt.IsZero() {
+			suites = append(suites, cloneSuite(suite))
+		}
+	}
+	sort.Slice(suites, func(i, j int) bool {
+		return deletedFirst(suites[i].DeletedAt, suites[j].DeletedAt, suites[i].ID, suites[j].ID)
+	})
+	return suites, nil
+}
+
+// PurgeDeletedBefore はbeforeより前に論理削除され、配下のグループが残っていないテストスイートを物理削除する
+func (r *MemoryTestSuiteRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	purged := 0
+	for id, suite := range r.store.suites {
+		if suite.DeletedAt.IsZero() || !suite.DeletedAt.Before(before) || r.store.hasGroups(id) {
+			continue
+		}
+		delete(r.store.suites, id)
+		purged++
+	}
+	return purged, nil
+}
+
+// activeSuites は削除されていないテストスイートのうちmatchを満たすものを作成日時の新しい順に返す
+// 呼び出し元でロックを取得してください
+func (s *Store) activeSuites(match func(*entity.TestSuite) bool) []*entity.TestSuite {
+	var suites []*entity.TestSuite
+	for _, suite := range s.suites {
+		if suite.DeletedAt.IsZero() && match(suite) {
+			suites = append(suites, cloneSuite(suite))
+		}
+	}
+	sort.Slice(suites, func(i, j int) bool {
+		if !suites[i].CreatedAt.Equal(suites[j].CreatedAt) {
+			return suites[i].CreatedAt.After(suites[j].CreatedAt)
+		}
+		return suites[i].ID > suites[j].ID
+	})
+	return suites
+}
+
+// keysetPage は並び順に並んだスイートから、カーソルの範囲内のLimit件を取得する
+// compareはスイートとカーソルの並び順での前後を比較する（スイートが前の場合は負の値）
+func keysetPage(suites []*entity.TestSuite, keyset *dto.TestSuiteKeyset, compare func(*entity.TestSuite, *dto.TestSuiteCursor) int) []*entity.TestSuite {
+	var inRange []*entity.TestSuite
+	for _, suite := range suites {
+		if keyset.After != nil && compare(suite, keyset.After) <= 0 {
+			continue
+		}
+		if keyset.Before != nil && compare(suite, keyset.Before) >= 0 {
+			continue
+		}
+		inRange = append(inRange, suite)
+	}
+
+	if keyset.FromLast {
+		return paginate(inRange, len(inRange)-keyset.Limit, keyset.Limit)
+	}
+	return paginate(inRange, 0, keyset.Limit)
+}
+
+// sortValues は並び順の各項目に対応するスイートの値を返す（dto.TestSuiteSortField.Valueと同じ型）
+func sortValues(suite *entity.TestSuite, order []dto.TestSuiteSortDTO, progressOf func(*entity.TestSuite) float64) []any {
+	values := make([]any, len(order))
+	for i, key := range order {
+		switch key.Field {
+		case dto.TestSuiteSortByName:
+			values[i] = suite.Name
+		case dto.TestSuiteSortByEstimatedStartDate:
+			values[i] = suite.EstimatedStartDate
+		case dto.TestSuiteSortByEstimatedEndDate:
+			values[i] = suite.EstimatedEndDate
+		case dto.TestSuiteSortByProgress:
+			values[i] = progressOf(suite)
+		case dto.TestSuiteSortByUpdatedAt:
+			values[i] = suite.UpdatedAt
+		default:
+			values[i] = suite.CreatedAt
+		}
+	}
+	return values
+}
+
+// compareSortKeys は並び順で位置aが位置bより前の場合は負、後の場合は正の値を返す
+// すべての項目が同じ場合は最後の項目と同じ方向のIDで比較する
+func compareSortKeys(order []dto.TestSuiteSortDTO, aValues []any, aID string, bValues []any, bID string) int {
+	for i, key := range order {
+		if c := compareSortValue(aValues[i], bValues[i]); c != 0 {
+			if key.Desc {
+				return -c
+			}
+			return c
+		}
+	}
+	c := strings.Compare(aID, bID)
+	if order[len(order)-1].Desc {
+		return -c
+	}
+	return c
+}
+
+func compareSortValue(a, b any) int {
+	switch av := a.(type) {
+	case string:
+		return strings.Compare(av, b.(string))
+	case float64:
+		return cmp.Compare(av, b.(float64))
+	case time.Time:
+		return av.Compare(b.(time.Time))
+	default:
+		return 0
+	}
+}
+
+// containsFold はsがsubstrを大文字・小文字を区別せずに含むかを返す
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// suiteCases はスイート配下の削除されていないグループに属する、削除されていないテストケースを返す
+func (s *Store) suiteCases(suiteID string) []*entity.TestCase {
+	return s.activeCases(func(tc *entity.TestCase) bool {
+		group, ok := s.activeGroup(tc.GroupID)
+		return ok && group.SuiteID == suiteID
+	})
+}
+
+// hasDelayedCases はスイート配下に遅延している削除されていないテストケースが存在するかを返す
+func (s *Store) hasDelayedCases(suiteID string) bool {
+	for _, tc := range s.suiteCases(suiteID) {
+		if tc.IsDelayed {
+			return true
+		}
+	}
+	return false
+}
+
+// hasGroups は削除済みを含め、指定されたスイートに属するグループが存在するかを返す
+func (s *Store) hasGroups(suiteID string) bool {
+	for _, group := range s.groups {
+		if group.SuiteID == suiteID {
+			return true
+		}
+	}
+	return false
+}
+
+// deletedFirst はゴミ箱の並び順（削除日時の新しい順、同時刻はID順）で a が b より前かを返す
+func deletedFirst(a, b time.Time, aID, bID string) bool {
+	if !a.Equal(b) {
+		return a.After(b)
+	}
+	return aID < bID
+}
+
+// paginate はoffsetからlimit件を切り出す
+func paginate[T any](items []*T, offset, limit int) []*T {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(items) || limit <= 0 {
+		return nil
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+)
+
+// Snapshotter はトランザクションのロールバックに参加するメモリ内リポジトリが実装するインターフェース
+type Snapshotter interface {
+	// Snapshot はトランザクション外からの書き込みを止めて現在の状態を保存し、
+	// その状態に戻す関数と、書き込みの停止を解除する関数を返す
+	Snapshot() (restore func(), release func())
+}
+
+// txContextKey はトランザクション内であることをcontextに記録するためのキー
+type txContextKey struct{}
+
+// writeGate はトランザクションの実行中、トランザクション外からの書き込みを待機させる
+// スナップショットの保存から復元までの間に他の書き込みが入ると、ロールバックでその書き込みまで失われるため
+type writeGate struct {
+	mutex sync.Mutex
+}
+
+// enter はトランザクション外からの書き込みの場合、実行中のトランザクションが終わるまで待機する
+// 戻り値の関数で書き込みの終了を通知する
+func (g *writeGate) enter(ctx context.Context) (leave func()) {
+	if ctx.Value(txContextKey{}) != nil {
+		return func() {}
+	}
+	g.mutex.Lock()
+	return g.mutex.Unlock
+}
+
+// close はトランザクション外からの書き込みを止め、再開する関数を返す
+func (g *writeGate) close() (open func()) {
+	g.mutex.Lock()
+	return g.mutex.Unlock
+}
+
+// MemoryTransactionManager はメモリ内リポジトリ向けのトランザクション管理実装
+// トランザクションは1つずつ直列に実行し、失敗時は開始時のスナップショットに戻す
+// 実行中はトランザクション外からの書き込みを待機させるため、fnの中ではfnに渡されたcontextで書き込むこと
+type MemoryTransactionManager struct {
+	participants []Snapshotter
+	mutex        sync.Mutex
+}
+
+// NewMemoryTransactionManager は新しいメモリ内トランザクション管理インスタンスを作成
+// participantsにはロールバック対象とするリポジトリを指定する
+func NewMemoryTransactionManager(participants ...Snapshotter) repository.TransactionManager {
+	return &MemoryTransactionManager{
+		participants: participants,
+	}
+}
+
+// RunInTransaction はfnを実行し、エラーまたはパニックの場合は各リポジトリの状態を元に戻す
+func (m *MemoryTransactionManager) RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// 外側のトランザクションに参加する
+	if ctx.Value(txContextKey{}) != nil {
+		return fn(ctx)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	restores := make([]func(), len(m.participants))
+	for i, participant := range m.participants {
+		restore, release := participant.Snapshot()
+		restores[i] = restore
+		defer release()
+	}
+	rollback := func() {
+		for i := len(restores) - 1; i >= 0; i-- {
+			restores[i]()
+		}
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, struct{}{})); err != nil {
+		rollback()
+		return err
+	}
+
+	return nil
+}
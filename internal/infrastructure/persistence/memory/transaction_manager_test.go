@@ -0,0 +1,82 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/memory"
+)
+
+func newRefreshToken(id, token string) *entity.RefreshToken {
+	return &entity.RefreshToken{
+		ID:        id,
+		UserID:    "user-1",
+		Token:     token,
+		ExpiresAt: time.Now().Add(time.Hour),
+		IssuedAt:  time.Now(),
+	}
+}
+
+func TestMemoryTransactionManager_RollbackOnError(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMemoryRefreshTokenRepository()
+	txManager := memory.NewMemoryTransactionManager(repo.(memory.Snapshotter))
+
+	if err := repo.Store(ctx, newRefreshToken("1", "token-1")); err != nil {
+		t.Fatalf("failed to store token: %v", err)
+	}
+
+	errFailed := errors.New("failed")
+	err := txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := repo.Store(ctx, newRefreshToken("2", "token-2")); err != nil {
+			return err
+		}
+		if err := repo.Revoke(ctx, "1"); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected fn error, got %v", err)
+	}
+
+	// トランザクション内の変更がすべて取り消されていること
+	if _, err := repo.GetByToken(ctx, "token-2"); err == nil {
+		t.Errorf("token stored in rolled back transaction should not exist")
+	}
+	token, err := repo.GetByToken(ctx, "token-1")
+	if err != nil {
+		t.Fatalf("failed to get token: %v", err)
+	}
+	if token.IsRevoked {
+		t.Errorf("revocation in rolled back transaction should be undone")
+	}
+}
+
+func TestMemoryTransactionManager_CommitAndNested(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMemoryRefreshTokenRepository()
+	txManager := memory.NewMemoryTransactionManager(repo.(memory.Snapshotter))
+
+	err := txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := repo.Store(ctx, newRefreshToken("1", "token-1")); err != nil {
+			return err
+		}
+		// 内側の呼び出しは外側のトランザクションに参加する（デッドロックしない）
+		return txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+			return repo.Store(ctx, newRefreshToken("2", "token-2"))
+		})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, tokenString := range []string{"token-1", "token-2"} {
+		if _, err := repo.GetByToken(ctx, tokenString); err != nil {
+			t.Errorf("token %s should be committed: %v", tokenString, err)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// MemoryUserRepository はユーザーをメモリ内に保存するリポジトリ実装
+// ユーザー名はusersテーブルの一意制約と同様に重複を許可しない
+type MemoryUserRepository struct {
+	store *Store
+}
+
+// NewMemoryUserRepository は指定されたデータ領域を使用するユーザーリポジトリを作成
+func NewMemoryUserRepository(store *Store) repository.UserRepository {
+	return &MemoryUserRepository{store: store}
+}
+
+// Create はユーザーを保存する
+func (r *MemoryUserRepository) Create(ctx context.Context, user *entity.User) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, exists := r.store.users[user.ID]; exists || r.usernameTaken(user.Username, "") {
+		return customerrors.Conflict("User", user.ID, "は既に存在しています")
+	}
+	r.store.users[user.ID] = cloneUser(user)
+	return nil
+}
+
+// FindByID はユーザーを取得する
+func (r *MemoryUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	user, ok := r.store.users[id]
+	if !ok {
+		return nil, customerrors.NotFound("User", id)
+	}
+	return cloneUser(user), nil
+}
+
+// FindByUsername はユーザー名でユーザーを取得する
+func (r *MemoryUserRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	for _, user := range r.store.users {
+		if user.Username == username {
+			return cloneUser(user), nil
+		}
+	}
+	return nil, customerrors.NotFound("User", "").WithContext(customerrors.Context{
+		"username": username,
+	})
+}
+
+// Update はユーザー名・パスワード・ロール・最終ログイン日時を更新する
+func (r *MemoryUserRepository) Update(ctx context.Context, user *entity.User) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	current, ok := r.store.users[user.ID]
+	if !ok {
+		return customerrors.NotFound("User", user.ID)
+	}
+	if r.usernameTaken(user.Username, user.ID) {
+		return customerrors.Conflict("User", user.ID, "は既に存在しています")
+	}
+
+	updated := cloneUser(user)
+	updated.CreatedAt = current.CreatedAt
+	updated.UpdatedAt = time.Now()
+	r.store.users[user.ID] = updated
+	return nil
+}
+
+// UpdateLastLogin は最終ログイン日時を現在時刻に更新する
+func (r *MemoryUserRepository) UpdateLastLogin(ctx context.Context, id string) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	user, ok := r.store.users[id]
+	if !ok {
+		return customerrors.NotFound("User", id)
+	}
+	now := time.Now()
+	user.LastLoginAt = &now
+	user.UpdatedAt = now
+	return nil
+}
+
+// Delete はユーザーを削除する
+func (r *MemoryUserRepository) Delete(ctx context.Context, id string) error {
+	unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if _, ok := r.store.users[id]; !ok {
+		return customerrors.NotFound("User", id)
+	}
+	delete(r.store.users, id)
+	return nil
+}
+
+// FindAll は全ユーザーを作成日時の新しい順に取得する
+func (r *MemoryUserRepository) FindAll(ctx context.Context) ([]*entity.User, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	users := make([]*entity.User, 0, len(r.store.users))
+	for _, user := range r.store.users {
+		users = append(users, cloneUser(user))
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if !users[i].CreatedAt.Equal(users[j].CreatedAt) {
+			return users[i].CreatedAt.After(users[j].CreatedAt)
+		}
+		return users[i].ID < users[j].ID
+	})
+	return users, nil
+}
+
+// CountByRole は指定されたロールのユーザー数を返す
+func (r *MemoryUserRepository) CountByRole(ctx context.Context, role entity.UserRole) (int, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+
+	count := 0
+	for _, user := range r.store.users {
+		if user.Role == role {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// usernameTaken はexceptID以外のユーザーが指定されたユーザー名を使用しているかを返す
+func (r *MemoryUserRepository) usernameTaken(username, exceptID string) bool {
+	for id, user := range r.store.users {
+		if id != exceptID && user.Username == username {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Usage は migrate サブコマンドの使い方です
+const Usage = `usage: migrate <command>
+
+commands:
+  up              apply all pending migrations (default)
+  down [N]        roll back the last N migrations (default 1)
+  to <version>    migrate up or down to the given version (0 rolls back everything)
+  status          show the applied version and pending migrations
+  force <version> record the version without running migrations (repairs a dirty database)`
+
+// command は解析済みの migrate サブコマンドです
+type command struct {
+	name    string
+	version uint
+	steps   int
+}
+
+// parseCommand は migrate に続く引数を解析します
+func parseCommand(args []string) (command, error) {
+	if len(args) == 0 {
+		return command{name: "up"}, nil
+	}
+
+	name, rest := args[0], args[1:]
+	switch name {
+	case "up", "status":
+		if len(rest) != 0 {
+			return command{}, fmt.Errorf("%s takes no arguments\n\n%s", name, Usage)
+		}
+		return command{name: name}, nil
+	case "down":
+		if len(rest) > 1 {
+			return command{}, fmt.Errorf("down takes at most one argument\n\n%s", Usage)
+		}
+		steps := 1
+		if len(rest) == 1 {
+			n, err := strconv.Atoi(rest[0])
+			if err != nil || n <= 0 {
+				return command{}, fmt.Errorf("invalid number of migrations %q\n\n%s", rest[0], Usage)
+			}
+			steps = n
+		}
+		return command{name: name, steps: steps}, nil
+	case "to", "force":
+		if len(rest) != 1 {
+			return command{}, fmt.Errorf("%s requires a version\n\n%s", name, Usage)
+		}
+		version, err := strconv.ParseUint(rest[0], 10, 64)
+		if err != nil {
+			return command{}, fmt.Errorf("invalid version %q\n\n%s", rest[0], Usage)
+		}
+		return command{name: name, version: uint(version)}, nil
+	default:
+		return command{}, fmt.Errorf("unknown migrate command %q\n\n%s", name, Usage)
+	}
+}
+
+// RunCommand は migrate サブコマンドを実行し、結果をoutに出力します
+func RunCommand(ctx context.Context, m *Migrator, args []string, out io.Writer) error {
+	cmd, err := parseCommand(args)
+	if err != nil {
+		return err
+	}
+
+	var executed []Migration
+	switch cmd.name {
+	case "up":
+		executed, err = m.Up(ctx)
+	case "down":
+		executed, err = m.Down(ctx, cmd.steps)
+	case "to":
+		executed, err = m.To(ctx, cmd.version)
+	case "force":
+		if err := m.Force(ctx, cmd.version); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "forced version %d\n", cmd.version)
+		return nil
+	case "status":
+		return printStatus(ctx, m, out)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(executed) == 0 {
+		fmt.Fprintln(out, "no change")
+		return nil
+	}
+	for _, migration := range executed {
+		fmt.Fprintf(out, "%s: %s\n", cmd.name, migration)
+	}
+	return nil
+}
+
+func printStatus(ctx context.Context, m *Migrator, out io.Writer) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	dirty := ""
+	if status.Dirty {
+		dirty = " (dirty)"
+	}
+	fmt.Fprintf(out, "version: %d%s\n", status.Version, dirty)
+	for _, migration := range status.Migrations {
+		state := "pending"
+		if migration.Applied {
+			state = "applied"
+		}
+		fmt.Fprintf(out, "  %-8s %s\n", state, migration.Migration)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// Dialect はマイグレーションを適用するデータベースの種類です
+type Dialect string
+
+const (
+	// DialectPostgres はPostgreSQLに適用します
+	DialectPostgres Dialect = "postgres"
+	// DialectSQLite はSQLiteに適用します
+	DialectSQLite Dialect = "sqlite"
+)
+
+// advisoryLockKey はマイグレーション中に取得するPostgreSQLのアドバイザリーロックのキー
+// 複数のレプリカが同時に起動しても、マイグレーションを実行するのは1つだけになります
+const advisoryLockKey int64 = 4_210_817_365
+
+// ParseDialect はデータベース設定のdriverからDialectを決定します
+// 空の場合はPostgreSQLとして扱います
+func ParseDialect(driver string) (Dialect, error) {
+	switch d := Dialect(strings.ToLower(strings.TrimSpace(driver))); d {
+	case "", "postgresql":
+		return DialectPostgres, nil
+	case DialectPostgres, DialectSQLite:
+		return d, nil
+	default:
+		return "", fmt.Errorf("unsupported database driver %q: must be one of postgres, sqlite", driver)
+	}
+}
+
+// acquireLock はマイグレーションの排他ロックを取得します
+// SQLiteは単一ノードでの運用が前提のためロックを取得せず、各マイグレーションのトランザクションで書き込みを直列化します
+func (d Dialect) acquireLock(ctx context.Context, conn *sql.Conn) error {
+	if d == DialectSQLite {
+		return nil
+	}
+	_, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", advisoryLockKey)
+	return err
+}
+
+// releaseLock はacquireLockで取得したロックを解放します
+func (d Dialect) releaseLock(ctx context.Context, conn *sql.Conn) error {
+	if d == DialectSQLite {
+		return nil
+	}
+	_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", advisoryLockKey)
+	return err
+}
+
+// tableExistsQuery はschema_migrationsテーブルが存在するかどうかを返すクエリです
+func (d Dialect) tableExistsQuery() string {
+	if d == DialectSQLite {
+		return "SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations')"
+	}
+	return "SELECT to_regclass('schema_migrations') IS NOT NULL"
+}
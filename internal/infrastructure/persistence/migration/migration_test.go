@@ -0,0 +1,208 @@
+package migration
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/FUJI0130/go-ddd-ca/scripts/migrations"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"000002_add_column.up.sql":     {Data: []byte("ALTER TABLE a ADD COLUMN b INT;")},
+		"000002_add_column.down.sql":   {Data: []byte("ALTER TABLE a DROP COLUMN b;")},
+		"000001_create_table.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+		"000001_create_table.down.sql": {Data: []byte("DROP TABLE a;")},
+		"000003_seed.up.sql":           {Data: []byte("INSERT INTO a VALUES (1);")},
+		"README.md":                    {Data: []byte("ignored")},
+		"embed.go":                     {Data: []byte("package migrations")},
+	}
+
+	got, err := Load(fsys)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 migrations, got %d", len(got))
+	}
+	for i, want := range []string{"000001_create_table", "000002_add_column", "000003_seed"} {
+		if got[i].String() != want {
+			t.Errorf("migrations[%d] = %s, want %s", i, got[i], want)
+		}
+	}
+	if got[0].Up != "CREATE TABLE a (id INT);" || got[0].Down != "DROP TABLE a;" {
+		t.Errorf("unexpected contents: %+v", got[0])
+	}
+	if got[2].Down != "" {
+		t.Errorf("migration without down file should have empty Down, got %q", got[2].Down)
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+	}{
+		{
+			name: "upがない",
+			fsys: fstest.MapFS{"000001_create_table.down.sql": {Data: []byte("DROP TABLE a;")}},
+		},
+		{
+			name: "同じバージョンで名前が異なる",
+			fsys: fstest.MapFS{
+				"000001_create_table.up.sql": {Data: []byte("CREATE TABLE a (id INT);")},
+				"000001_other.down.sql":      {Data: []byte("DROP TABLE a;")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Load(tt.fsys); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+// 埋め込んだマイグレーションはすべて取り消し可能で、バージョンが連番であること
+func TestEmbeddedMigrations(t *testing.T) {
+	embedded, err := Load(migrations.Files)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(embedded) == 0 {
+		t.Fatal("no migrations embedded")
+	}
+	for i, m := range embedded {
+		if m.Version != uint(i+1) {
+			t.Errorf("expected version %d, got %s", i+1, m)
+		}
+		if m.Down == "" {
+			t.Errorf("migration %s has no down file", m)
+		}
+	}
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Name: "one", Up: "up1", Down: "down1"},
+		{Version: 2, Name: "two", Up: "up2", Down: "down2"},
+		{Version: 5, Name: "five", Up: "up5", Down: "down5"},
+	}
+}
+
+func describe(steps []step) string {
+	result := ""
+	for _, s := range steps {
+		direction := "down"
+		if s.up {
+			direction = "up"
+		}
+		result += fmt.Sprintf("%s%d->%d ", direction, s.migration.Version, s.version)
+	}
+	return result
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		current uint
+		target  uint
+		want    string
+		wantErr bool
+	}{
+		{name: "空のデータベースから最新まで", current: 0, target: 5, want: "up1->1 up2->2 up5->5 "},
+		{name: "途中から指定バージョンまで", current: 1, target: 2, want: "up2->2 "},
+		{name: "1つ前に戻す", current: 5, target: 2, want: "down5->2 "},
+		{name: "すべて取り消す", current: 5, target: 0, want: "down5->2 down2->1 down1->0 "},
+		{name: "変更なし", current: 2, target: 2, want: ""},
+		{name: "存在しないバージョンを指定", current: 0, target: 3, wantErr: true},
+		{name: "データベースが未知のバージョン", current: 4, target: 5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps, err := plan(testMigrations(), tt.current, tt.target)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("plan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := describe(steps); got != tt.want {
+				t.Errorf("plan() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlan_MissingDown(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "one", Up: "up1", Down: "down1"},
+		{Version: 2, Name: "two", Up: "up2"},
+	}
+	if _, err := plan(migrations, 2, 1); err == nil {
+		t.Error("expected error for migration without down file")
+	}
+}
+
+func TestStepsBack(t *testing.T) {
+	tests := []struct {
+		name    string
+		current uint
+		n       int
+		want    uint
+		wantErr bool
+	}{
+		{name: "1件戻す", current: 5, n: 1, want: 2},
+		{name: "すべて戻す", current: 5, n: 3, want: 0},
+		{name: "適用数より多い", current: 2, n: 3, wantErr: true},
+		{name: "0件は不正", current: 5, n: 0, wantErr: true},
+		{name: "未知のバージョン", current: 4, n: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := stepsBack(testMigrations(), tt.current, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("stepsBack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("stepsBack() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    command
+		wantErr bool
+	}{
+		{name: "引数なしはup", args: nil, want: command{name: "up"}},
+		{name: "up", args: []string{"up"}, want: command{name: "up"}},
+		{name: "downの既定は1件", args: []string{"down"}, want: command{name: "down", steps: 1}},
+		{name: "down N", args: []string{"down", "3"}, want: command{name: "down", steps: 3}},
+		{name: "to", args: []string{"to", "12"}, want: command{name: "to", version: 12}},
+		{name: "force", args: []string{"force", "0"}, want: command{name: "force", version: 0}},
+		{name: "status", args: []string{"status"}, want: command{name: "status"}},
+		{name: "不明なコマンド", args: []string{"redo"}, wantErr: true},
+		{name: "downに不正な件数", args: []string{"down", "0"}, wantErr: true},
+		{name: "toにバージョンがない", args: []string{"to"}, wantErr: true},
+		{name: "toに不正なバージョン", args: []string{"to", "latest"}, wantErr: true},
+		{name: "statusに余分な引数", args: []string{"status", "1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCommand(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseCommand() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/FUJI0130/go-ddd-ca/scripts/migrations"
+	sqlitemigrations "github.com/FUJI0130/go-ddd-ca/scripts/migrations/sqlite"
+)
+
+// Migrator は埋め込まれたマイグレーションをデータベースに適用します
+type Migrator struct {
+	db         *sql.DB
+	dialect    Dialect
+	migrations []Migration
+}
+
+// Status はデータベースに適用済みのバージョンと各マイグレーションの状態です
+type Status struct {
+	Version    uint
+	Dirty      bool
+	Migrations []MigrationStatus
+}
+
+// MigrationStatus は1つのマイグレーションが適用済みかどうかを表します
+type MigrationStatus struct {
+	Migration
+	Applied bool
+}
+
+// NewMigrator は指定されたマイグレーションを適用するMigratorを作成します
+func NewMigrator(db *sql.DB, dialect Dialect, migrations []Migration) *Migrator {
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}
+}
+
+// NewEmbeddedMigrator はデータベースの種類に応じて埋め込んだマイグレーションを適用するMigratorを作成します
+// PostgreSQLはscripts/migrations、SQLiteはscripts/migrations/sqliteのマイグレーションを使用します
+func NewEmbeddedMigrator(db *sql.DB, dialect Dialect) (*Migrator, error) {
+	files := migrations.Files
+	if dialect == DialectSQLite {
+		files = sqlitemigrations.Files
+	}
+	embedded, err := Load(files)
+	if err != nil {
+		return nil, err
+	}
+	return NewMigrator(db, dialect, embedded), nil
+}
+
+// Up は未適用のマイグレーションをすべて適用し、適用したマイグレーションを返します
+// データベースがこのバイナリより新しいバージョンの場合は何もしません（新旧のレプリカが混在するローリングデプロイのため）
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn, current uint) error {
+		latest := latestVersion(m.migrations)
+		if current >= latest {
+			return nil
+		}
+		var err error
+		applied, err = m.apply(ctx, conn, current, latest)
+		return err
+	})
+	return applied, err
+}
+
+// Down は適用済みのマイグレーションを新しい順にn件取り消し、取り消したマイグレーションを返します
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn, current uint) error {
+		target, err := stepsBack(m.migrations, current, n)
+		if err != nil {
+			return err
+		}
+		reverted, err = m.apply(ctx, conn, current, target)
+		return err
+	})
+	return reverted, err
+}
+
+// To は指定したバージョンまでマイグレーションを適用または取り消します
+// 0を指定するとすべてのマイグレーションを取り消します
+func (m *Migrator) To(ctx context.Context, version uint) ([]Migration, error) {
+	var executed []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn, current uint) error {
+		var err error
+		executed, err = m.apply(ctx, conn, current, version)
+		return err
+	})
+	return executed, err
+}
+
+// Force はマイグレーションを実行せずに、適用済みのバージョンを記録し直します
+// 失敗して dirty になったデータベースを手動で修復した後や、既存のスキーマを取り込む場合に使用します
+func (m *Migrator) Force(ctx context.Context, version uint) error {
+	if !isKnownVersion(m.migrations, version) {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+	return m.lock(ctx, func(conn *sql.Conn) error {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		if err := setVersion(ctx, tx, version); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	})
+}
+
+// Status は適用済みのバージョンと各マイグレーションの状態を返します
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	var exists bool
+	if err := m.db.QueryRowContext(ctx, m.dialect.tableExistsQuery()).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to check schema_migrations: %w", err)
+	}
+
+	status := &Status{}
+	if exists {
+		version, dirty, err := currentVersion(ctx, m.db)
+		if err != nil {
+			return nil, err
+		}
+		status.Version = version
+		status.Dirty = dirty
+	}
+	for _, migration := range m.migrations {
+		status.Migrations = append(status.Migrations, MigrationStatus{
+			Migration: migration,
+			Applied:   migration.Version <= status.Version,
+		})
+	}
+	return status, nil
+}
+
+// withLock はロックを取得した上で、dirtyでないことを確認してfnを実行します
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn, current uint) error) error {
+	return m.lock(ctx, func(conn *sql.Conn) error {
+		current, dirty, err := currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("database is dirty at version %d: fix the schema manually and run \"migrate force <version>\"", current)
+		}
+		return fn(conn, current)
+	})
+}
+
+// lock はマイグレーションのロックを取得した接続でfnを実行します
+// PostgreSQLのアドバイザリーロックは接続単位のため、ロックの取得から解放まで同じ接続を使用します
+func (m *Migrator) lock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %w", err)
+	}
+	defer conn.Close()
+
+	// 他のレプリカがマイグレーション中の場合は完了まで待つ
+	if err := m.dialect.acquireLock(ctx, conn); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		// ctxがキャンセルされていても解放できるよう、新しいcontextを使用する
+		if err := m.dialect.releaseLock(context.Background(), conn); err != nil {
+			log.Printf("WARNING: failed to release migration lock: %v", err)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT NOT NULL PRIMARY KEY,
+			dirty BOOLEAN NOT NULL
+		)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	return fn(conn)
+}
+
+// apply はcurrentからtargetまでの手順を1つずつ実行します
+// 各マイグレーションとバージョンの記録は同じトランザクションで実行するため、失敗しても途中の状態は残りません
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, current, target uint) ([]Migration, error) {
+	steps, err := plan(m.migrations, current, target)
+	if err != nil {
+		return nil, err
+	}
+
+	executed := make([]Migration, 0, len(steps))
+	for _, s := range steps {
+		query, direction := s.migration.Up, "up"
+		if !s.up {
+			query, direction = s.migration.Down, "down"
+		}
+
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return executed, fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			_ = tx.Rollback()
+			return executed, fmt.Errorf("migration %s (%s) failed: %w", s.migration, direction, err)
+		}
+		if err := setVersion(ctx, tx, s.version); err != nil {
+			_ = tx.Rollback()
+			return executed, err
+		}
+		if err := tx.Commit(); err != nil {
+			return executed, fmt.Errorf("failed to commit migration %s (%s): %w", s.migration, direction, err)
+		}
+
+		log.Printf("Migrated %s (%s)", s.migration, direction)
+		executed = append(executed, s.migration)
+	}
+	return executed, nil
+}
+
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// currentVersion はschema_migrationsに記録されたバージョンを返します（未記録の場合は0）
+func currentVersion(ctx context.Context, q queryer) (uint, bool, error) {
+	var version int64
+	var dirty bool
+	err := q.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	return uint(version), dirty, nil
+}
+
+// setVersion はschema_migrationsを指定したバージョンの1行に置き換えます（0の場合は空にします）
+func setVersion(ctx context.Context, tx *sql.Tx, version uint) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations"); err != nil {
+		return fmt.Errorf("failed to update schema_migrations: %w", err)
+	}
+	if version == 0 {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, dirty) VALUES ($1, false)", int64(version)); err != nil {
+		return fmt.Errorf("failed to update schema_migrations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package migration
+
+import "fmt"
+
+// step は1つのマイグレーションの適用または取り消しです
+type step struct {
+	migration Migration
+	up        bool
+	// version は実行後にschema_migrationsへ記録するバージョン（すべて取り消した場合は0）
+	version uint
+}
+
+// plan は現在のバージョンからtargetに移行するための手順を返します
+// 移行元・移行先のバージョンはいずれも0または既知のマイグレーションでなければなりません
+func plan(migrations []Migration, current, target uint) ([]step, error) {
+	if !isKnownVersion(migrations, current) {
+		return nil, fmt.Errorf("database is at version %d, which is not among the embedded migrations", current)
+	}
+	if !isKnownVersion(migrations, target) {
+		return nil, fmt.Errorf("unknown migration version %d", target)
+	}
+
+	var steps []step
+	switch {
+	case target > current:
+		for _, m := range migrations {
+			if m.Version > current && m.Version <= target {
+				steps = append(steps, step{migration: m, up: true, version: m.Version})
+			}
+		}
+	case target < current:
+		for i := len(migrations) - 1; i >= 0; i-- {
+			m := migrations[i]
+			if m.Version <= target || m.Version > current {
+				continue
+			}
+			if m.Down == "" {
+				return nil, fmt.Errorf("migration %s has no down file", m)
+			}
+			var previous uint
+			if i > 0 {
+				previous = migrations[i-1].Version
+			}
+			steps = append(steps, step{migration: m, up: false, version: previous})
+		}
+	}
+	return steps, nil
+}
+
+// stepsBack はcurrentからn件取り消した後のバージョンを返します
+func stepsBack(migrations []Migration, current uint, n int) (uint, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("number of migrations to roll back must be positive, got %d", n)
+	}
+	index := -1
+	for i, m := range migrations {
+		if m.Version == current {
+			index = i
+		}
+	}
+	if current != 0 && index < 0 {
+		return 0, fmt.Errorf("database is at version %d, which is not among the embedded migrations", current)
+	}
+	if index+1 < n {
+		return 0, fmt.Errorf("cannot roll back %d migrations: only %d applied", n, index+1)
+	}
+	if index-n < 0 {
+		return 0, nil
+	}
+	return migrations[index-n].Version, nil
+}
+
+func isKnownVersion(migrations []Migration, version uint) bool {
+	if version == 0 {
+		return true
+	}
+	for _, m := range migrations {
+		if m.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+func latestVersion(migrations []Migration) uint {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
@@ -0,0 +1,27 @@
+// 注意　テスト実行時にはテスト用のコンテナを立ち上げること make test-integration
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository/repositorytest"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/postgres"
+)
+
+// TestRepositoryContract はメモリ内実装と同じ契約テストをPostgreSQLの実装で実行する
+// サブテストごとにスキーマを作り直し、空のデータベースから開始する
+func TestRepositoryContract(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		db, cleanup := setupTestDB(t)
+		t.Cleanup(cleanup)
+
+		return repositorytest.Repositories{
+			TestSuite:     postgres.NewTestSuiteRepository(db),
+			TestGroup:     postgres.NewTestGroupRepository(db),
+			TestCase:      postgres.NewTestCaseRepository(db),
+			User:          postgres.NewUserRepository(db),
+			EffortRecord:  postgres.NewEffortRecordRepository(db),
+			StatusHistory: postgres.NewStatusHistoryRepository(db),
+		}
+	})
+}
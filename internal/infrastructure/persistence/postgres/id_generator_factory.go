@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
 )
 
 // IsTestEnvironment は現在の実行環境がテスト環境かどうかを判定します
@@ -21,34 +22,40 @@ func IsTestEnvironment() bool {
 	return false
 }
 
-// NewTestSuiteIDGeneratorWithEnv は環境に適したIDジェネレーターを返します
-func NewTestSuiteIDGeneratorWithEnv(db *sql.DB) repository.TestSuiteIDGenerator {
-	if IsTestEnvironment() {
+// useTestEnvironmentGenerator はテスト環境用のジェネレーターを使用するかどうかを判定します
+// テスト環境用のジェネレーターはシーケンス方式の代替のため、他の採番方式ではそのまま設定に従います
+func useTestEnvironmentGenerator(options idgen.Options) bool {
+	return IsTestEnvironment() && (options.Strategy == "" || options.Strategy == idgen.StrategySequence)
+}
+
+// NewTestSuiteIDGeneratorWithEnv は環境と採番方式に適したIDジェネレーターを返します
+func NewTestSuiteIDGeneratorWithEnv(db *sql.DB, options idgen.Options) repository.TestSuiteIDGenerator {
+	if useTestEnvironmentGenerator(options) {
 		return NewTestEnvironmentSuiteIDGenerator(db)
 	}
-	return NewTestSuiteIDGenerator(db)
+	return NewTestSuiteIDGenerator(db, options)
 }
 
-// NewTestGroupIDGeneratorWithEnv は環境に適したIDジェネレーターを返します
-func NewTestGroupIDGeneratorWithEnv(db *sql.DB) repository.TestGroupIDGenerator {
-	if IsTestEnvironment() {
+// NewTestGroupIDGeneratorWithEnv は環境と採番方式に適したIDジェネレーターを返します
+func NewTestGroupIDGeneratorWithEnv(db *sql.DB, options idgen.Options) repository.TestGroupIDGenerator {
+	if useTestEnvironmentGenerator(options) {
 		return NewTestEnvironmentGroupIDGenerator(db)
 	}
-	return NewTestGroupIDGenerator(db)
+	return NewTestGroupIDGenerator(db, options)
 }
 
-// NewTestCaseIDGeneratorWithEnv は環境に適したIDジェネレーターを返します
-func NewTestCaseIDGeneratorWithEnv(db *sql.DB) repository.TestCaseIDGenerator {
-	if IsTestEnvironment() {
+// NewTestCaseIDGeneratorWithEnv は環境と採番方式に適したIDジェネレーターを返します
+func NewTestCaseIDGeneratorWithEnv(db *sql.DB, options idgen.Options) repository.TestCaseIDGenerator {
+	if useTestEnvironmentGenerator(options) {
 		return NewTestEnvironmentCaseIDGenerator(db)
 	}
-	return NewTestCaseIDGenerator(db)
+	return NewTestCaseIDGenerator(db, options)
 }
 
-// NewUserIDGeneratorWithEnv は環境に適したIDジェネレーターを返します
-func NewUserIDGeneratorWithEnv(db *sql.DB) repository.UserIDGenerator {
-	if IsTestEnvironment() {
+// NewUserIDGeneratorWithEnv は環境と採番方式に適したIDジェネレーターを返します
+func NewUserIDGeneratorWithEnv(db *sql.DB, options idgen.Options) repository.UserIDGenerator {
+	if useTestEnvironmentGenerator(options) {
 		return NewTestEnvironmentUserIDGenerator(db)
 	}
-	return NewUserIDGenerator(db)
+	return NewUserIDGenerator(db, options)
 }
@@ -2,6 +2,7 @@
 package postgres_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
@@ -9,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/migration"
 	_ "github.com/lib/pq"
 )
 
@@ -107,7 +109,9 @@ func cleanDatabase(t *testing.T, db *sql.DB) error {
 	}
 
 	// すべてのテーブルを結合
+	// 適用済みのマイグレーションの記録も削除し、次回のセットアップで再度すべて適用されるようにする
 	allTables := append(coreTables, authTables...)
+	allTables = append(allTables, "schema_migrations")
 
 	// すべてのテーブルをクリーンアップ
 	for _, table := range allTables {
@@ -134,6 +138,9 @@ func cleanDatabase(t *testing.T, db *sql.DB) error {
 
 	// シーケンスの削除
 	sequences := []string{
+		"test_suite_seq",
+		"test_group_seq",
+		"test_case_seq",
 		"user_seq",
 	}
 
@@ -147,32 +154,19 @@ func cleanDatabase(t *testing.T, db *sql.DB) error {
 	return nil
 }
 
-// executeMigrations はマイグレーションファイルを実行する
+// executeMigrations はサーバーと同じ埋め込みのマイグレーションをすべて適用する
+// リポジトリが参照する列（バージョン・論理削除など）は後続のマイグレーションで追加されるため、
+// 一部だけを適用するとテストが実際のスキーマと乖離する
 func executeMigrations(t *testing.T, db *sql.DB) error {
-	// 基本マイグレーション
-	migrations := []string{
-		"000001_create_enums.up.sql",
-		"000002_create_tables.up.sql",
-		"000003_create_indexes.up.sql",
-		"000004_create_triggers.up.sql",
+	migrator, err := migration.NewEmbeddedMigrator(db, migration.DialectPostgres)
+	if err != nil {
+		return err
 	}
-
-	// マイグレーションパスを取得
-	migrationsPath := getMigrationsPath(t)
-
-	for _, migration := range migrations {
-		migrationPath := filepath.Join(migrationsPath, migration)
-		content, err := os.ReadFile(migrationPath)
-		if err != nil {
-			t.Logf("Warning: Migration file %s not found: %v", migration, err)
-			continue
-		}
-
-		if _, err := db.Exec(string(content)); err != nil {
-			t.Logf("Warning: Failed to execute migration %s: %v", migration, err)
-		}
+	applied, err := migrator.Up(context.Background())
+	if err != nil {
+		return err
 	}
-
+	t.Logf("Applied %d migrations", len(applied))
 	return nil
 }
 
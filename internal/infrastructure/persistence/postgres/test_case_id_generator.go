@@ -2,36 +2,31 @@ package postgres
 
 import (
 	"database/sql"
-	"fmt"
 	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
 )
 
 // TestCaseIDGenerator はテストケースIDを生成するジェネレーター
 type TestCaseIDGenerator struct {
-	db *sql.DB
+	db      *sql.DB
+	options idgen.Options
 }
 
 // インターフェース実装の確認
 var _ repository.TestCaseIDGenerator = (*TestCaseIDGenerator)(nil)
 
-// NewTestCaseIDGenerator は新しいTestCaseIDGeneratorを作成します
-func NewTestCaseIDGenerator(db *sql.DB) *TestCaseIDGenerator {
-	return &TestCaseIDGenerator{db: db}
+// NewTestCaseIDGenerator は指定された採番方式の新しいTestCaseIDGeneratorを作成します
+func NewTestCaseIDGenerator(db *sql.DB, options idgen.Options) *TestCaseIDGenerator {
+	return &TestCaseIDGenerator{db: db, options: options}
 }
 
 // GenerateID はグループIDに紐づいた新しいケースIDを生成します
 func (g *TestCaseIDGenerator) GenerateID(groupID string) (string, error) {
-	var seq int
-	err := g.db.QueryRow("SELECT nextval('test_case_seq')").Scan(&seq)
+	seq, err := nextSequenceValue(g.db, g.options, "test_case_seq")
 	if err != nil {
-		return "", fmt.Errorf("failed to generate sequence number: %w", err)
+		return "", err
 	}
-
-	currentTime := time.Now()
-	// グループIDからプレフィックスを抽出（"TS001TG01"部分）
-	groupPrefix := groupID[:8]
-	id := fmt.Sprintf("%sTC%03d-%d%02d", groupPrefix, seq, currentTime.Year(), currentTime.Month())
-	return id, nil
+	return g.options.CaseID(groupID, seq, time.Now())
 }
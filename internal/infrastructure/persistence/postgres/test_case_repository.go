@@ -7,10 +7,17 @@ import (
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/common"
 	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
 	"github.com/lib/pq"
 )
 
+// testCaseColumns はテストケースのSELECTで取得するカラムです（scanTestCaseと順序を合わせる）
+const testCaseColumns = `
+            id, group_id, title, description, status, 
+            priority, planned_effort, actual_effort, due_date, is_delayed, 
+            delay_days, current_editor, is_locked, lock_expires_at, version, created_at, updated_at`
+
 // PostgresTestCaseRepository はテストケースのPostgreSQL実装
 type PostgresTestCaseRepository struct {
 	db *sql.DB
@@ -28,12 +35,12 @@ func (r *PostgresTestCaseRepository) Create(ctx context.Context, tc *entity.Test
 	query := `
         INSERT INTO test_cases (
             id, group_id, title, description, status, 
-            priority, planned_effort, actual_effort, is_delayed, 
-            delay_days, current_editor, is_locked, created_at, updated_at
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+            priority, planned_effort, actual_effort, due_date, is_delayed, 
+            delay_days, current_editor, is_locked, lock_expires_at, version, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
     `
 
-	_, err := r.db.ExecContext(
+	_, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		tc.ID,
@@ -44,10 +51,13 @@ func (r *PostgresTestCaseRepository) Create(ctx context.Context, tc *entity.Test
 		tc.Priority,
 		tc.PlannedEffort,
 		tc.ActualEffort,
+		sql.NullTime{Time: tc.DueDate, Valid: !tc.DueDate.IsZero()},
 		tc.IsDelayed,
 		tc.DelayDays,
-		tc.CurrentEditor,
+		sql.NullString{String: tc.CurrentEditor, Valid: tc.CurrentEditor != ""},
 		tc.IsLocked,
+		sql.NullTime{Time: tc.LockExpiresAt, Valid: !tc.LockExpiresAt.IsZero()},
+		tc.Version,
 		tc.CreatedAt,
 		tc.UpdatedAt,
 	)
@@ -79,30 +89,11 @@ func (r *PostgresTestCaseRepository) Create(ctx context.Context, tc *entity.Test
 // FindByID は指定されたIDのテストケースを取得します
 func (r *PostgresTestCaseRepository) FindByID(ctx context.Context, id string) (*entity.TestCase, error) {
 	query := `
-        SELECT 
-            id, group_id, title, description, status, 
-            priority, planned_effort, actual_effort, is_delayed, 
-            delay_days, current_editor, is_locked, created_at, updated_at
+        SELECT ` + testCaseColumns + `
         FROM test_cases
-        WHERE id = $1
+        WHERE id = $1 AND deleted_at IS NULL
     `
-	tc := &entity.TestCase{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&tc.ID,
-		&tc.GroupID,
-		&tc.Title,
-		&tc.Description,
-		&tc.Status,
-		&tc.Priority,
-		&tc.PlannedEffort,
-		&tc.ActualEffort,
-		&tc.IsDelayed,
-		&tc.DelayDays,
-		&tc.CurrentEditor,
-		&tc.IsLocked,
-		&tc.CreatedAt,
-		&tc.UpdatedAt,
-	)
+	tc, err := scanTestCase(executor(ctx, r.db).QueryRowContext(ctx, query, id))
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -120,7 +111,22 @@ func (r *PostgresTestCaseRepository) FindByID(ctx context.Context, id string) (*
 }
 
 // Update はテストケースの情報を更新します
+// 編集ロックの状態はAcquireLock・ReleaseLockでのみ、遅延状態はUpdateDelayでのみ更新します
+// 読み込み時のバージョンと一致する場合のみ更新し、バージョンを1つ進めます
+// 他の更新が先に行われていた場合は同時更新の競合エラーを返します
 func (r *PostgresTestCaseRepository) Update(ctx context.Context, tc *entity.TestCase) error {
+	return r.update(ctx, tc, "", false)
+}
+
+// UpdateAsEditor はeditor以外のユーザーが有効な編集ロックを保持していない場合のみ、Updateと同様にテストケースを更新します
+// 読み込み後に他のユーザーがロックを取得していた場合は編集ロックの競合エラーを返します
+func (r *PostgresTestCaseRepository) UpdateAsEditor(ctx context.Context, tc *entity.TestCase, editor string) error {
+	return r.update(ctx, tc, editor, true)
+}
+
+// update はテストケースを更新します
+// checkLockがtrueの場合は、バージョンに加えて編集ロックの保持者も更新の条件とします
+func (r *PostgresTestCaseRepository) update(ctx context.Context, tc *entity.TestCase, editor string, checkLock bool) error {
 	query := `
         UPDATE test_cases
         SET 
@@ -130,32 +136,48 @@ func (r *PostgresTestCaseRepository) Update(ctx context.Context, tc *entity.Test
             priority = $4,
             planned_effort = $5,
             actual_effort = $6,
-            is_delayed = $7,
-            delay_days = $8,
-            current_editor = $9,
-            is_locked = $10,
-            updated_at = $11
-        WHERE id = $12
+            due_date = $7,
+            updated_at = $8,
+            group_id = $9,
+            version = version + 1
+        WHERE id = $10 AND version = $11 AND deleted_at IS NULL
     `
-
-	result, err := r.db.ExecContext(
-		ctx,
-		query,
+	now := time.Now()
+	args := []interface{}{
 		tc.Title,
 		tc.Description,
 		tc.Status,
 		tc.Priority,
 		tc.PlannedEffort,
 		tc.ActualEffort,
-		tc.IsDelayed,
-		tc.DelayDays,
-		tc.CurrentEditor,
-		tc.IsLocked,
-		time.Now(),
+		sql.NullTime{Time: tc.DueDate, Valid: !tc.DueDate.IsZero()},
+		now,
+		tc.GroupID,
 		tc.ID,
-	)
+		tc.Version,
+	}
+	if checkLock {
+		query += `
+          AND (
+            is_locked = false
+            OR current_editor IS NULL
+            OR current_editor = $12
+            OR lock_expires_at IS NULL
+            OR lock_expires_at <= $13
+          )`
+		args = append(args, editor, now)
+	}
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, args...)
 
 	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == PgForeignKeyViolationCode {
+			return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+				"id":         tc.ID,
+				"groupId":    tc.GroupID,
+				"constraint": pqErr.Constraint,
+			})
+		}
 		return errors.NewDatabaseError("update", "test_cases", err).WithDetails(map[string]interface{}{
 			"id": tc.ID,
 		})
@@ -169,17 +191,35 @@ func (r *PostgresTestCaseRepository) Update(ctx context.Context, tc *entity.Test
 	}
 
 	if rowsAffected == 0 {
-		return errors.NewNotFoundError("TestCase", tc.ID)
+		if checkLock {
+			return r.editLockConflictError(ctx, tc)
+		}
+		return versionConflictError(ctx, executor(ctx, r.db), "test_cases", "TestCase", tc.ID, tc.Version, errors.NewNotFoundError("TestCase", tc.ID))
 	}
 
+	tc.Version++
 	return nil
 }
 
+// editLockConflictError は編集ロック・バージョン条件付きの更新で行が更新されなかった原因を判定します
+// バージョンが変わっていた場合は同時更新の競合エラーを、バージョンが一致する場合は他のユーザーが
+// ロックを保持しているため編集ロックの競合エラーを、存在しないか論理削除されている場合は未検出エラーを返します
+func (r *PostgresTestCaseRepository) editLockConflictError(ctx context.Context, tc *entity.TestCase) error {
+	current, err := r.FindByID(ctx, tc.ID)
+	if err != nil {
+		return err
+	}
+	if current.Version != tc.Version {
+		return errors.NewConcurrentModificationError("TestCase", tc.ID, int64(current.Version), int64(tc.Version))
+	}
+	return errors.NewEditLockConflictError("TestCase", tc.ID, current.CurrentEditor, current.LockExpiresAt)
+}
+
 // Delete は指定されたIDのテストケースを削除します
 func (r *PostgresTestCaseRepository) Delete(ctx context.Context, id string) error {
 	query := "DELETE FROM test_cases WHERE id = $1"
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
@@ -214,16 +254,13 @@ func (r *PostgresTestCaseRepository) Delete(ctx context.Context, id string) erro
 // FindByGroupID は指定されたグループIDに属するテストケース一覧を取得します
 func (r *PostgresTestCaseRepository) FindByGroupID(ctx context.Context, groupID string) ([]*entity.TestCase, error) {
 	query := `
-        SELECT 
-            id, group_id, title, description, status, 
-            priority, planned_effort, actual_effort, is_delayed, 
-            delay_days, current_editor, is_locked, created_at, updated_at
+        SELECT ` + testCaseColumns + `
         FROM test_cases
-        WHERE group_id = $1
+        WHERE group_id = $1 AND deleted_at IS NULL
         ORDER BY id ASC
     `
 
-	rows, err := r.db.QueryContext(ctx, query, groupID)
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, groupID)
 	if err != nil {
 		return nil, errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
 			"groupId": groupID,
@@ -233,23 +270,44 @@ func (r *PostgresTestCaseRepository) FindByGroupID(ctx context.Context, groupID
 
 	var cases []*entity.TestCase
 	for rows.Next() {
-		tc := &entity.TestCase{}
-		err := rows.Scan(
-			&tc.ID,
-			&tc.GroupID,
-			&tc.Title,
-			&tc.Description,
-			&tc.Status,
-			&tc.Priority,
-			&tc.PlannedEffort,
-			&tc.ActualEffort,
-			&tc.IsDelayed,
-			&tc.DelayDays,
-			&tc.CurrentEditor,
-			&tc.IsLocked,
-			&tc.CreatedAt,
-			&tc.UpdatedAt,
-		)
+		tc, err := scanTestCase(rows)
+		if err != nil {
+			return nil, errors.NewSystemError("テストケースデータの読み取りに失敗しました", err)
+		}
+		cases = append(cases, tc)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "test_cases", err)
+	}
+
+	return cases, nil
+}
+
+// FindByGroupIDs は複数のグループに属するテストケースを1回のクエリで取得します
+func (r *PostgresTestCaseRepository) FindByGroupIDs(ctx context.Context, groupIDs []string) ([]*entity.TestCase, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+        SELECT ` + testCaseColumns + `
+        FROM test_cases
+        WHERE group_id = ANY($1) AND deleted_at IS NULL
+        ORDER BY group_id ASC, id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, pq.Array(groupIDs))
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"groupIds": groupIDs,
+		})
+	}
+	defer rows.Close()
+
+	var cases []*entity.TestCase
+	for rows.Next() {
+		tc, err := scanTestCase(rows)
 		if err != nil {
 			return nil, errors.NewSystemError("テストケースデータの読み取りに失敗しました", err)
 		}
@@ -264,27 +322,31 @@ func (r *PostgresTestCaseRepository) FindByGroupID(ctx context.Context, groupID
 }
 
 // UpdateStatus は指定されたテストケースのステータスを更新します
-func (r *PostgresTestCaseRepository) UpdateStatus(ctx context.Context, id string, status entity.TestStatus) error {
+// 現在のステータスがfrom・バージョンがexpectedVersionの場合のみ更新し、同時に行われた遷移が遷移ルールを迂回しないようにします
+func (r *PostgresTestCaseRepository) UpdateStatus(ctx context.Context, id string, from, to entity.TestStatus, expectedVersion int) error {
 	query := `
         UPDATE test_cases
         SET 
             status = $1,
-            updated_at = $2
-        WHERE id = $3
+            updated_at = $2,
+            version = version + 1
+        WHERE id = $3 AND status = $4 AND version = $5 AND deleted_at IS NULL
     `
 
-	result, err := r.db.ExecContext(
+	result, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
-		status,
+		to,
 		time.Now(),
 		id,
+		from,
+		expectedVersion,
 	)
 
 	if err != nil {
 		return errors.NewDatabaseError("update_status", "test_cases", err).WithDetails(map[string]interface{}{
 			"id":     id,
-			"status": string(status),
+			"status": string(to),
 		})
 	}
 
@@ -292,28 +354,51 @@ func (r *PostgresTestCaseRepository) UpdateStatus(ctx context.Context, id string
 	if err != nil {
 		return errors.NewSystemError("ステータス更新結果の取得に失敗しました", err).WithDetails(map[string]interface{}{
 			"id":     id,
-			"status": string(status),
+			"status": string(to),
 		})
 	}
 
 	if rowsAffected == 0 {
-		return errors.NewNotFoundError("TestCase", id)
+		return statusConflictError(ctx, executor(ctx, r.db), id, from, to, expectedVersion)
 	}
 
 	return nil
 }
 
+// statusConflictError はステータス・バージョン条件付きの更新で行が更新されなかった原因を判定します
+// ステータスが変わっていた場合は遷移の競合エラーを、ステータス以外が更新されていた場合は同時更新の競合エラーを、
+// 存在しないか論理削除されている場合は未検出エラーを返します
+func statusConflictError(ctx context.Context, db common.SQLExecutor, id string, from, to entity.TestStatus, expectedVersion int) error {
+	var current string
+	var currentVersion int64
+	err := db.QueryRowContext(ctx, "SELECT status, version FROM test_cases WHERE id = $1 AND deleted_at IS NULL", id).Scan(&current, &currentVersion)
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	if err != nil {
+		return errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	if current != string(from) {
+		return errors.NewStatusTransitionConflictError("他の更新によりステータスが変更されています", current, string(to))
+	}
+	return errors.NewConcurrentModificationError("TestCase", id, currentVersion, int64(expectedVersion))
+}
+
 // AddEffort は指定されたテストケースに工数を追加します
 func (r *PostgresTestCaseRepository) AddEffort(ctx context.Context, id string, effort float64) error {
 	query := `
         UPDATE test_cases
         SET 
             actual_effort = actual_effort + $1,
-            updated_at = $2
-        WHERE id = $3
+            updated_at = $2,
+            version = version + 1
+        WHERE id = $3 AND deleted_at IS NULL
     `
 
-	result, err := r.db.ExecContext(
+	result, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		effort,
@@ -343,19 +428,76 @@ func (r *PostgresTestCaseRepository) AddEffort(ctx context.Context, id string, e
 	return nil
 }
 
+// UpdateDelay は指定されたテストケースの遅延状態を更新します
+// 遅延状態はシステムが算出する値のため、編集中のユーザーの更新が競合しないようバージョンは進めません
+// 遅延状態を判定したときのステータスのままの場合のみ更新し、完了したケースを遅延にしないようにします
+func (r *PostgresTestCaseRepository) UpdateDelay(ctx context.Context, id string, status entity.TestStatus, isDelayed bool, delayDays int) error {
+	query := `
+        UPDATE test_cases
+        SET 
+            is_delayed = $1,
+            delay_days = $2,
+            updated_at = $3
+        WHERE id = $4 AND status = $5 AND deleted_at IS NULL
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		isDelayed,
+		delayDays,
+		time.Now(),
+		id,
+		status,
+	)
+
+	if err != nil {
+		return errors.NewDatabaseError("update_delay", "test_cases", err).WithDetails(map[string]interface{}{
+			"id":        id,
+			"delayDays": delayDays,
+		})
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.NewSystemError("遅延状態の更新結果の取得に失敗しました", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	if rowsAffected == 0 {
+		return delayConflictError(ctx, executor(ctx, r.db), id, status)
+	}
+
+	return nil
+}
+
+// delayConflictError はステータス条件付きの遅延状態の更新で行が更新されなかった原因を判定します
+// ステータスが変わっていた場合は遷移の競合エラーを、存在しないか論理削除されている場合は未検出エラーを返します
+func delayConflictError(ctx context.Context, db common.SQLExecutor, id string, status entity.TestStatus) error {
+	var current string
+	err := db.QueryRowContext(ctx, "SELECT status FROM test_cases WHERE id = $1 AND deleted_at IS NULL", id).Scan(&current)
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	if err != nil {
+		return errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+	return errors.NewStatusTransitionConflictError("遅延状態の判定後にステータスが変更されています", current, string(status))
+}
+
 // FindByStatus は指定されたステータスのテストケース一覧を取得します
 func (r *PostgresTestCaseRepository) FindByStatus(ctx context.Context, status entity.TestStatus) ([]*entity.TestCase, error) {
 	query := `
-        SELECT 
-            id, group_id, title, description, status, 
-            priority, planned_effort, actual_effort, is_delayed, 
-            delay_days, current_editor, is_locked, created_at, updated_at
+        SELECT ` + testCaseColumns + `
         FROM test_cases
-        WHERE status = $1
+        WHERE status = $1 AND deleted_at IS NULL
         ORDER BY updated_at DESC
     `
 
-	rows, err := r.db.QueryContext(ctx, query, status)
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, status)
 	if err != nil {
 		return nil, errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
 			"status": string(status),
@@ -365,23 +507,233 @@ func (r *PostgresTestCaseRepository) FindByStatus(ctx context.Context, status en
 
 	var cases []*entity.TestCase
 	for rows.Next() {
-		tc := &entity.TestCase{}
-		err := rows.Scan(
-			&tc.ID,
-			&tc.GroupID,
-			&tc.Title,
-			&tc.Description,
-			&tc.Status,
-			&tc.Priority,
-			&tc.PlannedEffort,
-			&tc.ActualEffort,
-			&tc.IsDelayed,
-			&tc.DelayDays,
-			&tc.CurrentEditor,
-			&tc.IsLocked,
-			&tc.CreatedAt,
-			&tc.UpdatedAt,
-		)
+		tc, err := scanTestCase(rows)
+		if err != nil {
+			return nil, errors.NewSystemError("テストケースデータの読み取りに失敗しました", err)
+		}
+		cases = append(cases, tc)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "test_cases", err)
+	}
+
+	return cases, nil
+}
+
+// AcquireLock は指定されたテストケースの編集ロックをexpiresAtまで取得または延長します
+// ロックが存在しない、期限切れ、または同じユーザーが保持している場合のみ更新するため、
+// 同時に取得を試みても1人だけが成功します
+func (r *PostgresTestCaseRepository) AcquireLock(ctx context.Context, id, editor string, expiresAt time.Time) (bool, error) {
+	query := `
+        UPDATE test_cases
+        SET 
+            is_locked = true,
+            current_editor = $1,
+            lock_expires_at = $2
+        WHERE id = $3
+          AND deleted_at IS NULL
+          AND (
+            is_locked = false
+            OR current_editor IS NULL
+            OR current_editor = $1
+            OR lock_expires_at IS NULL
+            OR lock_expires_at <= $4
+          )
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, editor, expiresAt, id, time.Now())
+	if err != nil {
+		return false, errors.NewDatabaseError("acquire_lock", "test_cases", err).WithDetails(map[string]interface{}{
+			"id":     id,
+			"editor": editor,
+		})
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.NewSystemError("ロック取得結果の取得に失敗しました", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	if rowsAffected == 0 {
+		// 存在しないのか、他のユーザーがロック中なのかを区別する
+		if err := r.ensureExists(ctx, id); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// ReleaseLock は指定されたテストケースの編集ロックを解除します
+// editorが指定された場合は、そのユーザーが保持しているロックのみ解除します
+func (r *PostgresTestCaseRepository) ReleaseLock(ctx context.Context, id, editor string) error {
+	query := `
+        UPDATE test_cases
+        SET 
+            is_locked = false,
+            current_editor = NULL,
+            lock_expires_at = NULL
+        WHERE id = $1
+          AND deleted_at IS NULL
+          AND ($2 = '' OR current_editor = $2)
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id, editor)
+	if err != nil {
+		return errors.NewDatabaseError("release_lock", "test_cases", err).WithDetails(map[string]interface{}{
+			"id":     id,
+			"editor": editor,
+		})
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.NewSystemError("ロック解除結果の取得に失敗しました", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	if rowsAffected == 0 {
+		return r.ensureExists(ctx, id)
+	}
+
+	return nil
+}
+
+// ensureExists は指定されたテストケースが存在しないか論理削除されている場合にNotFoundエラーを返します
+func (r *PostgresTestCaseRepository) ensureExists(ctx context.Context, id string) error {
+	var exists bool
+	err := executor(ctx, r.db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM test_cases WHERE id = $1 AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil {
+		return errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+	if !exists {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	return nil
+}
+
+// SoftDelete は指定されたテストケースを論理削除します
+func (r *PostgresTestCaseRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_cases
+        SET 
+            deleted_at = $1,
+            version = version + 1
+        WHERE id = $2 AND deleted_at IS NULL
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "soft_delete", query, map[string]interface{}{"id": id}, deletedAt, id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+
+	return nil
+}
+
+// SoftDeleteByGroupID は指定されたグループに属する削除されていないテストケースを論理削除します
+func (r *PostgresTestCaseRepository) SoftDeleteByGroupID(ctx context.Context, groupID string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_cases
+        SET 
+            deleted_at = $1,
+            version = version + 1
+        WHERE group_id = $2 AND deleted_at IS NULL
+    `
+
+	_, err := r.execUpdate(ctx, "soft_delete", query, map[string]interface{}{"groupId": groupID}, deletedAt, groupID)
+	return err
+}
+
+// Restore は論理削除されたテストケースを復元します
+func (r *PostgresTestCaseRepository) Restore(ctx context.Context, id string) error {
+	query := `
+        UPDATE test_cases
+        SET 
+            deleted_at = NULL,
+            version = version + 1
+        WHERE id = $1 AND deleted_at IS NOT NULL
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "restore", query, map[string]interface{}{"id": id}, id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+
+	return nil
+}
+
+// RestoreByGroupID は指定されたグループと同じ日時に削除されたテストケースを復元します
+func (r *PostgresTestCaseRepository) RestoreByGroupID(ctx context.Context, groupID string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_cases
+        SET 
+            deleted_at = NULL,
+            version = version + 1
+        WHERE group_id = $1 AND deleted_at = $2
+    `
+
+	_, err := r.execUpdate(ctx, "restore", query, map[string]interface{}{"groupId": groupID}, groupID, deletedAt)
+	return err
+}
+
+// FindDeletedByID は論理削除された指定IDのテストケースを取得します
+func (r *PostgresTestCaseRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestCase, error) {
+	query := `
+        SELECT ` + testCaseColumns + `, deleted_at
+        FROM test_cases
+        WHERE id = $1 AND deleted_at IS NOT NULL
+    `
+	tc, err := scanDeletedTestCase(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("TestCase", id)
+		}
+		return nil, errors.NewSystemError(
+			"テストケースの検索中にエラーが発生しました",
+			err,
+		).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	return tc, nil
+}
+
+// FindDeleted は所属するグループが削除されていない、論理削除されたテストケースを取得します
+func (r *PostgresTestCaseRepository) FindDeleted(ctx context.Context) ([]*entity.TestCase, error) {
+	query := `
+        SELECT ` + testCaseColumns + `, deleted_at
+        FROM test_cases
+        WHERE deleted_at IS NOT NULL
+          AND EXISTS (
+            SELECT 1 FROM test_groups
+            WHERE test_groups.id = test_cases.group_id AND test_groups.deleted_at IS NULL
+          )
+        ORDER BY deleted_at DESC, id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_cases", err)
+	}
+	defer rows.Close()
+
+	var cases []*entity.TestCase
+	for rows.Next() {
+		tc, err := scanDeletedTestCase(rows)
 		if err != nil {
 			return nil, errors.NewSystemError("テストケースデータの読み取りに失敗しました", err)
 		}
@@ -394,3 +746,96 @@ func (r *PostgresTestCaseRepository) FindByStatus(ctx context.Context, status en
 
 	return cases, nil
 }
+
+// PurgeDeletedBefore はbeforeより前に論理削除されたテストケースを物理削除します
+// 外部キーで参照している工数記録と変更履歴も同じ文で削除します
+func (r *PostgresTestCaseRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	query := `
+        WITH purged AS (
+            SELECT id FROM test_cases
+            WHERE deleted_at IS NOT NULL AND deleted_at < $1
+        ), purged_efforts AS (
+            DELETE FROM effort_records WHERE test_case_id IN (SELECT id FROM purged)
+        ), purged_histories AS (
+            DELETE FROM status_history WHERE test_case_id IN (SELECT id FROM purged)
+        )
+        DELETE FROM test_cases WHERE id IN (SELECT id FROM purged)
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "purge", query, nil, before)
+	return int(rowsAffected), err
+}
+
+// execUpdate は更新系のクエリを実行し、影響を受けた行数を返します
+func (r *PostgresTestCaseRepository) execUpdate(ctx context.Context, operation, query string, details map[string]interface{}, args ...interface{}) (int64, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.NewDatabaseError(operation, "test_cases", err).WithDetails(details)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.NewSystemError("更新結果の取得に失敗しました", err).WithDetails(details)
+	}
+
+	return rowsAffected, nil
+}
+
+// scanDeletedTestCase はtestCaseColumnsに続けて削除日時を選択した1行分のテストケースを読み取ります
+func scanDeletedTestCase(row interface{ Scan(dest ...any) error }) (*entity.TestCase, error) {
+	var deletedAt time.Time
+	tc, err := scanTestCase(appendScanDest{row: row, extra: []any{&deletedAt}})
+	if err != nil {
+		return nil, err
+	}
+	tc.DeletedAt = deletedAt
+	return tc, nil
+}
+
+// appendScanDest はScanの読み取り先の末尾に追加の読み取り先を加えます
+type appendScanDest struct {
+	row   interface{ Scan(dest ...any) error }
+	extra []any
+}
+
+func (a appendScanDest) Scan(dest ...any) error {
+	return a.row.Scan(append(dest, a.extra...)...)
+}
+
+// scanTestCase は1行分のテストケースを読み取ります
+func scanTestCase(row interface{ Scan(dest ...any) error }) (*entity.TestCase, error) {
+	tc := &entity.TestCase{}
+	var dueDate sql.NullTime
+	var currentEditor sql.NullString
+	var lockExpiresAt sql.NullTime
+	err := row.Scan(
+		&tc.ID,
+		&tc.GroupID,
+		&tc.Title,
+		&tc.Description,
+		&tc.Status,
+		&tc.Priority,
+		&tc.PlannedEffort,
+		&tc.ActualEffort,
+		&dueDate,
+		&tc.IsDelayed,
+		&tc.DelayDays,
+		&currentEditor,
+		&tc.IsLocked,
+		&lockExpiresAt,
+		&tc.Version,
+		&tc.CreatedAt,
+		&tc.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if dueDate.Valid {
+		tc.DueDate = dueDate.Time
+	}
+	tc.CurrentEditor = currentEditor.String
+	if lockExpiresAt.Valid {
+		tc.LockExpiresAt = lockExpiresAt.Time
+	}
+	return tc, nil
+}
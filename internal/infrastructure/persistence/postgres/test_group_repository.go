@@ -29,11 +29,11 @@ func (r *PostgresTestGroupRepository) Create(ctx context.Context, group *entity.
 	query := `
         INSERT INTO test_groups (
             id, suite_id, name, description, display_order, 
-            status, created_at, updated_at
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+            status, status_locked, version, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
     `
 
-	_, err := r.db.ExecContext(
+	_, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		group.ID,
@@ -42,6 +42,8 @@ func (r *PostgresTestGroupRepository) Create(ctx context.Context, group *entity.
 		group.Description,
 		group.DisplayOrder,
 		group.Status,
+		group.StatusLocked,
+		group.Version,
 		group.CreatedAt,
 		group.UpdatedAt,
 	)
@@ -72,21 +74,36 @@ func (r *PostgresTestGroupRepository) Create(ctx context.Context, group *entity.
 
 // FindByID は指定されたIDのテストグループを取得します
 func (r *PostgresTestGroupRepository) FindByID(ctx context.Context, id string) (*entity.TestGroup, error) {
+	return r.findByID(ctx, id, false)
+}
+
+// FindByIDForUpdate は指定されたIDのテストグループを行ロック（SELECT ... FOR UPDATE）を取得して取得します
+// ロックはトランザクションの終了まで保持されるため、トランザクションの中で呼び出してください
+func (r *PostgresTestGroupRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.TestGroup, error) {
+	return r.findByID(ctx, id, true)
+}
+
+func (r *PostgresTestGroupRepository) findByID(ctx context.Context, id string, forUpdate bool) (*entity.TestGroup, error) {
 	query := `
         SELECT 
             id, suite_id, name, description, display_order,
-            status, created_at, updated_at
+            status, status_locked, version, created_at, updated_at
         FROM test_groups
-        WHERE id = $1
+        WHERE id = $1 AND deleted_at IS NULL
     `
+	if forUpdate {
+		query += "FOR UPDATE"
+	}
 	group := &entity.TestGroup{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := executor(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&group.ID,
 		&group.SuiteID,
 		&group.Name,
 		&group.Description,
 		&group.DisplayOrder,
 		&group.Status,
+		&group.StatusLocked,
+		&group.Version,
 		&group.CreatedAt,
 		&group.UpdatedAt,
 	)
@@ -107,6 +124,8 @@ func (r *PostgresTestGroupRepository) FindByID(ctx context.Context, id string) (
 }
 
 // Update はテストグループの情報を更新します
+// 読み込み時のバージョンと一致する場合のみ更新し、バージョンを1つ進めます
+// 他の更新が先に行われていた場合は同時更新の競合エラーを返します
 func (r *PostgresTestGroupRepository) Update(ctx context.Context, group *entity.TestGroup) error {
 	query := `
         UPDATE test_groups
@@ -115,19 +134,23 @@ func (r *PostgresTestGroupRepository) Update(ctx context.Context, group *entity.
             description = $2,
             display_order = $3,
             status = $4,
-            updated_at = $5
-        WHERE id = $6
+            status_locked = $5,
+            updated_at = $6,
+            version = version + 1
+        WHERE id = $7 AND version = $8 AND deleted_at IS NULL
     `
 
-	result, err := r.db.ExecContext(
+	result, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		group.Name,
 		group.Description,
 		group.DisplayOrder,
 		group.Status,
+		group.StatusLocked,
 		time.Now(),
 		group.ID,
+		group.Version,
 	)
 
 	if err != nil {
@@ -144,9 +167,10 @@ func (r *PostgresTestGroupRepository) Update(ctx context.Context, group *entity.
 	}
 
 	if rowsAffected == 0 {
-		return errors.NewNotFoundError("TestGroup", group.ID)
+		return versionConflictError(ctx, executor(ctx, r.db), "test_groups", "TestGroup", group.ID, group.Version, errors.NewNotFoundError("TestGroup", group.ID))
 	}
 
+	group.Version++
 	return nil
 }
 
@@ -154,7 +178,7 @@ func (r *PostgresTestGroupRepository) Update(ctx context.Context, group *entity.
 func (r *PostgresTestGroupRepository) Delete(ctx context.Context, id string) error {
 	query := "DELETE FROM test_groups WHERE id = $1"
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
@@ -191,13 +215,13 @@ func (r *PostgresTestGroupRepository) FindBySuiteID(ctx context.Context, suiteID
 	query := `
         SELECT 
             id, suite_id, name, description, display_order,
-            status, created_at, updated_at
+            status, status_locked, version, created_at, updated_at
         FROM test_groups
-        WHERE suite_id = $1
+        WHERE suite_id = $1 AND deleted_at IS NULL
         ORDER BY display_order ASC
     `
 
-	rows, err := r.db.QueryContext(ctx, query, suiteID)
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, suiteID)
 	if err != nil {
 		return nil, errors.NewDatabaseError("query", "test_groups", err).WithDetails(map[string]interface{}{
 			"suiteId": suiteID,
@@ -205,30 +229,33 @@ func (r *PostgresTestGroupRepository) FindBySuiteID(ctx context.Context, suiteID
 	}
 	defer rows.Close()
 
-	var groups []*entity.TestGroup
-	for rows.Next() {
-		group := &entity.TestGroup{}
-		err := rows.Scan(
-			&group.ID,
-			&group.SuiteID,
-			&group.Name,
-			&group.Description,
-			&group.DisplayOrder,
-			&group.Status,
-			&group.CreatedAt,
-			&group.UpdatedAt,
-		)
-		if err != nil {
-			return nil, errors.NewSystemError("テストグループデータの読み取りに失敗しました", err)
-		}
-		groups = append(groups, group)
+	return scanTestGroups(rows)
+}
+
+// FindBySuiteIDs は複数のスイートに属するテストグループを1回のクエリで取得します
+func (r *PostgresTestGroupRepository) FindBySuiteIDs(ctx context.Context, suiteIDs []string) ([]*entity.TestGroup, error) {
+	if len(suiteIDs) == 0 {
+		return nil, nil
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, errors.NewDatabaseError("iterate", "test_groups", err)
+	query := `
+        SELECT 
+            id, suite_id, name, description, display_order,
+            status, status_locked, version, created_at, updated_at
+        FROM test_groups
+        WHERE suite_id = ANY($1) AND deleted_at IS NULL
+        ORDER BY suite_id ASC, display_order ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, pq.Array(suiteIDs))
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_groups", err).WithDetails(map[string]interface{}{
+			"suiteIds": suiteIDs,
+		})
 	}
+	defer rows.Close()
 
-	return groups, nil
+	return scanTestGroups(rows)
 }
 
 // UpdateStatus は指定されたテストグループのステータスを更新します
@@ -237,11 +264,12 @@ func (r *PostgresTestGroupRepository) UpdateStatus(ctx context.Context, id strin
         UPDATE test_groups
         SET 
             status = $1,
-            updated_at = $2
-        WHERE id = $3
+            updated_at = $2,
+            version = version + 1
+        WHERE id = $3 AND deleted_at IS NULL
     `
 
-	result, err := r.db.ExecContext(
+	result, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		status,
@@ -277,11 +305,12 @@ func (r *PostgresTestGroupRepository) UpdateDisplayOrder(ctx context.Context, id
         UPDATE test_groups
         SET 
             display_order = $1,
-            updated_at = $2
-        WHERE id = $3
+            updated_at = $2,
+            version = version + 1
+        WHERE id = $3 AND deleted_at IS NULL
     `
 
-	result, err := r.db.ExecContext(
+	result, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		displayOrder,
@@ -310,3 +339,212 @@ func (r *PostgresTestGroupRepository) UpdateDisplayOrder(ctx context.Context, id
 
 	return nil
 }
+
+// SoftDelete は指定されたテストグループを論理削除します
+func (r *PostgresTestGroupRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_groups
+        SET 
+            deleted_at = $1,
+            version = version + 1
+        WHERE id = $2 AND deleted_at IS NULL
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "soft_delete", query, map[string]interface{}{"id": id}, deletedAt, id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+
+	return nil
+}
+
+// SoftDeleteBySuiteID は指定されたスイートに属する削除されていないテストグループを論理削除します
+func (r *PostgresTestGroupRepository) SoftDeleteBySuiteID(ctx context.Context, suiteID string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_groups
+        SET 
+            deleted_at = $1,
+            version = version + 1
+        WHERE suite_id = $2 AND deleted_at IS NULL
+    `
+
+	_, err := r.execUpdate(ctx, "soft_delete", query, map[string]interface{}{"suiteId": suiteID}, deletedAt, suiteID)
+	return err
+}
+
+// Restore は論理削除されたテストグループを復元します
+func (r *PostgresTestGroupRepository) Restore(ctx context.Context, id string) error {
+	query := `
+        UPDATE test_groups
+        SET 
+            deleted_at = NULL,
+            version = version + 1
+        WHERE id = $1 AND deleted_at IS NOT NULL
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "restore", query, map[string]interface{}{"id": id}, id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+
+	return nil
+}
+
+// RestoreBySuiteID は指定されたスイートと同じ日時に削除されたテストグループを復元します
+func (r *PostgresTestGroupRepository) RestoreBySuiteID(ctx context.Context, suiteID string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_groups
+        SET 
+            deleted_at = NULL,
+            version = version + 1
+        WHERE suite_id = $1 AND deleted_at = $2
+    `
+
+	_, err := r.execUpdate(ctx, "restore", query, map[string]interface{}{"suiteId": suiteID}, suiteID, deletedAt)
+	return err
+}
+
+// FindDeletedByID は論理削除された指定IDのテストグループを取得します
+func (r *PostgresTestGroupRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestGroup, error) {
+	query := `
+        SELECT 
+            id, suite_id, name, description, display_order,
+            status, status_locked, version, deleted_at, created_at, updated_at
+        FROM test_groups
+        WHERE id = $1 AND deleted_at IS NOT NULL
+    `
+	group, err := scanDeletedTestGroup(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("TestGroup", id)
+		}
+		return nil, errors.NewSystemError(
+			"テストグループの検索中にエラーが発生しました",
+			err,
+		).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	return group, nil
+}
+
+// FindDeleted は所属するスイートが削除されていない、論理削除されたテストグループを取得します
+func (r *PostgresTestGroupRepository) FindDeleted(ctx context.Context) ([]*entity.TestGroup, error) {
+	query := `
+        SELECT 
+            g.id, g.suite_id, g.name, g.description, g.display_order,
+            g.status, g.status_locked, g.version, g.deleted_at, g.created_at, g.updated_at
+        FROM test_groups g
+        JOIN test_suites s ON s.id = g.suite_id
+        WHERE g.deleted_at IS NOT NULL AND s.deleted_at IS NULL
+        ORDER BY g.deleted_at DESC, g.id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_groups", err)
+	}
+	defer rows.Close()
+
+	var groups []*entity.TestGroup
+	for rows.Next() {
+		group, err := scanDeletedTestGroup(rows)
+		if err != nil {
+			return nil, errors.NewSystemError("テストグループデータの読み取りに失敗しました", err)
+		}
+		groups = append(groups, group)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "test_groups", err)
+	}
+
+	return groups, nil
+}
+
+// PurgeDeletedBefore はbeforeより前に論理削除され、配下のケースが残っていないテストグループを物理削除します
+func (r *PostgresTestGroupRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	query := `
+        DELETE FROM test_groups
+        WHERE deleted_at IS NOT NULL
+          AND deleted_at < $1
+          AND NOT EXISTS (SELECT 1 FROM test_cases WHERE test_cases.group_id = test_groups.id)
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "purge", query, nil, before)
+	return int(rowsAffected), err
+}
+
+// execUpdate は更新系のクエリを実行し、影響を受けた行数を返します
+func (r *PostgresTestGroupRepository) execUpdate(ctx context.Context, operation, query string, details map[string]interface{}, args ...interface{}) (int64, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.NewDatabaseError(operation, "test_groups", err).WithDetails(details)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.NewSystemError("更新結果の取得に失敗しました", err).WithDetails(details)
+	}
+
+	return rowsAffected, nil
+}
+
+// scanTestGroups は検索結果のテストグループをすべて読み取ります
+func scanTestGroups(rows *sql.Rows) ([]*entity.TestGroup, error) {
+	var groups []*entity.TestGroup
+	for rows.Next() {
+		group := &entity.TestGroup{}
+		err := rows.Scan(
+			&group.ID,
+			&group.SuiteID,
+			&group.Name,
+			&group.Description,
+			&group.DisplayOrder,
+			&group.Status,
+			&group.StatusLocked,
+			&group.Version,
+			&group.CreatedAt,
+			&group.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.NewSystemError("テストグループデータの読み取りに失敗しました", err)
+		}
+		groups = append(groups, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "test_groups", err)
+	}
+
+	return groups, nil
+}
+
+// scanDeletedTestGroup は削除日時を含む1行分のテストグループを読み取ります
+func scanDeletedTestGroup(row interface{ Scan(dest ...any) error }) (*entity.TestGroup, error) {
+	group := &entity.TestGroup{}
+	err := row.Scan(
+		&group.ID,
+		&group.SuiteID,
+		&group.Name,
+		&group.Description,
+		&group.DisplayOrder,
+		&group.Status,
+		&group.StatusLocked,
+		&group.Version,
+		&group.DeletedAt,
+		&group.CreatedAt,
+		&group.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
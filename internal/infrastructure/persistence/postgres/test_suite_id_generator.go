@@ -6,27 +6,42 @@ import (
 	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
 )
 
 // インターフェース実装の確認
 var _ repository.TestSuiteIDGenerator = (*TestSuiteIDGenerator)(nil)
 
+// TestSuiteIDGenerator はテストスイートIDを生成するジェネレーター
+// シーケンスを使用する採番方式ではtest_suite_seqから番号を払い出します
 type TestSuiteIDGenerator struct {
-	db *sql.DB
+	db      *sql.DB
+	options idgen.Options
 }
 
-func NewTestSuiteIDGenerator(db *sql.DB) *TestSuiteIDGenerator {
-	return &TestSuiteIDGenerator{db: db}
+// NewTestSuiteIDGenerator は指定された採番方式の新しいTestSuiteIDGeneratorを作成します
+func NewTestSuiteIDGenerator(db *sql.DB, options idgen.Options) *TestSuiteIDGenerator {
+	return &TestSuiteIDGenerator{db: db, options: options}
 }
 
+// GenerateID は新しいスイートIDを生成します
 func (g *TestSuiteIDGenerator) GenerateID() (string, error) {
-	var seq int
-	err := g.db.QueryRow("SELECT nextval('test_suite_seq')").Scan(&seq)
+	seq, err := nextSequenceValue(g.db, g.options, "test_suite_seq")
 	if err != nil {
-		return "", fmt.Errorf("failed to generate sequence number: %w", err)
+		return "", err
+	}
+	return g.options.SuiteID(seq, time.Now())
+}
+
+// nextSequenceValue は採番方式がシーケンスを使用する場合にのみ、指定されたシーケンスの次の値を取得します
+func nextSequenceValue(db *sql.DB, options idgen.Options, sequence string) (int64, error) {
+	if !options.UsesSequence() {
+		return 0, nil
 	}
 
-	currentTime := time.Now()
-	id := fmt.Sprintf("TS%03d-%d%02d", seq, currentTime.Year(), currentTime.Month())
-	return id, nil
+	var seq int64
+	if err := db.QueryRow(fmt.Sprintf("SELECT nextval('%s')", sequence)).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("failed to generate sequence number: %w", err)
+	}
+	return seq, nil
 }
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+)
+
+// suiteCasesJoin はスイート配下の削除されていないテストケースを対象にするFROM・WHERE句です
+const suiteCasesJoin = `
+            FROM test_cases tc
+            JOIN test_groups tg ON tg.id = tc.group_id
+            WHERE tg.suite_id = test_suites.id AND tg.deleted_at IS NULL AND tc.deleted_at IS NULL`
+
+// suiteProgressExpression はentity.NewProgressSummaryと同じ優先度の加重平均でスイートの進捗率を計算する式です
+// SUMは加算の順序によって倍精度の末尾の桁がユースケースで計算した値と異なるため、
+// カーソルに埋め込む値（dto.RoundProgress）と同じ桁数のNUMERICに丸めて比較します
+var suiteProgressExpression = `ROUND(COALESCE((
+            SELECT SUM(` + caseRateExpression() + ` * ` + caseWeightExpression() + `) / SUM(` + caseWeightExpression() + `) * 100` +
+	suiteCasesJoin + `
+        ), 0)::numeric, ` + strconv.Itoa(dto.ProgressScale) + `)`
+
+// suiteDelayedCaseExists は遅延しているテストケースがスイート配下に存在するかを判定する式です
+const suiteDelayedCaseExists = `EXISTS (
+            SELECT 1` + suiteCasesJoin + ` AND tc.is_delayed
+        )`
+
+// testSuiteSortColumns は並び替えの項目に対応するカラムです（progressは進捗率を加えたテーブルでのみ使用できる）
+var testSuiteSortColumns = map[dto.TestSuiteSortField]string{
+	dto.TestSuiteSortByName:               "name",
+	dto.TestSuiteSortByEstimatedStartDate: "estimated_start_date",
+	dto.TestSuiteSortByEstimatedEndDate:   "estimated_end_date",
+	dto.TestSuiteSortByProgress:           "progress",
+	dto.TestSuiteSortByUpdatedAt:          "updated_at",
+	dto.TestSuiteSortByCreatedAt:          "created_at",
+}
+
+func caseRateExpression() string {
+	var b strings.Builder
+	b.WriteString("CASE tc.status")
+	for _, status := range entity.TestStatuses() {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %s", status, float8Literal(status.BaseProgressRate()))
+	}
+	fmt.Fprintf(&b, " ELSE %s END", float8Literal(entity.TestStatus("").BaseProgressRate()))
+	return b.String()
+}
+
+func caseWeightExpression() string {
+	var b strings.Builder
+	b.WriteString("CASE tc.priority")
+	for _, priority := range entity.Priorities() {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %s", priority, float8Literal(priority.Weight()))
+	}
+	fmt.Fprintf(&b, " ELSE %s END", float8Literal(entity.Priority("").Weight()))
+	return b.String()
+}
+
+// float8Literal は数値をNUMERICではなく倍精度のリテラルにします
+func float8Literal(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64) + "::float8"
+}
+
+// orderByClause は並び順のORDER BY句を返します（最後にIDで順序を決め、reverseの場合はすべての方向を反転する）
+func orderByClause(sort []dto.TestSuiteSortDTO, reverse bool) string {
+	keys := make([]string, 0, len(sort)+1)
+	for _, key := range sort {
+		keys = append(keys, testSuiteSortColumns[key.Field]+" "+sortDirection(key.Desc != reverse))
+	}
+	keys = append(keys, "id "+sortDirection(sort[len(sort)-1].Desc != reverse))
+	return " ORDER BY " + strings.Join(keys, ", ")
+}
+
+func sortDirection(desc bool) string {
+	if desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// keysetCondition は並び順でカーソルより後（beforeの場合は前）にあるスイートを対象にする条件を返します
+// 項目ごとに方向が異なる場合があるため、行値ではなく先頭の項目から順に比較する条件をORで連結します
+func keysetCondition(sort []dto.TestSuiteSortDTO, cursor *dto.TestSuiteCursor, before bool, paramCount int) (string, []interface{}) {
+	columns := make([]string, 0, len(sort)+1)
+	descs := make([]bool, 0, len(sort)+1)
+	for _, key := range sort {
+		columns = append(columns, testSuiteSortColumns[key.Field])
+		descs = append(descs, key.Desc)
+	}
+	columns = append(columns, "id")
+	descs = append(descs, sort[len(sort)-1].Desc)
+
+	args := append(append([]interface{}{}, cursor.Values...), cursor.ID)
+
+	terms := make([]string, len(columns))
+	for i, column := range columns {
+		var conds []string
+		for j := 0; j < i; j++ {
+			conds = append(conds, fmt.Sprintf("%s = $%d", columns[j], paramCount+j))
+		}
+		op := ">"
+		if descs[i] != before {
+			op = "<"
+		}
+		conds = append(conds, fmt.Sprintf("%s %s $%d", column, op, paramCount+i))
+		terms[i] = "(" + strings.Join(conds, " AND ") + ")"
+	}
+	return " AND (" + strings.Join(terms, " OR ") + ")", args
+}
+
+// containsPattern は部分一致検索のLIKEパターンを返します（ワイルドカード文字はエスケープする）
+func containsPattern(s string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+	return "%" + escaped + "%"
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
@@ -13,6 +14,14 @@ import (
 	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
 )
 
+// testSuiteColumns はテストスイートのSELECTで取得するカラムです（scanTestSuiteと順序を合わせる）
+const testSuiteColumns = `
+            id, name, description, status,
+            estimated_start_date, estimated_end_date,
+            require_effort_comment, exit_min_completion_rate, exit_allow_open_critical,
+            exit_override_reason, exit_override_by, exit_override_at,
+            version, created_by, created_at, updated_at`
+
 type PostgresTestSuiteRepository struct {
 	db *sql.DB
 }
@@ -29,11 +38,14 @@ func (r *PostgresTestSuiteRepository) Create(ctx context.Context, suite *entity.
         INSERT INTO test_suites (
             id, name, description, status, 
             estimated_start_date, estimated_end_date,
-            require_effort_comment, created_at, updated_at
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+            require_effort_comment, exit_min_completion_rate, exit_allow_open_critical,
+            exit_override_reason, exit_override_by, exit_override_at,
+            version, created_by, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
     `
+	overrideReason, overrideBy, overrideAt := exitOverrideValues(suite.ExitOverride)
 
-	_, err := r.db.ExecContext(
+	_, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		suite.ID,
@@ -43,6 +55,13 @@ func (r *PostgresTestSuiteRepository) Create(ctx context.Context, suite *entity.
 		suite.EstimatedStartDate,
 		suite.EstimatedEndDate,
 		suite.RequireEffortComment,
+		suite.ExitCriteria.MinCompletionRate,
+		suite.ExitCriteria.AllowOpenCritical,
+		overrideReason,
+		overrideBy,
+		overrideAt,
+		suite.Version,
+		sql.NullString{String: suite.CreatedBy, Valid: suite.CreatedBy != ""},
 		suite.CreatedAt,
 		suite.UpdatedAt,
 	)
@@ -57,25 +76,11 @@ func (r *PostgresTestSuiteRepository) Create(ctx context.Context, suite *entity.
 // FindByID は指定されたIDのテストスイートを取得します
 func (r *PostgresTestSuiteRepository) FindByID(ctx context.Context, id string) (*entity.TestSuite, error) {
 	query := `
-        SELECT 
-            id, name, description, status,
-            estimated_start_date, estimated_end_date,
-            require_effort_comment, created_at, updated_at
+        SELECT ` + testSuiteColumns + `
         FROM test_suites
-        WHERE id = $1
+        WHERE id = $1 AND deleted_at IS NULL
     `
-	suite := &entity.TestSuite{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&suite.ID,
-		&suite.Name,
-		&suite.Description,
-		&suite.Status,
-		&suite.EstimatedStartDate,
-		&suite.EstimatedEndDate,
-		&suite.RequireEffortComment,
-		&suite.CreatedAt,
-		&suite.UpdatedAt,
-	)
+	suite, err := scanTestSuite(executor(ctx, r.db).QueryRowContext(ctx, query, id))
 
 	if err != nil {
 		return nil, customerrors.ConvertDBError(err, "find", "TestSuite", id)
@@ -85,6 +90,8 @@ func (r *PostgresTestSuiteRepository) FindByID(ctx context.Context, id string) (
 }
 
 // Update はテストスイートの情報を更新します
+// 読み込み時のバージョンと一致する場合のみ更新し、バージョンを1つ進めます
+// 他の更新が先に行われていた場合は同時更新の競合エラーを返します
 func (r *PostgresTestSuiteRepository) Update(ctx context.Context, suite *entity.TestSuite) error {
 	query := `
         UPDATE test_suites
@@ -95,11 +102,18 @@ func (r *PostgresTestSuiteRepository) Update(ctx context.Context, suite *entity.
             estimated_start_date = $4,
             estimated_end_date = $5,
             require_effort_comment = $6,
-            updated_at = $7
-        WHERE id = $8
+            exit_min_completion_rate = $7,
+            exit_allow_open_critical = $8,
+            exit_override_reason = $9,
+            exit_override_by = $10,
+            exit_override_at = $11,
+            updated_at = $12,
+            version = version + 1
+        WHERE id = $13 AND version = $14 AND deleted_at IS NULL
     `
+	overrideReason, overrideBy, overrideAt := exitOverrideValues(suite.ExitOverride)
 
-	result, err := r.db.ExecContext(
+	result, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		suite.Name,
@@ -108,8 +122,14 @@ func (r *PostgresTestSuiteRepository) Update(ctx context.Context, suite *entity.
 		suite.EstimatedStartDate,
 		suite.EstimatedEndDate,
 		suite.RequireEffortComment,
+		suite.ExitCriteria.MinCompletionRate,
+		suite.ExitCriteria.AllowOpenCritical,
+		overrideReason,
+		overrideBy,
+		overrideAt,
 		time.Now(),
 		suite.ID,
+		suite.Version,
 	)
 
 	if err != nil {
@@ -127,9 +147,10 @@ func (r *PostgresTestSuiteRepository) Update(ctx context.Context, suite *entity.
 	}
 
 	if rowsAffected == 0 {
-		return customerrors.NotFound("TestSuite", suite.ID)
+		return versionConflictError(ctx, executor(ctx, r.db), "test_suites", "TestSuite", suite.ID, suite.Version, customerrors.NotFound("TestSuite", suite.ID))
 	}
 
+	suite.Version++
 	return nil
 }
 
@@ -137,7 +158,7 @@ func (r *PostgresTestSuiteRepository) Update(ctx context.Context, suite *entity.
 func (r *PostgresTestSuiteRepository) Delete(ctx context.Context, id string) error {
 	query := "DELETE FROM test_suites WHERE id = $1"
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return customerrors.ConvertDBError(err, "delete", "TestSuite", id)
 	}
@@ -165,11 +186,12 @@ func (r *PostgresTestSuiteRepository) UpdateStatus(ctx context.Context, id strin
         UPDATE test_suites
         SET 
             status = $1,
-            updated_at = $2
-        WHERE id = $3
+            updated_at = $2,
+            version = version + 1
+        WHERE id = $3 AND deleted_at IS NULL
     `
 
-	result, err := r.db.ExecContext(
+	result, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		status,
@@ -202,15 +224,12 @@ func (r *PostgresTestSuiteRepository) UpdateStatus(ctx context.Context, id strin
 // FindByStatus は指定された状態のテストスイート一覧を取得します
 func (r *PostgresTestSuiteRepository) FindByStatus(ctx context.Context, status valueobject.SuiteStatus) ([]*entity.TestSuite, error) {
 	query := `
-        SELECT 
-            id, name, description, status,
-            estimated_start_date, estimated_end_date,
-            require_effort_comment, created_at, updated_at
+        SELECT ` + testSuiteColumns + `
         FROM test_suites
-        WHERE status = $1
+        WHERE status = $1 AND deleted_at IS NULL
         ORDER BY created_at DESC
     `
-	rows, err := r.db.QueryContext(ctx, query, status)
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, status)
 	if err != nil {
 		return nil, customerrors.DBError("query", "test_suites", err).WithContext(customerrors.Context{
 			"status": status.String(),
@@ -220,18 +239,7 @@ func (r *PostgresTestSuiteRepository) FindByStatus(ctx context.Context, status v
 
 	var suites []*entity.TestSuite
 	for rows.Next() {
-		suite := &entity.TestSuite{}
-		err := rows.Scan(
-			&suite.ID,
-			&suite.Name,
-			&suite.Description,
-			&suite.Status,
-			&suite.EstimatedStartDate,
-			&suite.EstimatedEndDate,
-			&suite.RequireEffortComment,
-			&suite.CreatedAt,
-			&suite.UpdatedAt,
-		)
+		suite, err := scanTestSuite(rows)
 		if err != nil {
 			return nil, customerrors.NewInternalServerError(
 				"テストスイートデータの読み取りに失敗しました",
@@ -253,16 +261,23 @@ func (r *PostgresTestSuiteRepository) FindByStatus(ctx context.Context, status v
 }
 
 // FindWithFilters はフィルター条件に基づいてテストスイート一覧を取得します
+// 総件数はカーソルによる範囲を含めず、絞り込み条件のみで数えます
 func (r *PostgresTestSuiteRepository) FindWithFilters(ctx context.Context, params *dto.TestSuiteQueryParamDTO) ([]*entity.TestSuite, int, error) {
+	// 進捗率で絞り込み・並び替えを行う場合のみ、進捗率の列を加えたテーブルを対象にする
+	from := "test_suites"
+	if params.UsesProgress() {
+		from = `(
+            SELECT test_suites.*, ` + suiteProgressExpression + ` AS progress
+            FROM test_suites
+            WHERE deleted_at IS NULL
+        ) AS test_suites`
+	}
 	baseQuery := `
-        SELECT 
-            id, name, description, status,
-            estimated_start_date, estimated_end_date,
-            require_effort_comment, created_at, updated_at
-        FROM test_suites
-        WHERE 1=1
+        SELECT ` + testSuiteColumns + `
+        FROM ` + from + `
+        WHERE deleted_at IS NULL
     `
-	countQuery := "SELECT COUNT(*) FROM test_suites WHERE 1=1"
+	countQuery := "SELECT COUNT(*) FROM " + from + " WHERE deleted_at IS NULL"
 
 	var queryParams []interface{}
 	paramCount := 1
@@ -294,52 +309,97 @@ func (r *PostgresTestSuiteRepository) FindWithFilters(ctx context.Context, param
 		queryParams = append(queryParams, params.EndDate)
 		paramCount++
 	}
+	if params.Search != nil {
+		whereClause += fmt.Sprintf(" AND (name ILIKE $%d OR description ILIKE $%d)", paramCount, paramCount)
+		queryParams = append(queryParams, containsPattern(*params.Search))
+		paramCount++
+	}
+	if params.CreatedBy != nil {
+		whereClause += fmt.Sprintf(" AND created_by = $%d", paramCount)
+		queryParams = append(queryParams, *params.CreatedBy)
+		paramCount++
+	}
+	// 予定期間が指定された範囲と重なるか（範囲の開始以降に終わり、範囲の終了以前に始まる）
+	if params.PeriodFrom != nil {
+		whereClause += fmt.Sprintf(" AND estimated_end_date >= $%d", paramCount)
+		queryParams = append(queryParams, params.PeriodFrom)
+		paramCount++
+	}
+	if params.PeriodTo != nil {
+		whereClause += fmt.Sprintf(" AND estimated_start_date <= $%d", paramCount)
+		queryParams = append(queryParams, params.PeriodTo)
+		paramCount++
+	}
+	if params.MinProgress != nil {
+		whereClause += fmt.Sprintf(" AND progress >= $%d", paramCount)
+		queryParams = append(queryParams, *params.MinProgress)
+		paramCount++
+	}
+	if params.MaxProgress != nil {
+		whereClause += fmt.Sprintf(" AND progress <= $%d", paramCount)
+		queryParams = append(queryParams, *params.MaxProgress)
+		paramCount++
+	}
+	if params.HasDelayedCases != nil {
+		if *params.HasDelayedCases {
+			whereClause += " AND " + suiteDelayedCaseExists
+		} else {
+			whereClause += " AND NOT " + suiteDelayedCaseExists
+		}
+	}
 
 	var total int
-	err := r.db.QueryRowContext(ctx, countQuery+whereClause, queryParams...).Scan(&total)
+	err := executor(ctx, r.db).QueryRowContext(ctx, countQuery+whereClause, queryParams...).Scan(&total)
 	if err != nil {
 		return nil, 0, customerrors.DBError("count", "test_suites", err).WithContext(customerrors.Context{
 			"filters": fmt.Sprintf("%+v", params),
 		})
 	}
 
-	limit := 10 // デフォルト値
-	offset := 0
-	if params.PageSize != nil {
-		limit = *params.PageSize
-	}
-	if params.Page != nil {
-		offset = (*params.Page - 1) * limit
-	}
+	sort := params.SortOrder()
+	var pageClause string
+	if keyset := params.Keyset; keyset != nil {
+		if keyset.After != nil {
+			condition, args := keysetCondition(sort, keyset.After, false, paramCount)
+			pageClause += condition
+			queryParams = append(queryParams, args...)
+			paramCount += len(args)
+		}
+		if keyset.Before != nil {
+			condition, args := keysetCondition(sort, keyset.Before, true, paramCount)
+			pageClause += condition
+			queryParams = append(queryParams, args...)
+			paramCount += len(args)
+		}
+		// 末尾から取得する場合は逆順で取得し、読み取り後に元の並び順に戻す
+		pageClause += orderByClause(sort, keyset.FromLast) + fmt.Sprintf(" LIMIT $%d", paramCount)
+		queryParams = append(queryParams, keyset.Limit)
+	} else {
+		limit := 10 // デフォルト値
+		offset := 0
+		if params.PageSize != nil {
+			limit = *params.PageSize
+		}
+		if params.Page != nil {
+			offset = (*params.Page - 1) * limit
+		}
 
-	query := baseQuery + whereClause + " ORDER BY created_at DESC LIMIT $" +
-		fmt.Sprintf("%d OFFSET $%d", paramCount, paramCount+1)
-	queryParams = append(queryParams, limit, offset)
+		pageClause = orderByClause(sort, false) + fmt.Sprintf(" LIMIT $%d OFFSET $%d", paramCount, paramCount+1)
+		queryParams = append(queryParams, limit, offset)
+	}
 
-	rows, err := r.db.QueryContext(ctx, query, queryParams...)
+	rows, err := executor(ctx, r.db).QueryContext(ctx, baseQuery+whereClause+pageClause, queryParams...)
 	if err != nil {
 		return nil, 0, customerrors.DBError("query", "test_suites", err).WithContext(customerrors.Context{
 			"filters": fmt.Sprintf("%+v", params),
-			"limit":   limit,
-			"offset":  offset,
+			"page":    pageClause,
 		})
 	}
 	defer rows.Close()
 
 	var suites []*entity.TestSuite
 	for rows.Next() {
-		suite := &entity.TestSuite{}
-		err := rows.Scan(
-			&suite.ID,
-			&suite.Name,
-			&suite.Description,
-			&suite.Status,
-			&suite.EstimatedStartDate,
-			&suite.EstimatedEndDate,
-			&suite.RequireEffortComment,
-			&suite.CreatedAt,
-			&suite.UpdatedAt,
-		)
+		suite, err := scanTestSuite(rows)
 		if err != nil {
 			return nil, 0, customerrors.NewInternalServerError(
 				"テストスイートデータの読み取りに失敗しました",
@@ -357,5 +417,210 @@ func (r *PostgresTestSuiteRepository) FindWithFilters(ctx context.Context, param
 		})
 	}
 
+	if params.Keyset != nil && params.Keyset.FromLast {
+		slices.Reverse(suites)
+	}
+
 	return suites, total, nil
 }
+
+// SoftDelete は指定されたテストスイートを論理削除します
+// 既に削除されている場合は見つからないものとして扱います
+func (r *PostgresTestSuiteRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_suites
+        SET 
+            deleted_at = $1,
+            version = version + 1
+        WHERE id = $2 AND deleted_at IS NULL
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, deletedAt, id)
+	if err != nil {
+		return customerrors.ConvertDBError(err, "soft_delete", "TestSuite", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return customerrors.NewInternalServerError(
+			"削除結果の取得に失敗しました",
+		).WithContext(customerrors.Context{
+			"id":    id,
+			"error": err.Error(),
+		})
+	}
+
+	if rowsAffected == 0 {
+		return customerrors.NotFound("TestSuite", id)
+	}
+
+	return nil
+}
+
+// Restore は論理削除されたテストスイートを復元します
+func (r *PostgresTestSuiteRepository) Restore(ctx context.Context, id string) error {
+	query := `
+        UPDATE test_suites
+        SET 
+            deleted_at = NULL,
+            version = version + 1
+        WHERE id = $1 AND deleted_at IS NOT NULL
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return customerrors.ConvertDBError(err, "restore", "TestSuite", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return customerrors.NewInternalServerError(
+			"復元結果の取得に失敗しました",
+		).WithContext(customerrors.Context{
+			"id":    id,
+			"error": err.Error(),
+		})
+	}
+
+	if rowsAffected == 0 {
+		return customerrors.NotFound("TestSuite", id)
+	}
+
+	return nil
+}
+
+// FindDeletedByID は論理削除された指定IDのテストスイートを取得します
+func (r *PostgresTestSuiteRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestSuite, error) {
+	query := `
+        SELECT ` + testSuiteColumns + `, deleted_at
+        FROM test_suites
+        WHERE id = $1 AND deleted_at IS NOT NULL
+    `
+	suite, err := scanDeletedTestSuite(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+
+	if err != nil {
+		return nil, customerrors.ConvertDBError(err, "find", "TestSuite", id)
+	}
+
+	return suite, nil
+}
+
+// FindDeleted は論理削除されたテストスイートを削除日時の新しい順に取得します
+func (r *PostgresTestSuiteRepository) FindDeleted(ctx context.Context) ([]*entity.TestSuite, error) {
+	query := `
+        SELECT ` + testSuiteColumns + `, deleted_at
+        FROM test_suites
+        WHERE deleted_at IS NOT NULL
+        ORDER BY deleted_at DESC, id ASC
+    `
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, customerrors.DBError("query", "test_suites", err)
+	}
+	defer rows.Close()
+
+	var suites []*entity.TestSuite
+	for rows.Next() {
+		suite, err := scanDeletedTestSuite(rows)
+		if err != nil {
+			return nil, customerrors.NewInternalServerError(
+				"テストスイートデータの読み取りに失敗しました",
+			).WithContext(customerrors.Context{
+				"error": err.Error(),
+			})
+		}
+		suites = append(suites, suite)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, customerrors.DBError("iterate", "test_suites", err)
+	}
+
+	return suites, nil
+}
+
+// PurgeDeletedBefore はbeforeより前に論理削除されたテストスイートを物理削除します
+// 配下のグループが残っている場合は外部キー制約に違反するため対象外とします
+func (r *PostgresTestSuiteRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	query := `
+        DELETE FROM test_suites
+        WHERE deleted_at IS NOT NULL
+          AND deleted_at < $1
+          AND NOT EXISTS (SELECT 1 FROM test_groups WHERE test_groups.suite_id = test_suites.id)
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, customerrors.DBError("purge", "test_suites", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, customerrors.NewInternalServerError(
+			"削除結果の取得に失敗しました",
+		).WithContext(customerrors.Context{
+			"error": err.Error(),
+		})
+	}
+
+	return int(rowsAffected), nil
+}
+
+// scanDeletedTestSuite はtestSuiteColumnsに続けて削除日時を選択した1行分のテストスイートを読み取ります
+func scanDeletedTestSuite(row interface{ Scan(dest ...any) error }) (*entity.TestSuite, error) {
+	var deletedAt time.Time
+	suite, err := scanTestSuite(appendScanDest{row: row, extra: []any{&deletedAt}})
+	if err != nil {
+		return nil, err
+	}
+	suite.DeletedAt = deletedAt
+	return suite, nil
+}
+
+// scanTestSuite は1行分のテストスイートを読み取ります
+// 完了条件のオーバーライドが記録されていない場合、ExitOverrideはnilになります
+func scanTestSuite(row interface{ Scan(dest ...any) error }) (*entity.TestSuite, error) {
+	suite := &entity.TestSuite{}
+	var overrideReason, overrideBy, createdBy sql.NullString
+	var overrideAt sql.NullTime
+	err := row.Scan(
+		&suite.ID,
+		&suite.Name,
+		&suite.Description,
+		&suite.Status,
+		&suite.EstimatedStartDate,
+		&suite.EstimatedEndDate,
+		&suite.RequireEffortComment,
+		&suite.ExitCriteria.MinCompletionRate,
+		&suite.ExitCriteria.AllowOpenCritical,
+		&overrideReason,
+		&overrideBy,
+		&overrideAt,
+		&suite.Version,
+		&createdBy,
+		&suite.CreatedAt,
+		&suite.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	suite.CreatedBy = createdBy.String
+	if overrideAt.Valid {
+		suite.ExitOverride = &entity.ExitCriteriaOverride{
+			Reason:       overrideReason.String,
+			OverriddenBy: overrideBy.String,
+			OverriddenAt: overrideAt.Time,
+		}
+	}
+	return suite, nil
+}
+
+// exitOverrideValues は完了条件のオーバーライドをINSERT・UPDATEのパラメータに変換します（未記録の場合はNULL）
+func exitOverrideValues(override *entity.ExitCriteriaOverride) (sql.NullString, sql.NullString, sql.NullTime) {
+	if override == nil {
+		return sql.NullString{}, sql.NullString{}, sql.NullTime{}
+	}
+	return sql.NullString{String: override.Reason, Valid: true},
+		sql.NullString{String: override.OverriddenBy, Valid: true},
+		sql.NullTime{Time: override.OverriddenAt, Valid: true}
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/common"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// txContextKey はcontextに*sql.Txを格納するためのキー
+type txContextKey struct{}
+
+// PostgresTransactionManager は*sql.Txによるトランザクション管理のPostgreSQL実装
+type PostgresTransactionManager struct {
+	db *sql.DB
+}
+
+// NewTransactionManager は新しいTransactionManagerを作成します
+func NewTransactionManager(db *sql.DB) repository.TransactionManager {
+	return &PostgresTransactionManager{
+		db: db,
+	}
+}
+
+// RunInTransaction はトランザクションを開始し、*sql.Txを格納したcontextでfnを実行します
+func (m *PostgresTransactionManager) RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// 外側のトランザクションに参加し、コミットは外側に任せる
+	if _, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.NewDatabaseError("begin", "transaction", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		// ロールバックの失敗よりも処理自体のエラーを呼び出し元に返す
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.NewDatabaseError("commit", "transaction", err)
+	}
+
+	return nil
+}
+
+// executor はcontextにトランザクションが格納されていればそれを、なければdbを返します
+// リポジトリはすべてのクエリをこの戻り値で実行し、RunInTransactionの範囲に参加します
+func executor(ctx context.Context, db *sql.DB) common.SQLExecutor {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
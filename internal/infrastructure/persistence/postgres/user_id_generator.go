@@ -3,33 +3,36 @@ package postgres
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
 	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
 )
 
 // UserIDGenerator はPostgreSQL用のユーザーIDジェネレーター実装
 type UserIDGenerator struct {
-	db *sql.DB
+	db      *sql.DB
+	options idgen.Options
 }
 
-// NewUserIDGenerator は新しいUserIDGeneratorインスタンスを作成する
-func NewUserIDGenerator(db *sql.DB) repository.UserIDGenerator {
-	return &UserIDGenerator{db: db}
+// NewUserIDGenerator は指定された採番方式の新しいUserIDGeneratorインスタンスを作成する
+func NewUserIDGenerator(db *sql.DB, options idgen.Options) repository.UserIDGenerator {
+	return &UserIDGenerator{db: db, options: options}
 }
 
 // Generate は新しいユーザーIDを生成する
-// PostgreSQLのシーケンスuser_seqを使用して採番し、"user_"プレフィックスを付ける
+// シーケンス方式ではPostgreSQLのシーケンスuser_seqを使用して採番し、"user_"プレフィックスを付ける
 func (g *UserIDGenerator) Generate(ctx context.Context) (string, error) {
 	var id int64
-	err := g.db.QueryRowContext(ctx, "SELECT nextval('user_seq')").Scan(&id)
-	if err != nil {
-		return "", customerrors.DBError("generate_id", "user", err).WithContext(customerrors.Context{
-			"sequence": "user_seq",
-		})
+	if g.options.UsesSequence() {
+		err := g.db.QueryRowContext(ctx, "SELECT nextval('user_seq')").Scan(&id)
+		if err != nil {
+			return "", customerrors.DBError("generate_id", "user", err).WithContext(customerrors.Context{
+				"sequence": "user_seq",
+			})
+		}
 	}
 
-	// "user_"プレフィックス + シーケンス番号の形式でIDを生成
-	return fmt.Sprintf("user_%d", id), nil
+	return g.options.UserID(id, time.Now())
 }
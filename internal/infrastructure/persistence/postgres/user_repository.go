@@ -31,7 +31,7 @@ func (r *PostgresUserRepository) Create(ctx context.Context, user *entity.User)
 		) VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
-	_, err := r.db.ExecContext(
+	_, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		user.ID,
@@ -60,7 +60,7 @@ func (r *PostgresUserRepository) FindByID(ctx context.Context, id string) (*enti
 		WHERE id = $1
 	`
 	user := &entity.User{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := executor(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&user.ID,
 		&user.Username,
 		&user.PasswordHash,
@@ -87,7 +87,7 @@ func (r *PostgresUserRepository) FindByUsername(ctx context.Context, username st
 		WHERE username = $1
 	`
 	user := &entity.User{}
-	err := r.db.QueryRowContext(ctx, query, username).Scan(
+	err := executor(ctx, r.db).QueryRowContext(ctx, query, username).Scan(
 		&user.ID,
 		&user.Username,
 		&user.PasswordHash,
@@ -124,7 +124,7 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *entity.User)
 		WHERE id = $6
 	`
 
-	result, err := r.db.ExecContext(
+	result, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		user.Username,
@@ -167,7 +167,7 @@ func (r *PostgresUserRepository) UpdateLastLogin(ctx context.Context, id string)
 	`
 
 	now := time.Now()
-	result, err := r.db.ExecContext(
+	result, err := executor(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		now,
@@ -199,7 +199,7 @@ func (r *PostgresUserRepository) UpdateLastLogin(ctx context.Context, id string)
 func (r *PostgresUserRepository) Delete(ctx context.Context, id string) error {
 	query := "DELETE FROM users WHERE id = $1"
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return customerrors.ConvertDBError(err, "delete", "User", id)
 	}
@@ -231,7 +231,7 @@ func (r *PostgresUserRepository) FindAll(ctx context.Context) ([]*entity.User, e
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query)
 	if err != nil {
 		return nil, customerrors.ConvertDBError(err, "find_all", "User", "")
 	}
@@ -267,7 +267,7 @@ func (r *PostgresUserRepository) FindAll(ctx context.Context) ([]*entity.User, e
 func (r *PostgresUserRepository) CountByRole(ctx context.Context, role entity.UserRole) (int, error) {
 	query := "SELECT COUNT(*) FROM users WHERE role = $1"
 	var count int
-	err := r.db.QueryRowContext(ctx, query, role).Scan(&count)
+	err := executor(ctx, r.db).QueryRowContext(ctx, query, role).Scan(&count)
 	if err != nil {
 		return 0, customerrors.ConvertDBError(err, "count_by_role", "User", "")
 	}
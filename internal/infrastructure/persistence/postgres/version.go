@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/common"
+
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// versionConflictError はバージョン条件付きの更新で行が更新されなかった原因を判定します
+// 行が存在する場合は同時更新の競合エラーを、存在しないか論理削除されている場合はnotFoundを返します
+// tableには呼び出し元で定義したテーブル名のみを指定します
+func versionConflictError(ctx context.Context, db common.SQLExecutor, table, resource, id string, expectedVersion int, notFound error) error {
+	var currentVersion int64
+	err := db.QueryRowContext(ctx, "SELECT version FROM "+table+" WHERE id = $1 AND deleted_at IS NULL", id).Scan(&currentVersion)
+	if err == sql.ErrNoRows {
+		return notFound
+	}
+	if err != nil {
+		return errors.NewDatabaseError("query", table, err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	return errors.NewConcurrentModificationError(resource, id, currentVersion, int64(expectedVersion))
+}
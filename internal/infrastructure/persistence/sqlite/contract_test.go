@@ -0,0 +1,25 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository/repositorytest"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/sqlite"
+)
+
+// TestRepositoryContract はPostgreSQL・メモリ内実装と同じ契約テストをSQLiteの実装で実行する
+// サブテストごとに新しいデータベースファイルを作成し、空のデータベースから開始する
+func TestRepositoryContract(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		db, _ := setupTestDB(t)
+
+		return repositorytest.Repositories{
+			TestSuite:     sqlite.NewTestSuiteRepository(db),
+			TestGroup:     sqlite.NewTestGroupRepository(db),
+			TestCase:      sqlite.NewTestCaseRepository(db),
+			User:          sqlite.NewUserRepository(db),
+			EffortRecord:  sqlite.NewEffortRecordRepository(db),
+			StatusHistory: sqlite.NewStatusHistoryRepository(db),
+		}
+	})
+}
@@ -0,0 +1,70 @@
+// Package sqlite はリポジトリとIDジェネレーターのSQLite実装です
+// PostgreSQLを運用せずに1台のサーバーでシステムを動かすためのもので、
+// スキーマはscripts/migrations/sqliteのマイグレーションで作成します
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// DBConfig はSQLiteデータベースの接続設定を保持します
+type DBConfig struct {
+	Path         string
+	MaxOpenConns int
+	BusyTimeout  time.Duration
+}
+
+// NewDB はSQLiteデータベースを開きます（ファイルが存在しない場合は作成します）
+//
+// 接続ごとに次の設定を適用します
+//   - 外部キー制約を有効にする（SQLiteの既定では無効）
+//   - WALモードにして、書き込み中も読み取りをブロックしない
+//   - トランザクションをBEGIN IMMEDIATEで開始し、書き込みロックの取得待ちをbusy_timeoutに任せる
+func NewDB(config DBConfig) (*sql.DB, error) {
+	if config.Path == "" {
+		return nil, fmt.Errorf("sqlite database path is required")
+	}
+	if dir := filepath.Dir(config.Path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create directory for sqlite database: %w", err)
+		}
+	}
+
+	busyTimeout := 5 * time.Second
+	if config.BusyTimeout > 0 {
+		busyTimeout = config.BusyTimeout
+	}
+
+	params := url.Values{}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeout.Milliseconds()))
+	params.Set("_txlock", "immediate")
+
+	db, err := sql.Open("sqlite", config.Path+"?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	maxOpenConns := 10
+	if config.MaxOpenConns > 0 {
+		maxOpenConns = config.MaxOpenConns
+	}
+	db.SetMaxOpenConns(maxOpenConns)
+	db.SetMaxIdleConns(maxOpenConns)
+
+	// 接続確認
+	if err = db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
@@ -0,0 +1,119 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/sqlite"
+)
+
+func TestMigrations_DownAndUp(t *testing.T) {
+	ctx := context.Background()
+	db, migrator := setupTestDB(t)
+
+	reverted, err := migrator.To(ctx, 0)
+	if err != nil {
+		t.Fatalf("failed to roll back migrations: %v", err)
+	}
+	if len(reverted) == 0 {
+		t.Fatalf("expected migrations to be rolled back")
+	}
+
+	var tables int
+	if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name IN ('test_suites', 'id_sequences')").Scan(&tables); err != nil {
+		t.Fatalf("failed to query sqlite_master: %v", err)
+	}
+	if tables != 0 {
+		t.Errorf("expected tables to be dropped, %d remain", tables)
+	}
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("failed to reapply migrations: %v", err)
+	}
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	}
+	if status.Dirty || status.Version != status.Migrations[len(status.Migrations)-1].Version {
+		t.Errorf("expected latest clean version, got %+v", status)
+	}
+}
+
+func TestIDGenerator_Sequence(t *testing.T) {
+	db, _ := setupTestDB(t)
+
+	suiteGen := sqlite.NewTestSuiteIDGenerator(db, idgen.Options{})
+	first, err := suiteGen.GenerateID()
+	if err != nil {
+		t.Fatalf("failed to generate suite id: %v", err)
+	}
+	second, err := suiteGen.GenerateID()
+	if err != nil {
+		t.Fatalf("failed to generate suite id: %v", err)
+	}
+	if !strings.HasPrefix(first, "TS001-") || !strings.HasPrefix(second, "TS002-") {
+		t.Errorf("expected sequential suite ids, got %s and %s", first, second)
+	}
+
+	// シーケンスはID種別ごとに独立している
+	groupID, err := sqlite.NewTestGroupIDGenerator(db, idgen.Options{}).GenerateID(first)
+	if err != nil {
+		t.Fatalf("failed to generate group id: %v", err)
+	}
+	if !strings.HasPrefix(groupID, "TS001TG01-") {
+		t.Errorf("expected first group id, got %s", groupID)
+	}
+
+	userID, err := sqlite.NewUserIDGenerator(db, idgen.Options{}).Generate(context.Background())
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+	if userID != "user_1" {
+		t.Errorf("expected user_1, got %s", userID)
+	}
+}
+
+func TestTransactionManager_Rollback(t *testing.T) {
+	ctx := context.Background()
+	db, _ := setupTestDB(t)
+	repo := sqlite.NewTestSuiteRepository(db)
+	txManager := sqlite.NewTransactionManager(db)
+
+	now := time.Now()
+	suite := &entity.TestSuite{
+		ID:                 "TS001-202501",
+		Name:               "suite",
+		Status:             valueobject.SuiteStatusPreparation,
+		EstimatedStartDate: now,
+		EstimatedEndDate:   now.AddDate(0, 0, 7),
+		ExitCriteria:       entity.DefaultExitCriteria(),
+		Version:            1,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	errFailed := errors.New("failed")
+	err := txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := repo.Create(ctx, suite); err != nil {
+			return err
+		}
+		// トランザクション内では未確定の変更を読み取れる
+		if _, err := repo.FindByID(ctx, suite.ID); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected fn error, got %v", err)
+	}
+
+	if _, err := repo.FindByID(ctx, suite.ID); err == nil {
+		t.Errorf("suite created in rolled back transaction should not exist")
+	}
+}
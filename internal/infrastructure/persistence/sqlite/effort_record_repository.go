@@ -0,0 +1,217 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// effortRecordColumns は工数記録のSELECTで取得するカラムです（scanEffortRecordと順序を合わせる）
+const effortRecordColumns = `
+            id, test_case_id, record_date, effort_amount, is_additional,
+            COALESCE(comment, ''), recorded_by, created_at`
+
+// SQLiteEffortRecordRepository は工数記録のSQLite実装
+type SQLiteEffortRecordRepository struct {
+	db *sql.DB
+}
+
+// NewEffortRecordRepository は新しいEffortRecordRepositoryを作成します
+func NewEffortRecordRepository(db *sql.DB) repository.EffortRecordRepository {
+	return &SQLiteEffortRecordRepository{
+		db: db,
+	}
+}
+
+// Create は工数記録をデータベースに保存し、採番されたIDを設定します
+func (r *SQLiteEffortRecordRepository) Create(ctx context.Context, record *entity.EffortRecord) error {
+	query := `
+        INSERT INTO effort_records (
+            test_case_id, record_date, effort_amount, is_additional,
+            comment, recorded_by, created_at
+        ) VALUES (?, ?, ?, ?, ?, ?, ?)
+        RETURNING id
+    `
+
+	var id int
+	err := executor(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		record.TestCaseID,
+		date(record.RecordDate),
+		record.EffortAmount,
+		record.IsAdditional,
+		record.Comment,
+		record.RecordedBy,
+		timestamp(record.CreatedAt),
+	).Scan(&id)
+
+	if err != nil {
+		if customerrors.IsSQLiteForeignKeyViolation(err) {
+			return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+				"testCaseId": record.TestCaseID,
+			})
+		}
+		return errors.NewDatabaseError("create", "effort_records", err).WithDetails(map[string]interface{}{
+			"testCaseId": record.TestCaseID,
+		})
+	}
+
+	record.SetIDFromInt(id)
+
+	return nil
+}
+
+// FindByID は指定されたIDの工数記録を取得します
+func (r *SQLiteEffortRecordRepository) FindByID(ctx context.Context, id string) (*entity.EffortRecord, error) {
+	// 自動採番の整数IDのため、数値でないIDは存在しないものとして扱う
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, errors.NewNotFoundError("EffortRecord", id)
+	}
+
+	query := `
+        SELECT ` + effortRecordColumns + `
+        FROM effort_records
+        WHERE id = ?
+    `
+
+	record, err := scanEffortRecord(executor(ctx, r.db).QueryRowContext(ctx, query, intID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("EffortRecord", id)
+		}
+		return nil, errors.NewSystemError(
+			"工数記録の検索中にエラーが発生しました",
+			err,
+		).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	return record, nil
+}
+
+// Update は工数記録の工数とコメントを更新します
+func (r *SQLiteEffortRecordRepository) Update(ctx context.Context, record *entity.EffortRecord) error {
+	query := `
+        UPDATE effort_records
+        SET
+            effort_amount = ?,
+            comment = ?
+        WHERE id = ?
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		record.EffortAmount,
+		record.Comment,
+		record.IDAsInt(),
+	)
+
+	if err != nil {
+		return errors.NewDatabaseError("update", "effort_records", err).WithDetails(map[string]interface{}{
+			"id": record.ID,
+		})
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.NewSystemError("工数記録更新結果の取得に失敗しました", err).WithDetails(map[string]interface{}{
+			"id": record.ID,
+		})
+	}
+
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("EffortRecord", record.ID)
+	}
+
+	return nil
+}
+
+// FindByTestCaseID は指定されたテストケースの工数記録一覧を記録日順に取得します
+func (r *SQLiteEffortRecordRepository) FindByTestCaseID(ctx context.Context, testCaseID string) ([]*entity.EffortRecord, error) {
+	query := `
+        SELECT ` + effortRecordColumns + `
+        FROM effort_records
+        WHERE test_case_id = ?
+        ORDER BY record_date ASC, id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, testCaseID)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "effort_records", err).WithDetails(map[string]interface{}{
+			"testCaseId": testCaseID,
+		})
+	}
+	defer rows.Close()
+
+	return scanEffortRecords(rows)
+}
+
+// FindByRecorderAndDate は指定されたユーザーが指定日に記録した工数記録一覧を取得します
+func (r *SQLiteEffortRecordRepository) FindByRecorderAndDate(ctx context.Context, recordedBy string, recordDate time.Time) ([]*entity.EffortRecord, error) {
+	query := `
+        SELECT ` + effortRecordColumns + `
+        FROM effort_records
+        WHERE recorded_by = ? AND record_date = ?
+        ORDER BY id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, recordedBy, date(recordDate))
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "effort_records", err).WithDetails(map[string]interface{}{
+			"recordedBy": recordedBy,
+			"recordDate": date(recordDate),
+		})
+	}
+	defer rows.Close()
+
+	return scanEffortRecords(rows)
+}
+
+// scanEffortRecord は1行分の工数記録を読み取ります
+func scanEffortRecord(row interface{ Scan(dest ...any) error }) (*entity.EffortRecord, error) {
+	record := &entity.EffortRecord{}
+	var id int
+	err := row.Scan(
+		&id,
+		&record.TestCaseID,
+		&record.RecordDate,
+		&record.EffortAmount,
+		&record.IsAdditional,
+		&record.Comment,
+		&record.RecordedBy,
+		&record.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	record.SetIDFromInt(id)
+	return record, nil
+}
+
+// scanEffortRecords は複数行の工数記録を読み取ります
+func scanEffortRecords(rows *sql.Rows) ([]*entity.EffortRecord, error) {
+	var records []*entity.EffortRecord
+	for rows.Next() {
+		record, err := scanEffortRecord(rows)
+		if err != nil {
+			return nil, errors.NewSystemError("工数記録データの読み取りに失敗しました", err)
+		}
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "effort_records", err)
+	}
+
+	return records, nil
+}
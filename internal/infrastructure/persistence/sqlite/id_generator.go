@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/common"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// インターフェース実装の確認
+var (
+	_ repository.TestSuiteIDGenerator = (*TestSuiteIDGenerator)(nil)
+	_ repository.TestGroupIDGenerator = (*TestGroupIDGenerator)(nil)
+	_ repository.TestCaseIDGenerator  = (*TestCaseIDGenerator)(nil)
+	_ repository.UserIDGenerator      = (*UserIDGenerator)(nil)
+)
+
+// TestSuiteIDGenerator はテストスイートIDを生成するジェネレーター
+// シーケンスを使用する採番方式ではid_sequencesのtest_suite_seqから番号を払い出します
+type TestSuiteIDGenerator struct {
+	db      *sql.DB
+	options idgen.Options
+}
+
+// NewTestSuiteIDGenerator は指定された採番方式の新しいTestSuiteIDGeneratorを作成します
+func NewTestSuiteIDGenerator(db *sql.DB, options idgen.Options) *TestSuiteIDGenerator {
+	return &TestSuiteIDGenerator{db: db, options: options}
+}
+
+// GenerateID は新しいスイートIDを生成します
+func (g *TestSuiteIDGenerator) GenerateID() (string, error) {
+	seq, err := nextSequenceValue(context.Background(), g.db, g.options, "test_suite_seq")
+	if err != nil {
+		return "", err
+	}
+	return g.options.SuiteID(seq, time.Now())
+}
+
+// TestGroupIDGenerator はテストグループIDを生成するジェネレーター
+type TestGroupIDGenerator struct {
+	db      *sql.DB
+	options idgen.Options
+}
+
+// NewTestGroupIDGenerator は指定された採番方式の新しいTestGroupIDGeneratorを作成します
+func NewTestGroupIDGenerator(db *sql.DB, options idgen.Options) *TestGroupIDGenerator {
+	return &TestGroupIDGenerator{db: db, options: options}
+}
+
+// GenerateID はスイートIDに紐づいた新しいグループIDを生成します
+func (g *TestGroupIDGenerator) GenerateID(suiteID string) (string, error) {
+	seq, err := nextSequenceValue(context.Background(), g.db, g.options, "test_group_seq")
+	if err != nil {
+		return "", err
+	}
+	return g.options.GroupID(suiteID, seq, time.Now())
+}
+
+// TestCaseIDGenerator はテストケースIDを生成するジェネレーター
+type TestCaseIDGenerator struct {
+	db      *sql.DB
+	options idgen.Options
+}
+
+// NewTestCaseIDGenerator は指定された採番方式の新しいTestCaseIDGeneratorを作成します
+func NewTestCaseIDGenerator(db *sql.DB, options idgen.Options) *TestCaseIDGenerator {
+	return &TestCaseIDGenerator{db: db, options: options}
+}
+
+// GenerateID はグループIDに紐づいた新しいケースIDを生成します
+func (g *TestCaseIDGenerator) GenerateID(groupID string) (string, error) {
+	seq, err := nextSequenceValue(context.Background(), g.db, g.options, "test_case_seq")
+	if err != nil {
+		return "", err
+	}
+	return g.options.CaseID(groupID, seq, time.Now())
+}
+
+// UserIDGenerator はSQLite用のユーザーIDジェネレーター実装
+type UserIDGenerator struct {
+	db      *sql.DB
+	options idgen.Options
+}
+
+// NewUserIDGenerator は指定された採番方式の新しいUserIDGeneratorインスタンスを作成する
+func NewUserIDGenerator(db *sql.DB, options idgen.Options) repository.UserIDGenerator {
+	return &UserIDGenerator{db: db, options: options}
+}
+
+// Generate は新しいユーザーIDを生成する
+// トランザクション内で呼び出された場合は、書き込みロックを待たないよう同じトランザクションで採番する
+func (g *UserIDGenerator) Generate(ctx context.Context) (string, error) {
+	id, err := nextSequenceValue(ctx, executor(ctx, g.db), g.options, "user_seq")
+	if err != nil {
+		return "", customerrors.DBError("generate_id", "user", err).WithContext(customerrors.Context{
+			"sequence": "user_seq",
+		})
+	}
+	return g.options.UserID(id, time.Now())
+}
+
+// nextSequenceValue は採番方式がシーケンスを使用する場合にのみ、id_sequencesの指定された行を1つ進めた値を取得します
+// 1つのUPDATE文で加算と取得を行うため、同時に採番しても同じ番号は払い出されません
+func nextSequenceValue(ctx context.Context, db common.SQLExecutor, options idgen.Options, sequence string) (int64, error) {
+	if !options.UsesSequence() {
+		return 0, nil
+	}
+
+	var seq int64
+	err := db.QueryRowContext(ctx, "UPDATE id_sequences SET value = value + 1 WHERE name = ? RETURNING value", sequence).Scan(&seq)
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate sequence number: %w", err)
+	}
+	return seq, nil
+}
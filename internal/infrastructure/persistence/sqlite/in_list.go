@@ -0,0 +1,13 @@
+package sqlite
+
+import "strings"
+
+// inList はIN句のプレースホルダーと引数を返します
+// SQLiteには配列のパラメータがないため、PostgreSQLの= ANY($1)の代わりに使用します
+func inList(values []string) (string, []interface{}) {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return "(" + strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ") + ")", args
+}
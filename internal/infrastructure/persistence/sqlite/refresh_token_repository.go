@@ -0,0 +1,259 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/common"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// refreshTokenColumns はリフレッシュトークンのSELECTで取得するカラムです（scanRefreshTokenと順序を合わせる）
+// SQLiteのスキーマはマイグレーション1つで作成するため、PostgreSQL実装のような追加カラムの有無の確認は不要です
+const refreshTokenColumns = `
+		id, token, user_id, expires_at, revoked, issued_at,
+		last_used_at, COALESCE(client_info, ''), COALESCE(ip_address, '')`
+
+// SQLiteRefreshTokenRepository はSQLiteを使用したリフレッシュトークンリポジトリ
+type SQLiteRefreshTokenRepository struct {
+	db common.SQLExecutor // *sql.DB または *sql.Tx を受け入れ可能
+}
+
+// NewSQLiteRefreshTokenRepository は新しいSQLiteリポジトリインスタンスを作成
+func NewSQLiteRefreshTokenRepository(executor common.SQLExecutor) repository.RefreshTokenRepository {
+	return &SQLiteRefreshTokenRepository{
+		db: executor,
+	}
+}
+
+// Store はリフレッシュトークンをデータベースに保存する
+func (r *SQLiteRefreshTokenRepository) Store(ctx context.Context, token *entity.RefreshToken) error {
+	if token == nil {
+		return customerrors.NewValidationError("token cannot be nil", nil)
+	}
+
+	query := `
+		INSERT INTO refresh_tokens (
+			token, user_id, expires_at, revoked, issued_at,
+			client_info, ip_address, created_at, updated_at
+		) VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?8)
+		RETURNING id
+	`
+
+	var id int
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		token.Token,
+		token.UserID,
+		timestamp(token.ExpiresAt),
+		token.IsRevoked,
+		timestamp(token.IssuedAt),
+		token.ClientInfo,
+		token.IP,
+		timestamp(time.Now()),
+	).Scan(&id)
+
+	if err != nil {
+		if customerrors.IsSQLiteUniqueViolation(err) {
+			return customerrors.NewConflictError("token already exists")
+		}
+		return customerrors.WrapInternalServerError(err, "failed to store refresh token")
+	}
+
+	// 生成されたIDを設定
+	token.SetIDFromInt(id)
+
+	return nil
+}
+
+// GetByToken はトークン文字列からリフレッシュトークンエンティティを取得する
+func (r *SQLiteRefreshTokenRepository) GetByToken(ctx context.Context, tokenString string) (*entity.RefreshToken, error) {
+	query := `
+		SELECT ` + refreshTokenColumns + `
+		FROM refresh_tokens
+		WHERE token = ?
+	`
+
+	token, err := scanRefreshToken(r.db.QueryRowContext(ctx, query, tokenString))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, customerrors.NewNotFoundError("token not found")
+		}
+		return nil, customerrors.WrapInternalServerError(err, "failed to get refresh token")
+	}
+
+	return token, nil
+}
+
+// Revoke はトークンを無効化する
+func (r *SQLiteRefreshTokenRepository) Revoke(ctx context.Context, tokenID string) error {
+	// 文字列IDを整数に変換
+	id, err := strconv.Atoi(tokenID)
+	if err != nil {
+		return customerrors.NewValidationError("invalid token id format", map[string]string{
+			"tokenID": "must be a valid integer",
+		})
+	}
+
+	query := `
+		UPDATE refresh_tokens
+		SET revoked = 1, updated_at = ?
+		WHERE id = ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, timestamp(time.Now()), id)
+	if err != nil {
+		return customerrors.WrapInternalServerError(err, "failed to revoke refresh token")
+	}
+
+	return requireTokenAffected(result)
+}
+
+// GetByUserID はユーザーIDに関連付けられたすべてのトークンを取得する
+func (r *SQLiteRefreshTokenRepository) GetByUserID(ctx context.Context, userID string) ([]*entity.RefreshToken, error) {
+	query := `
+		SELECT ` + refreshTokenColumns + `
+		FROM refresh_tokens
+		WHERE user_id = ?
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, customerrors.WrapInternalServerError(err, "failed to get refresh tokens for user")
+	}
+	defer rows.Close()
+
+	var tokens []*entity.RefreshToken
+	for rows.Next() {
+		token, err := scanRefreshToken(rows)
+		if err != nil {
+			return nil, customerrors.WrapInternalServerError(err, "failed to scan refresh token")
+		}
+		tokens = append(tokens, token)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, customerrors.WrapInternalServerError(err, "error iterating refresh tokens")
+	}
+
+	return tokens, nil
+}
+
+// UpdateLastUsed はトークンの最終使用日時を更新する
+func (r *SQLiteRefreshTokenRepository) UpdateLastUsed(ctx context.Context, tokenID string, lastUsedAt time.Time) error {
+	// 文字列IDを整数に変換
+	id, err := strconv.Atoi(tokenID)
+	if err != nil {
+		return customerrors.NewValidationError("invalid token id format", map[string]string{
+			"tokenID": "must be a valid integer",
+		})
+	}
+
+	query := `
+		UPDATE refresh_tokens
+		SET last_used_at = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, timestamp(lastUsedAt), timestamp(time.Now()), id)
+	if err != nil {
+		return customerrors.WrapInternalServerError(err, "failed to update last used timestamp")
+	}
+
+	return requireTokenAffected(result)
+}
+
+// RevokeAllForUser はユーザーの全トークンを無効化する
+func (r *SQLiteRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID string) error {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked = 1, updated_at = ?
+		WHERE user_id = ? AND NOT revoked
+	`
+
+	_, err := r.db.ExecContext(ctx, query, timestamp(time.Now()), userID)
+	if err != nil {
+		return customerrors.WrapInternalServerError(err, "failed to revoke all tokens for user")
+	}
+
+	return nil
+}
+
+// DeleteExpired は期限切れトークンを削除する（クリーンアップ用）
+// CURRENT_TIMESTAMPは秒までの精度のため、保存時と同じ形式の現在日時と比較する
+func (r *SQLiteRefreshTokenRepository) DeleteExpired(ctx context.Context) error {
+	query := `
+		DELETE FROM refresh_tokens
+		WHERE expires_at < ?
+	`
+
+	_, err := r.db.ExecContext(ctx, query, timestamp(time.Now()))
+	if err != nil {
+		return customerrors.WrapInternalServerError(err, "failed to delete expired tokens")
+	}
+
+	return nil
+}
+
+// Count はユーザーのアクティブトークン数を返す
+func (r *SQLiteRefreshTokenRepository) Count(ctx context.Context, userID string) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM refresh_tokens
+		WHERE user_id = ? AND NOT revoked AND expires_at > ?
+	`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, userID, timestamp(time.Now())).Scan(&count)
+	if err != nil {
+		return 0, customerrors.WrapInternalServerError(err, "failed to count active tokens")
+	}
+
+	return count, nil
+}
+
+// requireTokenAffected は更新の対象となったトークンがなければNotFoundエラーを返す
+func requireTokenAffected(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return customerrors.WrapInternalServerError(err, "failed to get rows affected")
+	}
+
+	if rowsAffected == 0 {
+		return customerrors.NewNotFoundError("token not found")
+	}
+
+	return nil
+}
+
+// scanRefreshToken は1行分のリフレッシュトークンを読み取る
+func scanRefreshToken(row interface{ Scan(dest ...any) error }) (*entity.RefreshToken, error) {
+	token := &entity.RefreshToken{}
+	var id int
+	var lastUsedAt sql.NullTime
+	err := row.Scan(
+		&id,
+		&token.Token,
+		&token.UserID,
+		&token.ExpiresAt,
+		&token.IsRevoked,
+		&token.IssuedAt,
+		&lastUsedAt,
+		&token.ClientInfo,
+		&token.IP,
+	)
+	if err != nil {
+		return nil, err
+	}
+	token.SetIDFromInt(id)
+	if lastUsedAt.Valid {
+		token.LastUsedAt = lastUsedAt.Time
+	}
+	return token, nil
+}
@@ -0,0 +1,33 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/migration"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/sqlite"
+)
+
+// setupTestDB は一時ディレクトリに新しいデータベースを作成し、埋め込みのマイグレーションを適用する
+// データベースはテスト終了時に閉じられ、ファイルは一時ディレクトリごと削除される
+func setupTestDB(t *testing.T) (*sql.DB, *migration.Migrator) {
+	t.Helper()
+
+	db, err := sqlite.NewDB(sqlite.DBConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("failed to open sqlite database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := migration.NewEmbeddedMigrator(db, migration.DialectSQLite)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+
+	return db, migrator
+}
@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// SQLiteStatusHistoryRepository はステータス変更履歴のSQLite実装
+type SQLiteStatusHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewStatusHistoryRepository は新しいStatusHistoryRepositoryを作成します
+func NewStatusHistoryRepository(db *sql.DB) repository.StatusHistoryRepository {
+	return &SQLiteStatusHistoryRepository{
+		db: db,
+	}
+}
+
+// Create は変更履歴をデータベースに保存し、採番されたIDを設定します
+func (r *SQLiteStatusHistoryRepository) Create(ctx context.Context, history *entity.StatusHistory) error {
+	query := `
+        INSERT INTO status_history (
+            test_case_id, old_status, new_status, changed_at, changed_by, reason
+        ) VALUES (?, ?, ?, ?, ?, ?)
+        RETURNING id
+    `
+
+	var id int
+	err := executor(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		history.TestCaseID,
+		history.OldStatus,
+		history.NewStatus,
+		timestamp(history.ChangedAt),
+		history.ChangedBy,
+		history.Reason,
+	).Scan(&id)
+
+	if err != nil {
+		if customerrors.IsSQLiteForeignKeyViolation(err) {
+			return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+				"testCaseId": history.TestCaseID,
+			})
+		}
+		return errors.NewDatabaseError("create", "status_history", err).WithDetails(map[string]interface{}{
+			"testCaseId": history.TestCaseID,
+		})
+	}
+
+	history.SetIDFromInt(id)
+
+	return nil
+}
+
+// FindByTestCaseID は指定されたテストケースの変更履歴を古い順に取得します
+func (r *SQLiteStatusHistoryRepository) FindByTestCaseID(ctx context.Context, testCaseID string) ([]*entity.StatusHistory, error) {
+	query := `
+        SELECT
+            id, test_case_id, old_status, new_status,
+            changed_at, changed_by, COALESCE(reason, '')
+        FROM status_history
+        WHERE test_case_id = ?
+        ORDER BY changed_at ASC, id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, testCaseID)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "status_history", err).WithDetails(map[string]interface{}{
+			"testCaseId": testCaseID,
+		})
+	}
+	defer rows.Close()
+
+	var histories []*entity.StatusHistory
+	for rows.Next() {
+		history := &entity.StatusHistory{}
+		var id int
+		err := rows.Scan(
+			&id,
+			&history.TestCaseID,
+			&history.OldStatus,
+			&history.NewStatus,
+			&history.ChangedAt,
+			&history.ChangedBy,
+			&history.Reason,
+		)
+		if err != nil {
+			return nil, errors.NewSystemError("ステータス変更履歴データの読み取りに失敗しました", err)
+		}
+		history.SetIDFromInt(id)
+		histories = append(histories, history)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "status_history", err)
+	}
+
+	return histories, nil
+}
@@ -0,0 +1,729 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/common"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// testCaseColumns はテストケースのSELECTで取得するカラムです（scanTestCaseと順序を合わせる）
+const testCaseColumns = `
+            id, group_id, title, description, status,
+            priority, planned_effort, actual_effort, due_date, is_delayed,
+            delay_days, current_editor, is_locked, lock_expires_at, version, created_at, updated_at`
+
+// SQLiteTestCaseRepository はテストケースのSQLite実装
+type SQLiteTestCaseRepository struct {
+	db *sql.DB
+}
+
+// NewTestCaseRepository は新しいTestCaseRepositoryを作成します
+func NewTestCaseRepository(db *sql.DB) repository.TestCaseRepository {
+	return &SQLiteTestCaseRepository{
+		db: db,
+	}
+}
+
+// Create は新しいテストケースをデータベースに作成します
+func (r *SQLiteTestCaseRepository) Create(ctx context.Context, tc *entity.TestCase) error {
+	query := `
+        INSERT INTO test_cases (
+            id, group_id, title, description, status,
+            priority, planned_effort, actual_effort, due_date, is_delayed,
+            delay_days, current_editor, is_locked, lock_expires_at, version, created_at, updated_at
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	_, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		tc.ID,
+		tc.GroupID,
+		tc.Title,
+		tc.Description,
+		tc.Status,
+		tc.Priority,
+		tc.PlannedEffort,
+		tc.ActualEffort,
+		nullDate(tc.DueDate),
+		tc.IsDelayed,
+		tc.DelayDays,
+		sql.NullString{String: tc.CurrentEditor, Valid: tc.CurrentEditor != ""},
+		tc.IsLocked,
+		nullTimestamp(tc.LockExpiresAt),
+		tc.Version,
+		timestamp(tc.CreatedAt),
+		timestamp(tc.UpdatedAt),
+	)
+
+	if err != nil {
+		if customerrors.IsSQLiteUniqueViolation(err) {
+			return errors.NewAlreadyExistsError("TestCase", tc.ID)
+		}
+		if customerrors.IsSQLiteForeignKeyViolation(err) {
+			return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+				"id":      tc.ID,
+				"groupId": tc.GroupID,
+			})
+		}
+
+		// その他のデータベースエラー
+		return errors.NewDatabaseError("create", "test_cases", err).WithDetails(map[string]interface{}{
+			"id": tc.ID,
+		})
+	}
+
+	return nil
+}
+
+// FindByID は指定されたIDのテストケースを取得します
+func (r *SQLiteTestCaseRepository) FindByID(ctx context.Context, id string) (*entity.TestCase, error) {
+	query := `
+        SELECT ` + testCaseColumns + `
+        FROM test_cases
+        WHERE id = ? AND deleted_at IS NULL
+    `
+	tc, err := scanTestCase(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("TestCase", id)
+		}
+		return nil, errors.NewSystemError(
+			"テストケースの検索中にエラーが発生しました",
+			err,
+		).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	return tc, nil
+}
+
+// Update はテストケースの情報を更新します
+// 編集ロックの状態はAcquireLock・ReleaseLockでのみ、遅延状態はUpdateDelayでのみ更新します
+// 読み込み時のバージョンと一致する場合のみ更新し、バージョンを1つ進めます
+// 他の更新が先に行われていた場合は同時更新の競合エラーを返します
+func (r *SQLiteTestCaseRepository) Update(ctx context.Context, tc *entity.TestCase) error {
+	return r.update(ctx, tc, "", false)
+}
+
+// UpdateAsEditor はeditor以外のユーザーが有効な編集ロックを保持していない場合のみ、Updateと同様にテストケースを更新します
+// 読み込み後に他のユーザーがロックを取得していた場合は編集ロックの競合エラーを返します
+func (r *SQLiteTestCaseRepository) UpdateAsEditor(ctx context.Context, tc *entity.TestCase, editor string) error {
+	return r.update(ctx, tc, editor, true)
+}
+
+// update はテストケースを更新します
+// checkLockがtrueの場合は、バージョンに加えて編集ロックの保持者も更新の条件とします
+func (r *SQLiteTestCaseRepository) update(ctx context.Context, tc *entity.TestCase, editor string, checkLock bool) error {
+	query := `
+        UPDATE test_cases
+        SET
+            title = ?,
+            description = ?,
+            status = ?,
+            priority = ?,
+            planned_effort = ?,
+            actual_effort = ?,
+            due_date = ?,
+            updated_at = ?,
+            group_id = ?,
+            version = version + 1
+        WHERE id = ? AND version = ? AND deleted_at IS NULL
+    `
+	now := timestamp(time.Now())
+	args := []interface{}{
+		tc.Title,
+		tc.Description,
+		tc.Status,
+		tc.Priority,
+		tc.PlannedEffort,
+		tc.ActualEffort,
+		nullDate(tc.DueDate),
+		now,
+		tc.GroupID,
+		tc.ID,
+		tc.Version,
+	}
+	if checkLock {
+		query += `
+          AND (
+            is_locked = 0
+            OR current_editor IS NULL
+            OR current_editor = ?
+            OR lock_expires_at IS NULL
+            OR lock_expires_at <= ?
+          )`
+		args = append(args, editor, now)
+	}
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, args...)
+
+	if err != nil {
+		if customerrors.IsSQLiteForeignKeyViolation(err) {
+			return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+				"id":      tc.ID,
+				"groupId": tc.GroupID,
+			})
+		}
+		return errors.NewDatabaseError("update", "test_cases", err).WithDetails(map[string]interface{}{
+			"id": tc.ID,
+		})
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.NewSystemError("更新結果の取得に失敗しました", err).WithDetails(map[string]interface{}{
+			"id": tc.ID,
+		})
+	}
+
+	if rowsAffected == 0 {
+		if checkLock {
+			return r.editLockConflictError(ctx, tc)
+		}
+		return versionConflictError(ctx, executor(ctx, r.db), "test_cases", "TestCase", tc.ID, tc.Version, errors.NewNotFoundError("TestCase", tc.ID))
+	}
+
+	tc.Version++
+	return nil
+}
+
+// editLockConflictError は編集ロック・バージョン条件付きの更新で行が更新されなかった原因を判定します
+// バージョンが変わっていた場合は同時更新の競合エラーを、バージョンが一致する場合は他のユーザーが
+// ロックを保持しているため編集ロックの競合エラーを、存在しないか論理削除されている場合は未検出エラーを返します
+func (r *SQLiteTestCaseRepository) editLockConflictError(ctx context.Context, tc *entity.TestCase) error {
+	current, err := r.FindByID(ctx, tc.ID)
+	if err != nil {
+		return err
+	}
+	if current.Version != tc.Version {
+		return errors.NewConcurrentModificationError("TestCase", tc.ID, int64(current.Version), int64(tc.Version))
+	}
+	return errors.NewEditLockConflictError("TestCase", tc.ID, current.CurrentEditor, current.LockExpiresAt)
+}
+
+// Delete は指定されたIDのテストケースを削除します
+func (r *SQLiteTestCaseRepository) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM test_cases WHERE id = ?"
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		if customerrors.IsSQLiteForeignKeyViolation(err) {
+			return errors.NewDomainConflictError(
+				"TestCase",
+				id,
+				"関連する工数記録が存在するため削除できません",
+			)
+		}
+
+		return errors.NewDatabaseError("delete", "test_cases", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.NewSystemError("削除結果の取得に失敗しました", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+
+	return nil
+}
+
+// FindByGroupID は指定されたグループIDに属するテストケース一覧を取得します
+func (r *SQLiteTestCaseRepository) FindByGroupID(ctx context.Context, groupID string) ([]*entity.TestCase, error) {
+	query := `
+        SELECT ` + testCaseColumns + `
+        FROM test_cases
+        WHERE group_id = ? AND deleted_at IS NULL
+        ORDER BY id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, groupID)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"groupId": groupID,
+		})
+	}
+	defer rows.Close()
+
+	return scanTestCases(rows, scanTestCase)
+}
+
+// FindByGroupIDs は複数のグループに属するテストケースを1回のクエリで取得します
+func (r *SQLiteTestCaseRepository) FindByGroupIDs(ctx context.Context, groupIDs []string) ([]*entity.TestCase, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders, args := inList(groupIDs)
+	query := `
+        SELECT ` + testCaseColumns + `
+        FROM test_cases
+        WHERE group_id IN ` + placeholders + ` AND deleted_at IS NULL
+        ORDER BY group_id ASC, id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"groupIds": groupIDs,
+		})
+	}
+	defer rows.Close()
+
+	return scanTestCases(rows, scanTestCase)
+}
+
+// UpdateStatus は指定されたテストケースのステータスを更新します
+// 現在のステータスがfrom・バージョンがexpectedVersionの場合のみ更新し、同時に行われた遷移が遷移ルールを迂回しないようにします
+func (r *SQLiteTestCaseRepository) UpdateStatus(ctx context.Context, id string, from, to entity.TestStatus, expectedVersion int) error {
+	query := `
+        UPDATE test_cases
+        SET
+            status = ?,
+            updated_at = ?,
+            version = version + 1
+        WHERE id = ? AND status = ? AND version = ? AND deleted_at IS NULL
+    `
+
+	details := map[string]interface{}{"id": id, "status": string(to)}
+	rowsAffected, err := r.execUpdate(ctx, "update_status", query, details, to, timestamp(time.Now()), id, from, expectedVersion)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return statusConflictError(ctx, executor(ctx, r.db), id, from, to, expectedVersion)
+	}
+
+	return nil
+}
+
+// statusConflictError はステータス・バージョン条件付きの更新で行が更新されなかった原因を判定します
+// ステータスが変わっていた場合は遷移の競合エラーを、ステータス以外が更新されていた場合は同時更新の競合エラーを、
+// 存在しないか論理削除されている場合は未検出エラーを返します
+func statusConflictError(ctx context.Context, db common.SQLExecutor, id string, from, to entity.TestStatus, expectedVersion int) error {
+	var current string
+	var currentVersion int64
+	err := db.QueryRowContext(ctx, "SELECT status, version FROM test_cases WHERE id = ? AND deleted_at IS NULL", id).Scan(&current, &currentVersion)
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	if err != nil {
+		return errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	if current != string(from) {
+		return errors.NewStatusTransitionConflictError("他の更新によりステータスが変更されています", current, string(to))
+	}
+	return errors.NewConcurrentModificationError("TestCase", id, currentVersion, int64(expectedVersion))
+}
+
+// AddEffort は指定されたテストケースに工数を追加します
+func (r *SQLiteTestCaseRepository) AddEffort(ctx context.Context, id string, effort float64) error {
+	query := `
+        UPDATE test_cases
+        SET
+            actual_effort = actual_effort + ?,
+            updated_at = ?,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NULL
+    `
+
+	details := map[string]interface{}{"id": id, "effort": effort}
+	rowsAffected, err := r.execUpdate(ctx, "add_effort", query, details, effort, timestamp(time.Now()), id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+
+	return nil
+}
+
+// UpdateDelay は指定されたテストケースの遅延状態を更新します
+// 遅延状態はシステムが算出する値のため、編集中のユーザーの更新が競合しないようバージョンは進めません
+// 遅延状態を判定したときのステータスのままの場合のみ更新し、完了したケースを遅延にしないようにします
+func (r *SQLiteTestCaseRepository) UpdateDelay(ctx context.Context, id string, status entity.TestStatus, isDelayed bool, delayDays int) error {
+	query := `
+        UPDATE test_cases
+        SET
+            is_delayed = ?,
+            delay_days = ?,
+            updated_at = ?
+        WHERE id = ? AND status = ? AND deleted_at IS NULL
+    `
+
+	details := map[string]interface{}{"id": id, "delayDays": delayDays}
+	rowsAffected, err := r.execUpdate(ctx, "update_delay", query, details, isDelayed, delayDays, timestamp(time.Now()), id, status)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return delayConflictError(ctx, executor(ctx, r.db), id, status)
+	}
+
+	return nil
+}
+
+// delayConflictError はステータス条件付きの遅延状態の更新で行が更新されなかった原因を判定します
+// ステータスが変わっていた場合は遷移の競合エラーを、存在しないか論理削除されている場合は未検出エラーを返します
+func delayConflictError(ctx context.Context, db common.SQLExecutor, id string, status entity.TestStatus) error {
+	var current string
+	err := db.QueryRowContext(ctx, "SELECT status FROM test_cases WHERE id = ? AND deleted_at IS NULL", id).Scan(&current)
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	if err != nil {
+		return errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+	return errors.NewStatusTransitionConflictError("遅延状態の判定後にステータスが変更されています", current, string(status))
+}
+
+// FindByStatus は指定されたステータスのテストケース一覧を取得します
+func (r *SQLiteTestCaseRepository) FindByStatus(ctx context.Context, status entity.TestStatus) ([]*entity.TestCase, error) {
+	query := `
+        SELECT ` + testCaseColumns + `
+        FROM test_cases
+        WHERE status = ? AND deleted_at IS NULL
+        ORDER BY updated_at DESC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, status)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"status": string(status),
+		})
+	}
+	defer rows.Close()
+
+	return scanTestCases(rows, scanTestCase)
+}
+
+// AcquireLock は指定されたテストケースの編集ロックをexpiresAtまで取得または延長します
+// ロックが存在しない、期限切れ、または同じユーザーが保持している場合のみ更新するため、
+// 同時に取得を試みても1人だけが成功します
+func (r *SQLiteTestCaseRepository) AcquireLock(ctx context.Context, id, editor string, expiresAt time.Time) (bool, error) {
+	query := `
+        UPDATE test_cases
+        SET
+            is_locked = 1,
+            current_editor = ?1,
+            lock_expires_at = ?2
+        WHERE id = ?3
+          AND deleted_at IS NULL
+          AND (
+            is_locked = 0
+            OR current_editor IS NULL
+            OR current_editor = ?1
+            OR lock_expires_at IS NULL
+            OR lock_expires_at <= ?4
+          )
+    `
+
+	details := map[string]interface{}{"id": id, "editor": editor}
+	rowsAffected, err := r.execUpdate(ctx, "acquire_lock", query, details, editor, timestamp(expiresAt), id, timestamp(time.Now()))
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected == 0 {
+		// 存在しないのか、他のユーザーがロック中なのかを区別する
+		if err := r.ensureExists(ctx, id); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// ReleaseLock は指定されたテストケースの編集ロックを解除します
+// editorが指定された場合は、そのユーザーが保持しているロックのみ解除します
+func (r *SQLiteTestCaseRepository) ReleaseLock(ctx context.Context, id, editor string) error {
+	query := `
+        UPDATE test_cases
+        SET
+            is_locked = 0,
+            current_editor = NULL,
+            lock_expires_at = NULL
+        WHERE id = ?1
+          AND deleted_at IS NULL
+          AND (?2 = '' OR current_editor = ?2)
+    `
+
+	details := map[string]interface{}{"id": id, "editor": editor}
+	rowsAffected, err := r.execUpdate(ctx, "release_lock", query, details, id, editor)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return r.ensureExists(ctx, id)
+	}
+
+	return nil
+}
+
+// ensureExists は指定されたテストケースが存在しないか論理削除されている場合にNotFoundエラーを返します
+func (r *SQLiteTestCaseRepository) ensureExists(ctx context.Context, id string) error {
+	var exists bool
+	err := executor(ctx, r.db).QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM test_cases WHERE id = ? AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil {
+		return errors.NewDatabaseError("query", "test_cases", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+	if !exists {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+	return nil
+}
+
+// SoftDelete は指定されたテストケースを論理削除します
+func (r *SQLiteTestCaseRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_cases
+        SET
+            deleted_at = ?,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NULL
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "soft_delete", query, map[string]interface{}{"id": id}, timestamp(deletedAt), id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+
+	return nil
+}
+
+// SoftDeleteByGroupID は指定されたグループに属する削除されていないテストケースを論理削除します
+func (r *SQLiteTestCaseRepository) SoftDeleteByGroupID(ctx context.Context, groupID string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_cases
+        SET
+            deleted_at = ?,
+            version = version + 1
+        WHERE group_id = ? AND deleted_at IS NULL
+    `
+
+	_, err := r.execUpdate(ctx, "soft_delete", query, map[string]interface{}{"groupId": groupID}, timestamp(deletedAt), groupID)
+	return err
+}
+
+// Restore は論理削除されたテストケースを復元します
+func (r *SQLiteTestCaseRepository) Restore(ctx context.Context, id string) error {
+	query := `
+        UPDATE test_cases
+        SET
+            deleted_at = NULL,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NOT NULL
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "restore", query, map[string]interface{}{"id": id}, id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestCase", id)
+	}
+
+	return nil
+}
+
+// RestoreByGroupID は指定されたグループと同じ日時に削除されたテストケースを復元します
+func (r *SQLiteTestCaseRepository) RestoreByGroupID(ctx context.Context, groupID string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_cases
+        SET
+            deleted_at = NULL,
+            version = version + 1
+        WHERE group_id = ? AND deleted_at = ?
+    `
+
+	_, err := r.execUpdate(ctx, "restore", query, map[string]interface{}{"groupId": groupID}, groupID, timestamp(deletedAt))
+	return err
+}
+
+// FindDeletedByID は論理削除された指定IDのテストケースを取得します
+func (r *SQLiteTestCaseRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestCase, error) {
+	query := `
+        SELECT ` + testCaseColumns + `, deleted_at
+        FROM test_cases
+        WHERE id = ? AND deleted_at IS NOT NULL
+    `
+	tc, err := scanDeletedTestCase(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("TestCase", id)
+		}
+		return nil, errors.NewSystemError(
+			"テストケースの検索中にエラーが発生しました",
+			err,
+		).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	return tc, nil
+}
+
+// FindDeleted は所属するグループが削除されていない、論理削除されたテストケースを取得します
+func (r *SQLiteTestCaseRepository) FindDeleted(ctx context.Context) ([]*entity.TestCase, error) {
+	query := `
+        SELECT ` + testCaseColumns + `, deleted_at
+        FROM test_cases
+        WHERE deleted_at IS NOT NULL
+          AND EXISTS (
+            SELECT 1 FROM test_groups
+            WHERE test_groups.id = test_cases.group_id AND test_groups.deleted_at IS NULL
+          )
+        ORDER BY deleted_at DESC, id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_cases", err)
+	}
+	defer rows.Close()
+
+	return scanTestCases(rows, scanDeletedTestCase)
+}
+
+// PurgeDeletedBefore はbeforeより前に論理削除されたテストケースを物理削除します
+// SQLiteはDELETEを含むWITH句に対応していないため、参照している工数記録と変更履歴を先に削除します
+// 途中で失敗しても記録だけが消えないよう、3つの文を1つのトランザクションで実行します
+func (r *SQLiteTestCaseRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	purged := "SELECT id FROM test_cases WHERE deleted_at IS NOT NULL AND deleted_at < ?"
+	cutoff := timestamp(before)
+
+	var rowsAffected int64
+	err := NewTransactionManager(r.db).RunInTransaction(ctx, func(ctx context.Context) error {
+		if _, err := r.execUpdate(ctx, "purge", "DELETE FROM effort_records WHERE test_case_id IN ("+purged+")", nil, cutoff); err != nil {
+			return err
+		}
+		if _, err := r.execUpdate(ctx, "purge", "DELETE FROM status_history WHERE test_case_id IN ("+purged+")", nil, cutoff); err != nil {
+			return err
+		}
+		var err error
+		rowsAffected, err = r.execUpdate(ctx, "purge", "DELETE FROM test_cases WHERE id IN ("+purged+")", nil, cutoff)
+		return err
+	})
+
+	return int(rowsAffected), err
+}
+
+// execUpdate は更新系のクエリを実行し、影響を受けた行数を返します
+func (r *SQLiteTestCaseRepository) execUpdate(ctx context.Context, operation, query string, details map[string]interface{}, args ...interface{}) (int64, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.NewDatabaseError(operation, "test_cases", err).WithDetails(details)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.NewSystemError("更新結果の取得に失敗しました", err).WithDetails(details)
+	}
+
+	return rowsAffected, nil
+}
+
+// scanTestCases は複数行のテストケースをscanで読み取ります
+func scanTestCases(rows *sql.Rows, scan func(row interface{ Scan(dest ...any) error }) (*entity.TestCase, error)) ([]*entity.TestCase, error) {
+	var cases []*entity.TestCase
+	for rows.Next() {
+		tc, err := scan(rows)
+		if err != nil {
+			return nil, errors.NewSystemError("テストケースデータの読み取りに失敗しました", err)
+		}
+		cases = append(cases, tc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "test_cases", err)
+	}
+
+	return cases, nil
+}
+
+// scanDeletedTestCase はtestCaseColumnsに続けて削除日時を選択した1行分のテストケースを読み取ります
+func scanDeletedTestCase(row interface{ Scan(dest ...any) error }) (*entity.TestCase, error) {
+	var deletedAt time.Time
+	tc, err := scanTestCase(appendScanDest{row: row, extra: []any{&deletedAt}})
+	if err != nil {
+		return nil, err
+	}
+	tc.DeletedAt = deletedAt
+	return tc, nil
+}
+
+// appendScanDest はScanの読み取り先の末尾に追加の読み取り先を加えます
+type appendScanDest struct {
+	row   interface{ Scan(dest ...any) error }
+	extra []any
+}
+
+func (a appendScanDest) Scan(dest ...any) error {
+	return a.row.Scan(append(dest, a.extra...)...)
+}
+
+// scanTestCase は1行分のテストケースを読み取ります
+func scanTestCase(row interface{ Scan(dest ...any) error }) (*entity.TestCase, error) {
+	tc := &entity.TestCase{}
+	var dueDate sql.NullTime
+	var currentEditor sql.NullString
+	var lockExpiresAt sql.NullTime
+	err := row.Scan(
+		&tc.ID,
+		&tc.GroupID,
+		&tc.Title,
+		&tc.Description,
+		&tc.Status,
+		&tc.Priority,
+		&tc.PlannedEffort,
+		&tc.ActualEffort,
+		&dueDate,
+		&tc.IsDelayed,
+		&tc.DelayDays,
+		&currentEditor,
+		&tc.IsLocked,
+		&lockExpiresAt,
+		&tc.Version,
+		&tc.CreatedAt,
+		&tc.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if dueDate.Valid {
+		tc.DueDate = dueDate.Time
+	}
+	tc.CurrentEditor = currentEditor.String
+	if lockExpiresAt.Valid {
+		tc.LockExpiresAt = lockExpiresAt.Time
+	}
+	return tc, nil
+}
@@ -0,0 +1,494 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// testGroupColumns はテストグループのSELECTで取得するカラムです（scanTestGroupと順序を合わせる）
+const testGroupColumns = `
+            id, suite_id, name, description, display_order,
+            status, status_locked, version, created_at, updated_at`
+
+// SQLiteTestGroupRepository はテストグループのSQLite実装
+type SQLiteTestGroupRepository struct {
+	db *sql.DB
+}
+
+// NewTestGroupRepository は新しいTestGroupRepositoryを作成します
+func NewTestGroupRepository(db *sql.DB) repository.TestGroupRepository {
+	return &SQLiteTestGroupRepository{
+		db: db,
+	}
+}
+
+// Create は新しいテストグループをデータベースに作成します
+func (r *SQLiteTestGroupRepository) Create(ctx context.Context, group *entity.TestGroup) error {
+	query := `
+        INSERT INTO test_groups (
+            id, suite_id, name, description, display_order,
+            status, status_locked, version, created_at, updated_at
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+
+	_, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		group.ID,
+		group.SuiteID,
+		group.Name,
+		group.Description,
+		group.DisplayOrder,
+		group.Status,
+		group.StatusLocked,
+		group.Version,
+		timestamp(group.CreatedAt),
+		timestamp(group.UpdatedAt),
+	)
+
+	if err != nil {
+		if customerrors.IsSQLiteUniqueViolation(err) {
+			return errors.NewAlreadyExistsError("TestGroup", group.ID)
+		}
+		if customerrors.IsSQLiteForeignKeyViolation(err) {
+			return errors.NewDomainValidationError("関連するリソースが存在しません", map[string]string{
+				"id":      group.ID,
+				"suiteId": group.SuiteID,
+			})
+		}
+
+		// その他のデータベースエラー
+		return errors.NewDatabaseError("create", "test_groups", err).WithDetails(map[string]interface{}{
+			"id": group.ID,
+		})
+	}
+
+	return nil
+}
+
+// FindByID は指定されたIDのテストグループを取得します
+func (r *SQLiteTestGroupRepository) FindByID(ctx context.Context, id string) (*entity.TestGroup, error) {
+	query := `
+        SELECT ` + testGroupColumns + `
+        FROM test_groups
+        WHERE id = ? AND deleted_at IS NULL
+    `
+	group, err := scanTestGroup(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("TestGroup", id)
+		}
+		return nil, errors.NewSystemError(
+			"テストグループの検索中にエラーが発生しました",
+			err,
+		).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	return group, nil
+}
+
+// FindByIDForUpdate は指定されたIDのテストグループを取得します
+// SQLiteのトランザクションはBEGIN IMMEDIATEで書き込みごとに直列化されるため、行ロックは取得せずFindByIDと同じ結果を返します
+func (r *SQLiteTestGroupRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.TestGroup, error) {
+	return r.FindByID(ctx, id)
+}
+
+// Update はテストグループの情報を更新します
+// 読み込み時のバージョンと一致する場合のみ更新し、バージョンを1つ進めます
+// 他の更新が先に行われていた場合は同時更新の競合エラーを返します
+func (r *SQLiteTestGroupRepository) Update(ctx context.Context, group *entity.TestGroup) error {
+	query := `
+        UPDATE test_groups
+        SET
+            name = ?,
+            description = ?,
+            display_order = ?,
+            status = ?,
+            status_locked = ?,
+            updated_at = ?,
+            version = version + 1
+        WHERE id = ? AND version = ? AND deleted_at IS NULL
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		group.Name,
+		group.Description,
+		group.DisplayOrder,
+		group.Status,
+		group.StatusLocked,
+		timestamp(time.Now()),
+		group.ID,
+		group.Version,
+	)
+
+	if err != nil {
+		return errors.NewDatabaseError("update", "test_groups", err).WithDetails(map[string]interface{}{
+			"id": group.ID,
+		})
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.NewSystemError("更新結果の取得に失敗しました", err).WithDetails(map[string]interface{}{
+			"id": group.ID,
+		})
+	}
+
+	if rowsAffected == 0 {
+		return versionConflictError(ctx, executor(ctx, r.db), "test_groups", "TestGroup", group.ID, group.Version, errors.NewNotFoundError("TestGroup", group.ID))
+	}
+
+	group.Version++
+	return nil
+}
+
+// Delete は指定されたIDのテストグループを削除します
+func (r *SQLiteTestGroupRepository) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM test_groups WHERE id = ?"
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		if customerrors.IsSQLiteForeignKeyViolation(err) {
+			return errors.NewDomainConflictError(
+				"TestGroup",
+				id,
+				"関連するテストケースが存在するため削除できません",
+			)
+		}
+
+		return errors.NewDatabaseError("delete", "test_groups", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.NewSystemError("削除結果の取得に失敗しました", err).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+
+	return nil
+}
+
+// FindBySuiteID は指定されたスイートIDに属するテストグループ一覧を取得します
+func (r *SQLiteTestGroupRepository) FindBySuiteID(ctx context.Context, suiteID string) ([]*entity.TestGroup, error) {
+	query := `
+        SELECT ` + testGroupColumns + `
+        FROM test_groups
+        WHERE suite_id = ? AND deleted_at IS NULL
+        ORDER BY display_order ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, suiteID)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_groups", err).WithDetails(map[string]interface{}{
+			"suiteId": suiteID,
+		})
+	}
+	defer rows.Close()
+
+	var groups []*entity.TestGroup
+	for rows.Next() {
+		group, err := scanTestGroup(rows)
+		if err != nil {
+			return nil, errors.NewSystemError("テストグループデータの読み取りに失敗しました", err)
+		}
+		groups = append(groups, group)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "test_groups", err)
+	}
+
+	return groups, nil
+}
+
+// FindBySuiteIDs は複数のスイートに属するテストグループを1回のクエリで取得します
+func (r *SQLiteTestGroupRepository) FindBySuiteIDs(ctx context.Context, suiteIDs []string) ([]*entity.TestGroup, error) {
+	if len(suiteIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders, args := inList(suiteIDs)
+	query := `
+        SELECT ` + testGroupColumns + `
+        FROM test_groups
+        WHERE suite_id IN ` + placeholders + ` AND deleted_at IS NULL
+        ORDER BY suite_id ASC, display_order ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_groups", err).WithDetails(map[string]interface{}{
+			"suiteIds": suiteIDs,
+		})
+	}
+	defer rows.Close()
+
+	var groups []*entity.TestGroup
+	for rows.Next() {
+		group, err := scanTestGroup(rows)
+		if err != nil {
+			return nil, errors.NewSystemError("テストグループデータの読み取りに失敗しました", err)
+		}
+		groups = append(groups, group)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "test_groups", err)
+	}
+
+	return groups, nil
+}
+
+// UpdateStatus は指定されたテストグループのステータスを更新します
+func (r *SQLiteTestGroupRepository) UpdateStatus(ctx context.Context, id string, status valueobject.SuiteStatus) error {
+	query := `
+        UPDATE test_groups
+        SET
+            status = ?,
+            updated_at = ?,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NULL
+    `
+
+	details := map[string]interface{}{"id": id, "status": status.String()}
+	rowsAffected, err := r.execUpdate(ctx, "update_status", query, details, status, timestamp(time.Now()), id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+
+	return nil
+}
+
+// UpdateDisplayOrder は指定されたテストグループの表示順序を更新します
+func (r *SQLiteTestGroupRepository) UpdateDisplayOrder(ctx context.Context, id string, displayOrder int) error {
+	query := `
+        UPDATE test_groups
+        SET
+            display_order = ?,
+            updated_at = ?,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NULL
+    `
+
+	details := map[string]interface{}{"id": id, "displayOrder": displayOrder}
+	rowsAffected, err := r.execUpdate(ctx, "update_display_order", query, details, displayOrder, timestamp(time.Now()), id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+
+	return nil
+}
+
+// SoftDelete は指定されたテストグループを論理削除します
+func (r *SQLiteTestGroupRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_groups
+        SET
+            deleted_at = ?,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NULL
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "soft_delete", query, map[string]interface{}{"id": id}, timestamp(deletedAt), id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+
+	return nil
+}
+
+// SoftDeleteBySuiteID は指定されたスイートに属する削除されていないテストグループを論理削除します
+func (r *SQLiteTestGroupRepository) SoftDeleteBySuiteID(ctx context.Context, suiteID string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_groups
+        SET
+            deleted_at = ?,
+            version = version + 1
+        WHERE suite_id = ? AND deleted_at IS NULL
+    `
+
+	_, err := r.execUpdate(ctx, "soft_delete", query, map[string]interface{}{"suiteId": suiteID}, timestamp(deletedAt), suiteID)
+	return err
+}
+
+// Restore は論理削除されたテストグループを復元します
+func (r *SQLiteTestGroupRepository) Restore(ctx context.Context, id string) error {
+	query := `
+        UPDATE test_groups
+        SET
+            deleted_at = NULL,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NOT NULL
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "restore", query, map[string]interface{}{"id": id}, id)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.NewNotFoundError("TestGroup", id)
+	}
+
+	return nil
+}
+
+// RestoreBySuiteID は指定されたスイートと同じ日時に削除されたテストグループを復元します
+// 削除日時はtimestampで同じ文字列に変換されるため、文字列の一致で判定できます
+func (r *SQLiteTestGroupRepository) RestoreBySuiteID(ctx context.Context, suiteID string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_groups
+        SET
+            deleted_at = NULL,
+            version = version + 1
+        WHERE suite_id = ? AND deleted_at = ?
+    `
+
+	_, err := r.execUpdate(ctx, "restore", query, map[string]interface{}{"suiteId": suiteID}, suiteID, timestamp(deletedAt))
+	return err
+}
+
+// FindDeletedByID は論理削除された指定IDのテストグループを取得します
+func (r *SQLiteTestGroupRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestGroup, error) {
+	query := `
+        SELECT ` + testGroupColumns + `, deleted_at
+        FROM test_groups
+        WHERE id = ? AND deleted_at IS NOT NULL
+    `
+	group, err := scanDeletedTestGroup(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("TestGroup", id)
+		}
+		return nil, errors.NewSystemError(
+			"テストグループの検索中にエラーが発生しました",
+			err,
+		).WithDetails(map[string]interface{}{
+			"id": id,
+		})
+	}
+
+	return group, nil
+}
+
+// FindDeleted は所属するスイートが削除されていない、論理削除されたテストグループを取得します
+func (r *SQLiteTestGroupRepository) FindDeleted(ctx context.Context) ([]*entity.TestGroup, error) {
+	query := `
+        SELECT ` + testGroupColumns + `, deleted_at
+        FROM test_groups
+        WHERE deleted_at IS NOT NULL
+          AND EXISTS (
+            SELECT 1 FROM test_suites
+            WHERE test_suites.id = test_groups.suite_id AND test_suites.deleted_at IS NULL
+          )
+        ORDER BY deleted_at DESC, id ASC
+    `
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.NewDatabaseError("query", "test_groups", err)
+	}
+	defer rows.Close()
+
+	var groups []*entity.TestGroup
+	for rows.Next() {
+		group, err := scanDeletedTestGroup(rows)
+		if err != nil {
+			return nil, errors.NewSystemError("テストグループデータの読み取りに失敗しました", err)
+		}
+		groups = append(groups, group)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.NewDatabaseError("iterate", "test_groups", err)
+	}
+
+	return groups, nil
+}
+
+// PurgeDeletedBefore はbeforeより前に論理削除され、配下のケースが残っていないテストグループを物理削除します
+func (r *SQLiteTestGroupRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	query := `
+        DELETE FROM test_groups
+        WHERE deleted_at IS NOT NULL
+          AND deleted_at < ?
+          AND NOT EXISTS (SELECT 1 FROM test_cases WHERE test_cases.group_id = test_groups.id)
+    `
+
+	rowsAffected, err := r.execUpdate(ctx, "purge", query, nil, timestamp(before))
+	return int(rowsAffected), err
+}
+
+// execUpdate は更新系のクエリを実行し、影響を受けた行数を返します
+func (r *SQLiteTestGroupRepository) execUpdate(ctx context.Context, operation, query string, details map[string]interface{}, args ...interface{}) (int64, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.NewDatabaseError(operation, "test_groups", err).WithDetails(details)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.NewSystemError("更新結果の取得に失敗しました", err).WithDetails(details)
+	}
+
+	return rowsAffected, nil
+}
+
+// scanDeletedTestGroup はtestGroupColumnsに続けて削除日時を選択した1行分のテストグループを読み取ります
+func scanDeletedTestGroup(row interface{ Scan(dest ...any) error }) (*entity.TestGroup, error) {
+	var deletedAt time.Time
+	group, err := scanTestGroup(appendScanDest{row: row, extra: []any{&deletedAt}})
+	if err != nil {
+		return nil, err
+	}
+	group.DeletedAt = deletedAt
+	return group, nil
+}
+
+// scanTestGroup は1行分のテストグループを読み取ります
+func scanTestGroup(row interface{ Scan(dest ...any) error }) (*entity.TestGroup, error) {
+	group := &entity.TestGroup{}
+	err := row.Scan(
+		&group.ID,
+		&group.SuiteID,
+		&group.Name,
+		&group.Description,
+		&group.DisplayOrder,
+		&group.Status,
+		&group.StatusLocked,
+		&group.Version,
+		&group.CreatedAt,
+		&group.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
@@ -0,0 +1,619 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// testSuiteColumns はテストスイートのSELECTで取得するカラムです（scanTestSuiteと順序を合わせる）
+const testSuiteColumns = `
+            id, name, description, status,
+            estimated_start_date, estimated_end_date,
+            require_effort_comment, exit_min_completion_rate, exit_allow_open_critical,
+            exit_override_reason, exit_override_by, exit_override_at,
+            version, created_by, created_at, updated_at`
+
+// SQLiteTestSuiteRepository はテストスイートのSQLite実装
+type SQLiteTestSuiteRepository struct {
+	db *sql.DB
+}
+
+// NewTestSuiteRepository は新しいTestSuiteRepositoryを作成します
+func NewTestSuiteRepository(db *sql.DB) repository.TestSuiteRepository {
+	return &SQLiteTestSuiteRepository{
+		db: db,
+	}
+}
+
+// Create は新しいテストスイートをデータベースに作成します
+func (r *SQLiteTestSuiteRepository) Create(ctx context.Context, suite *entity.TestSuite) error {
+	query := `
+        INSERT INTO test_suites (
+            id, name, description, status,
+            estimated_start_date, estimated_end_date,
+            require_effort_comment, exit_min_completion_rate, exit_allow_open_critical,
+            exit_override_reason, exit_override_by, exit_override_at,
+            version, created_by, created_at, updated_at
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `
+	overrideReason, overrideBy, overrideAt := exitOverrideValues(suite.ExitOverride)
+
+	_, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		suite.ID,
+		suite.Name,
+		suite.Description,
+		suite.Status,
+		date(suite.EstimatedStartDate),
+		date(suite.EstimatedEndDate),
+		suite.RequireEffortComment,
+		suite.ExitCriteria.MinCompletionRate,
+		suite.ExitCriteria.AllowOpenCritical,
+		overrideReason,
+		overrideBy,
+		overrideAt,
+		suite.Version,
+		sql.NullString{String: suite.CreatedBy, Valid: suite.CreatedBy != ""},
+		timestamp(suite.CreatedAt),
+		timestamp(suite.UpdatedAt),
+	)
+
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "create", "TestSuite", suite.ID)
+	}
+
+	return nil
+}
+
+// FindByID は指定されたIDのテストスイートを取得します
+func (r *SQLiteTestSuiteRepository) FindByID(ctx context.Context, id string) (*entity.TestSuite, error) {
+	query := `
+        SELECT ` + testSuiteColumns + `
+        FROM test_suites
+        WHERE id = ? AND deleted_at IS NULL
+    `
+	suite, err := scanTestSuite(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+
+	if err != nil {
+		return nil, customerrors.ConvertSQLiteError(err, "find", "TestSuite", id)
+	}
+
+	return suite, nil
+}
+
+// Update はテストスイートの情報を更新します
+// 読み込み時のバージョンと一致する場合のみ更新し、バージョンを1つ進めます
+// 他の更新が先に行われていた場合は同時更新の競合エラーを返します
+func (r *SQLiteTestSuiteRepository) Update(ctx context.Context, suite *entity.TestSuite) error {
+	query := `
+        UPDATE test_suites
+        SET
+            name = ?,
+            description = ?,
+            status = ?,
+            estimated_start_date = ?,
+            estimated_end_date = ?,
+            require_effort_comment = ?,
+            exit_min_completion_rate = ?,
+            exit_allow_open_critical = ?,
+            exit_override_reason = ?,
+            exit_override_by = ?,
+            exit_override_at = ?,
+            updated_at = ?,
+            version = version + 1
+        WHERE id = ? AND version = ? AND deleted_at IS NULL
+    `
+	overrideReason, overrideBy, overrideAt := exitOverrideValues(suite.ExitOverride)
+
+	result, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		suite.Name,
+		suite.Description,
+		suite.Status,
+		date(suite.EstimatedStartDate),
+		date(suite.EstimatedEndDate),
+		suite.RequireEffortComment,
+		suite.ExitCriteria.MinCompletionRate,
+		suite.ExitCriteria.AllowOpenCritical,
+		overrideReason,
+		overrideBy,
+		overrideAt,
+		timestamp(time.Now()),
+		suite.ID,
+		suite.Version,
+	)
+
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "update", "TestSuite", suite.ID)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return customerrors.NewInternalServerError(
+			"更新結果の取得に失敗しました",
+		).WithContext(customerrors.Context{
+			"id":    suite.ID,
+			"error": err.Error(),
+		})
+	}
+
+	if rowsAffected == 0 {
+		return versionConflictError(ctx, executor(ctx, r.db), "test_suites", "TestSuite", suite.ID, suite.Version, customerrors.NotFound("TestSuite", suite.ID))
+	}
+
+	suite.Version++
+	return nil
+}
+
+// Delete は指定されたIDのテストスイートを削除します
+func (r *SQLiteTestSuiteRepository) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM test_suites WHERE id = ?"
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "delete", "TestSuite", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return customerrors.NewInternalServerError(
+			"削除結果の取得に失敗しました",
+		).WithContext(customerrors.Context{
+			"id":    id,
+			"error": err.Error(),
+		})
+	}
+
+	if rowsAffected == 0 {
+		return customerrors.NotFound("TestSuite", id)
+	}
+
+	return nil
+}
+
+// UpdateStatus はテストスイートの状態を更新します
+func (r *SQLiteTestSuiteRepository) UpdateStatus(ctx context.Context, id string, status valueobject.SuiteStatus) error {
+	query := `
+        UPDATE test_suites
+        SET
+            status = ?,
+            updated_at = ?,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NULL
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		status,
+		timestamp(time.Now()),
+		id,
+	)
+
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "update_status", "TestSuite", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return customerrors.NewInternalServerError(
+			"ステータス更新結果の取得に失敗しました",
+		).WithContext(customerrors.Context{
+			"id":     id,
+			"status": status.String(),
+			"error":  err.Error(),
+		})
+	}
+
+	if rowsAffected == 0 {
+		return customerrors.NotFound("TestSuite", id)
+	}
+
+	return nil
+}
+
+// FindByStatus は指定された状態のテストスイート一覧を取得します
+func (r *SQLiteTestSuiteRepository) FindByStatus(ctx context.Context, status valueobject.SuiteStatus) ([]*entity.TestSuite, error) {
+	query := `
+        SELECT ` + testSuiteColumns + `
+        FROM test_suites
+        WHERE status = ? AND deleted_at IS NULL
+        ORDER BY created_at DESC
+    `
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, status)
+	if err != nil {
+		return nil, customerrors.DBError("query", "test_suites", err).WithContext(customerrors.Context{
+			"status": status.String(),
+		})
+	}
+	defer rows.Close()
+
+	var suites []*entity.TestSuite
+	for rows.Next() {
+		suite, err := scanTestSuite(rows)
+		if err != nil {
+			return nil, customerrors.NewInternalServerError(
+				"テストスイートデータの読み取りに失敗しました",
+			).WithContext(customerrors.Context{
+				"error":  err.Error(),
+				"status": status.String(),
+			})
+		}
+		suites = append(suites, suite)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, customerrors.DBError("iterate", "test_suites", err).WithContext(customerrors.Context{
+			"status": status.String(),
+		})
+	}
+
+	return suites, nil
+}
+
+// FindWithFilters はフィルター条件に基づいてテストスイート一覧を取得します
+// 総件数はカーソルによる範囲を含めず、絞り込み条件のみで数えます
+func (r *SQLiteTestSuiteRepository) FindWithFilters(ctx context.Context, params *dto.TestSuiteQueryParamDTO) ([]*entity.TestSuite, int, error) {
+	// 進捗率で絞り込み・並び替えを行う場合のみ、進捗率の列を加えたテーブルを対象にする
+	from := "test_suites"
+	if params.UsesProgress() {
+		from = `(
+            SELECT test_suites.*, ` + suiteProgressExpression + ` AS progress
+            FROM test_suites
+            WHERE deleted_at IS NULL
+        ) AS test_suites`
+	}
+	baseQuery := `
+        SELECT ` + testSuiteColumns + `
+        FROM ` + from + `
+        WHERE deleted_at IS NULL
+    `
+	countQuery := "SELECT COUNT(*) FROM " + from + " WHERE deleted_at IS NULL"
+
+	var queryParams []interface{}
+
+	whereClause := ""
+	if params.Status != nil {
+		status, err := valueobject.NewSuiteStatus(*params.Status)
+		if err != nil {
+			return nil, 0, customerrors.Validation(
+				"無効なステータス値です",
+				map[string]string{
+					"status": *params.Status,
+					"error":  err.Error(),
+				},
+			)
+		}
+
+		whereClause += " AND status = ?"
+		queryParams = append(queryParams, status)
+	}
+	if params.StartDate != nil {
+		whereClause += " AND estimated_start_date >= ?"
+		queryParams = append(queryParams, date(*params.StartDate))
+	}
+	if params.EndDate != nil {
+		whereClause += " AND estimated_end_date <= ?"
+		queryParams = append(queryParams, date(*params.EndDate))
+	}
+	if params.Search != nil {
+		// SQLiteのLIKEはASCII文字の大文字・小文字を区別しない
+		pattern := containsPattern(*params.Search)
+		whereClause += ` AND (name LIKE ? ESCAPE '\' OR description LIKE ? ESCAPE '\')`
+		queryParams = append(queryParams, pattern, pattern)
+	}
+	if params.CreatedBy != nil {
+		whereClause += " AND created_by = ?"
+		queryParams = append(queryParams, *params.CreatedBy)
+	}
+	// 予定期間が指定された範囲と重なるか（範囲の開始以降に終わり、範囲の終了以前に始まる）
+	if params.PeriodFrom != nil {
+		whereClause += " AND estimated_end_date >= ?"
+		queryParams = append(queryParams, date(*params.PeriodFrom))
+	}
+	if params.PeriodTo != nil {
+		whereClause += " AND estimated_start_date <= ?"
+		queryParams = append(queryParams, date(*params.PeriodTo))
+	}
+	if params.MinProgress != nil {
+		whereClause += " AND progress >= ?"
+		queryParams = append(queryParams, *params.MinProgress)
+	}
+	if params.MaxProgress != nil {
+		whereClause += " AND progress <= ?"
+		queryParams = append(queryParams, *params.MaxProgress)
+	}
+	if params.HasDelayedCases != nil {
+		if *params.HasDelayedCases {
+			whereClause += " AND " + suiteDelayedCaseExists
+		} else {
+			whereClause += " AND NOT " + suiteDelayedCaseExists
+		}
+	}
+
+	var total int
+	err := executor(ctx, r.db).QueryRowContext(ctx, countQuery+whereClause, queryParams...).Scan(&total)
+	if err != nil {
+		return nil, 0, customerrors.DBError("count", "test_suites", err).WithContext(customerrors.Context{
+			"filters": fmt.Sprintf("%+v", params),
+		})
+	}
+
+	sort := params.SortOrder()
+	var pageClause string
+	if keyset := params.Keyset; keyset != nil {
+		// 日時は同じ形式のUTCの文字列で保存しているため、文字列の比較で前後を判定できる
+		if keyset.After != nil {
+			condition, args := keysetCondition(sort, keyset.After, false)
+			pageClause += condition
+			queryParams = append(queryParams, args...)
+		}
+		if keyset.Before != nil {
+			condition, args := keysetCondition(sort, keyset.Before, true)
+			pageClause += condition
+			queryParams = append(queryParams, args...)
+		}
+		// 末尾から取得する場合は逆順で取得し、読み取り後に元の並び順に戻す
+		pageClause += orderByClause(sort, keyset.FromLast) + " LIMIT ?"
+		queryParams = append(queryParams, keyset.Limit)
+	} else {
+		limit := 10 // デフォルト値
+		offset := 0
+		if params.PageSize != nil {
+			limit = *params.PageSize
+		}
+		if params.Page != nil {
+			offset = (*params.Page - 1) * limit
+		}
+
+		pageClause = orderByClause(sort, false) + " LIMIT ? OFFSET ?"
+		queryParams = append(queryParams, limit, offset)
+	}
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, baseQuery+whereClause+pageClause, queryParams...)
+	if err != nil {
+		return nil, 0, customerrors.DBError("query", "test_suites", err).WithContext(customerrors.Context{
+			"filters": fmt.Sprintf("%+v", params),
+			"page":    pageClause,
+		})
+	}
+	defer rows.Close()
+
+	var suites []*entity.TestSuite
+	for rows.Next() {
+		suite, err := scanTestSuite(rows)
+		if err != nil {
+			return nil, 0, customerrors.NewInternalServerError(
+				"テストスイートデータの読み取りに失敗しました",
+			).WithContext(customerrors.Context{
+				"error":   err.Error(),
+				"filters": fmt.Sprintf("%+v", params),
+			})
+		}
+		suites = append(suites, suite)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, customerrors.DBError("iterate", "test_suites", err).WithContext(customerrors.Context{
+			"filters": fmt.Sprintf("%+v", params),
+		})
+	}
+
+	if params.Keyset != nil && params.Keyset.FromLast {
+		slices.Reverse(suites)
+	}
+
+	return suites, total, nil
+}
+
+// SoftDelete は指定されたテストスイートを論理削除します
+// 既に削除されている場合は見つからないものとして扱います
+func (r *SQLiteTestSuiteRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	query := `
+        UPDATE test_suites
+        SET
+            deleted_at = ?,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NULL
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, timestamp(deletedAt), id)
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "soft_delete", "TestSuite", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return customerrors.NewInternalServerError(
+			"削除結果の取得に失敗しました",
+		).WithContext(customerrors.Context{
+			"id":    id,
+			"error": err.Error(),
+		})
+	}
+
+	if rowsAffected == 0 {
+		return customerrors.NotFound("TestSuite", id)
+	}
+
+	return nil
+}
+
+// Restore は論理削除されたテストスイートを復元します
+func (r *SQLiteTestSuiteRepository) Restore(ctx context.Context, id string) error {
+	query := `
+        UPDATE test_suites
+        SET
+            deleted_at = NULL,
+            version = version + 1
+        WHERE id = ? AND deleted_at IS NOT NULL
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "restore", "TestSuite", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return customerrors.NewInternalServerError(
+			"復元結果の取得に失敗しました",
+		).WithContext(customerrors.Context{
+			"id":    id,
+			"error": err.Error(),
+		})
+	}
+
+	if rowsAffected == 0 {
+		return customerrors.NotFound("TestSuite", id)
+	}
+
+	return nil
+}
+
+// FindDeletedByID は論理削除された指定IDのテストスイートを取得します
+func (r *SQLiteTestSuiteRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestSuite, error) {
+	query := `
+        SELECT ` + testSuiteColumns + `, deleted_at
+        FROM test_suites
+        WHERE id = ? AND deleted_at IS NOT NULL
+    `
+	suite, err := scanDeletedTestSuite(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+
+	if err != nil {
+		return nil, customerrors.ConvertSQLiteError(err, "find", "TestSuite", id)
+	}
+
+	return suite, nil
+}
+
+// FindDeleted は論理削除されたテストスイートを削除日時の新しい順に取得します
+func (r *SQLiteTestSuiteRepository) FindDeleted(ctx context.Context) ([]*entity.TestSuite, error) {
+	query := `
+        SELECT ` + testSuiteColumns + `, deleted_at
+        FROM test_suites
+        WHERE deleted_at IS NOT NULL
+        ORDER BY deleted_at DESC, id ASC
+    `
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, customerrors.DBError("query", "test_suites", err)
+	}
+	defer rows.Close()
+
+	var suites []*entity.TestSuite
+	for rows.Next() {
+		suite, err := scanDeletedTestSuite(rows)
+		if err != nil {
+			return nil, customerrors.NewInternalServerError(
+				"テストスイートデータの読み取りに失敗しました",
+			).WithContext(customerrors.Context{
+				"error": err.Error(),
+			})
+		}
+		suites = append(suites, suite)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, customerrors.DBError("iterate", "test_suites", err)
+	}
+
+	return suites, nil
+}
+
+// PurgeDeletedBefore はbeforeより前に論理削除されたテストスイートを物理削除します
+// 配下のグループが残っている場合は外部キー制約に違反するため対象外とします
+func (r *SQLiteTestSuiteRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	query := `
+        DELETE FROM test_suites
+        WHERE deleted_at IS NOT NULL
+          AND deleted_at < ?
+          AND NOT EXISTS (SELECT 1 FROM test_groups WHERE test_groups.suite_id = test_suites.id)
+    `
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, timestamp(before))
+	if err != nil {
+		return 0, customerrors.DBError("purge", "test_suites", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, customerrors.NewInternalServerError(
+			"削除結果の取得に失敗しました",
+		).WithContext(customerrors.Context{
+			"error": err.Error(),
+		})
+	}
+
+	return int(rowsAffected), nil
+}
+
+// scanDeletedTestSuite はtestSuiteColumnsに続けて削除日時を選択した1行分のテストスイートを読み取ります
+func scanDeletedTestSuite(row interface{ Scan(dest ...any) error }) (*entity.TestSuite, error) {
+	var deletedAt time.Time
+	suite, err := scanTestSuite(appendScanDest{row: row, extra: []any{&deletedAt}})
+	if err != nil {
+		return nil, err
+	}
+	suite.DeletedAt = deletedAt
+	return suite, nil
+}
+
+// scanTestSuite は1行分のテストスイートを読み取ります
+// 完了条件のオーバーライドが記録されていない場合、ExitOverrideはnilになります
+func scanTestSuite(row interface{ Scan(dest ...any) error }) (*entity.TestSuite, error) {
+	suite := &entity.TestSuite{}
+	var overrideReason, overrideBy, createdBy sql.NullString
+	var overrideAt sql.NullTime
+	err := row.Scan(
+		&suite.ID,
+		&suite.Name,
+		&suite.Description,
+		&suite.Status,
+		&suite.EstimatedStartDate,
+		&suite.EstimatedEndDate,
+		&suite.RequireEffortComment,
+		&suite.ExitCriteria.MinCompletionRate,
+		&suite.ExitCriteria.AllowOpenCritical,
+		&overrideReason,
+		&overrideBy,
+		&overrideAt,
+		&suite.Version,
+		&createdBy,
+		&suite.CreatedAt,
+		&suite.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	suite.CreatedBy = createdBy.String
+	if overrideAt.Valid {
+		suite.ExitOverride = &entity.ExitCriteriaOverride{
+			Reason:       overrideReason.String,
+			OverriddenBy: overrideBy.String,
+			OverriddenAt: overrideAt.Time,
+		}
+	}
+	return suite, nil
+}
+
+// exitOverrideValues は完了条件のオーバーライドをINSERT・UPDATEのパラメータに変換します（未記録の場合はNULL）
+func exitOverrideValues(override *entity.ExitCriteriaOverride) (sql.NullString, sql.NullString, sql.NullString) {
+	if override == nil {
+		return sql.NullString{}, sql.NullString{}, sql.NullString{}
+	}
+	return sql.NullString{String: override.Reason, Valid: true},
+		sql.NullString{String: override.OverriddenBy, Valid: true},
+		nullTimestamp(override.OverriddenAt)
+}
@@ -0,0 +1,40 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+)
+
+// SQLiteには日付・日時の型がないため、テキストとして保存します
+// 列の宣言型がDATE・TIMESTAMPの場合、ドライバーが読み取り時にtime.Time（UTC）に変換します
+const (
+	dateLayout      = "2006-01-02"
+	timestampLayout = "2006-01-02 15:04:05.999999999"
+)
+
+// timestamp はTIMESTAMP列に保存する値に変換します
+// 文字列の比較で前後を判定できるよう、UTCに揃えてタイムゾーンを含めずに保存します
+func timestamp(t time.Time) string {
+	return t.UTC().Format(timestampLayout)
+}
+
+// nullTimestamp はゼロ値をNULLとして保存するTIMESTAMP列の値に変換します
+func nullTimestamp(t time.Time) sql.NullString {
+	if t.IsZero() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: timestamp(t), Valid: true}
+}
+
+// date はDATE列に保存する値に変換します（PostgreSQLのDATE型と同様に時刻は切り捨てます）
+func date(t time.Time) string {
+	return t.Format(dateLayout)
+}
+
+// nullDate はゼロ値をNULLとして保存するDATE列の値に変換します
+func nullDate(t time.Time) sql.NullString {
+	if t.IsZero() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: date(t), Valid: true}
+}
@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/common"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// txContextKey はcontextに*sql.Txを格納するためのキー
+type txContextKey struct{}
+
+// SQLiteTransactionManager は*sql.Txによるトランザクション管理のSQLite実装
+type SQLiteTransactionManager struct {
+	db *sql.DB
+}
+
+// NewTransactionManager は新しいTransactionManagerを作成します
+func NewTransactionManager(db *sql.DB) repository.TransactionManager {
+	return &SQLiteTransactionManager{
+		db: db,
+	}
+}
+
+// RunInTransaction はトランザクションを開始し、*sql.Txを格納したcontextでfnを実行します
+// 接続はBEGIN IMMEDIATEで開始するため、他の書き込みトランザクションとは開始時点で直列化されます
+func (m *SQLiteTransactionManager) RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// 外側のトランザクションに参加し、コミットは外側に任せる
+	if _, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.NewDatabaseError("begin", "transaction", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.NewDatabaseError("commit", "transaction", err)
+	}
+
+	return nil
+}
+
+// executor はcontextにトランザクションが格納されていればそれを、なければdbを返します
+func executor(ctx context.Context, db *sql.DB) common.SQLExecutor {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
@@ -0,0 +1,240 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// userColumns はユーザーのSELECTで取得するカラムです（scanUserと順序を合わせる）
+const userColumns = `
+			id, username, password_hash, role,
+			created_at, updated_at, last_login_at`
+
+// SQLiteUserRepository はSQLiteを使用したユーザーリポジトリの実装
+type SQLiteUserRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository は新しいUserRepositoryインスタンスを作成する
+func NewUserRepository(db *sql.DB) repository.UserRepository {
+	return &SQLiteUserRepository{
+		db: db,
+	}
+}
+
+// Create は新しいユーザーをデータベースに作成する
+func (r *SQLiteUserRepository) Create(ctx context.Context, user *entity.User) error {
+	query := `
+		INSERT INTO users (
+			id, username, password_hash, role,
+			created_at, updated_at, last_login_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		user.ID,
+		user.Username,
+		user.PasswordHash,
+		user.Role,
+		timestamp(user.CreatedAt),
+		timestamp(user.UpdatedAt),
+		lastLoginAt(user.LastLoginAt),
+	)
+
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "create", "User", user.ID)
+	}
+
+	return nil
+}
+
+// FindByID は指定されたIDのユーザーを取得する
+func (r *SQLiteUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE id = ?
+	`
+	user, err := scanUser(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+
+	if err != nil {
+		return nil, customerrors.ConvertSQLiteError(err, "find", "User", id)
+	}
+
+	return user, nil
+}
+
+// FindByUsername は指定されたユーザー名のユーザーを取得する
+func (r *SQLiteUserRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE username = ?
+	`
+	user, err := scanUser(executor(ctx, r.db).QueryRowContext(ctx, query, username))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, customerrors.NotFound("User", "").WithContext(customerrors.Context{
+				"username": username,
+			})
+		}
+		return nil, customerrors.DBError("find_by_username", "users", err).WithContext(customerrors.Context{
+			"username": username,
+		})
+	}
+
+	return user, nil
+}
+
+// Update はユーザー情報を更新する
+func (r *SQLiteUserRepository) Update(ctx context.Context, user *entity.User) error {
+	query := `
+		UPDATE users
+		SET
+			username = ?,
+			password_hash = ?,
+			role = ?,
+			updated_at = ?,
+			last_login_at = ?
+		WHERE id = ?
+	`
+
+	result, err := executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		user.Username,
+		user.PasswordHash,
+		user.Role,
+		timestamp(time.Now()),
+		lastLoginAt(user.LastLoginAt),
+		user.ID,
+	)
+
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "update", "User", user.ID)
+	}
+
+	return r.requireAffected(result, "更新結果の取得に失敗しました", user.ID)
+}
+
+// UpdateLastLogin はユーザーの最終ログイン日時を更新する
+func (r *SQLiteUserRepository) UpdateLastLogin(ctx context.Context, id string) error {
+	query := `
+		UPDATE users
+		SET
+			last_login_at = ?1,
+			updated_at = ?1
+		WHERE id = ?2
+	`
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, timestamp(time.Now()), id)
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "update_last_login", "User", id)
+	}
+
+	return r.requireAffected(result, "更新結果の取得に失敗しました", id)
+}
+
+// Delete は指定されたIDのユーザーを削除する
+func (r *SQLiteUserRepository) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM users WHERE id = ?"
+
+	result, err := executor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return customerrors.ConvertSQLiteError(err, "delete", "User", id)
+	}
+
+	return r.requireAffected(result, "削除結果の取得に失敗しました", id)
+}
+
+// FindAll は全ユーザーの一覧を取得する
+func (r *SQLiteUserRepository) FindAll(ctx context.Context) ([]*entity.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		ORDER BY created_at DESC
+	`
+
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, customerrors.ConvertSQLiteError(err, "find_all", "User", "")
+	}
+	defer rows.Close()
+
+	var users []*entity.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, customerrors.ConvertSQLiteError(err, "scan", "User", "")
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, customerrors.ConvertSQLiteError(err, "iterate", "User", "")
+	}
+
+	return users, nil
+}
+
+// CountByRole は指定されたロールを持つユーザーの数を取得する
+func (r *SQLiteUserRepository) CountByRole(ctx context.Context, role entity.UserRole) (int, error) {
+	query := "SELECT COUNT(*) FROM users WHERE role = ?"
+	var count int
+	err := executor(ctx, r.db).QueryRowContext(ctx, query, role).Scan(&count)
+	if err != nil {
+		return 0, customerrors.ConvertSQLiteError(err, "count_by_role", "User", "")
+	}
+	return count, nil
+}
+
+// requireAffected は更新・削除の対象となった行がなければNotFoundエラーを返す
+func (r *SQLiteUserRepository) requireAffected(result sql.Result, message, id string) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return customerrors.NewInternalServerError(message).WithContext(customerrors.Context{
+			"id":    id,
+			"error": err.Error(),
+		})
+	}
+
+	if rowsAffected == 0 {
+		return customerrors.NotFound("User", id)
+	}
+
+	return nil
+}
+
+// scanUser は1行分のユーザーを読み取る
+func scanUser(row interface{ Scan(dest ...any) error }) (*entity.User, error) {
+	user := &entity.User{}
+	err := row.Scan(
+		&user.ID,
+		&user.Username,
+		&user.PasswordHash,
+		&user.Role,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&user.LastLoginAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// lastLoginAt は最終ログイン日時をlast_login_at列の値に変換する（未ログインの場合はNULL）
+func lastLoginAt(t *time.Time) sql.NullString {
+	if t == nil {
+		return sql.NullString{}
+	}
+	return nullTimestamp(*t)
+}
@@ -0,0 +1,132 @@
+// Package storage は永続化の実装（PostgreSQL・SQLite・メモリ）を切り替えて、
+// 各サーバーが使用するリポジトリとIDジェネレーターをまとめて組み立てます
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/memory"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/migration"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/postgres"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/sqlite"
+)
+
+// Backend は永続化の実装の種類を表します
+type Backend string
+
+const (
+	// BackendPostgres はデータベースに保存します（デフォルト）
+	// 設定のdatabase.driverにsqliteを指定した場合はSQLiteのファイルに保存します
+	BackendPostgres Backend = "postgres"
+	// BackendMemory はプロセス内のメモリに保存します
+	// データベースなしでデモやE2Eテストを行うためのもので、プロセスを終了するとデータは失われます
+	BackendMemory Backend = "memory"
+)
+
+// ParseBackend は--storageフラグの値を解釈します
+// 空の場合はPostgreSQLとして扱います
+func ParseBackend(value string) (Backend, error) {
+	switch b := Backend(strings.ToLower(strings.TrimSpace(value))); b {
+	case "":
+		return BackendPostgres, nil
+	case BackendPostgres, BackendMemory:
+		return b, nil
+	default:
+		return "", fmt.Errorf("unknown storage backend %q: must be one of postgres, memory", value)
+	}
+}
+
+// Repositories はサーバーが使用するリポジトリ・IDジェネレーター・トランザクション管理の一式です
+type Repositories struct {
+	TestSuite     repository.TestSuiteRepository
+	TestGroup     repository.TestGroupRepository
+	TestCase      repository.TestCaseRepository
+	User          repository.UserRepository
+	RefreshToken  repository.RefreshTokenRepository
+	EffortRecord  repository.EffortRecordRepository
+	StatusHistory repository.StatusHistoryRepository
+
+	TestSuiteIDGenerator repository.TestSuiteIDGenerator
+	TestGroupIDGenerator repository.TestGroupIDGenerator
+	TestCaseIDGenerator  repository.TestCaseIDGenerator
+	UserIDGenerator      repository.UserIDGenerator
+
+	TxManager repository.TransactionManager
+}
+
+// NewDatabaseRepositories はデータベースの種類（設定のdatabase.driver）に応じた実装の一式を作成します
+func NewDatabaseRepositories(db *sql.DB, dialect migration.Dialect, options idgen.Options) *Repositories {
+	if dialect == migration.DialectSQLite {
+		return NewSQLiteRepositories(db, options)
+	}
+	return NewPostgresRepositories(db, options)
+}
+
+// NewPostgresRepositories は接続済みのデータベースを使用するPostgreSQL実装の一式を作成します
+func NewPostgresRepositories(db *sql.DB, options idgen.Options) *Repositories {
+	return &Repositories{
+		TestSuite:     postgres.NewTestSuiteRepository(db),
+		TestGroup:     postgres.NewTestGroupRepository(db),
+		TestCase:      postgres.NewTestCaseRepository(db),
+		User:          postgres.NewUserRepository(db),
+		RefreshToken:  postgres.NewPostgresRefreshTokenRepository(db),
+		EffortRecord:  postgres.NewEffortRecordRepository(db),
+		StatusHistory: postgres.NewStatusHistoryRepository(db),
+
+		TestSuiteIDGenerator: postgres.NewTestSuiteIDGenerator(db, options),
+		TestGroupIDGenerator: postgres.NewTestGroupIDGenerator(db, options),
+		TestCaseIDGenerator:  postgres.NewTestCaseIDGenerator(db, options),
+		UserIDGenerator:      postgres.NewUserIDGenerator(db, options),
+
+		TxManager: postgres.NewTransactionManager(db),
+	}
+}
+
+// NewSQLiteRepositories は接続済みのデータベースを使用するSQLite実装の一式を作成します
+// PostgreSQLを運用せずに1台のサーバーで動かす場合に、database.driverにsqliteを指定して使用します
+func NewSQLiteRepositories(db *sql.DB, options idgen.Options) *Repositories {
+	return &Repositories{
+		TestSuite:     sqlite.NewTestSuiteRepository(db),
+		TestGroup:     sqlite.NewTestGroupRepository(db),
+		TestCase:      sqlite.NewTestCaseRepository(db),
+		User:          sqlite.NewUserRepository(db),
+		RefreshToken:  sqlite.NewSQLiteRefreshTokenRepository(db),
+		EffortRecord:  sqlite.NewEffortRecordRepository(db),
+		StatusHistory: sqlite.NewStatusHistoryRepository(db),
+
+		TestSuiteIDGenerator: sqlite.NewTestSuiteIDGenerator(db, options),
+		TestGroupIDGenerator: sqlite.NewTestGroupIDGenerator(db, options),
+		TestCaseIDGenerator:  sqlite.NewTestCaseIDGenerator(db, options),
+		UserIDGenerator:      sqlite.NewUserIDGenerator(db, options),
+
+		TxManager: sqlite.NewTransactionManager(db),
+	}
+}
+
+// NewMemoryRepositories は空のメモリ内データ領域を使用する実装の一式を作成します
+// リフレッシュトークンを含む全リポジトリがトランザクションのロールバックに参加します
+func NewMemoryRepositories(options idgen.Options) *Repositories {
+	store := memory.NewStore()
+	refreshTokenRepo := memory.NewMemoryRefreshTokenRepository().(*memory.MemoryRefreshTokenRepository)
+
+	return &Repositories{
+		TestSuite:     memory.NewMemoryTestSuiteRepository(store),
+		TestGroup:     memory.NewMemoryTestGroupRepository(store),
+		TestCase:      memory.NewMemoryTestCaseRepository(store),
+		User:          memory.NewMemoryUserRepository(store),
+		RefreshToken:  refreshTokenRepo,
+		EffortRecord:  memory.NewMemoryEffortRecordRepository(store),
+		StatusHistory: memory.NewMemoryStatusHistoryRepository(store),
+
+		TestSuiteIDGenerator: memory.NewTestSuiteIDGenerator(options),
+		TestGroupIDGenerator: memory.NewTestGroupIDGenerator(options),
+		TestCaseIDGenerator:  memory.NewTestCaseIDGenerator(options),
+		UserIDGenerator:      memory.NewUserIDGenerator(options),
+
+		TxManager: memory.NewMemoryTransactionManager(store, refreshTokenRepo),
+	}
+}
@@ -3,7 +3,9 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
 	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
@@ -99,6 +101,11 @@ func (h *TestSuiteHandler) List(w http.ResponseWriter, r *http.Request) {
 		Page:     intPtr(parseIntParam(r.URL.Query().Get("page"), 1)),
 		PageSize: intPtr(parseIntParam(r.URL.Query().Get("pageSize"), 10)),
 	}
+	if err := parseTestSuiteFilterParams(r.URL.Query(), params); err != nil {
+		apiErr := errors.ConvertToAPIError(err)
+		apiErr.WriteToResponse(w)
+		return
+	}
 
 	// バリデーション
 	if err := h.validator.Validate(params); err != nil {
@@ -122,6 +129,68 @@ func (h *TestSuiteHandler) List(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// parseTestSuiteFilterParams は一覧の絞り込み・並び替えのクエリパラメータを解析します
+// 日付はYYYY-MM-DDまたはRFC3339、並び順は"progress desc,name"の形式で指定します
+func parseTestSuiteFilterParams(query url.Values, params *dto.TestSuiteQueryParamDTO) error {
+	params.Search = stringPtr(query.Get("search"))
+	params.CreatedBy = stringPtr(query.Get("createdBy"))
+
+	var err error
+	if params.PeriodFrom, err = parseTimeParam(query, "periodFrom"); err != nil {
+		return err
+	}
+	if params.PeriodTo, err = parseTimeParam(query, "periodTo"); err != nil {
+		return err
+	}
+	if params.MinProgress, err = parseFloatParam(query, "minProgress"); err != nil {
+		return err
+	}
+	if params.MaxProgress, err = parseFloatParam(query, "maxProgress"); err != nil {
+		return err
+	}
+	if value := query.Get("hasDelayedCases"); value != "" {
+		hasDelayed, err := strconv.ParseBool(value)
+		if err != nil {
+			return invalidQueryParamError("hasDelayedCases", value)
+		}
+		params.HasDelayedCases = &hasDelayed
+	}
+
+	params.Sort, err = dto.ParseTestSuiteSort(query.Get("orderBy"))
+	return err
+}
+
+func parseTimeParam(query url.Values, name string) (*time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, invalidQueryParamError(name, value)
+}
+
+func parseFloatParam(query url.Values, name string) (*float64, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, invalidQueryParamError(name, value)
+	}
+	return &f, nil
+}
+
+func invalidQueryParamError(name, value string) error {
+	return errors.NewValidationError("クエリパラメータの形式が正しくありません", map[string]string{
+		name: value,
+	})
+}
+
 func parseIntParam(param string, defaultValue int) int {
 	if param == "" {
 		return defaultValue
@@ -373,6 +373,64 @@ func TestListTestSuite(t *testing.T) {
 			expectedCode:  http.StatusOK,
 			expectSuccess: true,
 		},
+		{
+			name: "検索語・期間・進捗率・並び順による絞り込み",
+			queryParams: map[string]string{
+				"search":          "ログイン",
+				"createdBy":       "U001",
+				"periodFrom":      "2024-01-01",
+				"periodTo":        "2024-03-31T00:00:00Z",
+				"minProgress":     "10",
+				"maxProgress":     "90.5",
+				"hasDelayedCases": "true",
+				"orderBy":         "progress desc,name",
+			},
+			setupMock: func(m *mockTestSuiteUseCase) {
+				expectedResponse := &dto.TestSuiteListResponseDTO{
+					TestSuites: []dto.TestSuiteResponseDTO{{
+						ID:        "TS001-202401",
+						Name:      "ログイン機能",
+						Status:    "実行中",
+						CreatedBy: "U001",
+						CreatedAt: fixedTime,
+						UpdatedAt: fixedTime,
+					}},
+					Total: 1,
+				}
+				m.On("ListTestSuites", mock.MatchedBy(func(params *dto.TestSuiteQueryParamDTO) bool {
+					return *params.Search == "ログイン" && *params.CreatedBy == "U001" &&
+						params.PeriodFrom.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) &&
+						params.PeriodTo.Equal(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)) &&
+						*params.MinProgress == 10 && *params.MaxProgress == 90.5 && *params.HasDelayedCases &&
+						len(params.Sort) == 2 && params.Sort[0] == dto.TestSuiteSortDTO{Field: dto.TestSuiteSortByProgress, Desc: true} &&
+						params.Sort[1] == dto.TestSuiteSortDTO{Field: dto.TestSuiteSortByName}
+				})).Return(expectedResponse, nil)
+			},
+			expectedCode:  http.StatusOK,
+			expectSuccess: true,
+		},
+		{
+			name: "数値でない進捗率",
+			queryParams: map[string]string{
+				"minProgress": "half",
+			},
+			setupMock: func(m *mockTestSuiteUseCase) {
+				// 解析エラーのためモック不要
+			},
+			expectedCode:  http.StatusBadRequest,
+			expectSuccess: false,
+		},
+		{
+			name: "並び替えに使用できない項目",
+			queryParams: map[string]string{
+				"orderBy": "priority desc",
+			},
+			setupMock: func(m *mockTestSuiteUseCase) {
+				// 解析エラーのためモック不要
+			},
+			expectedCode:  http.StatusBadRequest,
+			expectSuccess: false,
+		},
 		{
 			name: "無効なページネーションパラメータ",
 			queryParams: map[string]string{
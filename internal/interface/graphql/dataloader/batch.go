@@ -0,0 +1,148 @@
+// ファイル: internal/interface/graphql/dataloader/batch.go
+
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// batchFunc は複数のキーに対応する値を1回の問い合わせで取得する関数です
+// 結果に含まれないキーの値はゼロ値として扱います
+type batchFunc[V any] func(ctx context.Context, keys []string) (map[string]V, error)
+
+// batchLoader は待機時間の間に要求されたキーをまとめてbatchFuncを1回だけ呼び出します
+// 取得した値はキャッシュし、同じキーを再度要求された場合は問い合わせを行いません
+type batchLoader[V any] struct {
+	fetch batchFunc[V]
+
+	// バッチ処理のためのパラメータ
+	maxBatchSize int
+	wait         time.Duration
+
+	// キャッシュと収集中のバッチ
+	mutex sync.Mutex
+	cache map[string]*batchResult[V]
+	batch *pendingBatch[V]
+}
+
+// batchResult は1つのキーの取得結果で、doneが閉じられるまで値は確定していません
+type batchResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// pendingBatch は収集中のキーと、それぞれの結果の格納先です
+type pendingBatch[V any] struct {
+	ctx     context.Context
+	keys    []string
+	results []*batchResult[V]
+	once    sync.Once
+}
+
+func newBatchLoader[V any](fetch batchFunc[V], maxBatchSize int, wait time.Duration) *batchLoader[V] {
+	return &batchLoader[V]{
+		fetch:        fetch,
+		maxBatchSize: maxBatchSize,
+		wait:         wait,
+		cache:        make(map[string]*batchResult[V]),
+	}
+}
+
+// clear はキャッシュをクリアします（収集中のバッチはそのまま取得されます）
+func (l *batchLoader[V]) clear() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.cache = make(map[string]*batchResult[V])
+}
+
+// load はキーの値を取得します
+func (l *batchLoader[V]) load(ctx context.Context, key string) (V, error) {
+	return l.await(ctx, l.enqueue(ctx, key))
+}
+
+// loadMany は複数のキーを同じバッチに入れてから、すべての結果を待ちます
+func (l *batchLoader[V]) loadMany(ctx context.Context, keys []string) ([]V, []error) {
+	pending := make([]*batchResult[V], len(keys))
+	for i, key := range keys {
+		pending[i] = l.enqueue(ctx, key)
+	}
+
+	values := make([]V, len(keys))
+	errs := make([]error, len(keys))
+	for i, result := range pending {
+		values[i], errs[i] = l.await(ctx, result)
+	}
+	return values, errs
+}
+
+// enqueue はキャッシュにないキーを収集中のバッチに追加し、結果の格納先を返します
+// バッチが最大件数に達した場合は待機時間を待たずに取得を開始します
+func (l *batchLoader[V]) enqueue(ctx context.Context, key string) *batchResult[V] {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if result, exists := l.cache[key]; exists {
+		return result
+	}
+
+	result := &batchResult[V]{done: make(chan struct{})}
+	l.cache[key] = result
+
+	if l.batch == nil {
+		b := &pendingBatch[V]{ctx: ctx}
+		l.batch = b
+		time.AfterFunc(l.wait, func() { l.dispatch(b) })
+	}
+	b := l.batch
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, result)
+
+	if len(b.keys) >= l.maxBatchSize {
+		l.batch = nil
+		go l.dispatch(b)
+	}
+	return result
+}
+
+// dispatch はバッチのキーをまとめて取得し、結果を格納します（バッチごとに1回だけ実行される）
+func (l *batchLoader[V]) dispatch(b *pendingBatch[V]) {
+	b.once.Do(func() {
+		l.mutex.Lock()
+		if l.batch == b {
+			l.batch = nil
+		}
+		l.mutex.Unlock()
+
+		values, err := l.fetch(b.ctx, b.keys)
+		if err != nil {
+			// 失敗した結果はキャッシュせず、次の要求で再取得する
+			l.mutex.Lock()
+			for i, key := range b.keys {
+				if l.cache[key] == b.results[i] {
+					delete(l.cache, key)
+				}
+			}
+			l.mutex.Unlock()
+		}
+
+		for i, key := range b.keys {
+			b.results[i].value = values[key]
+			b.results[i].err = err
+			close(b.results[i].done)
+		}
+	})
+}
+
+// await は結果が確定するかコンテキストが終了するまで待ちます
+func (l *batchLoader[V]) await(ctx context.Context, result *batchResult[V]) (V, error) {
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,112 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingFetch は呼び出しごとのキーを記録し、キーをそのまま値として返します
+type recordingFetch struct {
+	mutex sync.Mutex
+	calls [][]string
+	err   error
+}
+
+func (f *recordingFetch) fetch(ctx context.Context, keys []string) (map[string]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.calls = append(f.calls, append([]string(nil), keys...))
+	if f.err != nil {
+		return nil, f.err
+	}
+	values := make(map[string]string, len(keys))
+	for _, key := range keys {
+		values[key] = "value-" + key
+	}
+	return values, nil
+}
+
+func (f *recordingFetch) callCount() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.calls)
+}
+
+func TestBatchLoader_CollectsConcurrentKeys(t *testing.T) {
+	f := &recordingFetch{}
+	loader := newBatchLoader(f.fetch, 100, 10*time.Millisecond)
+
+	keys := []string{"TS001", "TS002", "TS003", "TS001"}
+	values := make([]string, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := loader.load(context.Background(), key)
+			assert.NoError(t, err)
+			values[i] = value
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, []string{"value-TS001", "value-TS002", "value-TS003", "value-TS001"}, values)
+	assert.Equal(t, 1, f.callCount())
+	assert.ElementsMatch(t, []string{"TS001", "TS002", "TS003"}, f.calls[0])
+
+	t.Run("キャッシュ済みのキーは再取得しない", func(t *testing.T) {
+		value, err := loader.load(context.Background(), "TS002")
+		assert.NoError(t, err)
+		assert.Equal(t, "value-TS002", value)
+		assert.Equal(t, 1, f.callCount())
+	})
+
+	t.Run("Clear後は再取得する", func(t *testing.T) {
+		loader.clear()
+		_, err := loader.load(context.Background(), "TS002")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, f.callCount())
+	})
+}
+
+func TestBatchLoader_LoadManySplitsByMaxBatchSize(t *testing.T) {
+	f := &recordingFetch{}
+	loader := newBatchLoader(f.fetch, 2, time.Hour)
+
+	values, errs := loader.loadMany(context.Background(), []string{"TG01", "TG02", "TG03", "TG04"})
+
+	assert.Equal(t, []string{"value-TG01", "value-TG02", "value-TG03", "value-TG04"}, values)
+	assert.Equal(t, []error{nil, nil, nil, nil}, errs)
+	assert.ElementsMatch(t, [][]string{{"TG01", "TG02"}, {"TG03", "TG04"}}, f.calls)
+}
+
+func TestBatchLoader_ErrorIsNotCached(t *testing.T) {
+	f := &recordingFetch{err: errors.New("database error")}
+	loader := newBatchLoader(f.fetch, 100, time.Millisecond)
+
+	_, err := loader.load(context.Background(), "TS001")
+	assert.EqualError(t, err, "database error")
+
+	f.mutex.Lock()
+	f.err = nil
+	f.mutex.Unlock()
+
+	value, err := loader.load(context.Background(), "TS001")
+	assert.NoError(t, err)
+	assert.Equal(t, "value-TS001", value)
+	assert.Equal(t, 2, f.callCount())
+}
+
+func TestBatchLoader_ContextCanceled(t *testing.T) {
+	loader := newBatchLoader((&recordingFetch{}).fetch, 100, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := loader.load(ctx, "TS001")
+	assert.ErrorIs(t, err, context.Canceled)
+}
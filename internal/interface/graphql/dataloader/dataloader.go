@@ -5,19 +5,17 @@ package dataloader
 import (
 	"context"
 	"fmt"
-	"net/http"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/port"
 )
 
 type ctxKey string
 
-const (
-	testGroupLoaderKey ctxKey = "test_group_loader"
-	testCaseLoaderKey  ctxKey = "test_case_loader"
-)
+const dataLoadersKey ctxKey = "dataloaders"
 
 // DataLoaders には、すべてのデータローダーのインスタンスが含まれます
+// キャッシュを他のリクエストと共有しないよう、応答ごとに作成します
 type DataLoaders struct {
 	TestGroupLoader *TestGroupLoader
 	TestCaseLoader  *TestCaseLoader
@@ -31,34 +29,56 @@ func NewDataLoaders(groupUseCase port.TestGroupUseCase, caseUseCase port.TestCas
 	}
 }
 
-// Middleware はDataLoaderをコンテキストに追加するミドルウェアです
-func Middleware(loaders *DataLoaders, next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := context.WithValue(r.Context(), testGroupLoaderKey, loaders.TestGroupLoader)
-		ctx = context.WithValue(ctx, testCaseLoaderKey, loaders.TestCaseLoader)
+// Clear はすべてのデータローダーのキャッシュをクリアします
+func (l *DataLoaders) Clear() {
+	l.TestGroupLoader.Clear()
+	l.TestCaseLoader.Clear()
+}
+
+// ResponseMiddleware は応答ごとに新しいDataLoaderを作成し、コンテキストに追加するgqlgenのミドルウェアを返します
+// 同時に処理される他のリクエスト（他のユーザー）とキャッシュを共有しません
+// WebSocketの接続では複数の操作が実行され、サブスクリプションはイベントごとに応答を作成するため、
+// HTTPのリクエストごとではなく応答ごとに作成し、前のイベントで取得した変更前のグループ・ケースを返さないようにします
+func ResponseMiddleware(groupUseCase port.TestGroupUseCase, caseUseCase port.TestCaseUseCase) graphql.ResponseMiddleware {
+	return func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		loaders := NewDataLoaders(groupUseCase, caseUseCase)
+		return next(WithDataLoaders(ctx, loaders))
+	}
+}
 
-		// リクエスト処理開始時にDataLoaderをリセット
-		loaders.TestGroupLoader.Clear()
-		loaders.TestCaseLoader.Clear()
+// WithDataLoaders はDataLoaderを追加したコンテキストを返します
+func WithDataLoaders(ctx context.Context, loaders *DataLoaders) context.Context {
+	return context.WithValue(ctx, dataLoadersKey, loaders)
+}
+
+// InvalidateAfterMutation はミューテーションの実行後に、リクエストのDataLoaderのキャッシュをクリアするフィールドミドルウェアです
+// ミューテーションの結果に含まれるグループ・ケースや、同じリクエストの後続のミューテーションが変更前のデータを返さないようにします
+func InvalidateAfterMutation(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	res, err := next(ctx)
+
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Object == "Mutation" {
+		if loaders, ok := ctx.Value(dataLoadersKey).(*DataLoaders); ok {
+			loaders.Clear()
+		}
+	}
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	return res, err
 }
 
 // GetTestGroupLoader はコンテキストからTestGroupLoaderを取得します
 func GetTestGroupLoader(ctx context.Context) (*TestGroupLoader, error) {
-	loader, ok := ctx.Value(testGroupLoaderKey).(*TestGroupLoader)
+	loaders, ok := ctx.Value(dataLoadersKey).(*DataLoaders)
 	if !ok {
 		return nil, fmt.Errorf("TestGroupLoader not found in context")
 	}
-	return loader, nil
+	return loaders.TestGroupLoader, nil
 }
 
 // GetTestCaseLoader はコンテキストからTestCaseLoaderを取得します
 func GetTestCaseLoader(ctx context.Context) (*TestCaseLoader, error) {
-	loader, ok := ctx.Value(testCaseLoaderKey).(*TestCaseLoader)
+	loaders, ok := ctx.Value(dataLoadersKey).(*DataLoaders)
 	if !ok {
 		return nil, fmt.Errorf("TestCaseLoader not found in context")
 	}
-	return loader, nil
+	return loaders.TestCaseLoader, nil
 }
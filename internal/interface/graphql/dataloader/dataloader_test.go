@@ -0,0 +1,122 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/port"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingGroupUseCase はGetGroupsBySuiteIDsの呼び出し回数を数えるユースケースです
+type countingGroupUseCase struct {
+	port.TestGroupUseCase
+	mutex sync.Mutex
+	calls int
+	name  string
+}
+
+func (u *countingGroupUseCase) GetGroupsBySuiteIDs(ctx context.Context, suiteIDs []string) (map[string][]*dto.TestGroupResponseDTO, error) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	u.calls++
+	result := make(map[string][]*dto.TestGroupResponseDTO, len(suiteIDs))
+	for _, id := range suiteIDs {
+		result[id] = []*dto.TestGroupResponseDTO{{ID: id + "TG01", SuiteID: id, Name: u.name}}
+	}
+	return result, nil
+}
+
+func (u *countingGroupUseCase) setName(name string) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	u.name = name
+}
+
+func (u *countingGroupUseCase) callCount() int {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	return u.calls
+}
+
+// emptyCaseUseCase はテストケースを返さないユースケースです
+type emptyCaseUseCase struct {
+	port.TestCaseUseCase
+}
+
+func (emptyCaseUseCase) GetCasesByGroupIDs(ctx context.Context, groupIDs []string) (map[string][]*dto.TestCaseResponseDTO, error) {
+	return nil, nil
+}
+
+func TestResponseMiddleware_CreatesLoadersPerResponse(t *testing.T) {
+	useCase := &countingGroupUseCase{name: "機能テスト"}
+	middleware := ResponseMiddleware(useCase, emptyCaseUseCase{})
+
+	// サブスクリプションでは同じ操作のハンドラーがイベントごとに呼び出される
+	var seen []*TestGroupLoader
+	var names []string
+	respond := func(ctx context.Context) *graphql.Response {
+		loader, err := GetTestGroupLoader(ctx)
+		assert.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			groups, err := loader.GetGroupsBySuiteID(ctx, "TS001")
+			assert.NoError(t, err)
+			names = append(names, groups[0].Name)
+		}
+		seen = append(seen, loader)
+		return &graphql.Response{}
+	}
+
+	middleware(context.Background(), respond)
+	useCase.setName("機能テスト（変更後）")
+	middleware(context.Background(), respond)
+
+	// 応答内ではキャッシュを使い、次の応答（イベント）では変更後のデータを取得する
+	assert.Len(t, seen, 2)
+	assert.NotSame(t, seen[0], seen[1])
+	assert.Equal(t, []string{"機能テスト", "機能テスト", "機能テスト（変更後）", "機能テスト（変更後）"}, names)
+	assert.Equal(t, 2, useCase.callCount())
+}
+
+func TestGetTestGroupLoader_NotInContext(t *testing.T) {
+	_, err := GetTestGroupLoader(context.Background())
+	assert.Error(t, err)
+	_, err = GetTestCaseLoader(context.Background())
+	assert.Error(t, err)
+}
+
+func TestInvalidateAfterMutation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		object        string
+		expectedCalls int
+	}{
+		{name: "ミューテーションの後はキャッシュをクリアする", object: "Mutation", expectedCalls: 2},
+		{name: "クエリのフィールドではキャッシュを維持する", object: "TestSuite", expectedCalls: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := &countingGroupUseCase{}
+			ctx := WithDataLoaders(context.Background(), NewDataLoaders(useCase, emptyCaseUseCase{}))
+			loader, _ := GetTestGroupLoader(ctx)
+
+			_, err := loader.GetGroupsBySuiteID(ctx, "TS001")
+			assert.NoError(t, err)
+
+			fieldCtx := graphql.WithFieldContext(ctx, &graphql.FieldContext{Object: tc.object})
+			res, err := InvalidateAfterMutation(fieldCtx, func(ctx context.Context) (interface{}, error) {
+				return true, nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, true, res)
+
+			_, err = loader.GetGroupsBySuiteID(ctx, "TS001")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCalls, useCase.callCount())
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// ファイル: internal/interface/graphql/errorpresenter/presenter.go
+
+// Package errorpresenter はリゾルバーが返すエラーを、安定したエラーコードを持つGraphQLのエラーに変換します
+// クライアントはメッセージではなくextensions.codeで処理を分岐します
+package errorpresenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/requestid"
+	pkgerrors "github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+	"github.com/cockroachdb/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// extensions.codeに設定するエラーコード
+const (
+	CodeNotFound        = "NOT_FOUND"
+	CodeValidation      = "VALIDATION"
+	CodeConflict        = "CONFLICT"
+	CodeUnauthenticated = "UNAUTHENTICATED"
+	CodeForbidden       = "FORBIDDEN"
+	CodeInternal        = "INTERNAL"
+)
+
+// internalErrorMessage は本番環境でINTERNALのエラーの代わりに返すメッセージです
+const internalErrorMessage = "内部サーバーエラーが発生しました"
+
+// Presenter はgqlgenのエラープレゼンターです
+type Presenter struct {
+	// HideInternalDetails がtrueの場合（本番環境）、INTERNALのエラーのメッセージを汎用の文言に置き換え、スタックトレースを返しません
+	HideInternalDetails bool
+	// Logger はエラーの出力先です（nilの場合は標準のロガー）
+	Logger *log.Logger
+}
+
+// New はエラープレゼンターを作成します
+func New(hideInternalDetails bool) graphql.ErrorPresenterFunc {
+	p := &Presenter{HideInternalDetails: hideInternalDetails}
+	return p.Present
+}
+
+// presentedError はクライアントに返すエラーの内容です
+type presentedError struct {
+	code    string
+	message string
+	fields  map[string]string
+	details map[string]interface{}
+}
+
+// Present はエラーをエラーコードと詳細を含むGraphQLのエラーに変換し、リクエストIDとともにログに出力します
+func (p *Presenter) Present(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	reqID := requestid.FromContext(ctx)
+
+	// クエリの構文・検証エラーやクエリの制限など、GraphQLの層でコードが設定されたエラーはそのまま返す
+	if _, hasCode := gqlErr.Extensions["code"]; hasCode && gqlErr.Unwrap() == nil {
+		p.logf("GraphQL error (requestId=%s, code=%v, path=%s): %s", reqID, gqlErr.Extensions["code"], gqlErr.Path, gqlErr.Message)
+		if reqID != "" {
+			gqlErr.Extensions["requestId"] = reqID
+		}
+		return gqlErr
+	}
+
+	cause := err
+	if wrapped := gqlErr.Unwrap(); wrapped != nil {
+		cause = wrapped
+	}
+	presented := classify(ctx, gqlErr, cause)
+	p.logf("GraphQL error (requestId=%s, code=%s, path=%s): %+v", reqID, presented.code, gqlErr.Path, cause)
+
+	extensions := map[string]interface{}{"code": presented.code}
+	if reqID != "" {
+		extensions["requestId"] = reqID
+	}
+	if len(presented.fields) > 0 {
+		extensions["fields"] = presented.fields
+	}
+	if len(presented.details) > 0 {
+		extensions["details"] = presented.details
+	}
+
+	message := presented.message
+	if presented.code == CodeInternal {
+		if p.HideInternalDetails {
+			message = internalErrorMessage
+		} else {
+			extensions["trace"] = fmt.Sprintf("%+v", cause)
+		}
+	}
+
+	return &gqlerror.Error{
+		Message:    message,
+		Path:       gqlErr.Path,
+		Locations:  gqlErr.Locations,
+		Extensions: extensions,
+	}
+}
+
+func (p *Presenter) logf(format string, args ...interface{}) {
+	if p.Logger != nil {
+		p.Logger.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+// statusError はcustomerrorsのエラーが実装するメソッドです
+// customerrors.BaseErrorはWithContextの戻り値の型が各エラーと一致せず、AsBaseErrorでは判定できないため使用しない
+type statusError interface {
+	StatusCode() int
+	Message() string
+	GetContext() customerrors.Context
+}
+
+// classify はエラーの種類からエラーコードとクライアントに返す内容を決定します
+func classify(ctx context.Context, gqlErr *gqlerror.Error, err error) presentedError {
+	// ドメイン層のエラー（pkg/errors）
+	var domainErr pkgerrors.DomainError
+	if errors.As(err, &domainErr) {
+		presented := presentedError{
+			code:    codeFromDomainErrorCode(domainErr.ErrorCode()),
+			message: domainErr.ErrorMessage(),
+		}
+		if presented.code != CodeInternal {
+			// Details()はエラーが保持するマップを返すため、コピーしてからフィールドエラーを分ける
+			presented.details = make(map[string]interface{})
+			for key, value := range domainErr.Details() {
+				if fieldErrors, ok := value.(map[string]string); ok && key == "fieldErrors" {
+					presented.fields = fieldErrors
+					continue
+				}
+				presented.details[key] = value
+			}
+			if invalidInput, ok := domainErr.(*pkgerrors.InvalidInputError); ok {
+				presented.fields = map[string]string{invalidInput.Field: invalidInput.ErrorMessage()}
+			}
+		}
+		return presented
+	}
+
+	// API用のエラー（pkg/errors）
+	var apiErr *pkgerrors.APIError
+	if errors.As(err, &apiErr) {
+		presented := presentedError{
+			code:    codeFromStatusCode(apiErr.Status),
+			message: apiErr.Message,
+		}
+		if presented.code == CodeValidation {
+			presented.fields = apiErr.Details
+		}
+		return presented
+	}
+
+	// ユースケース・リポジトリのエラー（customerrors）
+	var statusErr statusError
+	if errors.As(err, &statusErr) {
+		presented := presentedError{
+			code:    codeFromStatusCode(statusErr.StatusCode()),
+			message: statusErr.Message(),
+		}
+		if presented.code != CodeInternal && len(statusErr.GetContext()) > 0 {
+			presented.details = map[string]interface{}(statusErr.GetContext())
+		}
+		if validationErr, ok := customerrors.AsValidationError(err); ok {
+			presented.fields = validationErr.Details
+		}
+		return presented
+	}
+
+	message, _ := customerrors.SplitMessageAndTrace(gqlErr.Message)
+
+	if isArgumentError(ctx, gqlErr.Path) {
+		return presentedError{code: CodeValidation, message: message}
+	}
+
+	return presentedError{code: CodeInternal, message: message}
+}
+
+// isArgumentError は引数の変換エラー（DateTimeの形式が不正な場合など）かどうかを判定します
+// 引数の変換エラーのパスは、フィールドのパスの後に引数名が続きます
+func isArgumentError(ctx context.Context, path ast.Path) bool {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return false
+	}
+	fieldPath := fc.Path()
+	if len(path) <= len(fieldPath) {
+		return false
+	}
+	_, isName := path[len(fieldPath)].(ast.PathName)
+	return isName
+}
+
+// codeFromDomainErrorCode はドメインエラーのコードをエラーコードに変換します
+func codeFromDomainErrorCode(code string) string {
+	switch {
+	case code == "NOT_FOUND" || strings.HasSuffix(code, "_NOT_FOUND"):
+		return CodeNotFound
+	case code == "VALIDATION_ERROR", code == "INVALID_INPUT", code == "INVALID_DATE_RANGE", code == "BAD_REQUEST":
+		return CodeValidation
+	case code == "CONFLICT":
+		return CodeConflict
+	case code == "UNAUTHORIZED":
+		return CodeUnauthenticated
+	case code == "FORBIDDEN", code == "PERMISSION_ERROR", code == "PERMISSION_DENIED":
+		return CodeForbidden
+	default:
+		return CodeInternal
+	}
+}
+
+// codeFromStatusCode はHTTPステータスコードをエラーコードに変換します
+func codeFromStatusCode(status int) string {
+	switch status {
+	case customerrors.StatusCodeNotFound:
+		return CodeNotFound
+	case customerrors.StatusCodeBadRequest, customerrors.StatusCodeUnprocessableEntity:
+		return CodeValidation
+	case customerrors.StatusCodeConflict:
+		return CodeConflict
+	case customerrors.StatusCodeUnauthorized:
+		return CodeUnauthenticated
+	case customerrors.StatusCodeForbidden:
+		return CodeForbidden
+	default:
+		return CodeInternal
+	}
+}
@@ -0,0 +1,225 @@
+package errorpresenter
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/requestid"
+	pkgerrors "github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// present はリゾルバーと同じくパスで包んだエラーをプレゼンターに渡します
+func present(t *testing.T, p *Presenter, ctx context.Context, err error) *gqlerror.Error {
+	t.Helper()
+	return p.Present(ctx, gqlerror.WrapPath(ast.Path{ast.PathName("testSuite")}, err))
+}
+
+func TestPresent(t *testing.T) {
+	testCases := []struct {
+		name            string
+		err             error
+		expectedCode    string
+		expectedMessage string
+		expectedFields  map[string]string
+		expectedDetails map[string]interface{}
+	}{
+		{
+			name:            "customerrorsのNotFound",
+			err:             customerrors.NewNotFoundError("テストスイートが見つかりません"),
+			expectedCode:    CodeNotFound,
+			expectedMessage: "テストスイートが見つかりません",
+		},
+		{
+			name:            "customerrorsのValidationはフィールドごとのエラーを返す",
+			err:             customerrors.NewValidationError("入力が正しくありません", map[string]string{"name": "名前は必須です"}),
+			expectedCode:    CodeValidation,
+			expectedMessage: "入力が正しくありません",
+			expectedFields:  map[string]string{"name": "名前は必須です"},
+		},
+		{
+			name: "customerrorsのコンテキストはDetailsに返す",
+			err: customerrors.NewValidationError("IDの型が正しくありません", map[string]string{"id": "TestSuiteのIDを指定してください"}).
+				WithContext(customerrors.Context{"id": "TestGroup:TG01"}),
+			expectedCode:    CodeValidation,
+			expectedMessage: "IDの型が正しくありません",
+			expectedFields:  map[string]string{"id": "TestSuiteのIDを指定してください"},
+			expectedDetails: map[string]interface{}{"id": "TestGroup:TG01"},
+		},
+		{
+			name:            "customerrorsのUnauthorized",
+			err:             customerrors.NewUnauthorizedError("認証が必要です"),
+			expectedCode:    CodeUnauthenticated,
+			expectedMessage: "認証が必要です",
+		},
+		{
+			name:            "customerrorsのForbidden",
+			err:             customerrors.NewForbiddenError("アクセス権限がありません"),
+			expectedCode:    CodeForbidden,
+			expectedMessage: "アクセス権限がありません",
+		},
+		{
+			name:            "customerrorsのConflict",
+			err:             customerrors.NewConflictError("既に存在します"),
+			expectedCode:    CodeConflict,
+			expectedMessage: "既に存在します",
+		},
+		{
+			name:            "pkg/errorsのドメインエラーはDetailsを返す",
+			err:             pkgerrors.NewStatusTransitionConflictError("遷移できません", "完了", "準備中"),
+			expectedCode:    CodeConflict,
+			expectedMessage: "遷移できません",
+			expectedDetails: pkgerrors.NewStatusTransitionConflictError("遷移できません", "完了", "準備中").Details(),
+		},
+		{
+			name:            "pkg/errorsのValidationErrorはフィールドエラーをfieldsに分ける",
+			err:             pkgerrors.NewDomainValidationError("入力が正しくありません", map[string]string{"title": "タイトルは必須です"}),
+			expectedCode:    CodeValidation,
+			expectedMessage: "入力が正しくありません",
+			expectedFields:  map[string]string{"title": "タイトルは必須です"},
+		},
+		{
+			name:            "pkg/errorsの固有のNotFound",
+			err:             pkgerrors.NewTestSuiteNotFoundError("TS001-202401"),
+			expectedCode:    CodeNotFound,
+			expectedMessage: pkgerrors.NewTestSuiteNotFoundError("TS001-202401").ErrorMessage(),
+		},
+		{
+			name:            "包まれたドメインエラーも判定する",
+			err:             errors.Wrap(pkgerrors.NewDomainForbiddenError("USER001", "テストスイート", "更新"), "更新に失敗"),
+			expectedCode:    CodeForbidden,
+			expectedMessage: pkgerrors.NewDomainForbiddenError("USER001", "テストスイート", "更新").ErrorMessage(),
+		},
+		{
+			name:            "APIErrorのValidationはDetailsをfieldsに返す",
+			err:             pkgerrors.NewValidationError("入力が正しくありません", map[string]string{"priority": "優先度が正しくありません"}),
+			expectedCode:    CodeValidation,
+			expectedMessage: "入力が正しくありません",
+			expectedFields:  map[string]string{"priority": "優先度が正しくありません"},
+		},
+		{
+			name:            "APIErrorのUnauthorized",
+			err:             pkgerrors.NewUnauthorizedError(),
+			expectedCode:    CodeUnauthenticated,
+			expectedMessage: "認証が必要です",
+		},
+		{
+			name:            "未知のエラーはINTERNAL",
+			err:             errors.New("接続が切断されました"),
+			expectedCode:    CodeInternal,
+			expectedMessage: "接続が切断されました",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &Presenter{Logger: log.New(&bytes.Buffer{}, "", 0)}
+
+			gqlErr := present(t, p, context.Background(), tc.err)
+
+			assert.Equal(t, tc.expectedCode, gqlErr.Extensions["code"])
+			assert.Equal(t, tc.expectedMessage, gqlErr.Message)
+			assert.NotContains(t, gqlErr.Message, "###")
+			assert.Equal(t, ast.Path{ast.PathName("testSuite")}, gqlErr.Path)
+			if tc.expectedFields != nil {
+				assert.Equal(t, tc.expectedFields, gqlErr.Extensions["fields"])
+			} else {
+				assert.NotContains(t, gqlErr.Extensions, "fields")
+			}
+			if tc.expectedDetails != nil {
+				assert.Equal(t, tc.expectedDetails, gqlErr.Extensions["details"])
+			}
+		})
+	}
+}
+
+func TestPresent_HideInternalDetails(t *testing.T) {
+	err := customerrors.NewInternalServerError("データベースに接続できません")
+
+	t.Run("開発環境ではメッセージとトレースを返す", func(t *testing.T) {
+		p := &Presenter{Logger: log.New(&bytes.Buffer{}, "", 0)}
+
+		gqlErr := present(t, p, context.Background(), err)
+
+		assert.Equal(t, CodeInternal, gqlErr.Extensions["code"])
+		assert.Equal(t, "データベースに接続できません", gqlErr.Message)
+		assert.Contains(t, gqlErr.Extensions, "trace")
+	})
+
+	t.Run("本番環境では汎用のメッセージのみ返す", func(t *testing.T) {
+		p := &Presenter{HideInternalDetails: true, Logger: log.New(&bytes.Buffer{}, "", 0)}
+
+		gqlErr := present(t, p, context.Background(), err)
+
+		assert.Equal(t, CodeInternal, gqlErr.Extensions["code"])
+		assert.Equal(t, internalErrorMessage, gqlErr.Message)
+		assert.NotContains(t, gqlErr.Extensions, "trace")
+	})
+
+	t.Run("本番環境でもINTERNAL以外のメッセージは返す", func(t *testing.T) {
+		p := &Presenter{HideInternalDetails: true, Logger: log.New(&bytes.Buffer{}, "", 0)}
+
+		gqlErr := present(t, p, context.Background(), customerrors.NewNotFoundError("テストケースが見つかりません"))
+
+		assert.Equal(t, "テストケースが見つかりません", gqlErr.Message)
+	})
+}
+
+func TestPresent_RequestID(t *testing.T) {
+	var logs bytes.Buffer
+	p := &Presenter{HideInternalDetails: true, Logger: log.New(&logs, "", 0)}
+	ctx := requestid.WithRequestID(context.Background(), "req-123")
+
+	gqlErr := present(t, p, ctx, customerrors.NewInternalServerError("データベースに接続できません"))
+
+	assert.Equal(t, "req-123", gqlErr.Extensions["requestId"])
+	// 本番環境でもログには元のエラーを出力する
+	assert.Contains(t, logs.String(), "requestId=req-123")
+	assert.Contains(t, logs.String(), "データベースに接続できません")
+}
+
+func TestPresent_GraphQLErrorCode(t *testing.T) {
+	p := &Presenter{Logger: log.New(&bytes.Buffer{}, "", 0)}
+	ctx := requestid.WithRequestID(context.Background(), "req-123")
+	err := &gqlerror.Error{
+		Message:    "クエリの深さが上限を超えています",
+		Extensions: map[string]interface{}{"code": "DEPTH_LIMIT_EXCEEDED"},
+	}
+
+	gqlErr := p.Present(ctx, err)
+
+	assert.Equal(t, "DEPTH_LIMIT_EXCEEDED", gqlErr.Extensions["code"])
+	assert.Equal(t, "req-123", gqlErr.Extensions["requestId"])
+}
+
+func TestPresent_ArgumentError(t *testing.T) {
+	p := &Presenter{Logger: log.New(&bytes.Buffer{}, "", 0)}
+	fc := &graphql.FieldContext{Field: graphql.CollectedField{Field: &ast.Field{Alias: "testSuites"}}}
+	ctx := graphql.WithFieldContext(context.Background(), fc)
+
+	t.Run("引数の変換エラーはVALIDATION", func(t *testing.T) {
+		err := gqlerror.WrapPath(ast.Path{ast.PathName("testSuites"), ast.PathName("filter")}, errors.New("日時の形式が正しくありません"))
+
+		gqlErr := p.Present(ctx, err)
+
+		assert.Equal(t, CodeValidation, gqlErr.Extensions["code"])
+		assert.Equal(t, "日時の形式が正しくありません", gqlErr.Message)
+	})
+
+	t.Run("リストの要素のエラーは引数のエラーとみなさない", func(t *testing.T) {
+		err := gqlerror.WrapPath(ast.Path{ast.PathName("testSuites"), ast.PathIndex(0)}, errors.New("接続が切断されました"))
+
+		gqlErr := p.Present(ctx, err)
+
+		require.NotNil(t, gqlErr)
+		assert.Equal(t, CodeInternal, gqlErr.Extensions["code"])
+	})
+}
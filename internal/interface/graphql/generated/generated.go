@@ -43,6 +43,7 @@ type ResolverRoot interface {
 	Mutation() MutationResolver
 	Query() QueryResolver
 	Subscription() SubscriptionResolver
+	TestCase() TestCaseResolver
 	TestGroup() TestGroupResolver
 	TestSuite() TestSuiteResolver
 }
@@ -60,17 +61,63 @@ type ComplexityRoot struct {
 		User         func(childComplexity int) int
 	}
 
+	EffortRecord struct {
+		Comment      func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		EffortAmount func(childComplexity int) int
+		ID           func(childComplexity int) int
+		IsAdditional func(childComplexity int) int
+		RecordDate   func(childComplexity int) int
+		RecordedBy   func(childComplexity int) int
+		TestCaseID   func(childComplexity int) int
+	}
+
+	EffortRecordList struct {
+		Records     func(childComplexity int) int
+		TotalEffort func(childComplexity int) int
+	}
+
+	ExitCriteria struct {
+		AllowOpenCritical func(childComplexity int) int
+		MinCompletionRate func(childComplexity int) int
+	}
+
+	ExitCriteriaOverride struct {
+		OverriddenAt func(childComplexity int) int
+		OverriddenBy func(childComplexity int) int
+		Reason       func(childComplexity int) int
+	}
+
 	Mutation struct {
 		ChangePassword        func(childComplexity int, oldPassword string, newPassword string) int
+		CorrectEffortRecord   func(childComplexity int, id string, input model.CorrectEffortRecordInput) int
+		CreateTestCase        func(childComplexity int, input model.CreateTestCaseInput) int
+		CreateTestGroup       func(childComplexity int, input model.CreateTestGroupInput) int
 		CreateTestSuite       func(childComplexity int, input model.CreateTestSuiteInput) int
 		CreateUser            func(childComplexity int, input model.CreateUserInput) int
+		DeleteTestCase        func(childComplexity int, id string) int
+		DeleteTestGroup       func(childComplexity int, id string) int
+		DeleteTestSuite       func(childComplexity int, id string) int
 		DeleteUser            func(childComplexity int, userID string) int
+		ForceUnlockTestCase   func(childComplexity int, id string) int
+		LockTestCase          func(childComplexity int, id string) int
 		Login                 func(childComplexity int, username string, password string) int
 		Logout                func(childComplexity int, refreshToken string) int
+		MoveTestCase          func(childComplexity int, id string, targetGroupID string) int
+		RecordEffort          func(childComplexity int, input model.RecordEffortInput) int
 		RefreshToken          func(childComplexity int, refreshToken string) int
+		RenewTestCaseLock     func(childComplexity int, id string) int
+		ReorderTestGroups     func(childComplexity int, suiteID string, groupIds []string) int
 		ResetPassword         func(childComplexity int, userID string, newPassword string) int
+		RestoreTestCase       func(childComplexity int, id string) int
+		RestoreTestGroup      func(childComplexity int, id string) int
+		RestoreTestSuite      func(childComplexity int, id string) int
+		UnlockTestCase        func(childComplexity int, id string) int
+		UpdateTestCase        func(childComplexity int, id string, input model.UpdateTestCaseInput) int
+		UpdateTestCaseStatus  func(childComplexity int, id string, status model.TestStatus, reason *string, expectedVersion *int) int
+		UpdateTestGroup       func(childComplexity int, id string, input model.UpdateTestGroupInput) int
 		UpdateTestSuite       func(childComplexity int, id string, input model.UpdateTestSuiteInput) int
-		UpdateTestSuiteStatus func(childComplexity int, id string, status model.SuiteStatus) int
+		UpdateTestSuiteStatus func(childComplexity int, id string, status model.SuiteStatus, overrideReason *string) int
 		UpdateUser            func(childComplexity int, userID string, input model.UpdateUserInput) int
 	}
 
@@ -82,59 +129,106 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		AdminData   func(childComplexity int) int
-		ManagerData func(childComplexity int) int
-		Me          func(childComplexity int) int
-		TestSuite   func(childComplexity int, id string) int
-		TestSuites  func(childComplexity int, status *model.SuiteStatus, page *int, pageSize *int) int
-		TesterData  func(childComplexity int) int
-		User        func(childComplexity int, id string) int
-		Users       func(childComplexity int) int
+		AdminData           func(childComplexity int) int
+		EffortRecords       func(childComplexity int, testCaseID string) int
+		EffortRecordsByUser func(childComplexity int, userID string, date time.Time) int
+		ManagerData         func(childComplexity int) int
+		Me                  func(childComplexity int) int
+		Node                func(childComplexity int, id string) int
+		Nodes               func(childComplexity int, ids []string) int
+		TestCase            func(childComplexity int, id string) int
+		TestGroup           func(childComplexity int, id string) int
+		TestSuite           func(childComplexity int, id string) int
+		TestSuites          func(childComplexity int, status *model.SuiteStatus, filter *model.TestSuiteFilter, orderBy []*model.TestSuiteOrder, first *int, after *string, last *int, before *string, page *int, pageSize *int) int
+		TesterData          func(childComplexity int) int
+		Trash               func(childComplexity int) int
+		User                func(childComplexity int, id string) int
+		Users               func(childComplexity int) int
+	}
+
+	StatusHistory struct {
+		ChangedAt func(childComplexity int) int
+		ChangedBy func(childComplexity int) int
+		ID        func(childComplexity int) int
+		NewStatus func(childComplexity int) int
+		OldStatus func(childComplexity int) int
+		Reason    func(childComplexity int) int
 	}
 
 	Subscription struct {
-		TestSuiteStatusChanged func(childComplexity int) int
+		SuiteActivity          func(childComplexity int, suiteID string) int
+		TestCaseUpdated        func(childComplexity int, suiteID string) int
+		TestSuiteStatusChanged func(childComplexity int, id string) int
+	}
+
+	SuiteActivity struct {
+		ActorID    func(childComplexity int) int
+		EntityID   func(childComplexity int) int
+		EntityType func(childComplexity int) int
+		OccurredAt func(childComplexity int) int
+		SuiteID    func(childComplexity int) int
+		TestCaseID func(childComplexity int) int
+		Type       func(childComplexity int) int
 	}
 
 	TestCase struct {
-		ActualEffort  func(childComplexity int) int
-		CreatedAt     func(childComplexity int) int
-		DelayDays     func(childComplexity int) int
-		Description   func(childComplexity int) int
-		GroupID       func(childComplexity int) int
-		ID            func(childComplexity int) int
-		IsDelayed     func(childComplexity int) int
-		PlannedEffort func(childComplexity int) int
-		Priority      func(childComplexity int) int
-		Status        func(childComplexity int) int
-		Title         func(childComplexity int) int
-		UpdatedAt     func(childComplexity int) int
+		ActualEffort   func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		DelayDays      func(childComplexity int) int
+		DeletedAt      func(childComplexity int) int
+		Description    func(childComplexity int) int
+		DueDate        func(childComplexity int) int
+		GlobalGroupID  func(childComplexity int) int
+		GlobalID       func(childComplexity int) int
+		GlobalLockedBy func(childComplexity int) int
+		IsDelayed      func(childComplexity int) int
+		LockExpiresAt  func(childComplexity int) int
+		PlannedEffort  func(childComplexity int) int
+		Priority       func(childComplexity int) int
+		Status         func(childComplexity int) int
+		StatusHistory  func(childComplexity int) int
+		Title          func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+		Version        func(childComplexity int) int
 	}
 
 	TestGroup struct {
-		Cases        func(childComplexity int) int
-		CreatedAt    func(childComplexity int) int
-		Description  func(childComplexity int) int
-		DisplayOrder func(childComplexity int) int
-		ID           func(childComplexity int) int
-		Name         func(childComplexity int) int
-		Status       func(childComplexity int) int
-		SuiteID      func(childComplexity int) int
-		UpdatedAt    func(childComplexity int) int
+		Cases              func(childComplexity int) int
+		CompletedCaseCount func(childComplexity int) int
+		CreatedAt          func(childComplexity int) int
+		DeletedAt          func(childComplexity int) int
+		Description        func(childComplexity int) int
+		DisplayOrder       func(childComplexity int) int
+		GlobalID           func(childComplexity int) int
+		GlobalSuiteID      func(childComplexity int) int
+		Name               func(childComplexity int) int
+		Progress           func(childComplexity int) int
+		Status             func(childComplexity int) int
+		StatusLocked       func(childComplexity int) int
+		TotalCaseCount     func(childComplexity int) int
+		UpdatedAt          func(childComplexity int) int
+		Version            func(childComplexity int) int
 	}
 
 	TestSuite struct {
+		CompletedCaseCount   func(childComplexity int) int
 		CreatedAt            func(childComplexity int) int
+		DeletedAt            func(childComplexity int) int
 		Description          func(childComplexity int) int
 		EstimatedEndDate     func(childComplexity int) int
 		EstimatedStartDate   func(childComplexity int) int
+		ExitCriteria         func(childComplexity int) int
+		ExitCriteriaOverride func(childComplexity int) int
+		GlobalCreatedBy      func(childComplexity int) int
+		GlobalID             func(childComplexity int) int
 		Groups               func(childComplexity int) int
-		ID                   func(childComplexity int) int
 		Name                 func(childComplexity int) int
 		Progress             func(childComplexity int) int
 		RequireEffortComment func(childComplexity int) int
 		Status               func(childComplexity int) int
+		TotalCaseCount       func(childComplexity int) int
 		UpdatedAt            func(childComplexity int) int
+		Version              func(childComplexity int) int
 	}
 
 	TestSuiteConnection struct {
@@ -148,9 +242,15 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	Trash struct {
+		TestCases  func(childComplexity int) int
+		TestGroups func(childComplexity int) int
+		TestSuites func(childComplexity int) int
+	}
+
 	User struct {
 		CreatedAt   func(childComplexity int) int
-		ID          func(childComplexity int) int
+		GlobalID    func(childComplexity int) int
 		LastLoginAt func(childComplexity int) int
 		Role        func(childComplexity int) int
 		UpdatedAt   func(childComplexity int) int
@@ -161,7 +261,24 @@ type ComplexityRoot struct {
 type MutationResolver interface {
 	CreateTestSuite(ctx context.Context, input model.CreateTestSuiteInput) (*model.TestSuite, error)
 	UpdateTestSuite(ctx context.Context, id string, input model.UpdateTestSuiteInput) (*model.TestSuite, error)
-	UpdateTestSuiteStatus(ctx context.Context, id string, status model.SuiteStatus) (*model.TestSuite, error)
+	UpdateTestSuiteStatus(ctx context.Context, id string, status model.SuiteStatus, overrideReason *string) (*model.TestSuite, error)
+	DeleteTestSuite(ctx context.Context, id string) (bool, error)
+	RestoreTestSuite(ctx context.Context, id string) (*model.TestSuite, error)
+	CreateTestGroup(ctx context.Context, input model.CreateTestGroupInput) (*model.TestGroup, error)
+	UpdateTestGroup(ctx context.Context, id string, input model.UpdateTestGroupInput) (*model.TestGroup, error)
+	DeleteTestGroup(ctx context.Context, id string) (bool, error)
+	RestoreTestGroup(ctx context.Context, id string) (*model.TestGroup, error)
+	ReorderTestGroups(ctx context.Context, suiteID string, groupIds []string) ([]*model.TestGroup, error)
+	CreateTestCase(ctx context.Context, input model.CreateTestCaseInput) (*model.TestCase, error)
+	UpdateTestCase(ctx context.Context, id string, input model.UpdateTestCaseInput) (*model.TestCase, error)
+	DeleteTestCase(ctx context.Context, id string) (bool, error)
+	RestoreTestCase(ctx context.Context, id string) (*model.TestCase, error)
+	MoveTestCase(ctx context.Context, id string, targetGroupID string) (*model.TestCase, error)
+	UpdateTestCaseStatus(ctx context.Context, id string, status model.TestStatus, reason *string, expectedVersion *int) (*model.TestCase, error)
+	LockTestCase(ctx context.Context, id string) (*model.TestCase, error)
+	RenewTestCaseLock(ctx context.Context, id string) (*model.TestCase, error)
+	UnlockTestCase(ctx context.Context, id string) (*model.TestCase, error)
+	ForceUnlockTestCase(ctx context.Context, id string) (*model.TestCase, error)
 	Login(ctx context.Context, username string, password string) (*model.AuthPayload, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*model.AuthPayload, error)
 	Logout(ctx context.Context, refreshToken string) (bool, error)
@@ -170,19 +287,33 @@ type MutationResolver interface {
 	ResetPassword(ctx context.Context, userID string, newPassword string) (bool, error)
 	DeleteUser(ctx context.Context, userID string) (bool, error)
 	UpdateUser(ctx context.Context, userID string, input model.UpdateUserInput) (*model.User, error)
+	RecordEffort(ctx context.Context, input model.RecordEffortInput) (*model.EffortRecord, error)
+	CorrectEffortRecord(ctx context.Context, id string, input model.CorrectEffortRecordInput) (*model.EffortRecord, error)
 }
 type QueryResolver interface {
 	TestSuite(ctx context.Context, id string) (*model.TestSuite, error)
-	TestSuites(ctx context.Context, status *model.SuiteStatus, page *int, pageSize *int) (*model.TestSuiteConnection, error)
+	TestSuites(ctx context.Context, status *model.SuiteStatus, filter *model.TestSuiteFilter, orderBy []*model.TestSuiteOrder, first *int, after *string, last *int, before *string, page *int, pageSize *int) (*model.TestSuiteConnection, error)
+	TestGroup(ctx context.Context, id string) (*model.TestGroup, error)
+	TestCase(ctx context.Context, id string) (*model.TestCase, error)
+	Trash(ctx context.Context) (*model.Trash, error)
 	Me(ctx context.Context) (*model.User, error)
 	AdminData(ctx context.Context) (string, error)
 	ManagerData(ctx context.Context) (string, error)
 	TesterData(ctx context.Context) (string, error)
 	Users(ctx context.Context) ([]*model.User, error)
 	User(ctx context.Context, id string) (*model.User, error)
+	EffortRecords(ctx context.Context, testCaseID string) (*model.EffortRecordList, error)
+	EffortRecordsByUser(ctx context.Context, userID string, date time.Time) (*model.EffortRecordList, error)
+	Node(ctx context.Context, id string) (model.Node, error)
+	Nodes(ctx context.Context, ids []string) ([]model.Node, error)
 }
 type SubscriptionResolver interface {
-	TestSuiteStatusChanged(ctx context.Context) (<-chan *model.TestSuite, error)
+	TestSuiteStatusChanged(ctx context.Context, id string) (<-chan *model.TestSuite, error)
+	TestCaseUpdated(ctx context.Context, suiteID string) (<-chan *model.TestCase, error)
+	SuiteActivity(ctx context.Context, suiteID string) (<-chan *model.SuiteActivity, error)
+}
+type TestCaseResolver interface {
+	StatusHistory(ctx context.Context, obj *model.TestCase) ([]*model.StatusHistory, error)
 }
 type TestGroupResolver interface {
 	Cases(ctx context.Context, obj *model.TestGroup) ([]*model.TestCase, error)
@@ -238,6 +369,111 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.AuthPayload.User(childComplexity), true
 
+	case "EffortRecord.comment":
+		if e.complexity.EffortRecord.Comment == nil {
+			break
+		}
+
+		return e.complexity.EffortRecord.Comment(childComplexity), true
+
+	case "EffortRecord.createdAt":
+		if e.complexity.EffortRecord.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.EffortRecord.CreatedAt(childComplexity), true
+
+	case "EffortRecord.effortAmount":
+		if e.complexity.EffortRecord.EffortAmount == nil {
+			break
+		}
+
+		return e.complexity.EffortRecord.EffortAmount(childComplexity), true
+
+	case "EffortRecord.id":
+		if e.complexity.EffortRecord.ID == nil {
+			break
+		}
+
+		return e.complexity.EffortRecord.ID(childComplexity), true
+
+	case "EffortRecord.isAdditional":
+		if e.complexity.EffortRecord.IsAdditional == nil {
+			break
+		}
+
+		return e.complexity.EffortRecord.IsAdditional(childComplexity), true
+
+	case "EffortRecord.recordDate":
+		if e.complexity.EffortRecord.RecordDate == nil {
+			break
+		}
+
+		return e.complexity.EffortRecord.RecordDate(childComplexity), true
+
+	case "EffortRecord.recordedBy":
+		if e.complexity.EffortRecord.RecordedBy == nil {
+			break
+		}
+
+		return e.complexity.EffortRecord.RecordedBy(childComplexity), true
+
+	case "EffortRecord.testCaseId":
+		if e.complexity.EffortRecord.TestCaseID == nil {
+			break
+		}
+
+		return e.complexity.EffortRecord.TestCaseID(childComplexity), true
+
+	case "EffortRecordList.records":
+		if e.complexity.EffortRecordList.Records == nil {
+			break
+		}
+
+		return e.complexity.EffortRecordList.Records(childComplexity), true
+
+	case "EffortRecordList.totalEffort":
+		if e.complexity.EffortRecordList.TotalEffort == nil {
+			break
+		}
+
+		return e.complexity.EffortRecordList.TotalEffort(childComplexity), true
+
+	case "ExitCriteria.allowOpenCritical":
+		if e.complexity.ExitCriteria.AllowOpenCritical == nil {
+			break
+		}
+
+		return e.complexity.ExitCriteria.AllowOpenCritical(childComplexity), true
+
+	case "ExitCriteria.minCompletionRate":
+		if e.complexity.ExitCriteria.MinCompletionRate == nil {
+			break
+		}
+
+		return e.complexity.ExitCriteria.MinCompletionRate(childComplexity), true
+
+	case "ExitCriteriaOverride.overriddenAt":
+		if e.complexity.ExitCriteriaOverride.OverriddenAt == nil {
+			break
+		}
+
+		return e.complexity.ExitCriteriaOverride.OverriddenAt(childComplexity), true
+
+	case "ExitCriteriaOverride.overriddenBy":
+		if e.complexity.ExitCriteriaOverride.OverriddenBy == nil {
+			break
+		}
+
+		return e.complexity.ExitCriteriaOverride.OverriddenBy(childComplexity), true
+
+	case "ExitCriteriaOverride.reason":
+		if e.complexity.ExitCriteriaOverride.Reason == nil {
+			break
+		}
+
+		return e.complexity.ExitCriteriaOverride.Reason(childComplexity), true
+
 	case "Mutation.changePassword":
 		if e.complexity.Mutation.ChangePassword == nil {
 			break
@@ -250,6 +486,42 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.ChangePassword(childComplexity, args["oldPassword"].(string), args["newPassword"].(string)), true
 
+	case "Mutation.correctEffortRecord":
+		if e.complexity.Mutation.CorrectEffortRecord == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_correctEffortRecord_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CorrectEffortRecord(childComplexity, args["id"].(string), args["input"].(model.CorrectEffortRecordInput)), true
+
+	case "Mutation.createTestCase":
+		if e.complexity.Mutation.CreateTestCase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createTestCase_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateTestCase(childComplexity, args["input"].(model.CreateTestCaseInput)), true
+
+	case "Mutation.createTestGroup":
+		if e.complexity.Mutation.CreateTestGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createTestGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateTestGroup(childComplexity, args["input"].(model.CreateTestGroupInput)), true
+
 	case "Mutation.createTestSuite":
 		if e.complexity.Mutation.CreateTestSuite == nil {
 			break
@@ -274,6 +546,42 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.CreateUser(childComplexity, args["input"].(model.CreateUserInput)), true
 
+	case "Mutation.deleteTestCase":
+		if e.complexity.Mutation.DeleteTestCase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTestCase_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTestCase(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteTestGroup":
+		if e.complexity.Mutation.DeleteTestGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTestGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTestGroup(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteTestSuite":
+		if e.complexity.Mutation.DeleteTestSuite == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTestSuite_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTestSuite(childComplexity, args["id"].(string)), true
+
 	case "Mutation.deleteUser":
 		if e.complexity.Mutation.DeleteUser == nil {
 			break
@@ -286,6 +594,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.DeleteUser(childComplexity, args["userId"].(string)), true
 
+	case "Mutation.forceUnlockTestCase":
+		if e.complexity.Mutation.ForceUnlockTestCase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_forceUnlockTestCase_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ForceUnlockTestCase(childComplexity, args["id"].(string)), true
+
+	case "Mutation.lockTestCase":
+		if e.complexity.Mutation.LockTestCase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_lockTestCase_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LockTestCase(childComplexity, args["id"].(string)), true
+
 	case "Mutation.login":
 		if e.complexity.Mutation.Login == nil {
 			break
@@ -310,6 +642,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.Logout(childComplexity, args["refreshToken"].(string)), true
 
+	case "Mutation.moveTestCase":
+		if e.complexity.Mutation.MoveTestCase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_moveTestCase_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MoveTestCase(childComplexity, args["id"].(string), args["targetGroupId"].(string)), true
+
+	case "Mutation.recordEffort":
+		if e.complexity.Mutation.RecordEffort == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_recordEffort_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RecordEffort(childComplexity, args["input"].(model.RecordEffortInput)), true
+
 	case "Mutation.refreshToken":
 		if e.complexity.Mutation.RefreshToken == nil {
 			break
@@ -322,6 +678,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.RefreshToken(childComplexity, args["refreshToken"].(string)), true
 
+	case "Mutation.renewTestCaseLock":
+		if e.complexity.Mutation.RenewTestCaseLock == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_renewTestCaseLock_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RenewTestCaseLock(childComplexity, args["id"].(string)), true
+
+	case "Mutation.reorderTestGroups":
+		if e.complexity.Mutation.ReorderTestGroups == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_reorderTestGroups_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReorderTestGroups(childComplexity, args["suiteId"].(string), args["groupIds"].([]string)), true
+
 	case "Mutation.resetPassword":
 		if e.complexity.Mutation.ResetPassword == nil {
 			break
@@ -334,6 +714,90 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.ResetPassword(childComplexity, args["userId"].(string), args["newPassword"].(string)), true
 
+	case "Mutation.restoreTestCase":
+		if e.complexity.Mutation.RestoreTestCase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreTestCase_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreTestCase(childComplexity, args["id"].(string)), true
+
+	case "Mutation.restoreTestGroup":
+		if e.complexity.Mutation.RestoreTestGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreTestGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreTestGroup(childComplexity, args["id"].(string)), true
+
+	case "Mutation.restoreTestSuite":
+		if e.complexity.Mutation.RestoreTestSuite == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreTestSuite_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreTestSuite(childComplexity, args["id"].(string)), true
+
+	case "Mutation.unlockTestCase":
+		if e.complexity.Mutation.UnlockTestCase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unlockTestCase_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnlockTestCase(childComplexity, args["id"].(string)), true
+
+	case "Mutation.updateTestCase":
+		if e.complexity.Mutation.UpdateTestCase == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateTestCase_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTestCase(childComplexity, args["id"].(string), args["input"].(model.UpdateTestCaseInput)), true
+
+	case "Mutation.updateTestCaseStatus":
+		if e.complexity.Mutation.UpdateTestCaseStatus == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateTestCaseStatus_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTestCaseStatus(childComplexity, args["id"].(string), args["status"].(model.TestStatus), args["reason"].(*string), args["expectedVersion"].(*int)), true
+
+	case "Mutation.updateTestGroup":
+		if e.complexity.Mutation.UpdateTestGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateTestGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTestGroup(childComplexity, args["id"].(string), args["input"].(model.UpdateTestGroupInput)), true
+
 	case "Mutation.updateTestSuite":
 		if e.complexity.Mutation.UpdateTestSuite == nil {
 			break
@@ -356,7 +820,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateTestSuiteStatus(childComplexity, args["id"].(string), args["status"].(model.SuiteStatus)), true
+		return e.complexity.Mutation.UpdateTestSuiteStatus(childComplexity, args["id"].(string), args["status"].(model.SuiteStatus), args["overrideReason"].(*string)), true
 
 	case "Mutation.updateUser":
 		if e.complexity.Mutation.UpdateUser == nil {
@@ -405,6 +869,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.AdminData(childComplexity), true
 
+	case "Query.effortRecords":
+		if e.complexity.Query.EffortRecords == nil {
+			break
+		}
+
+		args, err := ec.field_Query_effortRecords_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EffortRecords(childComplexity, args["testCaseId"].(string)), true
+
+	case "Query.effortRecordsByUser":
+		if e.complexity.Query.EffortRecordsByUser == nil {
+			break
+		}
+
+		args, err := ec.field_Query_effortRecordsByUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EffortRecordsByUser(childComplexity, args["userId"].(string), args["date"].(time.Time)), true
+
 	case "Query.managerData":
 		if e.complexity.Query.ManagerData == nil {
 			break
@@ -419,37 +907,92 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Me(childComplexity), true
 
-	case "Query.testSuite":
-		if e.complexity.Query.TestSuite == nil {
+	case "Query.node":
+		if e.complexity.Query.Node == nil {
 			break
 		}
 
-		args, err := ec.field_Query_testSuite_args(ctx, rawArgs)
+		args, err := ec.field_Query_node_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.TestSuite(childComplexity, args["id"].(string)), true
+		return e.complexity.Query.Node(childComplexity, args["id"].(string)), true
 
-	case "Query.testSuites":
-		if e.complexity.Query.TestSuites == nil {
+	case "Query.nodes":
+		if e.complexity.Query.Nodes == nil {
 			break
 		}
 
-		args, err := ec.field_Query_testSuites_args(ctx, rawArgs)
+		args, err := ec.field_Query_nodes_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.TestSuites(childComplexity, args["status"].(*model.SuiteStatus), args["page"].(*int), args["pageSize"].(*int)), true
+		return e.complexity.Query.Nodes(childComplexity, args["ids"].([]string)), true
 
-	case "Query.testerData":
-		if e.complexity.Query.TesterData == nil {
+	case "Query.testCase":
+		if e.complexity.Query.TestCase == nil {
 			break
 		}
 
-		return e.complexity.Query.TesterData(childComplexity), true
-
+		args, err := ec.field_Query_testCase_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TestCase(childComplexity, args["id"].(string)), true
+
+	case "Query.testGroup":
+		if e.complexity.Query.TestGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Query_testGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TestGroup(childComplexity, args["id"].(string)), true
+
+	case "Query.testSuite":
+		if e.complexity.Query.TestSuite == nil {
+			break
+		}
+
+		args, err := ec.field_Query_testSuite_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TestSuite(childComplexity, args["id"].(string)), true
+
+	case "Query.testSuites":
+		if e.complexity.Query.TestSuites == nil {
+			break
+		}
+
+		args, err := ec.field_Query_testSuites_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TestSuites(childComplexity, args["status"].(*model.SuiteStatus), args["filter"].(*model.TestSuiteFilter), args["orderBy"].([]*model.TestSuiteOrder), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string), args["page"].(*int), args["pageSize"].(*int)), true
+
+	case "Query.testerData":
+		if e.complexity.Query.TesterData == nil {
+			break
+		}
+
+		return e.complexity.Query.TesterData(childComplexity), true
+
+	case "Query.trash":
+		if e.complexity.Query.Trash == nil {
+			break
+		}
+
+		return e.complexity.Query.Trash(childComplexity), true
+
 	case "Query.user":
 		if e.complexity.Query.User == nil {
 			break
@@ -469,12 +1012,132 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Users(childComplexity), true
 
+	case "StatusHistory.changedAt":
+		if e.complexity.StatusHistory.ChangedAt == nil {
+			break
+		}
+
+		return e.complexity.StatusHistory.ChangedAt(childComplexity), true
+
+	case "StatusHistory.changedBy":
+		if e.complexity.StatusHistory.ChangedBy == nil {
+			break
+		}
+
+		return e.complexity.StatusHistory.ChangedBy(childComplexity), true
+
+	case "StatusHistory.id":
+		if e.complexity.StatusHistory.ID == nil {
+			break
+		}
+
+		return e.complexity.StatusHistory.ID(childComplexity), true
+
+	case "StatusHistory.newStatus":
+		if e.complexity.StatusHistory.NewStatus == nil {
+			break
+		}
+
+		return e.complexity.StatusHistory.NewStatus(childComplexity), true
+
+	case "StatusHistory.oldStatus":
+		if e.complexity.StatusHistory.OldStatus == nil {
+			break
+		}
+
+		return e.complexity.StatusHistory.OldStatus(childComplexity), true
+
+	case "StatusHistory.reason":
+		if e.complexity.StatusHistory.Reason == nil {
+			break
+		}
+
+		return e.complexity.StatusHistory.Reason(childComplexity), true
+
+	case "Subscription.suiteActivity":
+		if e.complexity.Subscription.SuiteActivity == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_suiteActivity_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.SuiteActivity(childComplexity, args["suiteId"].(string)), true
+
+	case "Subscription.testCaseUpdated":
+		if e.complexity.Subscription.TestCaseUpdated == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_testCaseUpdated_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.TestCaseUpdated(childComplexity, args["suiteId"].(string)), true
+
 	case "Subscription.testSuiteStatusChanged":
 		if e.complexity.Subscription.TestSuiteStatusChanged == nil {
 			break
 		}
 
-		return e.complexity.Subscription.TestSuiteStatusChanged(childComplexity), true
+		args, err := ec.field_Subscription_testSuiteStatusChanged_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.TestSuiteStatusChanged(childComplexity, args["id"].(string)), true
+
+	case "SuiteActivity.actorId":
+		if e.complexity.SuiteActivity.ActorID == nil {
+			break
+		}
+
+		return e.complexity.SuiteActivity.ActorID(childComplexity), true
+
+	case "SuiteActivity.entityId":
+		if e.complexity.SuiteActivity.EntityID == nil {
+			break
+		}
+
+		return e.complexity.SuiteActivity.EntityID(childComplexity), true
+
+	case "SuiteActivity.entityType":
+		if e.complexity.SuiteActivity.EntityType == nil {
+			break
+		}
+
+		return e.complexity.SuiteActivity.EntityType(childComplexity), true
+
+	case "SuiteActivity.occurredAt":
+		if e.complexity.SuiteActivity.OccurredAt == nil {
+			break
+		}
+
+		return e.complexity.SuiteActivity.OccurredAt(childComplexity), true
+
+	case "SuiteActivity.suiteId":
+		if e.complexity.SuiteActivity.SuiteID == nil {
+			break
+		}
+
+		return e.complexity.SuiteActivity.SuiteID(childComplexity), true
+
+	case "SuiteActivity.testCaseId":
+		if e.complexity.SuiteActivity.TestCaseID == nil {
+			break
+		}
+
+		return e.complexity.SuiteActivity.TestCaseID(childComplexity), true
+
+	case "SuiteActivity.type":
+		if e.complexity.SuiteActivity.Type == nil {
+			break
+		}
+
+		return e.complexity.SuiteActivity.Type(childComplexity), true
 
 	case "TestCase.actualEffort":
 		if e.complexity.TestCase.ActualEffort == nil {
@@ -497,6 +1160,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestCase.DelayDays(childComplexity), true
 
+	case "TestCase.deletedAt":
+		if e.complexity.TestCase.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.TestCase.DeletedAt(childComplexity), true
+
 	case "TestCase.description":
 		if e.complexity.TestCase.Description == nil {
 			break
@@ -504,19 +1174,33 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestCase.Description(childComplexity), true
 
+	case "TestCase.dueDate":
+		if e.complexity.TestCase.DueDate == nil {
+			break
+		}
+
+		return e.complexity.TestCase.DueDate(childComplexity), true
+
 	case "TestCase.groupId":
-		if e.complexity.TestCase.GroupID == nil {
+		if e.complexity.TestCase.GlobalGroupID == nil {
 			break
 		}
 
-		return e.complexity.TestCase.GroupID(childComplexity), true
+		return e.complexity.TestCase.GlobalGroupID(childComplexity), true
 
 	case "TestCase.id":
-		if e.complexity.TestCase.ID == nil {
+		if e.complexity.TestCase.GlobalID == nil {
+			break
+		}
+
+		return e.complexity.TestCase.GlobalID(childComplexity), true
+
+	case "TestCase.lockedBy":
+		if e.complexity.TestCase.GlobalLockedBy == nil {
 			break
 		}
 
-		return e.complexity.TestCase.ID(childComplexity), true
+		return e.complexity.TestCase.GlobalLockedBy(childComplexity), true
 
 	case "TestCase.isDelayed":
 		if e.complexity.TestCase.IsDelayed == nil {
@@ -525,6 +1209,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestCase.IsDelayed(childComplexity), true
 
+	case "TestCase.lockExpiresAt":
+		if e.complexity.TestCase.LockExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.TestCase.LockExpiresAt(childComplexity), true
+
 	case "TestCase.plannedEffort":
 		if e.complexity.TestCase.PlannedEffort == nil {
 			break
@@ -546,6 +1237,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestCase.Status(childComplexity), true
 
+	case "TestCase.statusHistory":
+		if e.complexity.TestCase.StatusHistory == nil {
+			break
+		}
+
+		return e.complexity.TestCase.StatusHistory(childComplexity), true
+
 	case "TestCase.title":
 		if e.complexity.TestCase.Title == nil {
 			break
@@ -560,6 +1258,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestCase.UpdatedAt(childComplexity), true
 
+	case "TestCase.version":
+		if e.complexity.TestCase.Version == nil {
+			break
+		}
+
+		return e.complexity.TestCase.Version(childComplexity), true
+
 	case "TestGroup.cases":
 		if e.complexity.TestGroup.Cases == nil {
 			break
@@ -567,6 +1272,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestGroup.Cases(childComplexity), true
 
+	case "TestGroup.completedCaseCount":
+		if e.complexity.TestGroup.CompletedCaseCount == nil {
+			break
+		}
+
+		return e.complexity.TestGroup.CompletedCaseCount(childComplexity), true
+
 	case "TestGroup.createdAt":
 		if e.complexity.TestGroup.CreatedAt == nil {
 			break
@@ -574,6 +1286,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestGroup.CreatedAt(childComplexity), true
 
+	case "TestGroup.deletedAt":
+		if e.complexity.TestGroup.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.TestGroup.DeletedAt(childComplexity), true
+
 	case "TestGroup.description":
 		if e.complexity.TestGroup.Description == nil {
 			break
@@ -589,11 +1308,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		return e.complexity.TestGroup.DisplayOrder(childComplexity), true
 
 	case "TestGroup.id":
-		if e.complexity.TestGroup.ID == nil {
+		if e.complexity.TestGroup.GlobalID == nil {
+			break
+		}
+
+		return e.complexity.TestGroup.GlobalID(childComplexity), true
+
+	case "TestGroup.suiteId":
+		if e.complexity.TestGroup.GlobalSuiteID == nil {
 			break
 		}
 
-		return e.complexity.TestGroup.ID(childComplexity), true
+		return e.complexity.TestGroup.GlobalSuiteID(childComplexity), true
 
 	case "TestGroup.name":
 		if e.complexity.TestGroup.Name == nil {
@@ -602,6 +1328,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestGroup.Name(childComplexity), true
 
+	case "TestGroup.progress":
+		if e.complexity.TestGroup.Progress == nil {
+			break
+		}
+
+		return e.complexity.TestGroup.Progress(childComplexity), true
+
 	case "TestGroup.status":
 		if e.complexity.TestGroup.Status == nil {
 			break
@@ -609,12 +1342,19 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestGroup.Status(childComplexity), true
 
-	case "TestGroup.suiteId":
-		if e.complexity.TestGroup.SuiteID == nil {
+	case "TestGroup.statusLocked":
+		if e.complexity.TestGroup.StatusLocked == nil {
+			break
+		}
+
+		return e.complexity.TestGroup.StatusLocked(childComplexity), true
+
+	case "TestGroup.totalCaseCount":
+		if e.complexity.TestGroup.TotalCaseCount == nil {
 			break
 		}
 
-		return e.complexity.TestGroup.SuiteID(childComplexity), true
+		return e.complexity.TestGroup.TotalCaseCount(childComplexity), true
 
 	case "TestGroup.updatedAt":
 		if e.complexity.TestGroup.UpdatedAt == nil {
@@ -623,6 +1363,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestGroup.UpdatedAt(childComplexity), true
 
+	case "TestGroup.version":
+		if e.complexity.TestGroup.Version == nil {
+			break
+		}
+
+		return e.complexity.TestGroup.Version(childComplexity), true
+
+	case "TestSuite.completedCaseCount":
+		if e.complexity.TestSuite.CompletedCaseCount == nil {
+			break
+		}
+
+		return e.complexity.TestSuite.CompletedCaseCount(childComplexity), true
+
 	case "TestSuite.createdAt":
 		if e.complexity.TestSuite.CreatedAt == nil {
 			break
@@ -630,6 +1384,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestSuite.CreatedAt(childComplexity), true
 
+	case "TestSuite.deletedAt":
+		if e.complexity.TestSuite.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.TestSuite.DeletedAt(childComplexity), true
+
 	case "TestSuite.description":
 		if e.complexity.TestSuite.Description == nil {
 			break
@@ -651,19 +1412,40 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestSuite.EstimatedStartDate(childComplexity), true
 
-	case "TestSuite.groups":
-		if e.complexity.TestSuite.Groups == nil {
+	case "TestSuite.exitCriteria":
+		if e.complexity.TestSuite.ExitCriteria == nil {
 			break
 		}
 
-		return e.complexity.TestSuite.Groups(childComplexity), true
+		return e.complexity.TestSuite.ExitCriteria(childComplexity), true
+
+	case "TestSuite.exitCriteriaOverride":
+		if e.complexity.TestSuite.ExitCriteriaOverride == nil {
+			break
+		}
+
+		return e.complexity.TestSuite.ExitCriteriaOverride(childComplexity), true
+
+	case "TestSuite.createdBy":
+		if e.complexity.TestSuite.GlobalCreatedBy == nil {
+			break
+		}
+
+		return e.complexity.TestSuite.GlobalCreatedBy(childComplexity), true
 
 	case "TestSuite.id":
-		if e.complexity.TestSuite.ID == nil {
+		if e.complexity.TestSuite.GlobalID == nil {
+			break
+		}
+
+		return e.complexity.TestSuite.GlobalID(childComplexity), true
+
+	case "TestSuite.groups":
+		if e.complexity.TestSuite.Groups == nil {
 			break
 		}
 
-		return e.complexity.TestSuite.ID(childComplexity), true
+		return e.complexity.TestSuite.Groups(childComplexity), true
 
 	case "TestSuite.name":
 		if e.complexity.TestSuite.Name == nil {
@@ -693,6 +1475,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestSuite.Status(childComplexity), true
 
+	case "TestSuite.totalCaseCount":
+		if e.complexity.TestSuite.TotalCaseCount == nil {
+			break
+		}
+
+		return e.complexity.TestSuite.TotalCaseCount(childComplexity), true
+
 	case "TestSuite.updatedAt":
 		if e.complexity.TestSuite.UpdatedAt == nil {
 			break
@@ -700,6 +1489,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestSuite.UpdatedAt(childComplexity), true
 
+	case "TestSuite.version":
+		if e.complexity.TestSuite.Version == nil {
+			break
+		}
+
+		return e.complexity.TestSuite.Version(childComplexity), true
+
 	case "TestSuiteConnection.edges":
 		if e.complexity.TestSuiteConnection.Edges == nil {
 			break
@@ -735,6 +1531,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestSuiteEdge.Node(childComplexity), true
 
+	case "Trash.testCases":
+		if e.complexity.Trash.TestCases == nil {
+			break
+		}
+
+		return e.complexity.Trash.TestCases(childComplexity), true
+
+	case "Trash.testGroups":
+		if e.complexity.Trash.TestGroups == nil {
+			break
+		}
+
+		return e.complexity.Trash.TestGroups(childComplexity), true
+
+	case "Trash.testSuites":
+		if e.complexity.Trash.TestSuites == nil {
+			break
+		}
+
+		return e.complexity.Trash.TestSuites(childComplexity), true
+
 	case "User.createdAt":
 		if e.complexity.User.CreatedAt == nil {
 			break
@@ -743,11 +1560,11 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		return e.complexity.User.CreatedAt(childComplexity), true
 
 	case "User.id":
-		if e.complexity.User.ID == nil {
+		if e.complexity.User.GlobalID == nil {
 			break
 		}
 
-		return e.complexity.User.ID(childComplexity), true
+		return e.complexity.User.GlobalID(childComplexity), true
 
 	case "User.lastLoginAt":
 		if e.complexity.User.LastLoginAt == nil {
@@ -785,8 +1602,17 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputCorrectEffortRecordInput,
+		ec.unmarshalInputCreateTestCaseInput,
+		ec.unmarshalInputCreateTestGroupInput,
 		ec.unmarshalInputCreateTestSuiteInput,
 		ec.unmarshalInputCreateUserInput,
+		ec.unmarshalInputExitCriteriaInput,
+		ec.unmarshalInputRecordEffortInput,
+		ec.unmarshalInputTestSuiteFilter,
+		ec.unmarshalInputTestSuiteOrder,
+		ec.unmarshalInputUpdateTestCaseInput,
+		ec.unmarshalInputUpdateTestGroupInput,
 		ec.unmarshalInputUpdateTestSuiteInput,
 		ec.unmarshalInputUpdateUserInput,
 	)
@@ -905,7 +1731,7 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 var sources = []*ast.Source{
 	{Name: "../schema/schema.graphqls", Input: `scalar DateTime
 
-type TestSuite {
+type TestSuite implements Node {
   id: ID!
   name: String!
   description: String
@@ -913,25 +1739,62 @@ type TestSuite {
   estimatedStartDate: DateTime!
   estimatedEndDate: DateTime!
   requireEffortComment: Boolean!
+  # 完了（COMPLETED）への遷移時に評価する完了条件
+  exitCriteria: ExitCriteria!
+  # 完了条件を満たさずにAdminが完了にした場合の記録
+  exitCriteriaOverride: ExitCriteriaOverride
+  # 配下のテストケースの状態と優先度から算出した進捗率（0〜100）
   progress: Float!
+  completedCaseCount: Int!
+  totalCaseCount: Int!
+  # 楽観的排他制御のバージョン（更新時にexpectedVersionとして指定する）
+  version: Int!
+  # 作成したユーザーのグローバルID（不明な場合はnull）
+  createdBy: ID
   createdAt: DateTime!
   updatedAt: DateTime!
+  # ゴミ箱に移動した日時（ゴミ箱にない場合はnull）
+  deletedAt: DateTime
   groups: [TestGroup!]
 }
 
-type TestGroup {
+# テストスイートの完了条件
+type ExitCriteria {
+  # 完了に必要なテストケースの完了率（0〜100、件数ベース）
+  minCompletionRate: Float!
+  # 未完了のCriticalケースが残っていても完了を許可するか
+  allowOpenCritical: Boolean!
+}
+
+type ExitCriteriaOverride {
+  reason: String!
+  # 完了にしたユーザーのID（グローバルIDではなくエンティティのID）
+  overriddenBy: ID!
+  overriddenAt: DateTime!
+}
+
+type TestGroup implements Node {
   id: ID!
   name: String!
   description: String
   displayOrder: Int!
+  # 所属するテストスイートのグローバルID
   suiteId: ID!
+  # 固定されていない場合は配下のテストケースの状態から自動的に算出される
   status: SuiteStatus!
+  statusLocked: Boolean!
+  # 配下のテストケースの状態と優先度から算出した進捗率（0〜100）
+  progress: Float!
+  completedCaseCount: Int!
+  totalCaseCount: Int!
+  version: Int!
   createdAt: DateTime!
   updatedAt: DateTime!
+  deletedAt: DateTime
   cases: [TestCase!]
 }
 
-type TestCase {
+type TestCase implements Node {
   id: ID!
   title: String!
   description: String
@@ -939,11 +1802,31 @@ type TestCase {
   priority: Priority!
   plannedEffort: Float
   actualEffort: Float
+  # テストケース個別の期限（未設定の場合はスイートの終了予定日で遅延を判定）
+  dueDate: DateTime
   isDelayed: Boolean!
   delayDays: Int
+  # 所属するテストグループのグローバルID
   groupId: ID!
+  # 編集ロックを保持しているユーザーのグローバルID（ロックされていない、または期限切れの場合はnull）
+  lockedBy: ID
+  # 編集ロックの有効期限
+  lockExpiresAt: DateTime
+  version: Int!
   createdAt: DateTime!
   updatedAt: DateTime!
+  deletedAt: DateTime
+  statusHistory: [StatusHistory!]!
+}
+
+type StatusHistory {
+  id: ID!
+  oldStatus: TestStatus!
+  newStatus: TestStatus!
+  changedAt: DateTime!
+  # 変更したユーザーのID（グローバルIDではなくエンティティのID）
+  changedBy: ID!
+  reason: String
 }
 
 enum SuiteStatus {
@@ -977,6 +1860,40 @@ type PageInfo {
   endCursor: String
 }
 
+# テストスイート一覧の絞り込み条件（指定した条件をすべて満たすスイートを返す）
+input TestSuiteFilter {
+  # 名前または説明に含まれる文字列（大文字・小文字は区別しない）
+  search: String
+  createdBy: ID
+  # 予定期間がこの範囲と重なるスイートを返す
+  periodFrom: DateTime
+  periodTo: DateTime
+  # 進捗率（0〜100）の範囲（境界の値を含む）
+  minProgress: Float
+  maxProgress: Float
+  # 遅延しているテストケースの有無
+  hasDelayedCases: Boolean
+}
+
+enum TestSuiteSortField {
+  NAME
+  ESTIMATED_START_DATE
+  ESTIMATED_END_DATE
+  PROGRESS
+  UPDATED_AT
+  CREATED_AT
+}
+
+enum SortDirection {
+  ASC
+  DESC
+}
+
+input TestSuiteOrder {
+  field: TestSuiteSortField!
+  direction: SortDirection = ASC
+}
+
 type TestSuiteConnection {
   edges: [TestSuiteEdge!]!
   pageInfo: PageInfo!
@@ -994,6 +1911,49 @@ input CreateTestSuiteInput {
   estimatedStartDate: DateTime!
   estimatedEndDate: DateTime!
   requireEffortComment: Boolean
+  # 省略時は「全ケース完了・Critical残存不可」
+  exitCriteria: ExitCriteriaInput
+}
+
+input ExitCriteriaInput {
+  minCompletionRate: Float!
+  allowOpenCritical: Boolean!
+}
+
+input CreateTestGroupInput {
+  suiteId: ID!
+  name: String!
+  description: String
+  displayOrder: Int
+}
+
+input UpdateTestGroupInput {
+  name: String
+  description: String
+  # 指定した場合、ステータスをこの値に固定する
+  status: SuiteStatus
+  # falseを指定すると固定を解除し、配下のテストケースから再計算する
+  statusLocked: Boolean
+  # 指定した場合、現在のバージョンと一致しなければCONFLICTエラーとなる
+  expectedVersion: Int
+}
+
+input CreateTestCaseInput {
+  groupId: ID!
+  title: String!
+  description: String
+  priority: Priority
+  plannedEffort: Float
+  dueDate: DateTime
+}
+
+input UpdateTestCaseInput {
+  title: String
+  description: String
+  priority: Priority
+  plannedEffort: Float
+  dueDate: DateTime
+  expectedVersion: Int
 }
 
 input UpdateTestSuiteInput {
@@ -1002,28 +1962,106 @@ input UpdateTestSuiteInput {
   estimatedStartDate: DateTime
   estimatedEndDate: DateTime
   requireEffortComment: Boolean
+  exitCriteria: ExitCriteriaInput
+  expectedVersion: Int
+}
+
+# ゴミ箱の内容（復元できる単位で、スイートごと削除されたグループ・ケースは含まない）
+type Trash {
+  testSuites: [TestSuite!]!
+  testGroups: [TestGroup!]!
+  testCases: [TestCase!]!
 }
 
 type Query {
   testSuite(id: ID!): TestSuite
-  testSuites(status: SuiteStatus, page: Int, pageSize: Int): TestSuiteConnection!
+  # orderByの順（省略時は作成日時の新しい順）に返す（first/afterで先頭側から、last/beforeで末尾側から取得する）
+  # カーソルは同じorderByを指定した場合のみ使用できる
+  testSuites(
+    status: SuiteStatus
+    filter: TestSuiteFilter
+    orderBy: [TestSuiteOrder!]
+    first: Int
+    after: String
+    last: Int
+    before: String
+    page: Int @deprecated(reason: "重複や欠落が発生するため、first/afterを使用してください")
+    pageSize: Int @deprecated(reason: "重複や欠落が発生するため、first/afterを使用してください")
+  ): TestSuiteConnection!
+  testGroup(id: ID!): TestGroup
+  testCase(id: ID!): TestCase
+  trash: Trash! @auth
 }
 
 type Mutation {
   createTestSuite(input: CreateTestSuiteInput!): TestSuite!
   updateTestSuite(id: ID!, input: UpdateTestSuiteInput!): TestSuite!
-  updateTestSuiteStatus(id: ID!, status: SuiteStatus!): TestSuite!
+  # 完了条件を満たさない状態でCOMPLETEDにする場合、AdminがoverrideReasonを指定する
+  updateTestSuiteStatus(id: ID!, status: SuiteStatus!, overrideReason: String): TestSuite!
+  deleteTestSuite(id: ID!): Boolean! @auth
+  restoreTestSuite(id: ID!): TestSuite! @auth
+  createTestGroup(input: CreateTestGroupInput!): TestGroup! @auth
+  updateTestGroup(id: ID!, input: UpdateTestGroupInput!): TestGroup! @auth
+  deleteTestGroup(id: ID!): Boolean! @auth
+  restoreTestGroup(id: ID!): TestGroup! @auth
+  reorderTestGroups(suiteId: ID!, groupIds: [ID!]!): [TestGroup!]! @auth
+  createTestCase(input: CreateTestCaseInput!): TestCase! @auth
+  updateTestCase(id: ID!, input: UpdateTestCaseInput!): TestCase! @auth
+  deleteTestCase(id: ID!): Boolean! @auth
+  restoreTestCase(id: ID!): TestCase! @auth
+  moveTestCase(id: ID!, targetGroupId: ID!): TestCase! @auth
+  updateTestCaseStatus(id: ID!, status: TestStatus!, reason: String, expectedVersion: Int): TestCase! @auth
+  lockTestCase(id: ID!): TestCase! @auth
+  renewTestCaseLock(id: ID!): TestCase! @auth
+  unlockTestCase(id: ID!): TestCase! @auth
+  forceUnlockTestCase(id: ID!): TestCase! @auth
+}
+
+enum SuiteActivityType {
+  TEST_SUITE_CREATED
+  TEST_SUITE_UPDATED
+  TEST_SUITE_STATUS_CHANGED
+  TEST_SUITE_DELETED
+  TEST_SUITE_RESTORED
+  TEST_GROUP_CREATED
+  TEST_GROUP_UPDATED
+  TEST_GROUP_DELETED
+  TEST_GROUP_RESTORED
+  TEST_CASE_CREATED
+  TEST_CASE_UPDATED
+  TEST_CASE_DELETED
+  TEST_CASE_RESTORED
+  TEST_CASE_STATUS_CHANGED
+  TEST_CASE_DELAYED
+  EFFORT_RECORDED
+}
+
+# テストスイート配下で発生した操作の通知
+# Nodeではないため、各IDはグローバルIDではなくエンティティのID（TS001-202501など）を返す
+type SuiteActivity {
+  type: SuiteActivityType!
+  suiteId: ID!
+  # 操作対象のエンティティ種別（TestSuite / TestGroup / TestCase / EffortRecord）
+  entityType: String!
+  entityId: ID!
+  # 工数記録の場合の対象テストケース
+  testCaseId: ID
+  # 操作したユーザー（不明な場合はnull）
+  actorId: ID
+  occurredAt: DateTime!
 }
 
 type Subscription {
-  testSuiteStatusChanged: TestSuite!
+  testSuiteStatusChanged(id: ID!): TestSuite!
+  testCaseUpdated(suiteId: ID!): TestCase!
+  suiteActivity(suiteId: ID!): SuiteActivity!
 }`, BuiltIn: false},
 	{Name: "../schema/auth.graphqls", Input: `# ディレクティブの定義
 directive @auth on FIELD_DEFINITION
 directive @hasRole(role: String!) on FIELD_DEFINITION
 
 # User型の定義（認証関連）
-type User {
+type User implements Node {
   id: ID!
   username: String!
   role: String!
@@ -1112,7 +2150,79 @@ extend type Query {
 
 
 `, BuiltIn: false},
-}
+	{Name: "../schema/effort.graphqls", Input: `# internal/interface/graphql/schema/effort.graphqls
+
+# 工数記録
+# Nodeではないため、各IDはグローバルIDではなくエンティティのIDを返す
+type EffortRecord {
+  id: ID!
+  testCaseId: ID!
+  recordDate: DateTime!
+  effortAmount: Float!
+  isAdditional: Boolean!
+  comment: String
+  recordedBy: ID!
+  createdAt: DateTime!
+}
+
+# 工数記録一覧（合計工数付き）
+type EffortRecordList {
+  records: [EffortRecord!]!
+  totalEffort: Float!
+}
+
+# 工数記録入力（記録者は認証ユーザー、記録日の省略時は当日）
+input RecordEffortInput {
+  testCaseId: ID!
+  recordDate: DateTime
+  effortAmount: Float!
+  isAdditional: Boolean
+  comment: String
+}
+
+# 工数記録の訂正入力
+input CorrectEffortRecordInput {
+  effortAmount: Float!
+  comment: String
+}
+
+# 工数記録関連のクエリ
+extend type Query {
+  # テストケースごとの工数記録一覧
+  effortRecords(testCaseId: ID!): EffortRecordList! @auth
+
+  # ユーザーの日別工数記録一覧
+  effortRecordsByUser(userId: ID!, date: DateTime!): EffortRecordList! @auth
+}
+
+# 工数記録関連のミューテーション
+extend type Mutation {
+  # 工数の記録
+  recordEffort(input: RecordEffortInput!): EffortRecord! @auth
+
+  # 工数記録の訂正（記録者本人またはAdmin/Manager）
+  correctEffortRecord(id: ID!, input: CorrectEffortRecordInput!): EffortRecord! @auth
+}
+`, BuiltIn: false},
+	{Name: "../schema/node.graphqls", Input: `# internal/interface/graphql/schema/node.graphqls
+
+# Relayのグローバルオブジェクト識別
+# idは型名を前置したグローバルID（例: TestSuite:TS001-202501）で、node/nodesでどの型のオブジェクトも再取得できる
+# Nodeを実装する型が他のNodeを参照するID（suiteId・groupId・lockedBy・createdBy）もグローバルIDを返す
+interface Node {
+  id: ID!
+}
+
+extend type Query {
+  # グローバルIDを指定してオブジェクトを取得する（存在しない場合はnull）
+  # 各型の取得クエリ（testSuite・userなど）と同じ権限が必要
+  node(id: ID!): Node
+
+  # 複数のグローバルIDを指定してオブジェクトを取得する（idsと同じ順で、取得できないものはnull）
+  nodes(ids: [ID!]!): [Node]!
+}
+`, BuiltIn: false},
+}
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // endregion ************************** generated!.gotpl **************************
@@ -1198,223 +2308,246 @@ func (ec *executionContext) field_Mutation_changePassword_argsNewPassword(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_createTestSuite_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_correctEffortRecord_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_createTestSuite_argsInput(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_correctEffortRecord_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg0
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_correctEffortRecord_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_createTestSuite_argsInput(
+func (ec *executionContext) field_Mutation_correctEffortRecord_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (model.CreateTestSuiteInput, error) {
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_correctEffortRecord_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (model.CorrectEffortRecordInput, error) {
 	if _, ok := rawArgs["input"]; !ok {
-		var zeroVal model.CreateTestSuiteInput
+		var zeroVal model.CorrectEffortRecordInput
 		return zeroVal, nil
 	}
 
 	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
 	if tmp, ok := rawArgs["input"]; ok {
-		return ec.unmarshalNCreateTestSuiteInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateTestSuiteInput(ctx, tmp)
+		return ec.unmarshalNCorrectEffortRecordInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCorrectEffortRecordInput(ctx, tmp)
 	}
 
-	var zeroVal model.CreateTestSuiteInput
+	var zeroVal model.CorrectEffortRecordInput
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_createUser_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_createTestCase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_createUser_argsInput(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_createTestCase_argsInput(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["input"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_createUser_argsInput(
+func (ec *executionContext) field_Mutation_createTestCase_argsInput(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (model.CreateUserInput, error) {
+) (model.CreateTestCaseInput, error) {
 	if _, ok := rawArgs["input"]; !ok {
-		var zeroVal model.CreateUserInput
+		var zeroVal model.CreateTestCaseInput
 		return zeroVal, nil
 	}
 
 	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
 	if tmp, ok := rawArgs["input"]; ok {
-		return ec.unmarshalNCreateUserInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateUserInput(ctx, tmp)
+		return ec.unmarshalNCreateTestCaseInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateTestCaseInput(ctx, tmp)
 	}
 
-	var zeroVal model.CreateUserInput
+	var zeroVal model.CreateTestCaseInput
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_deleteUser_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_createTestGroup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_deleteUser_argsUserID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_createTestGroup_argsInput(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["userId"] = arg0
+	args["input"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_deleteUser_argsUserID(
+func (ec *executionContext) field_Mutation_createTestGroup_argsInput(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (string, error) {
-	if _, ok := rawArgs["userId"]; !ok {
-		var zeroVal string
+) (model.CreateTestGroupInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal model.CreateTestGroupInput
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-	if tmp, ok := rawArgs["userId"]; ok {
-		return ec.unmarshalNID2string(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNCreateTestGroupInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateTestGroupInput(ctx, tmp)
 	}
 
-	var zeroVal string
+	var zeroVal model.CreateTestGroupInput
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_login_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_createTestSuite_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_login_argsUsername(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["username"] = arg0
-	arg1, err := ec.field_Mutation_login_argsPassword(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_createTestSuite_argsInput(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["password"] = arg1
+	args["input"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_login_argsUsername(
+func (ec *executionContext) field_Mutation_createTestSuite_argsInput(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (string, error) {
-	if _, ok := rawArgs["username"]; !ok {
-		var zeroVal string
+) (model.CreateTestSuiteInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal model.CreateTestSuiteInput
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("username"))
-	if tmp, ok := rawArgs["username"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNCreateTestSuiteInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateTestSuiteInput(ctx, tmp)
 	}
 
-	var zeroVal string
+	var zeroVal model.CreateTestSuiteInput
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_login_argsPassword(
+func (ec *executionContext) field_Mutation_createUser_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_createUser_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_createUser_argsInput(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (string, error) {
-	if _, ok := rawArgs["password"]; !ok {
-		var zeroVal string
+) (model.CreateUserInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal model.CreateUserInput
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
-	if tmp, ok := rawArgs["password"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNCreateUserInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateUserInput(ctx, tmp)
 	}
 
-	var zeroVal string
+	var zeroVal model.CreateUserInput
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_logout_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_deleteTestCase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_logout_argsRefreshToken(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_deleteTestCase_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["refreshToken"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_logout_argsRefreshToken(
+func (ec *executionContext) field_Mutation_deleteTestCase_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["refreshToken"]; !ok {
+	if _, ok := rawArgs["id"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("refreshToken"))
-	if tmp, ok := rawArgs["refreshToken"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
 	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_refreshToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_deleteTestGroup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_refreshToken_argsRefreshToken(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_deleteTestGroup_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["refreshToken"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_refreshToken_argsRefreshToken(
+func (ec *executionContext) field_Mutation_deleteTestGroup_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["refreshToken"]; !ok {
+	if _, ok := rawArgs["id"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("refreshToken"))
-	if tmp, ok := rawArgs["refreshToken"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
 	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_resetPassword_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_deleteTestSuite_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_resetPassword_argsUserID(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["userId"] = arg0
-	arg1, err := ec.field_Mutation_resetPassword_argsNewPassword(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_deleteTestSuite_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["newPassword"] = arg1
+	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_resetPassword_argsUserID(
+func (ec *executionContext) field_Mutation_deleteTestSuite_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["userId"]; !ok {
+	if _, ok := rawArgs["id"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-	if tmp, ok := rawArgs["userId"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
 		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
@@ -1422,40 +2555,45 @@ func (ec *executionContext) field_Mutation_resetPassword_argsUserID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_resetPassword_argsNewPassword(
+func (ec *executionContext) field_Mutation_deleteUser_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_deleteUser_argsUserID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["userId"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_deleteUser_argsUserID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["newPassword"]; !ok {
+	if _, ok := rawArgs["userId"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("newPassword"))
-	if tmp, ok := rawArgs["newPassword"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+	if tmp, ok := rawArgs["userId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
 	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updateTestSuiteStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_forceUnlockTestCase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_updateTestSuiteStatus_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_forceUnlockTestCase_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := ec.field_Mutation_updateTestSuiteStatus_argsStatus(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["status"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_updateTestSuiteStatus_argsID(
+func (ec *executionContext) field_Mutation_forceUnlockTestCase_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
@@ -1473,101 +2611,139 @@ func (ec *executionContext) field_Mutation_updateTestSuiteStatus_argsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updateTestSuiteStatus_argsStatus(
+func (ec *executionContext) field_Mutation_lockTestCase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_lockTestCase_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_lockTestCase_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (model.SuiteStatus, error) {
-	if _, ok := rawArgs["status"]; !ok {
-		var zeroVal model.SuiteStatus
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-	if tmp, ok := rawArgs["status"]; ok {
-		return ec.unmarshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
-	var zeroVal model.SuiteStatus
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updateTestSuite_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_login_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_updateTestSuite_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_login_argsUsername(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
-	arg1, err := ec.field_Mutation_updateTestSuite_argsInput(ctx, rawArgs)
+	args["username"] = arg0
+	arg1, err := ec.field_Mutation_login_argsPassword(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg1
+	args["password"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_updateTestSuite_argsID(
+func (ec *executionContext) field_Mutation_login_argsUsername(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["id"]; !ok {
+	if _, ok := rawArgs["username"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-	if tmp, ok := rawArgs["id"]; ok {
-		return ec.unmarshalNID2string(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("username"))
+	if tmp, ok := rawArgs["username"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
 	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updateTestSuite_argsInput(
+func (ec *executionContext) field_Mutation_login_argsPassword(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (model.UpdateTestSuiteInput, error) {
-	if _, ok := rawArgs["input"]; !ok {
-		var zeroVal model.UpdateTestSuiteInput
+) (string, error) {
+	if _, ok := rawArgs["password"]; !ok {
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-	if tmp, ok := rawArgs["input"]; ok {
-		return ec.unmarshalNUpdateTestSuiteInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUpdateTestSuiteInput(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
+	if tmp, ok := rawArgs["password"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
-	var zeroVal model.UpdateTestSuiteInput
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updateUser_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_logout_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Mutation_updateUser_argsUserID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_logout_argsRefreshToken(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["userId"] = arg0
-	arg1, err := ec.field_Mutation_updateUser_argsInput(ctx, rawArgs)
+	args["refreshToken"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_logout_argsRefreshToken(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["refreshToken"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("refreshToken"))
+	if tmp, ok := rawArgs["refreshToken"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_moveTestCase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_moveTestCase_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["input"] = arg1
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_moveTestCase_argsTargetGroupID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["targetGroupId"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_updateUser_argsUserID(
+func (ec *executionContext) field_Mutation_moveTestCase_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["userId"]; !ok {
+	if _, ok := rawArgs["id"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-	if tmp, ok := rawArgs["userId"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
 		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
@@ -1575,45 +2751,73 @@ func (ec *executionContext) field_Mutation_updateUser_argsUserID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updateUser_argsInput(
+func (ec *executionContext) field_Mutation_moveTestCase_argsTargetGroupID(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (model.UpdateUserInput, error) {
+) (string, error) {
+	if _, ok := rawArgs["targetGroupId"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("targetGroupId"))
+	if tmp, ok := rawArgs["targetGroupId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_recordEffort_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_recordEffort_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_recordEffort_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (model.RecordEffortInput, error) {
 	if _, ok := rawArgs["input"]; !ok {
-		var zeroVal model.UpdateUserInput
+		var zeroVal model.RecordEffortInput
 		return zeroVal, nil
 	}
 
 	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
 	if tmp, ok := rawArgs["input"]; ok {
-		return ec.unmarshalNUpdateUserInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUpdateUserInput(ctx, tmp)
+		return ec.unmarshalNRecordEffortInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐRecordEffortInput(ctx, tmp)
 	}
 
-	var zeroVal model.UpdateUserInput
+	var zeroVal model.RecordEffortInput
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_refreshToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Query___type_argsName(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_refreshToken_argsRefreshToken(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["name"] = arg0
+	args["refreshToken"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query___type_argsName(
+func (ec *executionContext) field_Mutation_refreshToken_argsRefreshToken(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
-	if _, ok := rawArgs["name"]; !ok {
+	if _, ok := rawArgs["refreshToken"]; !ok {
 		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-	if tmp, ok := rawArgs["name"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("refreshToken"))
+	if tmp, ok := rawArgs["refreshToken"]; ok {
 		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
@@ -1621,17 +2825,17 @@ func (ec *executionContext) field_Query___type_argsName(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_testSuite_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_renewTestCaseLock_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Query_testSuite_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_renewTestCaseLock_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query_testSuite_argsID(
+func (ec *executionContext) field_Mutation_renewTestCaseLock_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
@@ -1649,91 +2853,119 @@ func (ec *executionContext) field_Query_testSuite_argsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_testSuites_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_reorderTestGroups_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Query_testSuites_argsStatus(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["status"] = arg0
-	arg1, err := ec.field_Query_testSuites_argsPage(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_reorderTestGroups_argsSuiteID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["page"] = arg1
-	arg2, err := ec.field_Query_testSuites_argsPageSize(ctx, rawArgs)
+	args["suiteId"] = arg0
+	arg1, err := ec.field_Mutation_reorderTestGroups_argsGroupIds(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["pageSize"] = arg2
+	args["groupIds"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Query_testSuites_argsStatus(
+func (ec *executionContext) field_Mutation_reorderTestGroups_argsSuiteID(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (*model.SuiteStatus, error) {
-	if _, ok := rawArgs["status"]; !ok {
-		var zeroVal *model.SuiteStatus
+) (string, error) {
+	if _, ok := rawArgs["suiteId"]; !ok {
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-	if tmp, ok := rawArgs["status"]; ok {
-		return ec.unmarshalOSuiteStatus2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("suiteId"))
+	if tmp, ok := rawArgs["suiteId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
-	var zeroVal *model.SuiteStatus
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_testSuites_argsPage(
+func (ec *executionContext) field_Mutation_reorderTestGroups_argsGroupIds(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (*int, error) {
-	if _, ok := rawArgs["page"]; !ok {
-		var zeroVal *int
+) ([]string, error) {
+	if _, ok := rawArgs["groupIds"]; !ok {
+		var zeroVal []string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
-	if tmp, ok := rawArgs["page"]; ok {
-		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("groupIds"))
+	if tmp, ok := rawArgs["groupIds"]; ok {
+		return ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
 	}
 
-	var zeroVal *int
+	var zeroVal []string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_testSuites_argsPageSize(
+func (ec *executionContext) field_Mutation_resetPassword_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_resetPassword_argsUserID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["userId"] = arg0
+	arg1, err := ec.field_Mutation_resetPassword_argsNewPassword(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["newPassword"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_resetPassword_argsUserID(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (*int, error) {
-	if _, ok := rawArgs["pageSize"]; !ok {
-		var zeroVal *int
+) (string, error) {
+	if _, ok := rawArgs["userId"]; !ok {
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("pageSize"))
-	if tmp, ok := rawArgs["pageSize"]; ok {
-		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+	if tmp, ok := rawArgs["userId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
-	var zeroVal *int
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_resetPassword_argsNewPassword(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["newPassword"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("newPassword"))
+	if tmp, ok := rawArgs["newPassword"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_restoreTestCase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field_Query_user_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_restoreTestCase_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query_user_argsID(
+func (ec *executionContext) field_Mutation_restoreTestCase_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
 ) (string, error) {
@@ -1751,128 +2983,6340 @@ func (ec *executionContext) field_Query_user_argsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_restoreTestGroup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field___Directive_args_argsIncludeDeprecated(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_restoreTestGroup_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field___Directive_args_argsIncludeDeprecated(
+func (ec *executionContext) field_Mutation_restoreTestGroup_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (*bool, error) {
-	if _, ok := rawArgs["includeDeprecated"]; !ok {
-		var zeroVal *bool
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
-	var zeroVal *bool
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_restoreTestSuite_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field___Field_args_argsIncludeDeprecated(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_restoreTestSuite_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field___Field_args_argsIncludeDeprecated(
+func (ec *executionContext) field_Mutation_restoreTestSuite_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (*bool, error) {
-	if _, ok := rawArgs["includeDeprecated"]; !ok {
-		var zeroVal *bool
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
-	var zeroVal *bool
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_unlockTestCase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field___Type_enumValues_argsIncludeDeprecated(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_unlockTestCase_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field___Type_enumValues_argsIncludeDeprecated(
+func (ec *executionContext) field_Mutation_unlockTestCase_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (bool, error) {
-	if _, ok := rawArgs["includeDeprecated"]; !ok {
-		var zeroVal bool
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2bool(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
-	var zeroVal bool
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_updateTestCaseStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := ec.field___Type_fields_argsIncludeDeprecated(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_updateTestCaseStatus_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_updateTestCaseStatus_argsStatus(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg1
+	arg2, err := ec.field_Mutation_updateTestCaseStatus_argsReason(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["reason"] = arg2
+	arg3, err := ec.field_Mutation_updateTestCaseStatus_argsExpectedVersion(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["expectedVersion"] = arg3
 	return args, nil
 }
-func (ec *executionContext) field___Type_fields_argsIncludeDeprecated(
+func (ec *executionContext) field_Mutation_updateTestCaseStatus_argsID(
 	ctx context.Context,
 	rawArgs map[string]any,
-) (bool, error) {
-	if _, ok := rawArgs["includeDeprecated"]; !ok {
-		var zeroVal bool
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2bool(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
-	var zeroVal bool
+	var zeroVal string
 	return zeroVal, nil
 }
 
-// endregion ***************************** args.gotpl *****************************
+func (ec *executionContext) field_Mutation_updateTestCaseStatus_argsStatus(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (model.TestStatus, error) {
+	if _, ok := rawArgs["status"]; !ok {
+		var zeroVal model.TestStatus
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+	if tmp, ok := rawArgs["status"]; ok {
+		return ec.unmarshalNTestStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestStatus(ctx, tmp)
+	}
+
+	var zeroVal model.TestStatus
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestCaseStatus_argsReason(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["reason"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+	if tmp, ok := rawArgs["reason"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestCaseStatus_argsExpectedVersion(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["expectedVersion"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+	if tmp, ok := rawArgs["expectedVersion"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestCase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_updateTestCase_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_updateTestCase_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_updateTestCase_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestCase_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (model.UpdateTestCaseInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal model.UpdateTestCaseInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNUpdateTestCaseInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUpdateTestCaseInput(ctx, tmp)
+	}
+
+	var zeroVal model.UpdateTestCaseInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestGroup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_updateTestGroup_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_updateTestGroup_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_updateTestGroup_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestGroup_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (model.UpdateTestGroupInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal model.UpdateTestGroupInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNUpdateTestGroupInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUpdateTestGroupInput(ctx, tmp)
+	}
+
+	var zeroVal model.UpdateTestGroupInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestSuiteStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_updateTestSuiteStatus_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_updateTestSuiteStatus_argsStatus(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg1
+	arg2, err := ec.field_Mutation_updateTestSuiteStatus_argsOverrideReason(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["overrideReason"] = arg2
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_updateTestSuiteStatus_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestSuiteStatus_argsStatus(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (model.SuiteStatus, error) {
+	if _, ok := rawArgs["status"]; !ok {
+		var zeroVal model.SuiteStatus
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+	if tmp, ok := rawArgs["status"]; ok {
+		return ec.unmarshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx, tmp)
+	}
+
+	var zeroVal model.SuiteStatus
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestSuiteStatus_argsOverrideReason(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["overrideReason"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("overrideReason"))
+	if tmp, ok := rawArgs["overrideReason"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestSuite_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_updateTestSuite_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_updateTestSuite_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_updateTestSuite_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTestSuite_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (model.UpdateTestSuiteInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal model.UpdateTestSuiteInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNUpdateTestSuiteInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUpdateTestSuiteInput(ctx, tmp)
+	}
+
+	var zeroVal model.UpdateTestSuiteInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateUser_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_updateUser_argsUserID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["userId"] = arg0
+	arg1, err := ec.field_Mutation_updateUser_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_updateUser_argsUserID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["userId"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+	if tmp, ok := rawArgs["userId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updateUser_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (model.UpdateUserInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal model.UpdateUserInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNUpdateUserInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUpdateUserInput(ctx, tmp)
+	}
+
+	var zeroVal model.UpdateUserInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query___type_argsName(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query___type_argsName(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["name"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+	if tmp, ok := rawArgs["name"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_effortRecordsByUser_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_effortRecordsByUser_argsUserID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["userId"] = arg0
+	arg1, err := ec.field_Query_effortRecordsByUser_argsDate(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["date"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Query_effortRecordsByUser_argsUserID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["userId"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+	if tmp, ok := rawArgs["userId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_effortRecordsByUser_argsDate(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (time.Time, error) {
+	if _, ok := rawArgs["date"]; !ok {
+		var zeroVal time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("date"))
+	if tmp, ok := rawArgs["date"]; ok {
+		return ec.unmarshalNDateTime2timeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal time.Time
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_effortRecords_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_effortRecords_argsTestCaseID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["testCaseId"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_effortRecords_argsTestCaseID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["testCaseId"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("testCaseId"))
+	if tmp, ok := rawArgs["testCaseId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_node_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_node_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_node_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_nodes_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_nodes_argsIds(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["ids"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_nodes_argsIds(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]string, error) {
+	if _, ok := rawArgs["ids"]; !ok {
+		var zeroVal []string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("ids"))
+	if tmp, ok := rawArgs["ids"]; ok {
+		return ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
+	}
+
+	var zeroVal []string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testCase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_testCase_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_testCase_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testGroup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_testGroup_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_testGroup_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuite_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_testSuite_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_testSuite_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuites_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_testSuites_argsStatus(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg0
+	arg1, err := ec.field_Query_testSuites_argsFilter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg1
+	arg2, err := ec.field_Query_testSuites_argsOrderBy(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["orderBy"] = arg2
+	arg3, err := ec.field_Query_testSuites_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg3
+	arg4, err := ec.field_Query_testSuites_argsAfter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg4
+	arg5, err := ec.field_Query_testSuites_argsLast(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["last"] = arg5
+	arg6, err := ec.field_Query_testSuites_argsBefore(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["before"] = arg6
+	arg7, err := ec.field_Query_testSuites_argsPage(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["page"] = arg7
+	arg8, err := ec.field_Query_testSuites_argsPageSize(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["pageSize"] = arg8
+	return args, nil
+}
+func (ec *executionContext) field_Query_testSuites_argsStatus(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*model.SuiteStatus, error) {
+	if _, ok := rawArgs["status"]; !ok {
+		var zeroVal *model.SuiteStatus
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+	if tmp, ok := rawArgs["status"]; ok {
+		return ec.unmarshalOSuiteStatus2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx, tmp)
+	}
+
+	var zeroVal *model.SuiteStatus
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuites_argsFilter(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*model.TestSuiteFilter, error) {
+	if _, ok := rawArgs["filter"]; !ok {
+		var zeroVal *model.TestSuiteFilter
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+	if tmp, ok := rawArgs["filter"]; ok {
+		return ec.unmarshalOTestSuiteFilter2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteFilter(ctx, tmp)
+	}
+
+	var zeroVal *model.TestSuiteFilter
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuites_argsOrderBy(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]*model.TestSuiteOrder, error) {
+	if _, ok := rawArgs["orderBy"]; !ok {
+		var zeroVal []*model.TestSuiteOrder
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
+	if tmp, ok := rawArgs["orderBy"]; ok {
+		return ec.unmarshalOTestSuiteOrder2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteOrderᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.TestSuiteOrder
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuites_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["first"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuites_argsAfter(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["after"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+	if tmp, ok := rawArgs["after"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuites_argsLast(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["last"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+	if tmp, ok := rawArgs["last"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuites_argsBefore(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["before"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+	if tmp, ok := rawArgs["before"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuites_argsPage(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["page"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+	if tmp, ok := rawArgs["page"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_testSuites_argsPageSize(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["pageSize"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("pageSize"))
+	if tmp, ok := rawArgs["pageSize"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_user_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_user_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Subscription_suiteActivity_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Subscription_suiteActivity_argsSuiteID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["suiteId"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Subscription_suiteActivity_argsSuiteID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["suiteId"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("suiteId"))
+	if tmp, ok := rawArgs["suiteId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Subscription_testCaseUpdated_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Subscription_testCaseUpdated_argsSuiteID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["suiteId"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Subscription_testCaseUpdated_argsSuiteID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["suiteId"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("suiteId"))
+	if tmp, ok := rawArgs["suiteId"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Subscription_testSuiteStatusChanged_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Subscription_testSuiteStatusChanged_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Subscription_testSuiteStatusChanged_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field___Directive_args_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Directive_args_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*bool, error) {
+	if _, ok := rawArgs["includeDeprecated"]; !ok {
+		var zeroVal *bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field___Field_args_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Field_args_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*bool, error) {
+	if _, ok := rawArgs["includeDeprecated"]; !ok {
+		var zeroVal *bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field___Type_enumValues_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Type_enumValues_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (bool, error) {
+	if _, ok := rawArgs["includeDeprecated"]; !ok {
+		var zeroVal bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field___Type_fields_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Type_fields_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (bool, error) {
+	if _, ok := rawArgs["includeDeprecated"]; !ok {
+		var zeroVal bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AuthPayload_token(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuthPayload_token(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Token, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AuthPayload_token(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuthPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RefreshToken, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AuthPayload_refreshToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuthPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuthPayload_user(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.User, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AuthPayload_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuthPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "role":
+				return ec.fieldContext_User_role(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_User_updatedAt(ctx, field)
+			case "lastLoginAt":
+				return ec.fieldContext_User_lastLoginAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AuthPayload_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpiresAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AuthPayload_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AuthPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecord_id(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecord_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecord_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecord_testCaseId(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecord_testCaseId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TestCaseID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecord_testCaseId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecord_recordDate(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecord_recordDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecordDate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecord_recordDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecord_effortAmount(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecord_effortAmount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EffortAmount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecord_effortAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecord_isAdditional(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecord_isAdditional(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsAdditional, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecord_isAdditional(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecord_comment(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecord_comment(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Comment, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecord_comment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecord_recordedBy(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecord_recordedBy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RecordedBy, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecord_recordedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecord_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecord_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecord_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecordList_records(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecordList) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecordList_records(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Records, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.EffortRecord)
+	fc.Result = res
+	return ec.marshalNEffortRecord2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecordᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecordList_records(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecordList",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_EffortRecord_id(ctx, field)
+			case "testCaseId":
+				return ec.fieldContext_EffortRecord_testCaseId(ctx, field)
+			case "recordDate":
+				return ec.fieldContext_EffortRecord_recordDate(ctx, field)
+			case "effortAmount":
+				return ec.fieldContext_EffortRecord_effortAmount(ctx, field)
+			case "isAdditional":
+				return ec.fieldContext_EffortRecord_isAdditional(ctx, field)
+			case "comment":
+				return ec.fieldContext_EffortRecord_comment(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_EffortRecord_recordedBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_EffortRecord_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EffortRecord", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EffortRecordList_totalEffort(ctx context.Context, field graphql.CollectedField, obj *model.EffortRecordList) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_EffortRecordList_totalEffort(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalEffort, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_EffortRecordList_totalEffort(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EffortRecordList",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExitCriteria_minCompletionRate(ctx context.Context, field graphql.CollectedField, obj *model.ExitCriteria) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExitCriteria_minCompletionRate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MinCompletionRate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ExitCriteria_minCompletionRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExitCriteria",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExitCriteria_allowOpenCritical(ctx context.Context, field graphql.CollectedField, obj *model.ExitCriteria) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExitCriteria_allowOpenCritical(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AllowOpenCritical, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ExitCriteria_allowOpenCritical(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExitCriteria",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExitCriteriaOverride_reason(ctx context.Context, field graphql.CollectedField, obj *model.ExitCriteriaOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExitCriteriaOverride_reason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ExitCriteriaOverride_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExitCriteriaOverride",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExitCriteriaOverride_overriddenBy(ctx context.Context, field graphql.CollectedField, obj *model.ExitCriteriaOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExitCriteriaOverride_overriddenBy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OverriddenBy, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ExitCriteriaOverride_overriddenBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExitCriteriaOverride",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ExitCriteriaOverride_overriddenAt(ctx context.Context, field graphql.CollectedField, obj *model.ExitCriteriaOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ExitCriteriaOverride_overriddenAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OverriddenAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ExitCriteriaOverride_overriddenAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExitCriteriaOverride",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createTestSuite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createTestSuite(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateTestSuite(rctx, fc.Args["input"].(model.CreateTestSuiteInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestSuite)
+	fc.Result = res
+	return ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createTestSuite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestSuite_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestSuite_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestSuite_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestSuite_status(ctx, field)
+			case "estimatedStartDate":
+				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
+			case "estimatedEndDate":
+				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
+			case "requireEffortComment":
+				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
+			case "exitCriteria":
+				return ec.fieldContext_TestSuite_exitCriteria(ctx, field)
+			case "exitCriteriaOverride":
+				return ec.fieldContext_TestSuite_exitCriteriaOverride(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestSuite_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestSuite_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestSuite_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestSuite_version(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TestSuite_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestSuite_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestSuite_deletedAt(ctx, field)
+			case "groups":
+				return ec.fieldContext_TestSuite_groups(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createTestSuite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateTestSuite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateTestSuite(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateTestSuite(rctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateTestSuiteInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestSuite)
+	fc.Result = res
+	return ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateTestSuite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestSuite_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestSuite_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestSuite_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestSuite_status(ctx, field)
+			case "estimatedStartDate":
+				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
+			case "estimatedEndDate":
+				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
+			case "requireEffortComment":
+				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
+			case "exitCriteria":
+				return ec.fieldContext_TestSuite_exitCriteria(ctx, field)
+			case "exitCriteriaOverride":
+				return ec.fieldContext_TestSuite_exitCriteriaOverride(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestSuite_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestSuite_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestSuite_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestSuite_version(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TestSuite_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestSuite_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestSuite_deletedAt(ctx, field)
+			case "groups":
+				return ec.fieldContext_TestSuite_groups(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateTestSuite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateTestSuiteStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateTestSuiteStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateTestSuiteStatus(rctx, fc.Args["id"].(string), fc.Args["status"].(model.SuiteStatus), fc.Args["overrideReason"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestSuite)
+	fc.Result = res
+	return ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateTestSuiteStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestSuite_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestSuite_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestSuite_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestSuite_status(ctx, field)
+			case "estimatedStartDate":
+				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
+			case "estimatedEndDate":
+				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
+			case "requireEffortComment":
+				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
+			case "exitCriteria":
+				return ec.fieldContext_TestSuite_exitCriteria(ctx, field)
+			case "exitCriteriaOverride":
+				return ec.fieldContext_TestSuite_exitCriteriaOverride(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestSuite_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestSuite_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestSuite_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestSuite_version(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TestSuite_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestSuite_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestSuite_deletedAt(ctx, field)
+			case "groups":
+				return ec.fieldContext_TestSuite_groups(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateTestSuiteStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteTestSuite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteTestSuite(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DeleteTestSuite(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal bool
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteTestSuite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteTestSuite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_restoreTestSuite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_restoreTestSuite(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().RestoreTestSuite(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestSuite
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestSuite); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestSuite`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestSuite)
+	fc.Result = res
+	return ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_restoreTestSuite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestSuite_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestSuite_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestSuite_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestSuite_status(ctx, field)
+			case "estimatedStartDate":
+				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
+			case "estimatedEndDate":
+				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
+			case "requireEffortComment":
+				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
+			case "exitCriteria":
+				return ec.fieldContext_TestSuite_exitCriteria(ctx, field)
+			case "exitCriteriaOverride":
+				return ec.fieldContext_TestSuite_exitCriteriaOverride(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestSuite_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestSuite_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestSuite_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestSuite_version(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TestSuite_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestSuite_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestSuite_deletedAt(ctx, field)
+			case "groups":
+				return ec.fieldContext_TestSuite_groups(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_restoreTestSuite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createTestGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createTestGroup(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateTestGroup(rctx, fc.Args["input"].(model.CreateTestGroupInput))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestGroup
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestGroup); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestGroup`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestGroup)
+	fc.Result = res
+	return ec.marshalNTestGroup2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createTestGroup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestGroup_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestGroup_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestGroup_description(ctx, field)
+			case "displayOrder":
+				return ec.fieldContext_TestGroup_displayOrder(ctx, field)
+			case "suiteId":
+				return ec.fieldContext_TestGroup_suiteId(ctx, field)
+			case "status":
+				return ec.fieldContext_TestGroup_status(ctx, field)
+			case "statusLocked":
+				return ec.fieldContext_TestGroup_statusLocked(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestGroup_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestGroup_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestGroup_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestGroup_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestGroup_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestGroup_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestGroup_deletedAt(ctx, field)
+			case "cases":
+				return ec.fieldContext_TestGroup_cases(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestGroup", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createTestGroup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateTestGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateTestGroup(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpdateTestGroup(rctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateTestGroupInput))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestGroup
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestGroup); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestGroup`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestGroup)
+	fc.Result = res
+	return ec.marshalNTestGroup2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateTestGroup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestGroup_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestGroup_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestGroup_description(ctx, field)
+			case "displayOrder":
+				return ec.fieldContext_TestGroup_displayOrder(ctx, field)
+			case "suiteId":
+				return ec.fieldContext_TestGroup_suiteId(ctx, field)
+			case "status":
+				return ec.fieldContext_TestGroup_status(ctx, field)
+			case "statusLocked":
+				return ec.fieldContext_TestGroup_statusLocked(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestGroup_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestGroup_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestGroup_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestGroup_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestGroup_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestGroup_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestGroup_deletedAt(ctx, field)
+			case "cases":
+				return ec.fieldContext_TestGroup_cases(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestGroup", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateTestGroup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteTestGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteTestGroup(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DeleteTestGroup(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal bool
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteTestGroup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteTestGroup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_restoreTestGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_restoreTestGroup(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().RestoreTestGroup(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestGroup
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestGroup); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestGroup`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestGroup)
+	fc.Result = res
+	return ec.marshalNTestGroup2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_restoreTestGroup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestGroup_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestGroup_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestGroup_description(ctx, field)
+			case "displayOrder":
+				return ec.fieldContext_TestGroup_displayOrder(ctx, field)
+			case "suiteId":
+				return ec.fieldContext_TestGroup_suiteId(ctx, field)
+			case "status":
+				return ec.fieldContext_TestGroup_status(ctx, field)
+			case "statusLocked":
+				return ec.fieldContext_TestGroup_statusLocked(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestGroup_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestGroup_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestGroup_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestGroup_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestGroup_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestGroup_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestGroup_deletedAt(ctx, field)
+			case "cases":
+				return ec.fieldContext_TestGroup_cases(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestGroup", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_restoreTestGroup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_reorderTestGroups(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_reorderTestGroups(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().ReorderTestGroups(rctx, fc.Args["suiteId"].(string), fc.Args["groupIds"].([]string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal []*model.TestGroup
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.TestGroup); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestGroup`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TestGroup)
+	fc.Result = res
+	return ec.marshalNTestGroup2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroupᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_reorderTestGroups(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestGroup_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestGroup_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestGroup_description(ctx, field)
+			case "displayOrder":
+				return ec.fieldContext_TestGroup_displayOrder(ctx, field)
+			case "suiteId":
+				return ec.fieldContext_TestGroup_suiteId(ctx, field)
+			case "status":
+				return ec.fieldContext_TestGroup_status(ctx, field)
+			case "statusLocked":
+				return ec.fieldContext_TestGroup_statusLocked(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestGroup_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestGroup_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestGroup_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestGroup_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestGroup_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestGroup_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestGroup_deletedAt(ctx, field)
+			case "cases":
+				return ec.fieldContext_TestGroup_cases(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestGroup", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_reorderTestGroups_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createTestCase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createTestCase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateTestCase(rctx, fc.Args["input"].(model.CreateTestCaseInput))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestCase
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestCase); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestCase`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createTestCase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createTestCase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateTestCase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateTestCase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpdateTestCase(rctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateTestCaseInput))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestCase
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestCase); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestCase`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateTestCase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateTestCase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteTestCase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteTestCase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DeleteTestCase(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal bool
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteTestCase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteTestCase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_restoreTestCase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_restoreTestCase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().RestoreTestCase(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestCase
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestCase); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestCase`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_restoreTestCase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_restoreTestCase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_moveTestCase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_moveTestCase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().MoveTestCase(rctx, fc.Args["id"].(string), fc.Args["targetGroupId"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestCase
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestCase); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestCase`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_moveTestCase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_moveTestCase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateTestCaseStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateTestCaseStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpdateTestCaseStatus(rctx, fc.Args["id"].(string), fc.Args["status"].(model.TestStatus), fc.Args["reason"].(*string), fc.Args["expectedVersion"].(*int))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestCase
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestCase); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestCase`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateTestCaseStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateTestCaseStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_lockTestCase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_lockTestCase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().LockTestCase(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestCase
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestCase); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestCase`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_lockTestCase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_lockTestCase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_renewTestCaseLock(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_renewTestCaseLock(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().RenewTestCaseLock(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestCase
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestCase); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestCase`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_renewTestCaseLock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_renewTestCaseLock_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_unlockTestCase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_unlockTestCase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UnlockTestCase(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestCase
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestCase); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestCase`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_unlockTestCase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_unlockTestCase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_forceUnlockTestCase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_forceUnlockTestCase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().ForceUnlockTestCase(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.TestCase
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.TestCase); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.TestCase`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_forceUnlockTestCase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_forceUnlockTestCase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_login(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Login(rctx, fc.Args["username"].(string), fc.Args["password"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.AuthPayload)
+	fc.Result = res
+	return ec.marshalNAuthPayload2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐAuthPayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_AuthPayload_token(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_refreshToken(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RefreshToken(rctx, fc.Args["refreshToken"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.AuthPayload)
+	fc.Result = res
+	return ec.marshalNAuthPayload2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐAuthPayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "token":
+				return ec.fieldContext_AuthPayload_token(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_refreshToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_logout(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().Logout(rctx, fc.Args["refreshToken"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal bool
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_logout(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_logout_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createUser(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CreateUser(rctx, fc.Args["input"].(model.CreateUserInput))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			role, err := ec.unmarshalNString2string(ctx, "Admin")
+			if err != nil {
+				var zeroVal *model.User
+				return zeroVal, err
+			}
+			if ec.directives.HasRole == nil {
+				var zeroVal *model.User
+				return zeroVal, errors.New("directive hasRole is not implemented")
+			}
+			return ec.directives.HasRole(ctx, nil, directive0, role)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.User); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "role":
+				return ec.fieldContext_User_role(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_User_updatedAt(ctx, field)
+			case "lastLoginAt":
+				return ec.fieldContext_User_lastLoginAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_changePassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_changePassword(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().ChangePassword(rctx, fc.Args["oldPassword"].(string), fc.Args["newPassword"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal bool
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_changePassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_changePassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_resetPassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_resetPassword(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().ResetPassword(rctx, fc.Args["userId"].(string), fc.Args["newPassword"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			role, err := ec.unmarshalNString2string(ctx, "Admin")
+			if err != nil {
+				var zeroVal bool
+				return zeroVal, err
+			}
+			if ec.directives.HasRole == nil {
+				var zeroVal bool
+				return zeroVal, errors.New("directive hasRole is not implemented")
+			}
+			return ec.directives.HasRole(ctx, nil, directive0, role)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_resetPassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_resetPassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteUser(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().DeleteUser(rctx, fc.Args["userId"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			role, err := ec.unmarshalNString2string(ctx, "Admin")
+			if err != nil {
+				var zeroVal bool
+				return zeroVal, err
+			}
+			if ec.directives.HasRole == nil {
+				var zeroVal bool
+				return zeroVal, errors.New("directive hasRole is not implemented")
+			}
+			return ec.directives.HasRole(ctx, nil, directive0, role)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(bool); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateUser(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().UpdateUser(rctx, fc.Args["userId"].(string), fc.Args["input"].(model.UpdateUserInput))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			role, err := ec.unmarshalNString2string(ctx, "Admin")
+			if err != nil {
+				var zeroVal *model.User
+				return zeroVal, err
+			}
+			if ec.directives.HasRole == nil {
+				var zeroVal *model.User
+				return zeroVal, errors.New("directive hasRole is not implemented")
+			}
+			return ec.directives.HasRole(ctx, nil, directive0, role)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.User); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "role":
+				return ec.fieldContext_User_role(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_User_updatedAt(ctx, field)
+			case "lastLoginAt":
+				return ec.fieldContext_User_lastLoginAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_recordEffort(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_recordEffort(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().RecordEffort(rctx, fc.Args["input"].(model.RecordEffortInput))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.EffortRecord
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.EffortRecord); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.EffortRecord`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.EffortRecord)
+	fc.Result = res
+	return ec.marshalNEffortRecord2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_recordEffort(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_EffortRecord_id(ctx, field)
+			case "testCaseId":
+				return ec.fieldContext_EffortRecord_testCaseId(ctx, field)
+			case "recordDate":
+				return ec.fieldContext_EffortRecord_recordDate(ctx, field)
+			case "effortAmount":
+				return ec.fieldContext_EffortRecord_effortAmount(ctx, field)
+			case "isAdditional":
+				return ec.fieldContext_EffortRecord_isAdditional(ctx, field)
+			case "comment":
+				return ec.fieldContext_EffortRecord_comment(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_EffortRecord_recordedBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_EffortRecord_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EffortRecord", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recordEffort_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_correctEffortRecord(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_correctEffortRecord(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().CorrectEffortRecord(rctx, fc.Args["id"].(string), fc.Args["input"].(model.CorrectEffortRecordInput))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.EffortRecord
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.EffortRecord); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.EffortRecord`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.EffortRecord)
+	fc.Result = res
+	return ec.marshalNEffortRecord2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_correctEffortRecord(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_EffortRecord_id(ctx, field)
+			case "testCaseId":
+				return ec.fieldContext_EffortRecord_testCaseId(ctx, field)
+			case "recordDate":
+				return ec.fieldContext_EffortRecord_recordDate(ctx, field)
+			case "effortAmount":
+				return ec.fieldContext_EffortRecord_effortAmount(ctx, field)
+			case "isAdditional":
+				return ec.fieldContext_EffortRecord_isAdditional(ctx, field)
+			case "comment":
+				return ec.fieldContext_EffortRecord_comment(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_EffortRecord_recordedBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_EffortRecord_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EffortRecord", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_correctEffortRecord_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNextPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasPreviousPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_startCursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartCursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_startCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndCursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_testSuite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_testSuite(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TestSuite(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestSuite)
+	fc.Result = res
+	return ec.marshalOTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_testSuite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestSuite_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestSuite_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestSuite_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestSuite_status(ctx, field)
+			case "estimatedStartDate":
+				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
+			case "estimatedEndDate":
+				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
+			case "requireEffortComment":
+				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
+			case "exitCriteria":
+				return ec.fieldContext_TestSuite_exitCriteria(ctx, field)
+			case "exitCriteriaOverride":
+				return ec.fieldContext_TestSuite_exitCriteriaOverride(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestSuite_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestSuite_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestSuite_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestSuite_version(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TestSuite_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestSuite_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestSuite_deletedAt(ctx, field)
+			case "groups":
+				return ec.fieldContext_TestSuite_groups(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_testSuite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_testSuites(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_testSuites(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TestSuites(rctx, fc.Args["status"].(*model.SuiteStatus), fc.Args["filter"].(*model.TestSuiteFilter), fc.Args["orderBy"].([]*model.TestSuiteOrder), fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["last"].(*int), fc.Args["before"].(*string), fc.Args["page"].(*int), fc.Args["pageSize"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestSuiteConnection)
+	fc.Result = res
+	return ec.marshalNTestSuiteConnection2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_testSuites(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_TestSuiteConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_TestSuiteConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_TestSuiteConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestSuiteConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_testSuites_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_testGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_testGroup(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TestGroup(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestGroup)
+	fc.Result = res
+	return ec.marshalOTestGroup2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_testGroup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestGroup_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestGroup_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestGroup_description(ctx, field)
+			case "displayOrder":
+				return ec.fieldContext_TestGroup_displayOrder(ctx, field)
+			case "suiteId":
+				return ec.fieldContext_TestGroup_suiteId(ctx, field)
+			case "status":
+				return ec.fieldContext_TestGroup_status(ctx, field)
+			case "statusLocked":
+				return ec.fieldContext_TestGroup_statusLocked(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestGroup_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestGroup_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestGroup_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestGroup_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestGroup_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestGroup_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestGroup_deletedAt(ctx, field)
+			case "cases":
+				return ec.fieldContext_TestGroup_cases(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestGroup", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_testGroup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_testCase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_testCase(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TestCase(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TestCase)
+	fc.Result = res
+	return ec.marshalOTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_testCase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_testCase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_trash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_trash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().Trash(rctx)
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.Trash
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.Trash); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.Trash`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Trash)
+	fc.Result = res
+	return ec.marshalNTrash2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTrash(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_trash(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "testSuites":
+				return ec.fieldContext_Trash_testSuites(ctx, field)
+			case "testGroups":
+				return ec.fieldContext_Trash_testGroups(ctx, field)
+			case "testCases":
+				return ec.fieldContext_Trash_testCases(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trash", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_me(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().Me(rctx)
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.User
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.User); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_me(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "role":
+				return ec.fieldContext_User_role(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_User_updatedAt(ctx, field)
+			case "lastLoginAt":
+				return ec.fieldContext_User_lastLoginAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_adminData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_adminData(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().AdminData(rctx)
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			role, err := ec.unmarshalNString2string(ctx, "Admin")
+			if err != nil {
+				var zeroVal string
+				return zeroVal, err
+			}
+			if ec.directives.HasRole == nil {
+				var zeroVal string
+				return zeroVal, errors.New("directive hasRole is not implemented")
+			}
+			return ec.directives.HasRole(ctx, nil, directive0, role)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_adminData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_managerData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_managerData(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().ManagerData(rctx)
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			role, err := ec.unmarshalNString2string(ctx, "Manager")
+			if err != nil {
+				var zeroVal string
+				return zeroVal, err
+			}
+			if ec.directives.HasRole == nil {
+				var zeroVal string
+				return zeroVal, errors.New("directive hasRole is not implemented")
+			}
+			return ec.directives.HasRole(ctx, nil, directive0, role)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_managerData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_testerData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_testerData(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().TesterData(rctx)
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			role, err := ec.unmarshalNString2string(ctx, "Tester")
+			if err != nil {
+				var zeroVal string
+				return zeroVal, err
+			}
+			if ec.directives.HasRole == nil {
+				var zeroVal string
+				return zeroVal, errors.New("directive hasRole is not implemented")
+			}
+			return ec.directives.HasRole(ctx, nil, directive0, role)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(string); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_testerData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_users(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_users(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().Users(rctx)
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			role, err := ec.unmarshalNString2string(ctx, "Admin")
+			if err != nil {
+				var zeroVal []*model.User
+				return zeroVal, err
+			}
+			if ec.directives.HasRole == nil {
+				var zeroVal []*model.User
+				return zeroVal, errors.New("directive hasRole is not implemented")
+			}
+			return ec.directives.HasRole(ctx, nil, directive0, role)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]*model.User); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUserᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_users(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "role":
+				return ec.fieldContext_User_role(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_User_updatedAt(ctx, field)
+			case "lastLoginAt":
+				return ec.fieldContext_User_lastLoginAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_user(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().User(rctx, fc.Args["id"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			role, err := ec.unmarshalNString2string(ctx, "Admin")
+			if err != nil {
+				var zeroVal *model.User
+				return zeroVal, err
+			}
+			if ec.directives.HasRole == nil {
+				var zeroVal *model.User
+				return zeroVal, errors.New("directive hasRole is not implemented")
+			}
+			return ec.directives.HasRole(ctx, nil, directive0, role)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.User); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "username":
+				return ec.fieldContext_User_username(ctx, field)
+			case "role":
+				return ec.fieldContext_User_role(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_User_updatedAt(ctx, field)
+			case "lastLoginAt":
+				return ec.fieldContext_User_lastLoginAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_user_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_effortRecords(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_effortRecords(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().EffortRecords(rctx, fc.Args["testCaseId"].(string))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.EffortRecordList
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.EffortRecordList); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.EffortRecordList`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.EffortRecordList)
+	fc.Result = res
+	return ec.marshalNEffortRecordList2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecordList(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_effortRecords(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "records":
+				return ec.fieldContext_EffortRecordList_records(ctx, field)
+			case "totalEffort":
+				return ec.fieldContext_EffortRecordList_totalEffort(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EffortRecordList", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_effortRecords_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_effortRecordsByUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_effortRecordsByUser(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		directive0 := func(rctx context.Context) (any, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().EffortRecordsByUser(rctx, fc.Args["userId"].(string), fc.Args["date"].(time.Time))
+		}
+
+		directive1 := func(ctx context.Context) (any, error) {
+			if ec.directives.Auth == nil {
+				var zeroVal *model.EffortRecordList
+				return zeroVal, errors.New("directive auth is not implemented")
+			}
+			return ec.directives.Auth(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.EffortRecordList); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.EffortRecordList`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.EffortRecordList)
+	fc.Result = res
+	return ec.marshalNEffortRecordList2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecordList(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_effortRecordsByUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "records":
+				return ec.fieldContext_EffortRecordList_records(ctx, field)
+			case "totalEffort":
+				return ec.fieldContext_EffortRecordList_totalEffort(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EffortRecordList", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_effortRecordsByUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_node(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Node(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(model.Node)
+	fc.Result = res
+	return ec.marshalONode2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐNode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("FieldContext.Child cannot be called on type INTERFACE")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_node_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_nodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_nodes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Nodes(rctx, fc.Args["ids"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.Node)
+	fc.Result = res
+	return ec.marshalNNode2ᚕgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐNode(ctx, field.Selections, res)
+}
 
-// region    ************************** directives.gotpl **************************
+func (ec *executionContext) fieldContext_Query_nodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("FieldContext.Child cannot be called on type INTERFACE")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_nodes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
 
-// endregion ************************** directives.gotpl **************************
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
 
-// region    **************************** field.gotpl *****************************
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
 
-func (ec *executionContext) _AuthPayload_token(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AuthPayload_token(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1885,7 +9329,62 @@ func (ec *executionContext) _AuthPayload_token(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Token, nil
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StatusHistory_id(ctx context.Context, field graphql.CollectedField, obj *model.StatusHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StatusHistory_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1899,24 +9398,24 @@ func (ec *executionContext) _AuthPayload_token(ctx context.Context, field graphq
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AuthPayload_token(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StatusHistory_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
+		Object:     "StatusHistory",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+func (ec *executionContext) _StatusHistory_oldStatus(ctx context.Context, field graphql.CollectedField, obj *model.StatusHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StatusHistory_oldStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1929,7 +9428,95 @@ func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RefreshToken, nil
+		return obj.OldStatus, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.TestStatus)
+	fc.Result = res
+	return ec.marshalNTestStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StatusHistory_oldStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StatusHistory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TestStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StatusHistory_newStatus(ctx context.Context, field graphql.CollectedField, obj *model.StatusHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StatusHistory_newStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NewStatus, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.TestStatus)
+	fc.Result = res
+	return ec.marshalNTestStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StatusHistory_newStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StatusHistory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TestStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StatusHistory_changedAt(ctx context.Context, field graphql.CollectedField, obj *model.StatusHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StatusHistory_changedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChangedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1941,26 +9528,26 @@ func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AuthPayload_refreshToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StatusHistory_changedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
+		Object:     "StatusHistory",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AuthPayload_user(ctx, field)
+func (ec *executionContext) _StatusHistory_changedBy(ctx context.Context, field graphql.CollectedField, obj *model.StatusHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StatusHistory_changedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1973,7 +9560,7 @@ func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.ChangedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1985,40 +9572,26 @@ func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AuthPayload_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StatusHistory_changedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
+		Object:     "StatusHistory",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "role":
-				return ec.fieldContext_User_role(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_User_updatedAt(ctx, field)
-			case "lastLoginAt":
-				return ec.fieldContext_User_lastLoginAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AuthPayload_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.AuthPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AuthPayload_expiresAt(ctx, field)
+func (ec *executionContext) _StatusHistory_reason(ctx context.Context, field graphql.CollectedField, obj *model.StatusHistory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StatusHistory_reason(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2031,70 +9604,81 @@ func (ec *executionContext) _AuthPayload_expiresAt(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExpiresAt, nil
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AuthPayload_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StatusHistory_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AuthPayload",
+		Object:     "StatusHistory",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createTestSuite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createTestSuite(ctx, field)
+func (ec *executionContext) _Subscription_testSuiteStatusChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_testSuiteStatusChanged(ctx, field)
 	if err != nil {
-		return graphql.Null
+		return nil
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateTestSuite(rctx, fc.Args["input"].(model.CreateTestSuiteInput))
+		return ec.resolvers.Subscription().TestSuiteStatusChanged(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.TestSuite):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
 	}
-	res := resTmp.(*model.TestSuite)
-	fc.Result = res
-	return ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createTestSuite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_testSuiteStatusChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Subscription",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -2114,12 +9698,26 @@ func (ec *executionContext) fieldContext_Mutation_createTestSuite(ctx context.Co
 				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
 			case "requireEffortComment":
 				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
+			case "exitCriteria":
+				return ec.fieldContext_TestSuite_exitCriteria(ctx, field)
+			case "exitCriteriaOverride":
+				return ec.fieldContext_TestSuite_exitCriteriaOverride(ctx, field)
 			case "progress":
 				return ec.fieldContext_TestSuite_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestSuite_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestSuite_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestSuite_version(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TestSuite_createdBy(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_TestSuite_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestSuite_deletedAt(ctx, field)
 			case "groups":
 				return ec.fieldContext_TestSuite_groups(ctx, field)
 			}
@@ -2133,76 +9731,104 @@ func (ec *executionContext) fieldContext_Mutation_createTestSuite(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createTestSuite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Subscription_testSuiteStatusChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateTestSuite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updateTestSuite(ctx, field)
+func (ec *executionContext) _Subscription_testCaseUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_testCaseUpdated(ctx, field)
 	if err != nil {
-		return graphql.Null
+		return nil
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateTestSuite(rctx, fc.Args["id"].(string), fc.Args["input"].(model.UpdateTestSuiteInput))
+		return ec.resolvers.Subscription().TestCaseUpdated(rctx, fc.Args["suiteId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.TestCase):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
 	}
-	res := resTmp.(*model.TestSuite)
-	fc.Result = res
-	return ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateTestSuite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_testCaseUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Subscription",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_TestSuite_id(ctx, field)
-			case "name":
-				return ec.fieldContext_TestSuite_name(ctx, field)
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
 			case "description":
-				return ec.fieldContext_TestSuite_description(ctx, field)
+				return ec.fieldContext_TestCase_description(ctx, field)
 			case "status":
-				return ec.fieldContext_TestSuite_status(ctx, field)
-			case "estimatedStartDate":
-				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
-			case "estimatedEndDate":
-				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
-			case "requireEffortComment":
-				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
-			case "progress":
-				return ec.fieldContext_TestSuite_progress(ctx, field)
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_TestSuite_createdAt(ctx, field)
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
-			case "groups":
-				return ec.fieldContext_TestSuite_groups(ctx, field)
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
 		},
 	}
 	defer func() {
@@ -2212,15 +9838,144 @@ func (ec *executionContext) fieldContext_Mutation_updateTestSuite(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateTestSuite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Subscription_testCaseUpdated_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_suiteActivity(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_suiteActivity(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().SuiteActivity(rctx, fc.Args["suiteId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.SuiteActivity):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNSuiteActivity2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteActivity(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_suiteActivity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_SuiteActivity_type(ctx, field)
+			case "suiteId":
+				return ec.fieldContext_SuiteActivity_suiteId(ctx, field)
+			case "entityType":
+				return ec.fieldContext_SuiteActivity_entityType(ctx, field)
+			case "entityId":
+				return ec.fieldContext_SuiteActivity_entityId(ctx, field)
+			case "testCaseId":
+				return ec.fieldContext_SuiteActivity_testCaseId(ctx, field)
+			case "actorId":
+				return ec.fieldContext_SuiteActivity_actorId(ctx, field)
+			case "occurredAt":
+				return ec.fieldContext_SuiteActivity_occurredAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SuiteActivity", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_suiteActivity_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateTestSuiteStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updateTestSuiteStatus(ctx, field)
+func (ec *executionContext) _SuiteActivity_type(ctx context.Context, field graphql.CollectedField, obj *model.SuiteActivity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SuiteActivity_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SuiteActivityType)
+	fc.Result = res
+	return ec.marshalNSuiteActivityType2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteActivityType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SuiteActivity_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SuiteActivity",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SuiteActivityType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SuiteActivity_suiteId(ctx context.Context, field graphql.CollectedField, obj *model.SuiteActivity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SuiteActivity_suiteId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2233,7 +9988,7 @@ func (ec *executionContext) _Mutation_updateTestSuiteStatus(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateTestSuiteStatus(rctx, fc.Args["id"].(string), fc.Args["status"].(model.SuiteStatus))
+		return obj.SuiteID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2245,61 +10000,26 @@ func (ec *executionContext) _Mutation_updateTestSuiteStatus(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TestSuite)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateTestSuiteStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SuiteActivity_suiteId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "SuiteActivity",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_TestSuite_id(ctx, field)
-			case "name":
-				return ec.fieldContext_TestSuite_name(ctx, field)
-			case "description":
-				return ec.fieldContext_TestSuite_description(ctx, field)
-			case "status":
-				return ec.fieldContext_TestSuite_status(ctx, field)
-			case "estimatedStartDate":
-				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
-			case "estimatedEndDate":
-				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
-			case "requireEffortComment":
-				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
-			case "progress":
-				return ec.fieldContext_TestSuite_progress(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_TestSuite_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
-			case "groups":
-				return ec.fieldContext_TestSuite_groups(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateTestSuiteStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_login(ctx, field)
+func (ec *executionContext) _SuiteActivity_entityType(ctx context.Context, field graphql.CollectedField, obj *model.SuiteActivity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SuiteActivity_entityType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2312,7 +10032,7 @@ func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Login(rctx, fc.Args["username"].(string), fc.Args["password"].(string))
+		return obj.EntityType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2324,47 +10044,26 @@ func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.AuthPayload)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNAuthPayload2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐAuthPayload(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SuiteActivity_entityType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "SuiteActivity",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "token":
-				return ec.fieldContext_AuthPayload_token(ctx, field)
-			case "refreshToken":
-				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_refreshToken(ctx, field)
+func (ec *executionContext) _SuiteActivity_entityId(ctx context.Context, field graphql.CollectedField, obj *model.SuiteActivity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SuiteActivity_entityId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2377,7 +10076,7 @@ func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RefreshToken(rctx, fc.Args["refreshToken"].(string))
+		return obj.EntityID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2389,47 +10088,26 @@ func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.AuthPayload)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNAuthPayload2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐAuthPayload(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SuiteActivity_entityId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "SuiteActivity",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "token":
-				return ec.fieldContext_AuthPayload_token(ctx, field)
-			case "refreshToken":
-				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
-			case "expiresAt":
-				return ec.fieldContext_AuthPayload_expiresAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_refreshToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_logout(ctx, field)
+func (ec *executionContext) _SuiteActivity_testCaseId(ctx context.Context, field graphql.CollectedField, obj *model.SuiteActivity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SuiteActivity_testCaseId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2441,72 +10119,77 @@ func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().Logout(rctx, fc.Args["refreshToken"].(string))
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			if ec.directives.Auth == nil {
-				var zeroVal bool
-				return zeroVal, errors.New("directive auth is not implemented")
-			}
-			return ec.directives.Auth(ctx, nil, directive0)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(bool); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.TestCaseID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_logout(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SuiteActivity_testCaseId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "SuiteActivity",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SuiteActivity_actorId(ctx context.Context, field graphql.CollectedField, obj *model.SuiteActivity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SuiteActivity_actorId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_logout_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ActorID, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SuiteActivity_actorId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SuiteActivity",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createUser(ctx, field)
+func (ec *executionContext) _SuiteActivity_occurredAt(ctx context.Context, field graphql.CollectedField, obj *model.SuiteActivity) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SuiteActivity_occurredAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2518,35 +10201,52 @@ func (ec *executionContext) _Mutation_createUser(ctx context.Context, field grap
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().CreateUser(rctx, fc.Args["input"].(model.CreateUserInput))
+		ctx = rctx // use context from middleware stack in children
+		return obj.OccurredAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
 
-		directive1 := func(ctx context.Context) (any, error) {
-			role, err := ec.unmarshalNString2string(ctx, "Admin")
-			if err != nil {
-				var zeroVal *model.User
-				return zeroVal, err
-			}
-			if ec.directives.HasRole == nil {
-				var zeroVal *model.User
-				return zeroVal, errors.New("directive hasRole is not implemented")
-			}
-			return ec.directives.HasRole(ctx, nil, directive0, role)
-		}
+func (ec *executionContext) fieldContext_SuiteActivity_occurredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SuiteActivity",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
 
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*model.User); ok {
-			return data, nil
+func (ec *executionContext) _TestCase_id(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GlobalID(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2558,51 +10258,26 @@ func (ec *executionContext) _Mutation_createUser(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "TestCase",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "role":
-				return ec.fieldContext_User_role(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_User_updatedAt(ctx, field)
-			case "lastLoginAt":
-				return ec.fieldContext_User_lastLoginAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_changePassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_changePassword(ctx, field)
+func (ec *executionContext) _TestCase_title(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2614,30 +10289,8 @@ func (ec *executionContext) _Mutation_changePassword(ctx context.Context, field
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().ChangePassword(rctx, fc.Args["oldPassword"].(string), fc.Args["newPassword"].(string))
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			if ec.directives.Auth == nil {
-				var zeroVal bool
-				return zeroVal, errors.New("directive auth is not implemented")
-			}
-			return ec.directives.Auth(ctx, nil, directive0)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(bool); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2649,37 +10302,26 @@ func (ec *executionContext) _Mutation_changePassword(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_changePassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "TestCase",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_changePassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_resetPassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_resetPassword(ctx, field)
+func (ec *executionContext) _TestCase_description(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2691,77 +10333,36 @@ func (ec *executionContext) _Mutation_resetPassword(ctx context.Context, field g
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().ResetPassword(rctx, fc.Args["userId"].(string), fc.Args["newPassword"].(string))
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			role, err := ec.unmarshalNString2string(ctx, "Admin")
-			if err != nil {
-				var zeroVal bool
-				return zeroVal, err
-			}
-			if ec.directives.HasRole == nil {
-				var zeroVal bool
-				return zeroVal, errors.New("directive hasRole is not implemented")
-			}
-			return ec.directives.HasRole(ctx, nil, directive0, role)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(bool); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_resetPassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "TestCase",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_resetPassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteUser(ctx, field)
+func (ec *executionContext) _TestCase_status(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2773,35 +10374,8 @@ func (ec *executionContext) _Mutation_deleteUser(ctx context.Context, field grap
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().DeleteUser(rctx, fc.Args["userId"].(string))
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			role, err := ec.unmarshalNString2string(ctx, "Admin")
-			if err != nil {
-				var zeroVal bool
-				return zeroVal, err
-			}
-			if ec.directives.HasRole == nil {
-				var zeroVal bool
-				return zeroVal, errors.New("directive hasRole is not implemented")
-			}
-			return ec.directives.HasRole(ctx, nil, directive0, role)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(bool); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be bool`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2813,37 +10387,26 @@ func (ec *executionContext) _Mutation_deleteUser(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.TestStatus)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTestStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "TestCase",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type TestStatus does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updateUser(ctx, field)
+func (ec *executionContext) _TestCase_priority(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_priority(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2853,37 +10416,10 @@ func (ec *executionContext) _Mutation_updateUser(ctx context.Context, field grap
 			ec.Error(ctx, ec.Recover(ctx, r))
 			ret = graphql.Null
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().UpdateUser(rctx, fc.Args["userId"].(string), fc.Args["input"].(model.UpdateUserInput))
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			role, err := ec.unmarshalNString2string(ctx, "Admin")
-			if err != nil {
-				var zeroVal *model.User
-				return zeroVal, err
-			}
-			if ec.directives.HasRole == nil {
-				var zeroVal *model.User
-				return zeroVal, errors.New("directive hasRole is not implemented")
-			}
-			return ec.directives.HasRole(ctx, nil, directive0, role)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*model.User); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Priority, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2895,51 +10431,26 @@ func (ec *executionContext) _Mutation_updateUser(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(model.Priority)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNPriority2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "TestCase",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "role":
-				return ec.fieldContext_User_role(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_User_updatedAt(ctx, field)
-			case "lastLoginAt":
-				return ec.fieldContext_User_lastLoginAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type Priority does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+func (ec *executionContext) _TestCase_plannedEffort(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_plannedEffort(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2952,38 +10463,35 @@ func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasNextPage, nil
+		return obj.PlannedEffort, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_plannedEffort(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "TestCase",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+func (ec *executionContext) _TestCase_actualEffort(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_actualEffort(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2996,38 +10504,35 @@ func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasPreviousPage, nil
+		return obj.ActualEffort, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_actualEffort(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "TestCase",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_startCursor(ctx, field)
+func (ec *executionContext) _TestCase_dueDate(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_dueDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3040,7 +10545,7 @@ func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StartCursor, nil
+		return obj.DueDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3049,26 +10554,26 @@ func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_startCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_dueDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "TestCase",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
+func (ec *executionContext) _TestCase_isDelayed(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_isDelayed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3081,35 +10586,38 @@ func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EndCursor, nil
+		return obj.IsDelayed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_isDelayed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "TestCase",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_testSuite(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_testSuite(ctx, field)
+func (ec *executionContext) _TestCase_delayDays(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_delayDays(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3122,7 +10630,7 @@ func (ec *executionContext) _Query_testSuite(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TestSuite(rctx, fc.Args["id"].(string))
+		return obj.DelayDays, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3131,61 +10639,26 @@ func (ec *executionContext) _Query_testSuite(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.TestSuite)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_testSuite(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_delayDays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestCase",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_TestSuite_id(ctx, field)
-			case "name":
-				return ec.fieldContext_TestSuite_name(ctx, field)
-			case "description":
-				return ec.fieldContext_TestSuite_description(ctx, field)
-			case "status":
-				return ec.fieldContext_TestSuite_status(ctx, field)
-			case "estimatedStartDate":
-				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
-			case "estimatedEndDate":
-				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
-			case "requireEffortComment":
-				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
-			case "progress":
-				return ec.fieldContext_TestSuite_progress(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_TestSuite_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
-			case "groups":
-				return ec.fieldContext_TestSuite_groups(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_testSuite_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_testSuites(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_testSuites(ctx, field)
+func (ec *executionContext) _TestCase_groupId(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_groupId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3198,7 +10671,7 @@ func (ec *executionContext) _Query_testSuites(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TestSuites(rctx, fc.Args["status"].(*model.SuiteStatus), fc.Args["page"].(*int), fc.Args["pageSize"].(*int))
+		return obj.GlobalGroupID(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3210,45 +10683,26 @@ func (ec *executionContext) _Query_testSuites(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TestSuiteConnection)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTestSuiteConnection2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteConnection(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_testSuites(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_groupId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestCase",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "edges":
-				return ec.fieldContext_TestSuiteConnection_edges(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_TestSuiteConnection_pageInfo(ctx, field)
-			case "totalCount":
-				return ec.fieldContext_TestSuiteConnection_totalCount(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TestSuiteConnection", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_testSuites_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_me(ctx, field)
+func (ec *executionContext) _TestCase_lockedBy(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_lockedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3260,75 +10714,36 @@ func (ec *executionContext) _Query_me(ctx context.Context, field graphql.Collect
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().Me(rctx)
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			if ec.directives.Auth == nil {
-				var zeroVal *model.User
-				return zeroVal, errors.New("directive auth is not implemented")
-			}
-			return ec.directives.Auth(ctx, nil, directive0)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*model.User); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.GlobalLockedBy(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_me(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_lockedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestCase",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "role":
-				return ec.fieldContext_User_role(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_User_updatedAt(ctx, field)
-			case "lastLoginAt":
-				return ec.fieldContext_User_lastLoginAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_adminData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_adminData(ctx, field)
+func (ec *executionContext) _TestCase_lockExpiresAt(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3340,66 +10755,36 @@ func (ec *executionContext) _Query_adminData(ctx context.Context, field graphql.
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().AdminData(rctx)
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			role, err := ec.unmarshalNString2string(ctx, "Admin")
-			if err != nil {
-				var zeroVal string
-				return zeroVal, err
-			}
-			if ec.directives.HasRole == nil {
-				var zeroVal string
-				return zeroVal, errors.New("directive hasRole is not implemented")
-			}
-			return ec.directives.HasRole(ctx, nil, directive0, role)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.LockExpiresAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_adminData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_lockExpiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestCase",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_managerData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_managerData(ctx, field)
+func (ec *executionContext) _TestCase_version(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3411,35 +10796,8 @@ func (ec *executionContext) _Query_managerData(ctx context.Context, field graphq
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().ManagerData(rctx)
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			role, err := ec.unmarshalNString2string(ctx, "Manager")
-			if err != nil {
-				var zeroVal string
-				return zeroVal, err
-			}
-			if ec.directives.HasRole == nil {
-				var zeroVal string
-				return zeroVal, errors.New("directive hasRole is not implemented")
-			}
-			return ec.directives.HasRole(ctx, nil, directive0, role)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3451,26 +10809,26 @@ func (ec *executionContext) _Query_managerData(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_managerData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestCase",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_testerData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_testerData(ctx, field)
+func (ec *executionContext) _TestCase_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3482,35 +10840,8 @@ func (ec *executionContext) _Query_testerData(ctx context.Context, field graphql
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().TesterData(rctx)
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			role, err := ec.unmarshalNString2string(ctx, "Tester")
-			if err != nil {
-				var zeroVal string
-				return zeroVal, err
-			}
-			if ec.directives.HasRole == nil {
-				var zeroVal string
-				return zeroVal, errors.New("directive hasRole is not implemented")
-			}
-			return ec.directives.HasRole(ctx, nil, directive0, role)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(string); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be string`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3522,26 +10853,26 @@ func (ec *executionContext) _Query_testerData(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_testerData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestCase",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_users(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_users(ctx, field)
+func (ec *executionContext) _TestCase_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3553,35 +10884,8 @@ func (ec *executionContext) _Query_users(ctx context.Context, field graphql.Coll
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().Users(rctx)
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			role, err := ec.unmarshalNString2string(ctx, "Admin")
-			if err != nil {
-				var zeroVal []*model.User
-				return zeroVal, err
-			}
-			if ec.directives.HasRole == nil {
-				var zeroVal []*model.User
-				return zeroVal, errors.New("directive hasRole is not implemented")
-			}
-			return ec.directives.HasRole(ctx, nil, directive0, role)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.([]*model.User); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be []*github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3593,40 +10897,26 @@ func (ec *executionContext) _Query_users(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.User)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUserᚄ(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_users(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestCase",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "role":
-				return ec.fieldContext_User_role(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_User_updatedAt(ctx, field)
-			case "lastLoginAt":
-				return ec.fieldContext_User_lastLoginAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_user(ctx, field)
+func (ec *executionContext) _TestCase_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3638,88 +10928,94 @@ func (ec *executionContext) _Query_user(ctx context.Context, field graphql.Colle
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		directive0 := func(rctx context.Context) (any, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().User(rctx, fc.Args["id"].(string))
-		}
-
-		directive1 := func(ctx context.Context) (any, error) {
-			role, err := ec.unmarshalNString2string(ctx, "Admin")
-			if err != nil {
-				var zeroVal *model.User
-				return zeroVal, err
-			}
-			if ec.directives.HasRole == nil {
-				var zeroVal *model.User
-				return zeroVal, errors.New("directive hasRole is not implemented")
-			}
-			return ec.directives.HasRole(ctx, nil, directive0, role)
-		}
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeletedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
 
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*model.User); ok {
-			return data, nil
+func (ec *executionContext) fieldContext_TestCase_deletedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestCase",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestCase_statusHistory(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestCase_statusHistory(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model.User`, tmp)
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.TestCase().StatusHistory(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.([]*model.StatusHistory)
 	fc.Result = res
-	return ec.marshalOUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNStatusHistory2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐStatusHistoryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestCase_statusHistory(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestCase",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "role":
-				return ec.fieldContext_User_role(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_User_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_User_updatedAt(ctx, field)
-			case "lastLoginAt":
-				return ec.fieldContext_User_lastLoginAt(ctx, field)
+				return ec.fieldContext_StatusHistory_id(ctx, field)
+			case "oldStatus":
+				return ec.fieldContext_StatusHistory_oldStatus(ctx, field)
+			case "newStatus":
+				return ec.fieldContext_StatusHistory_newStatus(ctx, field)
+			case "changedAt":
+				return ec.fieldContext_StatusHistory_changedAt(ctx, field)
+			case "changedBy":
+				return ec.fieldContext_StatusHistory_changedBy(ctx, field)
+			case "reason":
+				return ec.fieldContext_StatusHistory_reason(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type StatusHistory", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_user_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _TestGroup_id(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3732,70 +11028,38 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.GlobalID(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _TestGroup_name(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3808,131 +11072,79 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "TestGroup",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_testSuiteStatusChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_testSuiteStatusChanged(ctx, field)
+func (ec *executionContext) _TestGroup_description(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_description(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().TestSuiteStatusChanged(rctx)
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.TestSuite):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_testSuiteStatusChanged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "TestGroup",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_TestSuite_id(ctx, field)
-			case "name":
-				return ec.fieldContext_TestSuite_name(ctx, field)
-			case "description":
-				return ec.fieldContext_TestSuite_description(ctx, field)
-			case "status":
-				return ec.fieldContext_TestSuite_status(ctx, field)
-			case "estimatedStartDate":
-				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
-			case "estimatedEndDate":
-				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
-			case "requireEffortComment":
-				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
-			case "progress":
-				return ec.fieldContext_TestSuite_progress(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_TestSuite_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
-			case "groups":
-				return ec.fieldContext_TestSuite_groups(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_id(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_id(ctx, field)
+func (ec *executionContext) _TestGroup_displayOrder(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_displayOrder(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3945,7 +11157,7 @@ func (ec *executionContext) _TestCase_id(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.DisplayOrder, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3957,26 +11169,26 @@ func (ec *executionContext) _TestCase_id(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_displayOrder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_title(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_title(ctx, field)
+func (ec *executionContext) _TestGroup_suiteId(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_suiteId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3989,7 +11201,7 @@ func (ec *executionContext) _TestCase_title(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.GlobalSuiteID(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4003,24 +11215,24 @@ func (ec *executionContext) _TestCase_title(ctx context.Context, field graphql.C
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_suiteId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_description(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_description(ctx, field)
+func (ec *executionContext) _TestGroup_status(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4033,35 +11245,38 @@ func (ec *executionContext) _TestCase_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.SuiteStatus)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type SuiteStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
-
-func (ec *executionContext) _TestCase_status(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_status(ctx, field)
+
+func (ec *executionContext) _TestGroup_statusLocked(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_statusLocked(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4074,7 +11289,7 @@ func (ec *executionContext) _TestCase_status(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.StatusLocked, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4086,26 +11301,26 @@ func (ec *executionContext) _TestCase_status(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.TestStatus)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNTestStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestStatus(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_statusLocked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type TestStatus does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_priority(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_priority(ctx, field)
+func (ec *executionContext) _TestGroup_progress(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_progress(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4118,7 +11333,7 @@ func (ec *executionContext) _TestCase_priority(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Priority, nil
+		return obj.Progress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4130,26 +11345,26 @@ func (ec *executionContext) _TestCase_priority(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Priority)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNPriority2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_progress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Priority does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_plannedEffort(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_plannedEffort(ctx, field)
+func (ec *executionContext) _TestGroup_completedCaseCount(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_completedCaseCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4162,35 +11377,38 @@ func (ec *executionContext) _TestCase_plannedEffort(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PlannedEffort, nil
+		return obj.CompletedCaseCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_plannedEffort(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_completedCaseCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_actualEffort(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_actualEffort(ctx, field)
+func (ec *executionContext) _TestGroup_totalCaseCount(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_totalCaseCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4203,35 +11421,38 @@ func (ec *executionContext) _TestCase_actualEffort(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActualEffort, nil
+		return obj.TotalCaseCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_actualEffort(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_totalCaseCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_isDelayed(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_isDelayed(ctx, field)
+func (ec *executionContext) _TestGroup_version(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4244,7 +11465,7 @@ func (ec *executionContext) _TestCase_isDelayed(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDelayed, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4256,26 +11477,26 @@ func (ec *executionContext) _TestCase_isDelayed(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_isDelayed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_delayDays(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_delayDays(ctx, field)
+func (ec *executionContext) _TestGroup_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4288,35 +11509,38 @@ func (ec *executionContext) _TestCase_delayDays(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DelayDays, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_delayDays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_groupId(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_groupId(ctx, field)
+func (ec *executionContext) _TestGroup_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4329,7 +11553,7 @@ func (ec *executionContext) _TestCase_groupId(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GroupID, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4341,26 +11565,26 @@ func (ec *executionContext) _TestCase_groupId(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_groupId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_createdAt(ctx, field)
+func (ec *executionContext) _TestGroup_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4373,26 +11597,23 @@ func (ec *executionContext) _TestCase_createdAt(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.DeletedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_deletedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4403,8 +11624,8 @@ func (ec *executionContext) fieldContext_TestCase_createdAt(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _TestCase_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.TestCase) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestCase_updatedAt(ctx, field)
+func (ec *executionContext) _TestGroup_cases(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestGroup_cases(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4417,38 +11638,73 @@ func (ec *executionContext) _TestCase_updatedAt(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return ec.resolvers.TestGroup().Cases(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*model.TestCase)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOTestCase2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCaseᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestCase_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestGroup_cases(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestCase",
+		Object:     "TestGroup",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestGroup_id(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestGroup_id(ctx, field)
+func (ec *executionContext) _TestSuite_id(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4461,7 +11717,7 @@ func (ec *executionContext) _TestGroup_id(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.GlobalID(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4478,11 +11734,11 @@ func (ec *executionContext) _TestGroup_id(ctx context.Context, field graphql.Col
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestGroup_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestGroup",
+		Object:     "TestSuite",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type ID does not have child fields")
@@ -4491,8 +11747,8 @@ func (ec *executionContext) fieldContext_TestGroup_id(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _TestGroup_name(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestGroup_name(ctx, field)
+func (ec *executionContext) _TestSuite_name(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4522,9 +11778,9 @@ func (ec *executionContext) _TestGroup_name(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestGroup_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestGroup",
+		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4535,8 +11791,8 @@ func (ec *executionContext) fieldContext_TestGroup_name(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _TestGroup_description(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestGroup_description(ctx, field)
+func (ec *executionContext) _TestSuite_description(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4563,9 +11819,9 @@ func (ec *executionContext) _TestGroup_description(ctx context.Context, field gr
 	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestGroup_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestGroup",
+		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4576,8 +11832,8 @@ func (ec *executionContext) fieldContext_TestGroup_description(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _TestGroup_displayOrder(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestGroup_displayOrder(ctx, field)
+func (ec *executionContext) _TestSuite_status(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4590,7 +11846,7 @@ func (ec *executionContext) _TestGroup_displayOrder(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayOrder, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4602,26 +11858,26 @@ func (ec *executionContext) _TestGroup_displayOrder(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.SuiteStatus)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestGroup_displayOrder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestGroup",
+		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type SuiteStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestGroup_suiteId(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestGroup_suiteId(ctx, field)
+func (ec *executionContext) _TestSuite_estimatedStartDate(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4634,7 +11890,7 @@ func (ec *executionContext) _TestGroup_suiteId(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SuiteID, nil
+		return obj.EstimatedStartDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4646,26 +11902,26 @@ func (ec *executionContext) _TestGroup_suiteId(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestGroup_suiteId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_estimatedStartDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestGroup",
+		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestGroup_status(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestGroup_status(ctx, field)
+func (ec *executionContext) _TestSuite_estimatedEndDate(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4678,7 +11934,7 @@ func (ec *executionContext) _TestGroup_status(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.EstimatedEndDate, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4690,26 +11946,26 @@ func (ec *executionContext) _TestGroup_status(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SuiteStatus)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestGroup_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_estimatedEndDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestGroup",
+		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type SuiteStatus does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestGroup_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestGroup_createdAt(ctx, field)
+func (ec *executionContext) _TestSuite_requireEffortComment(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4722,7 +11978,7 @@ func (ec *executionContext) _TestGroup_createdAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.RequireEffortComment, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4734,26 +11990,26 @@ func (ec *executionContext) _TestGroup_createdAt(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestGroup_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_requireEffortComment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestGroup",
+		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestGroup_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestGroup_updatedAt(ctx, field)
+func (ec *executionContext) _TestSuite_exitCriteria(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_exitCriteria(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4766,7 +12022,7 @@ func (ec *executionContext) _TestGroup_updatedAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.ExitCriteria, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4778,26 +12034,32 @@ func (ec *executionContext) _TestGroup_updatedAt(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*model.ExitCriteria)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNExitCriteria2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐExitCriteria(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestGroup_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_exitCriteria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestGroup",
+		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "minCompletionRate":
+				return ec.fieldContext_ExitCriteria_minCompletionRate(ctx, field)
+			case "allowOpenCritical":
+				return ec.fieldContext_ExitCriteria_allowOpenCritical(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExitCriteria", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestGroup_cases(ctx context.Context, field graphql.CollectedField, obj *model.TestGroup) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestGroup_cases(ctx, field)
+func (ec *executionContext) _TestSuite_exitCriteriaOverride(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_exitCriteriaOverride(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4810,7 +12072,7 @@ func (ec *executionContext) _TestGroup_cases(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.TestGroup().Cases(rctx, obj)
+		return obj.ExitCriteriaOverride, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4819,52 +12081,34 @@ func (ec *executionContext) _TestGroup_cases(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TestCase)
+	res := resTmp.(*model.ExitCriteriaOverride)
 	fc.Result = res
-	return ec.marshalOTestCase2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCaseᚄ(ctx, field.Selections, res)
+	return ec.marshalOExitCriteriaOverride2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐExitCriteriaOverride(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestGroup_cases(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_exitCriteriaOverride(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestGroup",
+		Object:     "TestSuite",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_TestCase_id(ctx, field)
-			case "title":
-				return ec.fieldContext_TestCase_title(ctx, field)
-			case "description":
-				return ec.fieldContext_TestCase_description(ctx, field)
-			case "status":
-				return ec.fieldContext_TestCase_status(ctx, field)
-			case "priority":
-				return ec.fieldContext_TestCase_priority(ctx, field)
-			case "plannedEffort":
-				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
-			case "actualEffort":
-				return ec.fieldContext_TestCase_actualEffort(ctx, field)
-			case "isDelayed":
-				return ec.fieldContext_TestCase_isDelayed(ctx, field)
-			case "delayDays":
-				return ec.fieldContext_TestCase_delayDays(ctx, field)
-			case "groupId":
-				return ec.fieldContext_TestCase_groupId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_TestCase_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "reason":
+				return ec.fieldContext_ExitCriteriaOverride_reason(ctx, field)
+			case "overriddenBy":
+				return ec.fieldContext_ExitCriteriaOverride_overriddenBy(ctx, field)
+			case "overriddenAt":
+				return ec.fieldContext_ExitCriteriaOverride_overriddenAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ExitCriteriaOverride", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_id(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_id(ctx, field)
+func (ec *executionContext) _TestSuite_progress(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_progress(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4877,7 +12121,7 @@ func (ec *executionContext) _TestSuite_id(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Progress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4889,26 +12133,26 @@ func (ec *executionContext) _TestSuite_id(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_progress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_name(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_name(ctx, field)
+func (ec *executionContext) _TestSuite_completedCaseCount(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_completedCaseCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4921,7 +12165,7 @@ func (ec *executionContext) _TestSuite_name(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.CompletedCaseCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4933,26 +12177,26 @@ func (ec *executionContext) _TestSuite_name(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_completedCaseCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_description(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_description(ctx, field)
+func (ec *executionContext) _TestSuite_totalCaseCount(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_totalCaseCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4965,35 +12209,38 @@ func (ec *executionContext) _TestSuite_description(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.TotalCaseCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_totalCaseCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_status(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_status(ctx, field)
+func (ec *executionContext) _TestSuite_version(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5006,7 +12253,7 @@ func (ec *executionContext) _TestSuite_status(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5018,26 +12265,26 @@ func (ec *executionContext) _TestSuite_status(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.SuiteStatus)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type SuiteStatus does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_estimatedStartDate(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
+func (ec *executionContext) _TestSuite_createdBy(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_createdBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5050,38 +12297,35 @@ func (ec *executionContext) _TestSuite_estimatedStartDate(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EstimatedStartDate, nil
+		return obj.GlobalCreatedBy(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_estimatedStartDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuite",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_estimatedEndDate(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
+func (ec *executionContext) _TestSuite_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5094,7 +12338,7 @@ func (ec *executionContext) _TestSuite_estimatedEndDate(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EstimatedEndDate, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5111,7 +12355,7 @@ func (ec *executionContext) _TestSuite_estimatedEndDate(ctx context.Context, fie
 	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_estimatedEndDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuite",
 		Field:      field,
@@ -5124,8 +12368,8 @@ func (ec *executionContext) fieldContext_TestSuite_estimatedEndDate(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_requireEffortComment(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
+func (ec *executionContext) _TestSuite_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5138,7 +12382,7 @@ func (ec *executionContext) _TestSuite_requireEffortComment(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequireEffortComment, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5150,26 +12394,26 @@ func (ec *executionContext) _TestSuite_requireEffortComment(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_requireEffortComment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_progress(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_progress(ctx, field)
+func (ec *executionContext) _TestSuite_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5182,38 +12426,35 @@ func (ec *executionContext) _TestSuite_progress(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Progress, nil
+		return obj.DeletedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_progress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_deletedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuite",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_createdAt(ctx, field)
+func (ec *executionContext) _TestSuite_groups(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuite_groups(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5226,38 +12467,67 @@ func (ec *executionContext) _TestSuite_createdAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return ec.resolvers.TestSuite().Groups(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*model.TestGroup)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOTestGroup2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroupᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuite_groups(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuite",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestGroup_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestGroup_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestGroup_description(ctx, field)
+			case "displayOrder":
+				return ec.fieldContext_TestGroup_displayOrder(ctx, field)
+			case "suiteId":
+				return ec.fieldContext_TestGroup_suiteId(ctx, field)
+			case "status":
+				return ec.fieldContext_TestGroup_status(ctx, field)
+			case "statusLocked":
+				return ec.fieldContext_TestGroup_statusLocked(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestGroup_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestGroup_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestGroup_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestGroup_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestGroup_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestGroup_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestGroup_deletedAt(ctx, field)
+			case "cases":
+				return ec.fieldContext_TestGroup_cases(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestGroup", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_updatedAt(ctx, field)
+func (ec *executionContext) _TestSuiteConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuiteConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5270,7 +12540,7 @@ func (ec *executionContext) _TestSuite_updatedAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5282,26 +12552,32 @@ func (ec *executionContext) _TestSuite_updatedAt(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*model.TestSuiteEdge)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNTestSuiteEdge2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuiteConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestSuite",
+		Object:     "TestSuiteConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "node":
+				return ec.fieldContext_TestSuiteEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_TestSuiteEdge_cursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestSuiteEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuite_groups(ctx context.Context, field graphql.CollectedField, obj *model.TestSuite) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuite_groups(ctx, field)
+func (ec *executionContext) _TestSuiteConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuiteConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5314,55 +12590,48 @@ func (ec *executionContext) _TestSuite_groups(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.TestSuite().Groups(rctx, obj)
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TestGroup)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalOTestGroup2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroupᚄ(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuite_groups(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuiteConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestSuite",
+		Object:     "TestSuiteConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_TestGroup_id(ctx, field)
-			case "name":
-				return ec.fieldContext_TestGroup_name(ctx, field)
-			case "description":
-				return ec.fieldContext_TestGroup_description(ctx, field)
-			case "displayOrder":
-				return ec.fieldContext_TestGroup_displayOrder(ctx, field)
-			case "suiteId":
-				return ec.fieldContext_TestGroup_suiteId(ctx, field)
-			case "status":
-				return ec.fieldContext_TestGroup_status(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_TestGroup_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_TestGroup_updatedAt(ctx, field)
-			case "cases":
-				return ec.fieldContext_TestGroup_cases(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TestGroup", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuiteConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuiteConnection_edges(ctx, field)
+func (ec *executionContext) _TestSuiteConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuiteConnection_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5375,7 +12644,7 @@ func (ec *executionContext) _TestSuiteConnection_edges(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5387,32 +12656,26 @@ func (ec *executionContext) _TestSuiteConnection_edges(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TestSuiteEdge)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTestSuiteEdge2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteEdgeᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuiteConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuiteConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TestSuiteConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "node":
-				return ec.fieldContext_TestSuiteEdge_node(ctx, field)
-			case "cursor":
-				return ec.fieldContext_TestSuiteEdge_cursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TestSuiteEdge", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuiteConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuiteConnection_pageInfo(ctx, field)
+func (ec *executionContext) _TestSuiteEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuiteEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5425,7 +12688,7 @@ func (ec *executionContext) _TestSuiteConnection_pageInfo(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5437,36 +12700,64 @@ func (ec *executionContext) _TestSuiteConnection_pageInfo(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PageInfo)
+	res := resTmp.(*model.TestSuite)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuiteConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuiteEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestSuiteConnection",
+		Object:     "TestSuiteEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "id":
+				return ec.fieldContext_TestSuite_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestSuite_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestSuite_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestSuite_status(ctx, field)
+			case "estimatedStartDate":
+				return ec.fieldContext_TestSuite_estimatedStartDate(ctx, field)
+			case "estimatedEndDate":
+				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
+			case "requireEffortComment":
+				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
+			case "exitCriteria":
+				return ec.fieldContext_TestSuite_exitCriteria(ctx, field)
+			case "exitCriteriaOverride":
+				return ec.fieldContext_TestSuite_exitCriteriaOverride(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestSuite_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestSuite_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestSuite_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestSuite_version(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TestSuite_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestSuite_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestSuite_deletedAt(ctx, field)
+			case "groups":
+				return ec.fieldContext_TestSuite_groups(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TestSuite", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuiteConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuiteConnection_totalCount(ctx, field)
+func (ec *executionContext) _TestSuiteEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestSuiteEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5479,7 +12770,7 @@ func (ec *executionContext) _TestSuiteConnection_totalCount(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5491,26 +12782,26 @@ func (ec *executionContext) _TestSuiteConnection_totalCount(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuiteConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestSuiteEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestSuiteConnection",
+		Object:     "TestSuiteEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuiteEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuiteEdge_node(ctx, field)
+func (ec *executionContext) _Trash_testSuites(ctx context.Context, field graphql.CollectedField, obj *model.Trash) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trash_testSuites(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5523,7 +12814,7 @@ func (ec *executionContext) _TestSuiteEdge_node(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.TestSuites, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5535,14 +12826,14 @@ func (ec *executionContext) _TestSuiteEdge_node(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TestSuite)
+	res := resTmp.([]*model.TestSuite)
 	fc.Result = res
-	return ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, field.Selections, res)
+	return ec.marshalNTestSuite2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuiteEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trash_testSuites(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestSuiteEdge",
+		Object:     "Trash",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5562,12 +12853,26 @@ func (ec *executionContext) fieldContext_TestSuiteEdge_node(_ context.Context, f
 				return ec.fieldContext_TestSuite_estimatedEndDate(ctx, field)
 			case "requireEffortComment":
 				return ec.fieldContext_TestSuite_requireEffortComment(ctx, field)
+			case "exitCriteria":
+				return ec.fieldContext_TestSuite_exitCriteria(ctx, field)
+			case "exitCriteriaOverride":
+				return ec.fieldContext_TestSuite_exitCriteriaOverride(ctx, field)
 			case "progress":
 				return ec.fieldContext_TestSuite_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestSuite_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestSuite_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestSuite_version(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_TestSuite_createdBy(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_TestSuite_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_TestSuite_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestSuite_deletedAt(ctx, field)
 			case "groups":
 				return ec.fieldContext_TestSuite_groups(ctx, field)
 			}
@@ -5577,8 +12882,8 @@ func (ec *executionContext) fieldContext_TestSuiteEdge_node(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _TestSuiteEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.TestSuiteEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TestSuiteEdge_cursor(ctx, field)
+func (ec *executionContext) _Trash_testGroups(ctx context.Context, field graphql.CollectedField, obj *model.Trash) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trash_testGroups(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5591,7 +12896,7 @@ func (ec *executionContext) _TestSuiteEdge_cursor(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.TestGroups, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5603,19 +12908,133 @@ func (ec *executionContext) _TestSuiteEdge_cursor(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.TestGroup)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTestGroup2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroupᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TestSuiteEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Trash_testGroups(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TestSuiteEdge",
+		Object:     "Trash",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestGroup_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TestGroup_name(ctx, field)
+			case "description":
+				return ec.fieldContext_TestGroup_description(ctx, field)
+			case "displayOrder":
+				return ec.fieldContext_TestGroup_displayOrder(ctx, field)
+			case "suiteId":
+				return ec.fieldContext_TestGroup_suiteId(ctx, field)
+			case "status":
+				return ec.fieldContext_TestGroup_status(ctx, field)
+			case "statusLocked":
+				return ec.fieldContext_TestGroup_statusLocked(ctx, field)
+			case "progress":
+				return ec.fieldContext_TestGroup_progress(ctx, field)
+			case "completedCaseCount":
+				return ec.fieldContext_TestGroup_completedCaseCount(ctx, field)
+			case "totalCaseCount":
+				return ec.fieldContext_TestGroup_totalCaseCount(ctx, field)
+			case "version":
+				return ec.fieldContext_TestGroup_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestGroup_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestGroup_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestGroup_deletedAt(ctx, field)
+			case "cases":
+				return ec.fieldContext_TestGroup_cases(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestGroup", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trash_testCases(ctx context.Context, field graphql.CollectedField, obj *model.Trash) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Trash_testCases(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TestCases, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TestCase)
+	fc.Result = res
+	return ec.marshalNTestCase2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCaseᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Trash_testCases(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trash",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TestCase_id(ctx, field)
+			case "title":
+				return ec.fieldContext_TestCase_title(ctx, field)
+			case "description":
+				return ec.fieldContext_TestCase_description(ctx, field)
+			case "status":
+				return ec.fieldContext_TestCase_status(ctx, field)
+			case "priority":
+				return ec.fieldContext_TestCase_priority(ctx, field)
+			case "plannedEffort":
+				return ec.fieldContext_TestCase_plannedEffort(ctx, field)
+			case "actualEffort":
+				return ec.fieldContext_TestCase_actualEffort(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_TestCase_dueDate(ctx, field)
+			case "isDelayed":
+				return ec.fieldContext_TestCase_isDelayed(ctx, field)
+			case "delayDays":
+				return ec.fieldContext_TestCase_delayDays(ctx, field)
+			case "groupId":
+				return ec.fieldContext_TestCase_groupId(ctx, field)
+			case "lockedBy":
+				return ec.fieldContext_TestCase_lockedBy(ctx, field)
+			case "lockExpiresAt":
+				return ec.fieldContext_TestCase_lockExpiresAt(ctx, field)
+			case "version":
+				return ec.fieldContext_TestCase_version(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_TestCase_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_TestCase_updatedAt(ctx, field)
+			case "deletedAt":
+				return ec.fieldContext_TestCase_deletedAt(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_TestCase_statusHistory(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestCase", field.Name)
 		},
 	}
 	return fc, nil
@@ -5635,7 +13054,7 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.GlobalID(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5656,7 +13075,7 @@ func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphq
 	fc = &graphql.FieldContext{
 		Object:     "User",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type ID does not have child fields")
@@ -7826,12 +15245,156 @@ func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field
 			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	return fc, nil
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputCorrectEffortRecordInput(ctx context.Context, obj any) (model.CorrectEffortRecordInput, error) {
+	var it model.CorrectEffortRecordInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"effortAmount", "comment"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "effortAmount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("effortAmount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EffortAmount = data
+		case "comment":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("comment"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Comment = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateTestCaseInput(ctx context.Context, obj any) (model.CreateTestCaseInput, error) {
+	var it model.CreateTestCaseInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"groupId", "title", "description", "priority", "plannedEffort", "dueDate"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "groupId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupID = data
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "priority":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
+			data, err := ec.unmarshalOPriority2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Priority = data
+		case "plannedEffort":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("plannedEffort"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PlannedEffort = data
+		case "dueDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dueDate"))
+			data, err := ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DueDate = data
+		}
+	}
+
+	return it, nil
 }
 
-// endregion **************************** field.gotpl *****************************
+func (ec *executionContext) unmarshalInputCreateTestGroupInput(ctx context.Context, obj any) (model.CreateTestGroupInput, error) {
+	var it model.CreateTestGroupInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
 
-// region    **************************** input.gotpl *****************************
+	fieldsInOrder := [...]string{"suiteId", "name", "description", "displayOrder"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "suiteId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("suiteId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SuiteID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "displayOrder":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("displayOrder"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DisplayOrder = data
+		}
+	}
+
+	return it, nil
+}
 
 func (ec *executionContext) unmarshalInputCreateTestSuiteInput(ctx context.Context, obj any) (model.CreateTestSuiteInput, error) {
 	var it model.CreateTestSuiteInput
@@ -7840,7 +15403,7 @@ func (ec *executionContext) unmarshalInputCreateTestSuiteInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "description", "estimatedStartDate", "estimatedEndDate", "requireEffortComment"}
+	fieldsInOrder := [...]string{"name", "description", "estimatedStartDate", "estimatedEndDate", "requireEffortComment", "exitCriteria"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -7882,6 +15445,13 @@ func (ec *executionContext) unmarshalInputCreateTestSuiteInput(ctx context.Conte
 				return it, err
 			}
 			it.RequireEffortComment = data
+		case "exitCriteria":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exitCriteria"))
+			data, err := ec.unmarshalOExitCriteriaInput2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐExitCriteriaInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExitCriteria = data
 		}
 	}
 
@@ -7922,7 +15492,320 @@ func (ec *executionContext) unmarshalInputCreateUserInput(ctx context.Context, o
 			if err != nil {
 				return it, err
 			}
-			it.Role = data
+			it.Role = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputExitCriteriaInput(ctx context.Context, obj any) (model.ExitCriteriaInput, error) {
+	var it model.ExitCriteriaInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"minCompletionRate", "allowOpenCritical"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "minCompletionRate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minCompletionRate"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinCompletionRate = data
+		case "allowOpenCritical":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("allowOpenCritical"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AllowOpenCritical = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRecordEffortInput(ctx context.Context, obj any) (model.RecordEffortInput, error) {
+	var it model.RecordEffortInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"testCaseId", "recordDate", "effortAmount", "isAdditional", "comment"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "testCaseId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("testCaseId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TestCaseID = data
+		case "recordDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("recordDate"))
+			data, err := ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RecordDate = data
+		case "effortAmount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("effortAmount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EffortAmount = data
+		case "isAdditional":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isAdditional"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsAdditional = data
+		case "comment":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("comment"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Comment = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTestSuiteFilter(ctx context.Context, obj any) (model.TestSuiteFilter, error) {
+	var it model.TestSuiteFilter
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"search", "createdBy", "periodFrom", "periodTo", "minProgress", "maxProgress", "hasDelayedCases"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "search":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Search = data
+		case "createdBy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdBy"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedBy = data
+		case "periodFrom":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("periodFrom"))
+			data, err := ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PeriodFrom = data
+		case "periodTo":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("periodTo"))
+			data, err := ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PeriodTo = data
+		case "minProgress":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minProgress"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinProgress = data
+		case "maxProgress":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxProgress"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxProgress = data
+		case "hasDelayedCases":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hasDelayedCases"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HasDelayedCases = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTestSuiteOrder(ctx context.Context, obj any) (model.TestSuiteOrder, error) {
+	var it model.TestSuiteOrder
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["direction"]; !present {
+		asMap["direction"] = "ASC"
+	}
+
+	fieldsInOrder := [...]string{"field", "direction"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNTestSuiteSortField2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteSortField(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "direction":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			data, err := ec.unmarshalOSortDirection2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSortDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Direction = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateTestCaseInput(ctx context.Context, obj any) (model.UpdateTestCaseInput, error) {
+	var it model.UpdateTestCaseInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "description", "priority", "plannedEffort", "dueDate", "expectedVersion"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "priority":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
+			data, err := ec.unmarshalOPriority2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Priority = data
+		case "plannedEffort":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("plannedEffort"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PlannedEffort = data
+		case "dueDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dueDate"))
+			data, err := ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DueDate = data
+		case "expectedVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedVersion = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateTestGroupInput(ctx context.Context, obj any) (model.UpdateTestGroupInput, error) {
+	var it model.UpdateTestGroupInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "description", "status", "statusLocked", "expectedVersion"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalOSuiteStatus2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "statusLocked":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("statusLocked"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StatusLocked = data
+		case "expectedVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedVersion = data
 		}
 	}
 
@@ -7936,7 +15819,7 @@ func (ec *executionContext) unmarshalInputUpdateTestSuiteInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "description", "estimatedStartDate", "estimatedEndDate", "requireEffortComment"}
+	fieldsInOrder := [...]string{"name", "description", "estimatedStartDate", "estimatedEndDate", "requireEffortComment", "exitCriteria", "expectedVersion"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -7978,6 +15861,20 @@ func (ec *executionContext) unmarshalInputUpdateTestSuiteInput(ctx context.Conte
 				return it, err
 			}
 			it.RequireEffortComment = data
+		case "exitCriteria":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exitCriteria"))
+			data, err := ec.unmarshalOExitCriteriaInput2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐExitCriteriaInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExitCriteria = data
+		case "expectedVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expectedVersion"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpectedVersion = data
 		}
 	}
 
@@ -8022,6 +15919,43 @@ func (ec *executionContext) unmarshalInputUpdateUserInput(ctx context.Context, o
 
 // region    ************************** interface.gotpl ***************************
 
+func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj model.Node) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.User:
+		return ec._User(ctx, sel, &obj)
+	case *model.User:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._User(ctx, sel, obj)
+	case model.TestSuite:
+		return ec._TestSuite(ctx, sel, &obj)
+	case *model.TestSuite:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TestSuite(ctx, sel, obj)
+	case model.TestGroup:
+		return ec._TestGroup(ctx, sel, &obj)
+	case *model.TestGroup:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TestGroup(ctx, sel, obj)
+	case model.TestCase:
+		return ec._TestCase(ctx, sel, &obj)
+	case *model.TestCase:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TestCase(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
 // endregion ************************** interface.gotpl ***************************
 
 // region    **************************** object.gotpl ****************************
@@ -8080,6 +16014,214 @@ func (ec *executionContext) _AuthPayload(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
+var effortRecordImplementors = []string{"EffortRecord"}
+
+func (ec *executionContext) _EffortRecord(ctx context.Context, sel ast.SelectionSet, obj *model.EffortRecord) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, effortRecordImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EffortRecord")
+		case "id":
+			out.Values[i] = ec._EffortRecord_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "testCaseId":
+			out.Values[i] = ec._EffortRecord_testCaseId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recordDate":
+			out.Values[i] = ec._EffortRecord_recordDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effortAmount":
+			out.Values[i] = ec._EffortRecord_effortAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isAdditional":
+			out.Values[i] = ec._EffortRecord_isAdditional(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "comment":
+			out.Values[i] = ec._EffortRecord_comment(ctx, field, obj)
+		case "recordedBy":
+			out.Values[i] = ec._EffortRecord_recordedBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._EffortRecord_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var effortRecordListImplementors = []string{"EffortRecordList"}
+
+func (ec *executionContext) _EffortRecordList(ctx context.Context, sel ast.SelectionSet, obj *model.EffortRecordList) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, effortRecordListImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EffortRecordList")
+		case "records":
+			out.Values[i] = ec._EffortRecordList_records(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalEffort":
+			out.Values[i] = ec._EffortRecordList_totalEffort(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var exitCriteriaImplementors = []string{"ExitCriteria"}
+
+func (ec *executionContext) _ExitCriteria(ctx context.Context, sel ast.SelectionSet, obj *model.ExitCriteria) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, exitCriteriaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExitCriteria")
+		case "minCompletionRate":
+			out.Values[i] = ec._ExitCriteria_minCompletionRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "allowOpenCritical":
+			out.Values[i] = ec._ExitCriteria_allowOpenCritical(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var exitCriteriaOverrideImplementors = []string{"ExitCriteriaOverride"}
+
+func (ec *executionContext) _ExitCriteriaOverride(ctx context.Context, sel ast.SelectionSet, obj *model.ExitCriteriaOverride) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, exitCriteriaOverrideImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExitCriteriaOverride")
+		case "reason":
+			out.Values[i] = ec._ExitCriteriaOverride_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "overriddenBy":
+			out.Values[i] = ec._ExitCriteriaOverride_overriddenBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "overriddenAt":
+			out.Values[i] = ec._ExitCriteriaOverride_overriddenAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var mutationImplementors = []string{"Mutation"}
 
 func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -8101,21 +16243,140 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = graphql.MarshalString("Mutation")
 		case "createTestSuite":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createTestSuite(ctx, field)
+				return ec._Mutation_createTestSuite(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateTestSuite":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateTestSuite(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateTestSuiteStatus":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateTestSuiteStatus(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteTestSuite":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteTestSuite(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreTestSuite":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreTestSuite(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createTestGroup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createTestGroup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateTestGroup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateTestGroup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteTestGroup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteTestGroup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreTestGroup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreTestGroup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reorderTestGroups":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_reorderTestGroups(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createTestCase":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createTestCase(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateTestCase":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateTestCase(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteTestCase":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteTestCase(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restoreTestCase":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreTestCase(ctx, field)
 			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updateTestSuite":
+		case "moveTestCase":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateTestSuite(ctx, field)
+				return ec._Mutation_moveTestCase(ctx, field)
 			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updateTestSuiteStatus":
+		case "updateTestCaseStatus":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updateTestSuiteStatus(ctx, field)
+				return ec._Mutation_updateTestCaseStatus(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lockTestCase":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_lockTestCase(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "renewTestCaseLock":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_renewTestCaseLock(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unlockTestCase":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_unlockTestCase(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "forceUnlockTestCase":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_forceUnlockTestCase(ctx, field)
 			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
@@ -8176,6 +16437,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "recordEffort":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_recordEffort(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "correctEffortRecord":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_correctEffortRecord(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8306,6 +16581,66 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "testGroup":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_testGroup(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "testCase":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_testCase(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "trash":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_trash(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "me":
 			field := field
@@ -8435,6 +16770,91 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "effortRecords":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_effortRecords(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "effortRecordsByUser":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_effortRecordsByUser(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "node":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_node(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "nodes":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_nodes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -8467,6 +16887,67 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
+var statusHistoryImplementors = []string{"StatusHistory"}
+
+func (ec *executionContext) _StatusHistory(ctx context.Context, sel ast.SelectionSet, obj *model.StatusHistory) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, statusHistoryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StatusHistory")
+		case "id":
+			out.Values[i] = ec._StatusHistory_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "oldStatus":
+			out.Values[i] = ec._StatusHistory_oldStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "newStatus":
+			out.Values[i] = ec._StatusHistory_newStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changedAt":
+			out.Values[i] = ec._StatusHistory_changedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changedBy":
+			out.Values[i] = ec._StatusHistory_changedBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._StatusHistory_reason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var subscriptionImplementors = []string{"Subscription"}
 
 func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
@@ -8479,15 +16960,82 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 		return nil
 	}
 
-	switch fields[0].Name {
-	case "testSuiteStatusChanged":
-		return ec._Subscription_testSuiteStatusChanged(ctx, fields[0])
-	default:
-		panic("unknown field " + strconv.Quote(fields[0].Name))
+	switch fields[0].Name {
+	case "testSuiteStatusChanged":
+		return ec._Subscription_testSuiteStatusChanged(ctx, fields[0])
+	case "testCaseUpdated":
+		return ec._Subscription_testCaseUpdated(ctx, fields[0])
+	case "suiteActivity":
+		return ec._Subscription_suiteActivity(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var suiteActivityImplementors = []string{"SuiteActivity"}
+
+func (ec *executionContext) _SuiteActivity(ctx context.Context, sel ast.SelectionSet, obj *model.SuiteActivity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, suiteActivityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SuiteActivity")
+		case "type":
+			out.Values[i] = ec._SuiteActivity_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "suiteId":
+			out.Values[i] = ec._SuiteActivity_suiteId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entityType":
+			out.Values[i] = ec._SuiteActivity_entityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entityId":
+			out.Values[i] = ec._SuiteActivity_entityId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "testCaseId":
+			out.Values[i] = ec._SuiteActivity_testCaseId(ctx, field, obj)
+		case "actorId":
+			out.Values[i] = ec._SuiteActivity_actorId(ctx, field, obj)
+		case "occurredAt":
+			out.Values[i] = ec._SuiteActivity_occurredAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
+
+	return out
 }
 
-var testCaseImplementors = []string{"TestCase"}
+var testCaseImplementors = []string{"TestCase", "Node"}
 
 func (ec *executionContext) _TestCase(ctx context.Context, sel ast.SelectionSet, obj *model.TestCase) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, testCaseImplementors)
@@ -8501,51 +17049,100 @@ func (ec *executionContext) _TestCase(ctx context.Context, sel ast.SelectionSet,
 		case "id":
 			out.Values[i] = ec._TestCase_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "title":
 			out.Values[i] = ec._TestCase_title(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "description":
 			out.Values[i] = ec._TestCase_description(ctx, field, obj)
 		case "status":
 			out.Values[i] = ec._TestCase_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "priority":
 			out.Values[i] = ec._TestCase_priority(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "plannedEffort":
 			out.Values[i] = ec._TestCase_plannedEffort(ctx, field, obj)
 		case "actualEffort":
 			out.Values[i] = ec._TestCase_actualEffort(ctx, field, obj)
+		case "dueDate":
+			out.Values[i] = ec._TestCase_dueDate(ctx, field, obj)
 		case "isDelayed":
 			out.Values[i] = ec._TestCase_isDelayed(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "delayDays":
 			out.Values[i] = ec._TestCase_delayDays(ctx, field, obj)
 		case "groupId":
 			out.Values[i] = ec._TestCase_groupId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "lockedBy":
+			out.Values[i] = ec._TestCase_lockedBy(ctx, field, obj)
+		case "lockExpiresAt":
+			out.Values[i] = ec._TestCase_lockExpiresAt(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._TestCase_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "createdAt":
 			out.Values[i] = ec._TestCase_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "updatedAt":
 			out.Values[i] = ec._TestCase_updatedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "deletedAt":
+			out.Values[i] = ec._TestCase_deletedAt(ctx, field, obj)
+		case "statusHistory":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TestCase_statusHistory(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8569,7 +17166,7 @@ func (ec *executionContext) _TestCase(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var testGroupImplementors = []string{"TestGroup"}
+var testGroupImplementors = []string{"TestGroup", "Node"}
 
 func (ec *executionContext) _TestGroup(ctx context.Context, sel ast.SelectionSet, obj *model.TestGroup) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, testGroupImplementors)
@@ -8607,6 +17204,31 @@ func (ec *executionContext) _TestGroup(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "statusLocked":
+			out.Values[i] = ec._TestGroup_statusLocked(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "progress":
+			out.Values[i] = ec._TestGroup_progress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "completedCaseCount":
+			out.Values[i] = ec._TestGroup_completedCaseCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "totalCaseCount":
+			out.Values[i] = ec._TestGroup_totalCaseCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "version":
+			out.Values[i] = ec._TestGroup_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "createdAt":
 			out.Values[i] = ec._TestGroup_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -8617,6 +17239,8 @@ func (ec *executionContext) _TestGroup(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "deletedAt":
+			out.Values[i] = ec._TestGroup_deletedAt(ctx, field, obj)
 		case "cases":
 			field := field
 
@@ -8673,7 +17297,7 @@ func (ec *executionContext) _TestGroup(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var testSuiteImplementors = []string{"TestSuite"}
+var testSuiteImplementors = []string{"TestSuite", "Node"}
 
 func (ec *executionContext) _TestSuite(ctx context.Context, sel ast.SelectionSet, obj *model.TestSuite) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, testSuiteImplementors)
@@ -8716,11 +17340,35 @@ func (ec *executionContext) _TestSuite(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "exitCriteria":
+			out.Values[i] = ec._TestSuite_exitCriteria(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "exitCriteriaOverride":
+			out.Values[i] = ec._TestSuite_exitCriteriaOverride(ctx, field, obj)
 		case "progress":
 			out.Values[i] = ec._TestSuite_progress(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "completedCaseCount":
+			out.Values[i] = ec._TestSuite_completedCaseCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "totalCaseCount":
+			out.Values[i] = ec._TestSuite_totalCaseCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "version":
+			out.Values[i] = ec._TestSuite_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdBy":
+			out.Values[i] = ec._TestSuite_createdBy(ctx, field, obj)
 		case "createdAt":
 			out.Values[i] = ec._TestSuite_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -8731,6 +17379,8 @@ func (ec *executionContext) _TestSuite(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "deletedAt":
+			out.Values[i] = ec._TestSuite_deletedAt(ctx, field, obj)
 		case "groups":
 			field := field
 
@@ -8880,7 +17530,56 @@ func (ec *executionContext) _TestSuiteEdge(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var userImplementors = []string{"User"}
+var trashImplementors = []string{"Trash"}
+
+func (ec *executionContext) _Trash(ctx context.Context, sel ast.SelectionSet, obj *model.Trash) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, trashImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Trash")
+		case "testSuites":
+			out.Values[i] = ec._Trash_testSuites(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "testGroups":
+			out.Values[i] = ec._Trash_testGroups(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "testCases":
+			out.Values[i] = ec._Trash_testCases(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var userImplementors = []string{"User", "Node"}
 
 func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model.User) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
@@ -9268,62 +17967,237 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 			Context:  ctx,
 		})
 	}
-
-	return out
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAuthPayload2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v model.AuthPayload) graphql.Marshaler {
+	return ec._AuthPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAuthPayload2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v *model.AuthPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AuthPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNCorrectEffortRecordInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCorrectEffortRecordInput(ctx context.Context, v any) (model.CorrectEffortRecordInput, error) {
+	res, err := ec.unmarshalInputCorrectEffortRecordInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateTestCaseInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateTestCaseInput(ctx context.Context, v any) (model.CreateTestCaseInput, error) {
+	res, err := ec.unmarshalInputCreateTestCaseInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateTestGroupInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateTestGroupInput(ctx context.Context, v any) (model.CreateTestGroupInput, error) {
+	res, err := ec.unmarshalInputCreateTestGroupInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateTestSuiteInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateTestSuiteInput(ctx context.Context, v any) (model.CreateTestSuiteInput, error) {
+	res, err := ec.unmarshalInputCreateTestSuiteInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateUserInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateUserInput(ctx context.Context, v any) (model.CreateUserInput, error) {
+	res, err := ec.unmarshalInputCreateUserInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNDateTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDateTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNEffortRecord2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecord(ctx context.Context, sel ast.SelectionSet, v model.EffortRecord) graphql.Marshaler {
+	return ec._EffortRecord(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEffortRecord2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecordᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.EffortRecord) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNEffortRecord2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecord(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNEffortRecord2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecord(ctx context.Context, sel ast.SelectionSet, v *model.EffortRecord) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._EffortRecord(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNEffortRecordList2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecordList(ctx context.Context, sel ast.SelectionSet, v model.EffortRecordList) graphql.Marshaler {
+	return ec._EffortRecordList(ctx, sel, &v)
+}
 
-func (ec *executionContext) marshalNAuthPayload2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v model.AuthPayload) graphql.Marshaler {
-	return ec._AuthPayload(ctx, sel, &v)
+func (ec *executionContext) marshalNEffortRecordList2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐEffortRecordList(ctx context.Context, sel ast.SelectionSet, v *model.EffortRecordList) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._EffortRecordList(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAuthPayload2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v *model.AuthPayload) graphql.Marshaler {
+func (ec *executionContext) marshalNExitCriteria2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐExitCriteria(ctx context.Context, sel ast.SelectionSet, v *model.ExitCriteria) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AuthPayload(ctx, sel, v)
+	return ec._ExitCriteria(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalBoolean(v)
+	res := graphql.MarshalFloatContext(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	return res
+	return graphql.WrapContextMarshaler(ctx, res)
 }
 
-func (ec *executionContext) unmarshalNCreateTestSuiteInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateTestSuiteInput(ctx context.Context, v any) (model.CreateTestSuiteInput, error) {
-	res, err := ec.unmarshalInputCreateTestSuiteInput(ctx, v)
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNCreateUserInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐCreateUserInput(ctx context.Context, v any) (model.CreateUserInput, error) {
-	res, err := ec.unmarshalInputCreateUserInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
 }
 
-func (ec *executionContext) unmarshalNDateTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
-	res, err := graphql.UnmarshalTime(v)
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDateTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalTime(v)
+	res := graphql.MarshalInt(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -9332,108 +18206,277 @@ func (ec *executionContext) marshalNDateTime2timeᚐTime(ctx context.Context, se
 	return res
 }
 
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
-	res, err := graphql.UnmarshalFloatContext(ctx, v)
+func (ec *executionContext) marshalNNode2ᚕgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐNode(ctx context.Context, sel ast.SelectionSet, v []model.Node) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalONode2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐNode(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPriority2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx context.Context, v any) (model.Priority, error) {
+	var res model.Priority
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalFloatContext(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNPriority2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx context.Context, sel ast.SelectionSet, v model.Priority) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNRecordEffortInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐRecordEffortInput(ctx context.Context, v any) (model.RecordEffortInput, error) {
+	res, err := ec.unmarshalInputRecordEffortInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNStatusHistory2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐStatusHistoryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.StatusHistory) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNStatusHistory2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐStatusHistory(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNStatusHistory2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐStatusHistory(ctx context.Context, sel ast.SelectionSet, v *model.StatusHistory) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return graphql.WrapContextMarshaler(ctx, res)
+	return ec._StatusHistory(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalID(v)
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalID(v)
+	res := graphql.MarshalString(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	return res
-}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNSuiteActivity2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteActivity(ctx context.Context, sel ast.SelectionSet, v model.SuiteActivity) graphql.Marshaler {
+	return ec._SuiteActivity(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSuiteActivity2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteActivity(ctx context.Context, sel ast.SelectionSet, v *model.SuiteActivity) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SuiteActivity(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSuiteActivityType2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteActivityType(ctx context.Context, v any) (model.SuiteActivityType, error) {
+	var res model.SuiteActivityType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSuiteActivityType2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteActivityType(ctx context.Context, sel ast.SelectionSet, v model.SuiteActivityType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx context.Context, v any) (model.SuiteStatus, error) {
+	var res model.SuiteStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx context.Context, sel ast.SelectionSet, v model.SuiteStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNTestCase2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx context.Context, sel ast.SelectionSet, v model.TestCase) graphql.Marshaler {
+	return ec._TestCase(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNTestCase2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCaseᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TestCase) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	return res
+
+	return ret
 }
 
-func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+func (ec *executionContext) marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx context.Context, sel ast.SelectionSet, v *model.TestCase) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._PageInfo(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNPriority2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx context.Context, v any) (model.Priority, error) {
-	var res model.Priority
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNPriority2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx context.Context, sel ast.SelectionSet, v model.Priority) graphql.Marshaler {
-	return v
+	return ec._TestCase(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNTestGroup2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroup(ctx context.Context, sel ast.SelectionSet, v model.TestGroup) graphql.Marshaler {
+	return ec._TestGroup(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalNTestGroup2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroupᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TestGroup) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx context.Context, v any) (model.SuiteStatus, error) {
-	var res model.SuiteStatus
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTestGroup2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroup(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalNSuiteStatus2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSuiteStatus(ctx context.Context, sel ast.SelectionSet, v model.SuiteStatus) graphql.Marshaler {
-	return v
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx context.Context, sel ast.SelectionSet, v *model.TestCase) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._TestCase(ctx, sel, v)
+
+	return ret
 }
 
 func (ec *executionContext) marshalNTestGroup2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroup(ctx context.Context, sel ast.SelectionSet, v *model.TestGroup) graphql.Marshaler {
@@ -9460,6 +18503,50 @@ func (ec *executionContext) marshalNTestSuite2githubᚗcomᚋFUJI0130ᚋgoᚑddd
 	return ec._TestSuite(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalNTestSuite2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TestSuite) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx context.Context, sel ast.SelectionSet, v *model.TestSuite) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -9538,6 +18625,45 @@ func (ec *executionContext) marshalNTestSuiteEdge2ᚖgithubᚗcomᚋFUJI0130ᚋg
 	return ec._TestSuiteEdge(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNTestSuiteOrder2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteOrder(ctx context.Context, v any) (*model.TestSuiteOrder, error) {
+	res, err := ec.unmarshalInputTestSuiteOrder(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNTestSuiteSortField2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteSortField(ctx context.Context, v any) (model.TestSuiteSortField, error) {
+	var res model.TestSuiteSortField
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNTestSuiteSortField2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteSortField(ctx context.Context, sel ast.SelectionSet, v model.TestSuiteSortField) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNTrash2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTrash(ctx context.Context, sel ast.SelectionSet, v model.Trash) graphql.Marshaler {
+	return ec._Trash(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNTrash2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTrash(ctx context.Context, sel ast.SelectionSet, v *model.Trash) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Trash(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNUpdateTestCaseInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUpdateTestCaseInput(ctx context.Context, v any) (model.UpdateTestCaseInput, error) {
+	res, err := ec.unmarshalInputUpdateTestCaseInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNUpdateTestGroupInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUpdateTestGroupInput(ctx context.Context, v any) (model.UpdateTestGroupInput, error) {
+	res, err := ec.unmarshalInputUpdateTestGroupInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpdateTestSuiteInput2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUpdateTestSuiteInput(ctx context.Context, v any) (model.UpdateTestSuiteInput, error) {
 	res, err := ec.unmarshalInputUpdateTestSuiteInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -9907,6 +19033,21 @@ func (ec *executionContext) marshalODateTime2ᚖtimeᚐTime(ctx context.Context,
 	return res
 }
 
+func (ec *executionContext) unmarshalOExitCriteriaInput2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐExitCriteriaInput(ctx context.Context, v any) (*model.ExitCriteriaInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputExitCriteriaInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOExitCriteriaOverride2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐExitCriteriaOverride(ctx context.Context, sel ast.SelectionSet, v *model.ExitCriteriaOverride) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ExitCriteriaOverride(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
 	if v == nil {
 		return nil, nil
@@ -9924,6 +19065,24 @@ func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel as
 	return graphql.WrapContextMarshaler(ctx, res)
 }
 
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalID(*v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
 	if v == nil {
 		return nil, nil
@@ -9942,6 +19101,45 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return res
 }
 
+func (ec *executionContext) marshalONode2githubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐNode(ctx context.Context, sel ast.SelectionSet, v model.Node) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Node(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOPriority2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx context.Context, v any) (*model.Priority, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.Priority)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOPriority2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐPriority(ctx context.Context, sel ast.SelectionSet, v *model.Priority) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOSortDirection2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSortDirection(ctx context.Context, v any) (*model.SortDirection, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.SortDirection)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOSortDirection2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐSortDirection(ctx context.Context, sel ast.SelectionSet, v *model.SortDirection) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -10035,6 +19233,13 @@ func (ec *executionContext) marshalOTestCase2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgo
 	return ret
 }
 
+func (ec *executionContext) marshalOTestCase2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestCase(ctx context.Context, sel ast.SelectionSet, v *model.TestCase) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TestCase(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOTestGroup2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroupᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TestGroup) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -10082,6 +19287,13 @@ func (ec *executionContext) marshalOTestGroup2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgo
 	return ret
 }
 
+func (ec *executionContext) marshalOTestGroup2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestGroup(ctx context.Context, sel ast.SelectionSet, v *model.TestGroup) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TestGroup(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuite(ctx context.Context, sel ast.SelectionSet, v *model.TestSuite) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -10089,6 +19301,32 @@ func (ec *executionContext) marshalOTestSuite2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑ
 	return ec._TestSuite(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOTestSuiteFilter2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteFilter(ctx context.Context, v any) (*model.TestSuiteFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputTestSuiteFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOTestSuiteOrder2ᚕᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteOrderᚄ(ctx context.Context, v any) ([]*model.TestSuiteOrder, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.TestSuiteOrder, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNTestSuiteOrder2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐTestSuiteOrder(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalOUser2ᚖgithubᚗcomᚋFUJI0130ᚋgoᚑdddᚑcaᚋinternalᚋinterfaceᚋgraphqlᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model.User) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -0,0 +1,79 @@
+// ファイル: internal/interface/graphql/globalid/globalid.go
+
+// Package globalid はRelayのグローバルオブジェクト識別で使用する、型名を前置したID（例: TestSuite:TS001-202501）を扱います
+package globalid
+
+import (
+	"strings"
+
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// Nodeインターフェースを実装する型名
+const (
+	TestSuite = "TestSuite"
+	TestGroup = "TestGroup"
+	TestCase  = "TestCase"
+	User      = "User"
+)
+
+// separator は型名とIDの区切り文字です（各エンティティのIDには含まれません）
+const separator = ":"
+
+var knownTypes = map[string]bool{
+	TestSuite: true,
+	TestGroup: true,
+	TestCase:  true,
+	User:      true,
+}
+
+// Encode は型名とエンティティのIDからグローバルIDを作成します
+func Encode(typeName, id string) string {
+	return typeName + separator + id
+}
+
+// Decode はグローバルIDを型名とエンティティのIDに分解します
+func Decode(globalID string) (typeName string, id string, err error) {
+	typeName, id, found := strings.Cut(globalID, separator)
+	if !found || id == "" || !knownTypes[typeName] {
+		return "", "", invalidIDError("グローバルIDの形式が正しくありません", "型名とIDを「:」で区切って指定してください（例: TestSuite:TS001-202501）", globalID)
+	}
+	return typeName, id, nil
+}
+
+// LocalID は引数で受け取ったIDから、指定した型のエンティティのIDを取り出します
+// 型名を前置しない従来のIDはそのまま返し、他の型のグローバルIDはエラーとします
+func LocalID(id, typeName string) (string, error) {
+	if !strings.Contains(id, separator) {
+		return id, nil
+	}
+
+	actualType, localID, err := Decode(id)
+	if err != nil {
+		return "", err
+	}
+	if actualType != typeName {
+		return "", invalidIDError("IDの型が正しくありません", typeName+"のIDを指定してください", id)
+	}
+	return localID, nil
+}
+
+// LocalIDs は複数のIDからエンティティのIDを取り出します
+func LocalIDs(ids []string, typeName string) ([]string, error) {
+	localIDs := make([]string, len(ids))
+	for i, id := range ids {
+		localID, err := LocalID(id, typeName)
+		if err != nil {
+			return nil, err
+		}
+		localIDs[i] = localID
+	}
+	return localIDs, nil
+}
+
+// invalidIDError はIDの検証エラーを作成します
+// フィールドのエラーにはメッセージを、コンテキストには指定された値を設定します
+func invalidIDError(message, fieldMessage, id string) error {
+	return customerrors.NewValidationError(message, map[string]string{"id": fieldMessage}).
+		WithContext(customerrors.Context{"id": id})
+}
@@ -0,0 +1,77 @@
+package globalid
+
+import (
+	"testing"
+
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	globalID := Encode(TestGroup, "TS001TG01-202501")
+	assert.Equal(t, "TestGroup:TS001TG01-202501", globalID)
+
+	typeName, id, err := Decode(globalID)
+	assert.NoError(t, err)
+	assert.Equal(t, TestGroup, typeName)
+	assert.Equal(t, "TS001TG01-202501", id)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		globalID string
+	}{
+		{name: "型名がない", globalID: "TS001-202501"},
+		{name: "IDが空", globalID: "TestSuite:"},
+		{name: "Nodeではない型", globalID: "EffortRecord:ER001"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := Decode(tc.globalID)
+			validationErr, ok := customerrors.AsValidationError(err)
+			require.True(t, ok)
+			assert.NotEmpty(t, validationErr.Details["id"])
+			assert.Equal(t, tc.globalID, validationErr.GetContext()["id"])
+		})
+	}
+}
+
+func TestLocalID(t *testing.T) {
+	testCases := []struct {
+		name       string
+		id         string
+		typeName   string
+		expectedID string
+		wantErr    bool
+	}{
+		{name: "グローバルID", id: "TestSuite:TS001-202501", typeName: TestSuite, expectedID: "TS001-202501"},
+		{name: "従来のIDはそのまま使用する", id: "TS001-202501", typeName: TestSuite, expectedID: "TS001-202501"},
+		{name: "プレフィックス方式のID", id: "TestCase:ACME-TS001-TG01-TC001", typeName: TestCase, expectedID: "ACME-TS001-TG01-TC001"},
+		{name: "他の型のグローバルIDはエラー", id: "TestCase:TS001TG01TC001-202501", typeName: TestGroup, wantErr: true},
+		{name: "不正なグローバルIDはエラー", id: "Unknown:1", typeName: User, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := LocalID(tc.id, tc.typeName)
+			if tc.wantErr {
+				assert.True(t, customerrors.IsValidationError(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedID, id)
+		})
+	}
+}
+
+func TestLocalIDs(t *testing.T) {
+	ids, err := LocalIDs([]string{"TestGroup:TG01", "TG02"}, TestGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TG01", "TG02"}, ids)
+
+	_, err = LocalIDs([]string{"TestGroup:TG01", "TestSuite:TS001"}, TestGroup)
+	assert.Error(t, err)
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/idgen"
 	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/postgres"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/generated"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/globalid"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/resolver"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/interactor"
@@ -96,9 +98,9 @@ func setupRepositories(db *sql.DB) {
 	fmt.Println("TEST_ENV =", os.Getenv("TEST_ENV"))
 
 	// ファクトリーメソッドを使用
-	testSuiteIDGen = postgres.NewTestSuiteIDGeneratorWithEnv(db)
-	testGroupIDGen = postgres.NewTestGroupIDGeneratorWithEnv(db)
-	testCaseIDGen = postgres.NewTestCaseIDGeneratorWithEnv(db)
+	testSuiteIDGen = postgres.NewTestSuiteIDGeneratorWithEnv(db, idgen.Options{})
+	testGroupIDGen = postgres.NewTestGroupIDGeneratorWithEnv(db, idgen.Options{})
+	testCaseIDGen = postgres.NewTestCaseIDGeneratorWithEnv(db, idgen.Options{})
 	// デバッグ情報を出力
 	fmt.Printf("使用しているSuiteIDGenerator: %T\n", testSuiteIDGen)
 	fmt.Printf("使用しているGroupIDGenerator: %T\n", testGroupIDGen)
@@ -106,9 +108,9 @@ func setupRepositories(db *sql.DB) {
 }
 
 func setupUseCases() {
-	testSuiteUseCase = interactor.NewTestSuiteInteractor(testSuiteRepo, testSuiteIDGen)
-	testGroupUseCase = interactor.NewTestGroupInteractor(testGroupRepo, testGroupIDGen)
-	testCaseUseCase = interactor.NewTestCaseInteractor(testCaseRepo, testCaseIDGen)
+	testSuiteUseCase = interactor.NewTestSuiteInteractor(testSuiteRepo, testGroupRepo, testCaseRepo, postgres.NewUserRepository(db), testSuiteIDGen, nil, postgres.NewTransactionManager(db))
+	testGroupUseCase = interactor.NewTestGroupInteractor(testGroupRepo, testCaseRepo, testGroupIDGen, nil, postgres.NewTransactionManager(db))
+	testCaseUseCase = interactor.NewTestCaseInteractor(testCaseRepo, testGroupRepo, postgres.NewStatusHistoryRepository(db), postgres.NewUserRepository(db), testCaseIDGen, nil, postgres.NewTransactionManager(db))
 }
 
 func setupGraphQLServer() *client.Client {
@@ -489,7 +491,7 @@ func TestGraphQLQueries(t *testing.T) {
         `, &resp, client.Var("id", createdTestSuiteID))
 
 		assert.NoError(t, err)
-		assert.Equal(t, createdTestSuiteID, resp.TestSuite.ID)
+		assert.Equal(t, globalid.Encode(globalid.TestSuite, createdTestSuiteID), resp.TestSuite.ID)
 		assert.Contains(t, resp.TestSuite.Name, "統合テスト用スイート")
 		assert.Equal(t, "GraphQL統合テスト用", resp.TestSuite.Description)
 		assert.Equal(t, "PREPARATION", resp.TestSuite.Status)
@@ -528,7 +530,7 @@ func TestGraphQLQueries(t *testing.T) {
         `, &resp, client.Var("id", createdTestSuiteID))
 
 		assert.NoError(t, err)
-		assert.Equal(t, createdTestSuiteID, resp.TestSuite.ID)
+		assert.Equal(t, globalid.Encode(globalid.TestSuite, createdTestSuiteID), resp.TestSuite.ID)
 		assert.Len(t, resp.TestSuite.Groups, 1)
 		assert.Contains(t, resp.TestSuite.Groups[0].Name, "統合テスト用グループ")
 		assert.Len(t, resp.TestSuite.Groups[0].Cases, 1)
@@ -2,34 +2,49 @@ package model
 
 import (
 	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/globalid"
 )
 
 // TestSuite はGraphQLモデルのテストスイート型
 type TestSuite struct {
-	ID                   string       `json:"id"`
-	Name                 string       `json:"name"`
-	Description          string       `json:"description"`
-	Status               SuiteStatus  `json:"status"`
-	EstimatedStartDate   time.Time    `json:"estimatedStartDate"`
-	EstimatedEndDate     time.Time    `json:"estimatedEndDate"`
-	RequireEffortComment bool         `json:"requireEffortComment"`
-	Progress             float64      `json:"progress"`
-	CreatedAt            time.Time    `json:"createdAt"`
-	UpdatedAt            time.Time    `json:"updatedAt"`
-	Groups               []*TestGroup `json:"groups,omitempty"`
+	ID                   string                `json:"id"`
+	Name                 string                `json:"name"`
+	Description          string                `json:"description"`
+	Status               SuiteStatus           `json:"status"`
+	EstimatedStartDate   time.Time             `json:"estimatedStartDate"`
+	EstimatedEndDate     time.Time             `json:"estimatedEndDate"`
+	RequireEffortComment bool                  `json:"requireEffortComment"`
+	ExitCriteria         *ExitCriteria         `json:"exitCriteria"`
+	ExitCriteriaOverride *ExitCriteriaOverride `json:"exitCriteriaOverride,omitempty"`
+	Progress             float64               `json:"progress"`
+	CompletedCaseCount   int                   `json:"completedCaseCount"`
+	TotalCaseCount       int                   `json:"totalCaseCount"`
+	Version              int                   `json:"version"`
+	CreatedBy            *string               `json:"createdBy,omitempty"`
+	CreatedAt            time.Time             `json:"createdAt"`
+	UpdatedAt            time.Time             `json:"updatedAt"`
+	DeletedAt            *time.Time            `json:"deletedAt,omitempty"`
+	Groups               []*TestGroup          `json:"groups,omitempty"`
 }
 
 // TestGroup はGraphQLモデルのテストグループ型
 type TestGroup struct {
-	ID           string      `json:"id"`
-	Name         string      `json:"name"`
-	Description  string      `json:"description"`
-	DisplayOrder int         `json:"displayOrder"`
-	SuiteID      string      `json:"suiteId"`
-	Status       SuiteStatus `json:"status"`
-	CreatedAt    time.Time   `json:"createdAt"`
-	UpdatedAt    time.Time   `json:"updatedAt"`
-	Cases        []*TestCase `json:"cases,omitempty"`
+	ID                 string      `json:"id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	DisplayOrder       int         `json:"displayOrder"`
+	SuiteID            string      `json:"suiteId"`
+	Status             SuiteStatus `json:"status"`
+	StatusLocked       bool        `json:"statusLocked"`
+	Progress           float64     `json:"progress"`
+	CompletedCaseCount int         `json:"completedCaseCount"`
+	TotalCaseCount     int         `json:"totalCaseCount"`
+	Version            int         `json:"version"`
+	CreatedAt          time.Time   `json:"createdAt"`
+	UpdatedAt          time.Time   `json:"updatedAt"`
+	DeletedAt          *time.Time  `json:"deletedAt,omitempty"`
+	Cases              []*TestCase `json:"cases,omitempty"`
 }
 
 // TestCase はGraphQLモデルのテストケース型
@@ -41,11 +56,44 @@ type TestCase struct {
 	Priority      Priority   `json:"priority"`
 	PlannedEffort *float64   `json:"plannedEffort,omitempty"`
 	ActualEffort  *float64   `json:"actualEffort,omitempty"`
+	DueDate       *time.Time `json:"dueDate,omitempty"`
 	IsDelayed     bool       `json:"isDelayed"`
 	DelayDays     *int       `json:"delayDays,omitempty"`
 	GroupID       string     `json:"groupId"`
+	LockedBy      *string    `json:"lockedBy,omitempty"`
+	LockExpiresAt *time.Time `json:"lockExpiresAt,omitempty"`
+	Version       int        `json:"version"`
 	CreatedAt     time.Time  `json:"createdAt"`
 	UpdatedAt     time.Time  `json:"updatedAt"`
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"`
+}
+
+// StatusHistory はGraphQLモデルのステータス変更履歴型
+type StatusHistory struct {
+	ID        string     `json:"id"`
+	OldStatus TestStatus `json:"oldStatus"`
+	NewStatus TestStatus `json:"newStatus"`
+	ChangedAt time.Time  `json:"changedAt"`
+	ChangedBy string     `json:"changedBy"`
+	Reason    *string    `json:"reason,omitempty"`
+}
+
+// EffortRecord はGraphQLモデルの工数記録型
+type EffortRecord struct {
+	ID           string    `json:"id"`
+	TestCaseID   string    `json:"testCaseId"`
+	RecordDate   time.Time `json:"recordDate"`
+	EffortAmount float64   `json:"effortAmount"`
+	IsAdditional bool      `json:"isAdditional"`
+	Comment      *string   `json:"comment,omitempty"`
+	RecordedBy   string    `json:"recordedBy"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// EffortRecordList はGraphQLモデルの工数記録一覧型
+type EffortRecordList struct {
+	Records     []*EffortRecord `json:"records"`
+	TotalEffort float64         `json:"totalEffort"`
 }
 
 // User は認証されたユーザーを表すGraphQLモデル
@@ -65,3 +113,46 @@ type AuthPayload struct {
 	User         *User     `json:"user"`
 	ExpiresAt    time.Time `json:"expiresAt"`
 }
+
+// GlobalID はGraphQLのidとして返す、型名を前置したグローバルIDです
+func (t TestSuite) GlobalID() string { return globalid.Encode(globalid.TestSuite, t.ID) }
+
+// GlobalID はGraphQLのidとして返す、型名を前置したグローバルIDです
+func (t TestGroup) GlobalID() string { return globalid.Encode(globalid.TestGroup, t.ID) }
+
+// GlobalID はGraphQLのidとして返す、型名を前置したグローバルIDです
+func (t TestCase) GlobalID() string { return globalid.Encode(globalid.TestCase, t.ID) }
+
+// GlobalID はGraphQLのidとして返す、型名を前置したグローバルIDです
+func (u User) GlobalID() string { return globalid.Encode(globalid.User, u.ID) }
+
+// GlobalCreatedBy はGraphQLのcreatedByとして返す、作成したユーザーのグローバルIDです
+func (t TestSuite) GlobalCreatedBy() *string { return encodeOptional(globalid.User, t.CreatedBy) }
+
+// GlobalSuiteID はGraphQLのsuiteIdとして返す、所属するテストスイートのグローバルIDです
+func (t TestGroup) GlobalSuiteID() string { return globalid.Encode(globalid.TestSuite, t.SuiteID) }
+
+// GlobalGroupID はGraphQLのgroupIdとして返す、所属するテストグループのグローバルIDです
+func (t TestCase) GlobalGroupID() string { return globalid.Encode(globalid.TestGroup, t.GroupID) }
+
+// GlobalLockedBy はGraphQLのlockedByとして返す、編集ロックを保持しているユーザーのグローバルIDです
+func (t TestCase) GlobalLockedBy() *string { return encodeOptional(globalid.User, t.LockedBy) }
+
+// encodeOptional は未設定（nil）の参照をnilのまま、設定されている参照をグローバルIDに変換します
+func encodeOptional(typeName string, id *string) *string {
+	if id == nil {
+		return nil
+	}
+	encoded := globalid.Encode(typeName, *id)
+	return &encoded
+}
+
+// Nodeインターフェースの実装
+func (TestSuite) IsNode()         {}
+func (t TestSuite) GetID() string { return t.GlobalID() }
+func (TestGroup) IsNode()         {}
+func (t TestGroup) GetID() string { return t.GlobalID() }
+func (TestCase) IsNode()          {}
+func (t TestCase) GetID() string  { return t.GlobalID() }
+func (User) IsNode()              {}
+func (u User) GetID() string      { return u.GlobalID() }
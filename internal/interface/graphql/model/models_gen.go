@@ -10,12 +10,39 @@ import (
 	"time"
 )
 
+type Node interface {
+	IsNode()
+	GetID() string
+}
+
+type CorrectEffortRecordInput struct {
+	EffortAmount float64 `json:"effortAmount"`
+	Comment      *string `json:"comment,omitempty"`
+}
+
+type CreateTestCaseInput struct {
+	GroupID       string     `json:"groupId"`
+	Title         string     `json:"title"`
+	Description   *string    `json:"description,omitempty"`
+	Priority      *Priority  `json:"priority,omitempty"`
+	PlannedEffort *float64   `json:"plannedEffort,omitempty"`
+	DueDate       *time.Time `json:"dueDate,omitempty"`
+}
+
+type CreateTestGroupInput struct {
+	SuiteID      string  `json:"suiteId"`
+	Name         string  `json:"name"`
+	Description  *string `json:"description,omitempty"`
+	DisplayOrder *int    `json:"displayOrder,omitempty"`
+}
+
 type CreateTestSuiteInput struct {
-	Name                 string    `json:"name"`
-	Description          *string   `json:"description,omitempty"`
-	EstimatedStartDate   time.Time `json:"estimatedStartDate"`
-	EstimatedEndDate     time.Time `json:"estimatedEndDate"`
-	RequireEffortComment *bool     `json:"requireEffortComment,omitempty"`
+	Name                 string             `json:"name"`
+	Description          *string            `json:"description,omitempty"`
+	EstimatedStartDate   time.Time          `json:"estimatedStartDate"`
+	EstimatedEndDate     time.Time          `json:"estimatedEndDate"`
+	RequireEffortComment *bool              `json:"requireEffortComment,omitempty"`
+	ExitCriteria         *ExitCriteriaInput `json:"exitCriteria,omitempty"`
 }
 
 type CreateUserInput struct {
@@ -24,6 +51,22 @@ type CreateUserInput struct {
 	Role     string `json:"role"`
 }
 
+type ExitCriteria struct {
+	MinCompletionRate float64 `json:"minCompletionRate"`
+	AllowOpenCritical bool    `json:"allowOpenCritical"`
+}
+
+type ExitCriteriaInput struct {
+	MinCompletionRate float64 `json:"minCompletionRate"`
+	AllowOpenCritical bool    `json:"allowOpenCritical"`
+}
+
+type ExitCriteriaOverride struct {
+	Reason       string    `json:"reason"`
+	OverriddenBy string    `json:"overriddenBy"`
+	OverriddenAt time.Time `json:"overriddenAt"`
+}
+
 type Mutation struct {
 }
 
@@ -37,9 +80,27 @@ type PageInfo struct {
 type Query struct {
 }
 
+type RecordEffortInput struct {
+	TestCaseID   string     `json:"testCaseId"`
+	RecordDate   *time.Time `json:"recordDate,omitempty"`
+	EffortAmount float64    `json:"effortAmount"`
+	IsAdditional *bool      `json:"isAdditional,omitempty"`
+	Comment      *string    `json:"comment,omitempty"`
+}
+
 type Subscription struct {
 }
 
+type SuiteActivity struct {
+	Type       SuiteActivityType `json:"type"`
+	SuiteID    string            `json:"suiteId"`
+	EntityType string            `json:"entityType"`
+	EntityID   string            `json:"entityId"`
+	TestCaseID *string           `json:"testCaseId,omitempty"`
+	ActorID    *string           `json:"actorId,omitempty"`
+	OccurredAt time.Time         `json:"occurredAt"`
+}
+
 type TestSuiteConnection struct {
 	Edges      []*TestSuiteEdge `json:"edges"`
 	PageInfo   *PageInfo        `json:"pageInfo"`
@@ -51,12 +112,52 @@ type TestSuiteEdge struct {
 	Cursor string     `json:"cursor"`
 }
 
+type TestSuiteFilter struct {
+	Search          *string    `json:"search,omitempty"`
+	CreatedBy       *string    `json:"createdBy,omitempty"`
+	PeriodFrom      *time.Time `json:"periodFrom,omitempty"`
+	PeriodTo        *time.Time `json:"periodTo,omitempty"`
+	MinProgress     *float64   `json:"minProgress,omitempty"`
+	MaxProgress     *float64   `json:"maxProgress,omitempty"`
+	HasDelayedCases *bool      `json:"hasDelayedCases,omitempty"`
+}
+
+type TestSuiteOrder struct {
+	Field     TestSuiteSortField `json:"field"`
+	Direction *SortDirection     `json:"direction,omitempty"`
+}
+
+type Trash struct {
+	TestSuites []*TestSuite `json:"testSuites"`
+	TestGroups []*TestGroup `json:"testGroups"`
+	TestCases  []*TestCase  `json:"testCases"`
+}
+
+type UpdateTestCaseInput struct {
+	Title           *string    `json:"title,omitempty"`
+	Description     *string    `json:"description,omitempty"`
+	Priority        *Priority  `json:"priority,omitempty"`
+	PlannedEffort   *float64   `json:"plannedEffort,omitempty"`
+	DueDate         *time.Time `json:"dueDate,omitempty"`
+	ExpectedVersion *int       `json:"expectedVersion,omitempty"`
+}
+
+type UpdateTestGroupInput struct {
+	Name            *string      `json:"name,omitempty"`
+	Description     *string      `json:"description,omitempty"`
+	Status          *SuiteStatus `json:"status,omitempty"`
+	StatusLocked    *bool        `json:"statusLocked,omitempty"`
+	ExpectedVersion *int         `json:"expectedVersion,omitempty"`
+}
+
 type UpdateTestSuiteInput struct {
-	Name                 *string    `json:"name,omitempty"`
-	Description          *string    `json:"description,omitempty"`
-	EstimatedStartDate   *time.Time `json:"estimatedStartDate,omitempty"`
-	EstimatedEndDate     *time.Time `json:"estimatedEndDate,omitempty"`
-	RequireEffortComment *bool      `json:"requireEffortComment,omitempty"`
+	Name                 *string            `json:"name,omitempty"`
+	Description          *string            `json:"description,omitempty"`
+	EstimatedStartDate   *time.Time         `json:"estimatedStartDate,omitempty"`
+	EstimatedEndDate     *time.Time         `json:"estimatedEndDate,omitempty"`
+	RequireEffortComment *bool              `json:"requireEffortComment,omitempty"`
+	ExitCriteria         *ExitCriteriaInput `json:"exitCriteria,omitempty"`
+	ExpectedVersion      *int               `json:"expectedVersion,omitempty"`
 }
 
 type UpdateUserInput struct {
@@ -123,6 +224,144 @@ func (e Priority) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SuiteActivityType string
+
+const (
+	SuiteActivityTypeTestSuiteCreated       SuiteActivityType = "TEST_SUITE_CREATED"
+	SuiteActivityTypeTestSuiteUpdated       SuiteActivityType = "TEST_SUITE_UPDATED"
+	SuiteActivityTypeTestSuiteStatusChanged SuiteActivityType = "TEST_SUITE_STATUS_CHANGED"
+	SuiteActivityTypeTestSuiteDeleted       SuiteActivityType = "TEST_SUITE_DELETED"
+	SuiteActivityTypeTestSuiteRestored      SuiteActivityType = "TEST_SUITE_RESTORED"
+	SuiteActivityTypeTestGroupCreated       SuiteActivityType = "TEST_GROUP_CREATED"
+	SuiteActivityTypeTestGroupUpdated       SuiteActivityType = "TEST_GROUP_UPDATED"
+	SuiteActivityTypeTestGroupDeleted       SuiteActivityType = "TEST_GROUP_DELETED"
+	SuiteActivityTypeTestGroupRestored      SuiteActivityType = "TEST_GROUP_RESTORED"
+	SuiteActivityTypeTestCaseCreated        SuiteActivityType = "TEST_CASE_CREATED"
+	SuiteActivityTypeTestCaseUpdated        SuiteActivityType = "TEST_CASE_UPDATED"
+	SuiteActivityTypeTestCaseDeleted        SuiteActivityType = "TEST_CASE_DELETED"
+	SuiteActivityTypeTestCaseRestored       SuiteActivityType = "TEST_CASE_RESTORED"
+	SuiteActivityTypeTestCaseStatusChanged  SuiteActivityType = "TEST_CASE_STATUS_CHANGED"
+	SuiteActivityTypeTestCaseDelayed        SuiteActivityType = "TEST_CASE_DELAYED"
+	SuiteActivityTypeEffortRecorded         SuiteActivityType = "EFFORT_RECORDED"
+)
+
+var AllSuiteActivityType = []SuiteActivityType{
+	SuiteActivityTypeTestSuiteCreated,
+	SuiteActivityTypeTestSuiteUpdated,
+	SuiteActivityTypeTestSuiteStatusChanged,
+	SuiteActivityTypeTestSuiteDeleted,
+	SuiteActivityTypeTestSuiteRestored,
+	SuiteActivityTypeTestGroupCreated,
+	SuiteActivityTypeTestGroupUpdated,
+	SuiteActivityTypeTestGroupDeleted,
+	SuiteActivityTypeTestGroupRestored,
+	SuiteActivityTypeTestCaseCreated,
+	SuiteActivityTypeTestCaseUpdated,
+	SuiteActivityTypeTestCaseDeleted,
+	SuiteActivityTypeTestCaseRestored,
+	SuiteActivityTypeTestCaseStatusChanged,
+	SuiteActivityTypeTestCaseDelayed,
+	SuiteActivityTypeEffortRecorded,
+}
+
+func (e SuiteActivityType) IsValid() bool {
+	switch e {
+	case SuiteActivityTypeTestSuiteCreated, SuiteActivityTypeTestSuiteUpdated, SuiteActivityTypeTestSuiteStatusChanged, SuiteActivityTypeTestSuiteDeleted, SuiteActivityTypeTestSuiteRestored, SuiteActivityTypeTestGroupCreated, SuiteActivityTypeTestGroupUpdated, SuiteActivityTypeTestGroupDeleted, SuiteActivityTypeTestGroupRestored, SuiteActivityTypeTestCaseCreated, SuiteActivityTypeTestCaseUpdated, SuiteActivityTypeTestCaseDeleted, SuiteActivityTypeTestCaseRestored, SuiteActivityTypeTestCaseStatusChanged, SuiteActivityTypeTestCaseDelayed, SuiteActivityTypeEffortRecorded:
+		return true
+	}
+	return false
+}
+
+func (e SuiteActivityType) String() string {
+	return string(e)
+}
+
+func (e *SuiteActivityType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SuiteActivityType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SuiteActivityType", str)
+	}
+	return nil
+}
+
+func (e SuiteActivityType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SuiteActivityType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SuiteActivityType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type SuiteStatus string
 
 const (
@@ -246,3 +485,66 @@ func (e TestStatus) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type TestSuiteSortField string
+
+const (
+	TestSuiteSortFieldName               TestSuiteSortField = "NAME"
+	TestSuiteSortFieldEstimatedStartDate TestSuiteSortField = "ESTIMATED_START_DATE"
+	TestSuiteSortFieldEstimatedEndDate   TestSuiteSortField = "ESTIMATED_END_DATE"
+	TestSuiteSortFieldProgress           TestSuiteSortField = "PROGRESS"
+	TestSuiteSortFieldUpdatedAt          TestSuiteSortField = "UPDATED_AT"
+	TestSuiteSortFieldCreatedAt          TestSuiteSortField = "CREATED_AT"
+)
+
+var AllTestSuiteSortField = []TestSuiteSortField{
+	TestSuiteSortFieldName,
+	TestSuiteSortFieldEstimatedStartDate,
+	TestSuiteSortFieldEstimatedEndDate,
+	TestSuiteSortFieldProgress,
+	TestSuiteSortFieldUpdatedAt,
+	TestSuiteSortFieldCreatedAt,
+}
+
+func (e TestSuiteSortField) IsValid() bool {
+	switch e {
+	case TestSuiteSortFieldName, TestSuiteSortFieldEstimatedStartDate, TestSuiteSortFieldEstimatedEndDate, TestSuiteSortFieldProgress, TestSuiteSortFieldUpdatedAt, TestSuiteSortFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e TestSuiteSortField) String() string {
+	return string(e)
+}
+
+func (e *TestSuiteSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TestSuiteSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TestSuiteSortField", str)
+	}
+	return nil
+}
+
+func (e TestSuiteSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TestSuiteSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TestSuiteSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,72 @@
+// ファイル: internal/interface/graphql/querylimit/cost.go
+
+package querylimit
+
+import (
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/generated"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+)
+
+// 件数を引数で指定できない一覧フィールドで、コストの計算に使用する想定件数
+const (
+	estimatedGroupsPerSuite = 10
+	estimatedCasesPerGroup  = 20
+	estimatedHistoryPerCase = 10
+	estimatedEffortRecords  = 20
+	estimatedTrashItems     = 20
+	estimatedUsers          = 20
+	defaultFieldCost        = 1
+)
+
+// SetFieldCosts はフィールドごとのコストを設定します
+// 一覧を返すフィールドは、子フィールドのコストに取得件数（件数を指定できない場合は想定件数）を掛けて計算します
+// 設定しないフィールドのコストはgqlgenの既定（子フィールドのコスト + 1）です
+func SetFieldCosts(c *generated.ComplexityRoot) {
+	c.Query.TestSuites = func(childComplexity int, status *model.SuiteStatus, filter *model.TestSuiteFilter, orderBy []*model.TestSuiteOrder, first *int, after *string, last *int, before *string, page *int, pageSize *int) int {
+		return listCost(childComplexity, testSuitesPageSize(first, last, pageSize))
+	}
+	c.Query.Nodes = func(childComplexity int, ids []string) int {
+		return listCost(childComplexity, len(ids))
+	}
+	c.Query.Users = func(childComplexity int) int {
+		return listCost(childComplexity, estimatedUsers)
+	}
+	c.TestSuite.Groups = func(childComplexity int) int {
+		return listCost(childComplexity, estimatedGroupsPerSuite)
+	}
+	c.TestGroup.Cases = func(childComplexity int) int {
+		return listCost(childComplexity, estimatedCasesPerGroup)
+	}
+	c.TestCase.StatusHistory = func(childComplexity int) int {
+		return listCost(childComplexity, estimatedHistoryPerCase)
+	}
+	c.EffortRecordList.Records = func(childComplexity int) int {
+		return listCost(childComplexity, estimatedEffortRecords)
+	}
+	c.Trash.TestSuites = func(childComplexity int) int {
+		return listCost(childComplexity, estimatedTrashItems)
+	}
+	c.Trash.TestGroups = func(childComplexity int) int {
+		return listCost(childComplexity, estimatedTrashItems)
+	}
+	c.Trash.TestCases = func(childComplexity int) int {
+		return listCost(childComplexity, estimatedTrashItems)
+	}
+}
+
+// listCost は件数分の子フィールドのコストに、フィールド自体のコストを加えます
+func listCost(childComplexity, count int) int {
+	return defaultFieldCost + count*childComplexity
+}
+
+// testSuitesPageSize はtestSuitesで取得する件数を返します
+// 指定された件数をそのまま使用するため、上限を超える件数はコストの上限で拒否されます
+func testSuitesPageSize(first, last, pageSize *int) int {
+	for _, size := range []*int{first, last, pageSize} {
+		if size != nil && *size > 0 {
+			return *size
+		}
+	}
+	return dto.DefaultTestSuitePageSize
+}
@@ -0,0 +1,133 @@
+// ファイル: internal/interface/graphql/querylimit/limit.go
+
+// Package querylimit はGraphQLのクエリの深さとコストを制限するgqlgenの拡張機能です
+// ネストした一覧を大量に取得するクエリがデータベースに過大な負荷をかけないよう、実行前に拒否します
+package querylimit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// DefaultMaxDepth はクエリのネストの深さの既定の上限です
+	DefaultMaxDepth = 10
+	// DefaultMaxComplexity は1回のリクエストのコストの既定の上限です
+	// testSuites(first: 20)でグループ・ケースまで取得するクエリは許可し、100件では拒否される程度の値です
+	DefaultMaxComplexity = 10000
+
+	// ErrDepthLimitExceeded は深さの上限を超えた場合のエラーコードです
+	ErrDepthLimitExceeded = "DEPTH_LIMIT_EXCEEDED"
+	// ErrComplexityLimitExceeded はコストの上限を超えた場合のエラーコードです
+	ErrComplexityLimitExceeded = "COMPLEXITY_LIMIT_EXCEEDED"
+)
+
+// DepthLimit はクエリのネストの深さを制限する拡張機能です（MaxDepthが0以下の場合は制限しない）
+// イントロスペクションのフィールド（__schemaなど）の深さは数えません
+type DepthLimit struct {
+	MaxDepth int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = DepthLimit{}
+
+// ExtensionName は拡張機能の名前を返します
+func (l DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+// Validate はスキーマに対する拡張機能の設定を検証します
+func (l DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext はクエリの深さを計算し、上限を超えている場合はエラーを返します
+func (l DepthLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if l.MaxDepth <= 0 || opCtx.Operation == nil {
+		return nil
+	}
+
+	depth := selectionDepth(opCtx.Operation.SelectionSet)
+	if depth <= l.MaxDepth {
+		return nil
+	}
+
+	err := gqlerror.Errorf("クエリの深さ%dが上限の%dを超えています", depth, l.MaxDepth)
+	errcode.Set(err, ErrDepthLimitExceeded)
+	err.Extensions["depth"] = depth
+	err.Extensions["maxDepth"] = l.MaxDepth
+	return err
+}
+
+// selectionDepth はフラグメントを展開したうえで、フィールドのネストの最大の深さを返します
+func selectionDepth(selections ast.SelectionSet) int {
+	maxDepth := 0
+	for _, selection := range selections {
+		depth := 0
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = 1 + selectionDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			depth = selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = selectionDepth(s.Definition.SelectionSet)
+			}
+		}
+		maxDepth = max(maxDepth, depth)
+	}
+	return maxDepth
+}
+
+// ComplexityLimit はリクエストのコストを制限する拡張機能です（MaxComplexityが0以下の場合は制限しない）
+// コストはSetFieldCostsで設定したフィールドごとのコストから計算し、上限を超えた場合はエラーで計算したコストを返します
+type ComplexityLimit struct {
+	MaxComplexity int
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &ComplexityLimit{}
+
+// ExtensionName は拡張機能の名前を返します
+func (l *ComplexityLimit) ExtensionName() string {
+	return "ComplexityLimit"
+}
+
+// Validate はコストの計算に使用するスキーマを保持します
+func (l *ComplexityLimit) Validate(schema graphql.ExecutableSchema) error {
+	l.es = schema
+	return nil
+}
+
+// MutateOperationContext はリクエストのコストを計算し、上限を超えている場合はエラーを返します
+func (l *ComplexityLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if l.MaxComplexity <= 0 || opCtx.Operation == nil {
+		return nil
+	}
+
+	cost := complexity.Calculate(ctx, l.es, opCtx.Operation, opCtx.Variables)
+	if cost <= l.MaxComplexity {
+		return nil
+	}
+
+	err := gqlerror.Errorf("クエリのコスト%dが上限の%dを超えています（取得件数やネストを減らしてください）", cost, l.MaxComplexity)
+	errcode.Set(err, ErrComplexityLimitExceeded)
+	err.Extensions["complexity"] = cost
+	err.Extensions["maxComplexity"] = l.MaxComplexity
+	return err
+}
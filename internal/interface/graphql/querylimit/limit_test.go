@@ -0,0 +1,121 @@
+package querylimit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/generated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+)
+
+const nestedSuitesQuery = `query ($pageSize: Int) {
+	testSuites(pageSize: $pageSize) {
+		edges { node { groups { cases { id } } } }
+	}
+}`
+
+// newSchema はフィールドのコストを設定した実行可能スキーマを作成します
+// 拡張機能は実行前に評価されるため、リゾルバーは不要です
+func newSchema() graphql.ExecutableSchema {
+	c := generated.Config{}
+	SetFieldCosts(&c.Complexity)
+	return generated.NewExecutableSchema(c)
+}
+
+// newOperationContext はクエリを検証し、拡張機能に渡すOperationContextを作成します
+func newOperationContext(t *testing.T, es graphql.ExecutableSchema, query string, variables map[string]interface{}) *graphql.OperationContext {
+	t.Helper()
+	doc, errs := gqlparser.LoadQuery(es.Schema(), query)
+	require.Empty(t, errs)
+	return &graphql.OperationContext{
+		RawQuery:  query,
+		Variables: variables,
+		Doc:       doc,
+		Operation: doc.Operations[0],
+	}
+}
+
+func TestDepthLimit(t *testing.T) {
+	es := newSchema()
+
+	testCases := []struct {
+		name          string
+		maxDepth      int
+		query         string
+		expectedDepth int
+	}{
+		{name: "上限以内の深さは許可する", maxDepth: 6, query: nestedSuitesQuery},
+		{name: "上限を超える深さは拒否する", maxDepth: 5, query: nestedSuitesQuery, expectedDepth: 6},
+		{
+			name:          "フラグメントを展開して深さを数える",
+			maxDepth:      3,
+			query:         `{ testSuites { ...suites } } fragment suites on TestSuiteConnection { edges { node { id } } }`,
+			expectedDepth: 4,
+		},
+		{name: "イントロスペクションの深さは数えない", maxDepth: 1, query: `{ __schema { types { fields { type { name } } } } }`},
+		{name: "0の場合は制限しない", maxDepth: 0, query: nestedSuitesQuery},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opCtx := newOperationContext(t, es, tc.query, nil)
+
+			err := DepthLimit{MaxDepth: tc.maxDepth}.MutateOperationContext(context.Background(), opCtx)
+
+			if tc.expectedDepth == 0 {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Equal(t, ErrDepthLimitExceeded, err.Extensions["code"])
+			assert.Equal(t, tc.expectedDepth, err.Extensions["depth"])
+			assert.Equal(t, tc.maxDepth, err.Extensions["maxDepth"])
+		})
+	}
+}
+
+func TestComplexityLimit(t *testing.T) {
+	es := newSchema()
+	limit := &ComplexityLimit{MaxComplexity: DefaultMaxComplexity}
+	require.NoError(t, limit.Validate(es))
+
+	// cases: 1 + 20*1 = 21, groups: 1 + 10*21 = 211, node: 212, edges: 213, testSuites: 1 + pageSize*213
+	testCases := []struct {
+		name               string
+		pageSize           interface{}
+		expectedComplexity int
+	}{
+		{name: "既定の件数は許可する", pageSize: nil},
+		{name: "少ない件数は許可する", pageSize: int64(20)},
+		{name: "件数に応じたコストが上限を超える場合は拒否する", pageSize: int64(100), expectedComplexity: 21301},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opCtx := newOperationContext(t, es, nestedSuitesQuery, map[string]interface{}{"pageSize": tc.pageSize})
+
+			err := limit.MutateOperationContext(context.Background(), opCtx)
+
+			if tc.expectedComplexity == 0 {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Equal(t, ErrComplexityLimitExceeded, err.Extensions["code"])
+			assert.Equal(t, tc.expectedComplexity, err.Extensions["complexity"])
+			assert.Equal(t, DefaultMaxComplexity, err.Extensions["maxComplexity"])
+			assert.Contains(t, err.Message, "21301")
+		})
+	}
+
+	t.Run("0の場合は制限しない", func(t *testing.T) {
+		unlimited := &ComplexityLimit{}
+		require.NoError(t, unlimited.Validate(es))
+		opCtx := newOperationContext(t, es, nestedSuitesQuery, map[string]interface{}{"pageSize": int64(1000)})
+
+		assert.Nil(t, unlimited.MutateOperationContext(context.Background(), opCtx))
+	})
+}
@@ -0,0 +1,54 @@
+// ファイル: internal/interface/graphql/requestid/requestid.go
+
+// Package requestid はリクエストごとのIDを発行し、ログとエラーレスポンスで同じリクエストを追跡できるようにします
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+)
+
+// HeaderName はリクエストIDを受け渡すHTTPヘッダーです
+const HeaderName = "X-Request-ID"
+
+type ctxKey string
+
+const requestIDKey ctxKey = "requestID"
+
+// validRequestID はクライアントから受け取るリクエストIDとして使用できる形式です（ログへの不正な文字の混入を防ぐ）
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// Middleware はリクエストIDをコンテキストに追加し、レスポンスヘッダーで返すミドルウェアです
+// クライアントがX-Request-IDを指定した場合はその値を使用し、指定しない場合は新しく発行します
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(HeaderName)
+		if !validRequestID.MatchString(id) {
+			id = newID()
+		}
+
+		w.Header().Set(HeaderName, id)
+		next.ServeHTTP(w, r.WithContext(WithRequestID(r.Context(), id)))
+	})
+}
+
+// WithRequestID はリクエストIDを追加したコンテキストを返します
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// FromContext はコンテキストからリクエストIDを取得します（ない場合は空文字列）
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// newID はランダムな16文字の16進数のIDを発行します
+func newID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,45 @@
+package requestid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	testCases := []struct {
+		name       string
+		header     string
+		expectedID string
+	}{
+		{name: "クライアントが指定したIDを使用する", header: "req-123", expectedID: "req-123"},
+		{name: "指定がない場合は発行する", header: ""},
+		{name: "不正な文字を含む場合は発行する", header: "req\n123"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var ctxID string
+			handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctxID = FromContext(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodPost, "/query", nil)
+			if tc.header != "" {
+				req.Header.Set(HeaderName, tc.header)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.NotEmpty(t, ctxID)
+			assert.Equal(t, ctxID, rec.Header().Get(HeaderName))
+			if tc.expectedID != "" {
+				assert.Equal(t, tc.expectedID, ctxID)
+			} else {
+				assert.Regexp(t, `^[0-9a-f]{16}$`, ctxID)
+			}
+		})
+	}
+}
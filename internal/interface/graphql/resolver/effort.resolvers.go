@@ -0,0 +1,108 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/auth"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/globalid"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+)
+
+// RecordEffort は認証ユーザーを記録者としてテストケースに工数を記録します
+func (r *mutationResolver) RecordEffort(ctx context.Context, input model.RecordEffortInput) (*model.EffortRecord, error) {
+	testCaseID, err := globalid.LocalID(input.TestCaseID, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	// 入力をDTOに変換
+	createDTO := &dto.EffortRecordCreateDTO{
+		TestCaseID:   testCaseID,
+		EffortAmount: input.EffortAmount,
+		RecordedBy:   user.ID,
+	}
+	if input.RecordDate != nil {
+		createDTO.RecordDate = *input.RecordDate
+	}
+	if input.IsAdditional != nil {
+		createDTO.IsAdditional = *input.IsAdditional
+	}
+	if input.Comment != nil {
+		createDTO.Comment = *input.Comment
+	}
+
+	// ユースケースの呼び出し
+	result, err := r.EffortUseCase.RecordEffort(ctx, createDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	return EffortRecordDTOToModel(result), nil
+}
+
+// CorrectEffortRecord は認証ユーザーを訂正者として工数記録を訂正します
+func (r *mutationResolver) CorrectEffortRecord(ctx context.Context, id string, input model.CorrectEffortRecordInput) (*model.EffortRecord, error) {
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	// 入力をDTOに変換
+	correctDTO := &dto.EffortRecordCorrectDTO{
+		EffortAmount: input.EffortAmount,
+		CorrectedBy:  user.ID,
+	}
+	if input.Comment != nil {
+		correctDTO.Comment = *input.Comment
+	}
+
+	// ユースケースの呼び出し
+	result, err := r.EffortUseCase.CorrectEffortRecord(ctx, id, correctDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	return EffortRecordDTOToModel(result), nil
+}
+
+// EffortRecords はテストケースの工数記録一覧を取得します
+func (r *queryResolver) EffortRecords(ctx context.Context, testCaseID string) (*model.EffortRecordList, error) {
+	testCaseID, err := globalid.LocalID(testCaseID, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EffortUseCase.GetEffortRecordsByTestCase(ctx, testCaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return EffortRecordListDTOToModel(result), nil
+}
+
+// EffortRecordsByUser はユーザーの指定日の工数記録一覧を取得します
+func (r *queryResolver) EffortRecordsByUser(ctx context.Context, userID string, date time.Time) (*model.EffortRecordList, error) {
+	userID, err := globalid.LocalID(userID, globalid.User)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EffortUseCase.GetEffortRecordsByUserAndDate(ctx, userID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return EffortRecordListDTOToModel(result), nil
+}
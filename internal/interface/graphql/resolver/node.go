@@ -0,0 +1,86 @@
+package resolver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/auth"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/globalid"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model"
+	pkgerrors "github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
+	"github.com/cockroachdb/errors"
+)
+
+// resolveNode はグローバルIDの型名に応じたユースケースでオブジェクトを取得します
+// 権限の確認は型ごとの取得クエリ（testSuite・userなど）と同じで、存在しない場合はnilを返します
+func (r *queryResolver) resolveNode(ctx context.Context, id string) (model.Node, error) {
+	typeName, localID, err := globalid.Decode(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var node model.Node
+	switch typeName {
+	case globalid.TestSuite:
+		suite, err := r.TestSuiteUseCase.GetTestSuite(ctx, localID)
+		if err != nil || suite == nil {
+			return nil, ignoreNotFound(err)
+		}
+		node = TestSuiteDTOToModel(suite)
+	case globalid.TestGroup:
+		group, err := r.TestGroupUseCase.GetTestGroup(ctx, localID)
+		if err != nil || group == nil {
+			return nil, ignoreNotFound(err)
+		}
+		node = TestGroupDTOToModel(group)
+	case globalid.TestCase:
+		testCase, err := r.TestCaseUseCase.GetTestCase(ctx, localID)
+		if err != nil || testCase == nil {
+			return nil, ignoreNotFound(err)
+		}
+		node = TestCaseDTOToModel(testCase)
+	case globalid.User:
+		user, err := r.resolveUserNode(ctx, localID)
+		if err != nil || user == nil {
+			return nil, ignoreNotFound(err)
+		}
+		node = user
+	}
+
+	return node, nil
+}
+
+// resolveUserNode はユーザーを取得します
+// userクエリと同じく管理者権限が必要ですが、自分自身はmeクエリと同じく取得できます
+func (r *queryResolver) resolveUserNode(ctx context.Context, id string) (*model.User, error) {
+	current := auth.GetUserFromContext(ctx)
+	if current == nil {
+		return nil, customerrors.NewUnauthorizedError("認証が必要です")
+	}
+	if current.ID == id {
+		return mapUserEntityToModel(current), nil
+	}
+	if current.Role != entity.RoleAdmin {
+		return nil, customerrors.NewForbiddenError("アクセス権限がありません")
+	}
+
+	user, err := r.UserManagementUseCase.FindUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return mapUserEntityToModel(user), nil
+}
+
+// ignoreNotFound は存在しないことを表すエラーをnilにします（Relayの仕様ではnodeはnullを返す）
+func ignoreNotFound(err error) error {
+	if customerrors.IsNotFoundError(err) {
+		return nil
+	}
+	var domainErr pkgerrors.DomainError
+	if errors.As(err, &domainErr) && (domainErr.ErrorCode() == "NOT_FOUND" || strings.HasSuffix(domainErr.ErrorCode(), "_NOT_FOUND")) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,36 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.76
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Node はグローバルIDを指定してオブジェクトを取得するクエリのリゾルバーです
+// 存在しない場合はnullを返し、権限がない場合はエラーを返します
+func (r *queryResolver) Node(ctx context.Context, id string) (model.Node, error) {
+	return r.resolveNode(ctx, id)
+}
+
+// Nodes は複数のグローバルIDを指定してオブジェクトを取得するクエリのリゾルバーです
+// 取得できないIDの位置はnullとし、そのエラーはレスポンスのerrorsに追加します
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	nodes := make([]model.Node, len(ids))
+	for i, id := range ids {
+		node, err := r.resolveNode(ctx, id)
+		if err != nil {
+			graphql.AddError(ctx, gqlerror.WrapPath(append(graphql.GetPath(ctx), ast.PathIndex(i)), err))
+			continue
+		}
+		nodes[i] = node
+	}
+
+	return nodes, nil
+}
@@ -3,8 +3,10 @@ package resolver
 import (
 	"context"
 
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/port"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
 )
 
 // TestSuiteUseCase はGraphQLリゾルバーが使用するテストスイートのユースケースインターフェースです
@@ -24,6 +26,9 @@ type TestSuiteUseCase interface {
 
 	// UpdateTestSuiteStatus はIDを指定してテストスイートのステータスを更新します
 	UpdateTestSuiteStatus(ctx context.Context, id string, statusDTO *dto.TestSuiteStatusUpdateDTO) (*dto.TestSuiteResponseDTO, error)
+
+	// DeleteTestSuite はIDを指定してテストスイートを配下のグループ・ケースごとゴミ箱に移動します
+	DeleteTestSuite(ctx context.Context, id string) error
 }
 
 // Resolver はGraphQLリゾルバーのルート構造体です
@@ -42,6 +47,15 @@ type Resolver struct {
 	AuthUseCase port.AuthUseCase
 
 	UserManagementUseCase port.UserManagementUseCase
+
+	// 工数記録用のユースケース
+	EffortUseCase port.EffortUseCase
+
+	// ゴミ箱（復元・一覧）用のユースケース
+	TrashUseCase port.TrashUseCase
+
+	// サブスクリプション用のイベント購読
+	EventSubscriber event.Subscriber
 }
 
 // NewResolver は新しいリゾルバーインスタンスを作成します
@@ -52,6 +66,9 @@ func NewResolver(
 	testCaseUseCase port.TestCaseUseCase,
 	authUseCase port.AuthUseCase,
 	userManagementUseCase port.UserManagementUseCase, // ← 追加
+	effortUseCase port.EffortUseCase,
+	trashUseCase port.TrashUseCase,
+	eventSubscriber event.Subscriber,
 ) *Resolver {
 	return &Resolver{
 		TestSuiteUseCase:      testSuiteUseCase,
@@ -59,5 +76,49 @@ func NewResolver(
 		TestCaseUseCase:       testCaseUseCase,
 		AuthUseCase:           authUseCase,
 		UserManagementUseCase: userManagementUseCase, // ← 追加
+		EffortUseCase:         effortUseCase,
+		TrashUseCase:          trashUseCase,
+		EventSubscriber:       eventSubscriber,
 	}
 }
+
+// subscribeSuiteEvents は指定されたスイートのドメインイベントを購読します
+// 受信したイベントはfilterで絞り込み、convertでクライアントに送信する値に変換します
+// convertがfalseを返したイベントは送信しません
+func subscribeSuiteEvents[T any](
+	ctx context.Context,
+	subscriber event.Subscriber,
+	suiteID string,
+	filter func(*event.Event) bool,
+	convert func(context.Context, *event.Event) (T, bool),
+) (<-chan T, error) {
+	if subscriber == nil {
+		return nil, customerrors.NewInternalServerError("サブスクリプションは利用できません")
+	}
+
+	events, err := subscriber.Subscribe(ctx, suiteID)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan T, 1)
+	go func() {
+		defer close(ch)
+		for e := range events {
+			if !filter(e) {
+				continue
+			}
+			value, ok := convert(ctx, e)
+			if !ok {
+				continue
+			}
+			select {
+			case ch <- value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
@@ -56,6 +56,11 @@ func (m *MockTestSuiteUseCase) UpdateTestSuiteStatus(ctx context.Context, id str
 	return args.Get(0).(*dto.TestSuiteResponseDTO), args.Error(1)
 }
 
+func (m *MockTestSuiteUseCase) DeleteTestSuite(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 // MockTestGroupUseCase はテストグループユースケースのモック
 type MockTestGroupUseCase struct {
 	mock.Mock
@@ -69,6 +74,14 @@ func (m *MockTestGroupUseCase) GetGroupsBySuiteID(ctx context.Context, suiteID s
 	return args.Get(0).([]*dto.TestGroupResponseDTO), args.Error(1)
 }
 
+func (m *MockTestGroupUseCase) GetGroupsBySuiteIDs(ctx context.Context, suiteIDs []string) (map[string][]*dto.TestGroupResponseDTO, error) {
+	args := m.Called(ctx, suiteIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]*dto.TestGroupResponseDTO), args.Error(1)
+}
+
 // CreateTestGroup はテストグループを作成するモックメソッド
 func (m *MockTestGroupUseCase) CreateTestGroup(ctx context.Context, input *dto.TestGroupCreateDTO) (*dto.TestGroupResponseDTO, error) {
 	args := m.Called(ctx, input)
@@ -93,6 +106,14 @@ func (m *MockTestCaseUseCase) GetCasesByGroupID(ctx context.Context, groupID str
 	return args.Get(0).([]*dto.TestCaseResponseDTO), args.Error(1)
 }
 
+func (m *MockTestCaseUseCase) GetCasesByGroupIDs(ctx context.Context, groupIDs []string) (map[string][]*dto.TestCaseResponseDTO, error) {
+	args := m.Called(ctx, groupIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]*dto.TestCaseResponseDTO), args.Error(1)
+}
+
 // その他TestCaseUseCaseメソッドを実装...
 
 // テストヘルパー関数
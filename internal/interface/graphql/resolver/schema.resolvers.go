@@ -8,10 +8,14 @@ import (
 	"context"
 	"time"
 
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/auth"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/dataloader"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/generated"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/globalid"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/support/customerrors"
 )
 
 // CreateTestSuite はテストスイート作成ミューテーションのリゾルバーです
@@ -34,6 +38,12 @@ func (r *mutationResolver) CreateTestSuite(ctx context.Context, input model.Crea
 	} else {
 		createDTO.RequireEffortComment = false // デフォルト値
 	}
+	if input.ExitCriteria != nil {
+		createDTO.ExitCriteria = exitCriteriaInputToDTO(input.ExitCriteria)
+	}
+	if user := auth.GetUserFromContext(ctx); user != nil {
+		createDTO.CreatedBy = user.ID
+	}
 
 	// ユースケースを呼び出し
 	result, err := r.TestSuiteUseCase.CreateTestSuite(ctx, createDTO)
@@ -42,23 +52,17 @@ func (r *mutationResolver) CreateTestSuite(ctx context.Context, input model.Crea
 	}
 
 	// レスポンスDTOをGraphQLモデルに変換
-	return &model.TestSuite{
-		ID:                   result.ID,
-		Name:                 result.Name,
-		Description:          result.Description,
-		Status:               mapStatusToEnum(result.Status),
-		EstimatedStartDate:   result.EstimatedStartDate,
-		EstimatedEndDate:     result.EstimatedEndDate,
-		RequireEffortComment: result.RequireEffortComment,
-		Progress:             result.Progress,
-		CreatedAt:            result.CreatedAt,
-		UpdatedAt:            result.UpdatedAt,
-	}, nil
+	return TestSuiteDTOToModel(result), nil
 }
 
 // UpdateTestSuite はテストスイート更新ミューテーションのリゾルバーです
 // IDと更新情報を受け取り、既存のテストスイートを更新します
 func (r *mutationResolver) UpdateTestSuite(ctx context.Context, id string, input model.UpdateTestSuiteInput) (*model.TestSuite, error) {
+	id, err := globalid.LocalID(id, globalid.TestSuite)
+	if err != nil {
+		return nil, err
+	}
+
 	// DTOに変換
 	updateDTO := &dto.TestSuiteUpdateDTO{}
 
@@ -78,6 +82,12 @@ func (r *mutationResolver) UpdateTestSuite(ctx context.Context, id string, input
 	if input.RequireEffortComment != nil {
 		updateDTO.RequireEffortComment = input.RequireEffortComment
 	}
+	if input.ExitCriteria != nil {
+		updateDTO.ExitCriteria = exitCriteriaInputToDTO(input.ExitCriteria)
+	}
+	if input.ExpectedVersion != nil {
+		updateDTO.ExpectedVersion = input.ExpectedVersion
+	}
 
 	// ユースケースを呼び出し
 	result, err := r.TestSuiteUseCase.UpdateTestSuite(ctx, id, updateDTO)
@@ -86,27 +96,31 @@ func (r *mutationResolver) UpdateTestSuite(ctx context.Context, id string, input
 	}
 
 	// レスポンスをGraphQLモデルに変換
-	return &model.TestSuite{
-		ID:                   result.ID,
-		Name:                 result.Name,
-		Description:          result.Description,
-		Status:               mapStatusToEnum(result.Status),
-		EstimatedStartDate:   result.EstimatedStartDate,
-		EstimatedEndDate:     result.EstimatedEndDate,
-		RequireEffortComment: result.RequireEffortComment,
-		Progress:             result.Progress,
-		CreatedAt:            result.CreatedAt,
-		UpdatedAt:            result.UpdatedAt,
-	}, nil
+	return TestSuiteDTOToModel(result), nil
 }
 
 // UpdateTestSuiteStatus はテストスイートステータス更新ミューテーションのリゾルバーです
 // IDと新しいステータスを受け取り、テストスイートのステータスを更新します
-func (r *mutationResolver) UpdateTestSuiteStatus(ctx context.Context, id string, status model.SuiteStatus) (*model.TestSuite, error) {
+// 完了条件のオーバーライドには認証済みのAdminユーザーが必要です
+func (r *mutationResolver) UpdateTestSuiteStatus(ctx context.Context, id string, status model.SuiteStatus, overrideReason *string) (*model.TestSuite, error) {
+	id, err := globalid.LocalID(id, globalid.TestSuite)
+	if err != nil {
+		return nil, err
+	}
+
 	// DTOに変換
 	statusDTO := &dto.TestSuiteStatusUpdateDTO{
 		Status: mapEnumToStatus(status),
 	}
+	if user := auth.GetUserFromContext(ctx); user != nil {
+		statusDTO.ChangedBy = user.ID
+	}
+	if overrideReason != nil {
+		if statusDTO.ChangedBy == "" {
+			return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+		}
+		statusDTO.OverrideReason = *overrideReason
+	}
 
 	// ユースケースを呼び出し
 	result, err := r.TestSuiteUseCase.UpdateTestSuiteStatus(ctx, id, statusDTO)
@@ -115,23 +129,416 @@ func (r *mutationResolver) UpdateTestSuiteStatus(ctx context.Context, id string,
 	}
 
 	// レスポンスをGraphQLモデルに変換
-	return &model.TestSuite{
-		ID:                   result.ID,
-		Name:                 result.Name,
-		Description:          result.Description,
-		Status:               mapStatusToEnum(result.Status),
-		EstimatedStartDate:   result.EstimatedStartDate,
-		EstimatedEndDate:     result.EstimatedEndDate,
-		RequireEffortComment: result.RequireEffortComment,
-		Progress:             result.Progress,
-		CreatedAt:            result.CreatedAt,
-		UpdatedAt:            result.UpdatedAt,
-	}, nil
+	return TestSuiteDTOToModel(result), nil
+}
+
+// DeleteTestSuite はテストスイート削除ミューテーションのリゾルバーです
+// 配下のグループ・ケースごとゴミ箱に移動し、restoreTestSuiteで元に戻せます
+func (r *mutationResolver) DeleteTestSuite(ctx context.Context, id string) (bool, error) {
+	id, err := globalid.LocalID(id, globalid.TestSuite)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.TestSuiteUseCase.DeleteTestSuite(ctx, id); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestoreTestSuite はゴミ箱のテストスイートを復元するミューテーションのリゾルバーです
+func (r *mutationResolver) RestoreTestSuite(ctx context.Context, id string) (*model.TestSuite, error) {
+	id, err := globalid.LocalID(id, globalid.TestSuite)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.TrashUseCase.RestoreTestSuite(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return TestSuiteDTOToModel(result), nil
+}
+
+// CreateTestGroup はテストグループ作成ミューテーションのリゾルバーです
+// 表示順を省略した場合はスイートの末尾に追加します
+func (r *mutationResolver) CreateTestGroup(ctx context.Context, input model.CreateTestGroupInput) (*model.TestGroup, error) {
+	suiteID, err := globalid.LocalID(input.SuiteID, globalid.TestSuite)
+	if err != nil {
+		return nil, err
+	}
+
+	// DTOに変換
+	createDTO := &dto.TestGroupCreateDTO{
+		SuiteID: suiteID,
+		Name:    input.Name,
+	}
+	if input.Description != nil {
+		createDTO.Description = *input.Description
+	}
+	if input.DisplayOrder != nil {
+		createDTO.DisplayOrder = *input.DisplayOrder
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestGroupUseCase.CreateTestGroup(ctx, createDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestGroupDTOToModel(result), nil
+}
+
+// UpdateTestGroup はテストグループ更新ミューテーションのリゾルバーです
+func (r *mutationResolver) UpdateTestGroup(ctx context.Context, id string, input model.UpdateTestGroupInput) (*model.TestGroup, error) {
+	id, err := globalid.LocalID(id, globalid.TestGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	// DTOに変換
+	updateDTO := &dto.TestGroupUpdateDTO{
+		Name:            input.Name,
+		Description:     input.Description,
+		StatusLocked:    input.StatusLocked,
+		ExpectedVersion: input.ExpectedVersion,
+	}
+	if input.Status != nil {
+		status := mapEnumToStatus(*input.Status)
+		updateDTO.Status = &status
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestGroupUseCase.UpdateTestGroup(ctx, id, updateDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestGroupDTOToModel(result), nil
+}
+
+// DeleteTestGroup はテストグループ削除ミューテーションのリゾルバーです
+func (r *mutationResolver) DeleteTestGroup(ctx context.Context, id string) (bool, error) {
+	id, err := globalid.LocalID(id, globalid.TestGroup)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.TestGroupUseCase.DeleteTestGroup(ctx, id); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestoreTestGroup はゴミ箱のテストグループを復元するミューテーションのリゾルバーです
+func (r *mutationResolver) RestoreTestGroup(ctx context.Context, id string) (*model.TestGroup, error) {
+	id, err := globalid.LocalID(id, globalid.TestGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.TrashUseCase.RestoreTestGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return TestGroupDTOToModel(result), nil
+}
+
+// ReorderTestGroups はテストグループ並べ替えミューテーションのリゾルバーです
+// スイートに属するすべてのグループIDを新しい表示順で受け取ります
+func (r *mutationResolver) ReorderTestGroups(ctx context.Context, suiteID string, groupIds []string) ([]*model.TestGroup, error) {
+	suiteID, err := globalid.LocalID(suiteID, globalid.TestSuite)
+	if err != nil {
+		return nil, err
+	}
+	groupIds, err = globalid.LocalIDs(groupIds, globalid.TestGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユースケースを呼び出し
+	groupDTOs, err := r.TestGroupUseCase.ReorderTestGroups(ctx, suiteID, groupIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	groups := make([]*model.TestGroup, len(groupDTOs))
+	for i, dto := range groupDTOs {
+		groups[i] = TestGroupDTOToModel(dto)
+	}
+
+	return groups, nil
+}
+
+// CreateTestCase はテストケース作成ミューテーションのリゾルバーです
+func (r *mutationResolver) CreateTestCase(ctx context.Context, input model.CreateTestCaseInput) (*model.TestCase, error) {
+	groupID, err := globalid.LocalID(input.GroupID, globalid.TestGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	// DTOに変換
+	createDTO := &dto.TestCaseCreateDTO{
+		GroupID: groupID,
+		Title:   input.Title,
+		DueDate: input.DueDate,
+	}
+	if input.Description != nil {
+		createDTO.Description = *input.Description
+	}
+	if input.Priority != nil {
+		createDTO.Priority = mapEnumToPriority(*input.Priority)
+	}
+	if input.PlannedEffort != nil {
+		createDTO.PlannedEffort = *input.PlannedEffort
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestCaseUseCase.CreateTestCase(ctx, createDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestCaseDTOToModel(result), nil
+}
+
+// UpdateTestCase はテストケース更新ミューテーションのリゾルバーです
+// ステータスの変更はupdateTestCaseStatusで行います
+func (r *mutationResolver) UpdateTestCase(ctx context.Context, id string, input model.UpdateTestCaseInput) (*model.TestCase, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	// DTOに変換
+	updateDTO := &dto.TestCaseUpdateDTO{
+		Title:           input.Title,
+		Description:     input.Description,
+		PlannedEffort:   input.PlannedEffort,
+		DueDate:         input.DueDate,
+		UpdatedBy:       user.ID,
+		ExpectedVersion: input.ExpectedVersion,
+	}
+	if input.Priority != nil {
+		priority := mapEnumToPriority(*input.Priority)
+		updateDTO.Priority = &priority
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestCaseUseCase.UpdateTestCase(ctx, id, updateDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestCaseDTOToModel(result), nil
+}
+
+// DeleteTestCase はテストケース削除ミューテーションのリゾルバーです
+func (r *mutationResolver) DeleteTestCase(ctx context.Context, id string) (bool, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return false, err
+	}
+
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return false, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	if err := r.TestCaseUseCase.DeleteTestCase(ctx, id, user.ID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestoreTestCase はゴミ箱のテストケースを復元するミューテーションのリゾルバーです
+func (r *mutationResolver) RestoreTestCase(ctx context.Context, id string) (*model.TestCase, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.TrashUseCase.RestoreTestCase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return TestCaseDTOToModel(result), nil
+}
+
+// MoveTestCase はテストケース移動ミューテーションのリゾルバーです
+func (r *mutationResolver) MoveTestCase(ctx context.Context, id string, targetGroupID string) (*model.TestCase, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+	targetGroupID, err = globalid.LocalID(targetGroupID, globalid.TestGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestCaseUseCase.MoveTestCase(ctx, id, targetGroupID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestCaseDTOToModel(result), nil
+}
+
+// UpdateTestCaseStatus はテストケースステータス更新ミューテーションのリゾルバーです
+// 認証ユーザーを変更者として、遷移ルールに従ってステータスを更新し変更履歴を記録します
+// expectedVersionを指定した場合、読み込み後に他の更新が行われていれば競合エラーとなります
+func (r *mutationResolver) UpdateTestCaseStatus(ctx context.Context, id string, status model.TestStatus, reason *string, expectedVersion *int) (*model.TestCase, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	// DTOに変換
+	statusDTO := &dto.TestCaseStatusUpdateDTO{
+		Status:          mapEnumToTestStatus(status),
+		ChangedBy:       user.ID,
+		ExpectedVersion: expectedVersion,
+	}
+	if reason != nil {
+		statusDTO.Reason = *reason
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestCaseUseCase.UpdateTestCaseStatus(ctx, id, statusDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestCaseDTOToModel(result), nil
+}
+
+// LockTestCase はテストケース編集ロック取得ミューテーションのリゾルバーです
+// 認証ユーザーを編集者として一定期間のロックを取得します
+func (r *mutationResolver) LockTestCase(ctx context.Context, id string) (*model.TestCase, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestCaseUseCase.LockTestCase(ctx, id, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestCaseDTOToModel(result), nil
+}
+
+// RenewTestCaseLock はテストケース編集ロック延長ミューテーションのリゾルバーです
+func (r *mutationResolver) RenewTestCaseLock(ctx context.Context, id string) (*model.TestCase, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestCaseUseCase.RenewTestCaseLock(ctx, id, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestCaseDTOToModel(result), nil
+}
+
+// UnlockTestCase はテストケース編集ロック解除ミューテーションのリゾルバーです
+func (r *mutationResolver) UnlockTestCase(ctx context.Context, id string) (*model.TestCase, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestCaseUseCase.UnlockTestCase(ctx, id, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestCaseDTOToModel(result), nil
+}
+
+// ForceUnlockTestCase はテストケース編集ロック強制解除ミューテーションのリゾルバーです
+// 他のユーザーが保持するロックを解除するため、管理者またはマネージャーのみ実行できます
+func (r *mutationResolver) ForceUnlockTestCase(ctx context.Context, id string) (*model.TestCase, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.GetUserFromContext(ctx)
+	if user == nil {
+		return nil, customerrors.NewUnauthorizedError("ユーザー情報が取得できません")
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestCaseUseCase.ForceUnlockTestCase(ctx, id, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestCaseDTOToModel(result), nil
 }
 
 // TestSuite はテストスイート取得クエリのリゾルバーです
 // IDを指定して単一のテストスイートを取得します
 func (r *queryResolver) TestSuite(ctx context.Context, id string) (*model.TestSuite, error) {
+	id, err := globalid.LocalID(id, globalid.TestSuite)
+	if err != nil {
+		return nil, err
+	}
+
 	// ユースケースを呼び出し
 	result, err := r.TestSuiteUseCase.GetTestSuite(ctx, id)
 	if err != nil {
@@ -148,24 +555,31 @@ func (r *queryResolver) TestSuite(ctx context.Context, id string) (*model.TestSu
 }
 
 // TestSuites はテストスイート一覧取得クエリのリゾルバーです
-// オプションでステータスによるフィルタリングやページネーションができます
-func (r *queryResolver) TestSuites(ctx context.Context, status *model.SuiteStatus, page *int, pageSize *int) (*model.TestSuiteConnection, error) {
+// first/after・last/beforeのカーソルでページングします（page/pageSizeを指定した場合は従来のオフセット方式）
+func (r *queryResolver) TestSuites(ctx context.Context, status *model.SuiteStatus, filter *model.TestSuiteFilter, orderBy []*model.TestSuiteOrder, first *int, after *string, last *int, before *string, page *int, pageSize *int) (*model.TestSuiteConnection, error) {
 	// DTOに変換
-	params := &dto.TestSuiteQueryParamDTO{}
-
-	// NULLチェックを追加
-	if page != nil {
-		params.Page = page
-	} else {
-		defaultPage := 1
-		params.Page = &defaultPage
+	params := &dto.TestSuiteQueryParamDTO{Sort: testSuiteOrderToDTO(orderBy)}
+	applyTestSuiteFilter(params, filter)
+	if params.CreatedBy != nil {
+		createdBy, err := globalid.LocalID(*params.CreatedBy, globalid.User)
+		if err != nil {
+			return nil, err
+		}
+		params.CreatedBy = &createdBy
+	}
+	if err := dto.ValidateTestSuiteSort(params.Sort); err != nil {
+		return nil, err
 	}
 
-	if pageSize != nil {
+	if page != nil || pageSize != nil {
+		params.Page = page
 		params.PageSize = pageSize
 	} else {
-		defaultPageSize := 10 // デフォルト値
-		params.PageSize = &defaultPageSize
+		keyset, err := dto.NewTestSuiteKeyset(first, after, last, before, params.SortOrder())
+		if err != nil {
+			return nil, err
+		}
+		params.Keyset = keyset
 	}
 
 	if status != nil {
@@ -181,21 +595,11 @@ func (r *queryResolver) TestSuites(ctx context.Context, status *model.SuiteStatu
 
 	// エッジを作成
 	edges := make([]*model.TestSuiteEdge, len(result.TestSuites))
-	for i, ts := range result.TestSuites {
+	for i := range result.TestSuites {
+		ts := &result.TestSuites[i]
 		edges[i] = &model.TestSuiteEdge{
-			Node: &model.TestSuite{
-				ID:                   ts.ID,
-				Name:                 ts.Name,
-				Description:          ts.Description,
-				Status:               mapStatusToEnum(ts.Status),
-				EstimatedStartDate:   ts.EstimatedStartDate,
-				EstimatedEndDate:     ts.EstimatedEndDate,
-				RequireEffortComment: ts.RequireEffortComment,
-				Progress:             ts.Progress,
-				CreatedAt:            ts.CreatedAt,
-				UpdatedAt:            ts.UpdatedAt,
-			},
-			Cursor: ts.ID, // IDをカーソルとして使用
+			Node:   TestSuiteDTOToModel(ts),
+			Cursor: dto.NewTestSuiteCursor(ts, params.SortOrder()),
 		}
 	}
 
@@ -203,8 +607,8 @@ func (r *queryResolver) TestSuites(ctx context.Context, status *model.SuiteStatu
 	return &model.TestSuiteConnection{
 		Edges: edges,
 		PageInfo: &model.PageInfo{
-			HasNextPage:     len(result.TestSuites) == *params.PageSize,
-			HasPreviousPage: *params.Page > 1,
+			HasNextPage:     result.HasNextPage,
+			HasPreviousPage: result.HasPreviousPage,
 			StartCursor:     getStartCursor(edges),
 			EndCursor:       getEndCursor(edges),
 		},
@@ -212,16 +616,155 @@ func (r *queryResolver) TestSuites(ctx context.Context, status *model.SuiteStatu
 	}, nil
 }
 
+// TestGroup はテストグループ取得クエリのリゾルバーです
+func (r *queryResolver) TestGroup(ctx context.Context, id string) (*model.TestGroup, error) {
+	id, err := globalid.LocalID(id, globalid.TestGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestGroupUseCase.GetTestGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestGroupDTOToModel(result), nil
+}
+
+// TestCase はテストケース取得クエリのリゾルバーです
+func (r *queryResolver) TestCase(ctx context.Context, id string) (*model.TestCase, error) {
+	id, err := globalid.LocalID(id, globalid.TestCase)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユースケースを呼び出し
+	result, err := r.TestCaseUseCase.GetTestCase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスをGraphQLモデルに変換
+	return TestCaseDTOToModel(result), nil
+}
+
+// Trash はゴミ箱の内容を取得するクエリのリゾルバーです
+func (r *queryResolver) Trash(ctx context.Context) (*model.Trash, error) {
+	result, err := r.TrashUseCase.ListTrash(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return TrashDTOToModel(result), nil
+}
+
 // TestSuiteStatusChanged はテストスイートのステータス変更を監視するサブスクリプションのリゾルバーです
 // クライアントはこのサブスクリプションを使用してリアルタイムでステータス変更を受け取れます
-func (r *subscriptionResolver) TestSuiteStatusChanged(ctx context.Context) (<-chan *model.TestSuite, error) {
-	// この実装は簡易版です。実際の実装ではWebSocketやPub/Subの仕組みを使用します
-	ch := make(chan *model.TestSuite, 1)
+func (r *subscriptionResolver) TestSuiteStatusChanged(ctx context.Context, id string) (<-chan *model.TestSuite, error) {
+	id, err := globalid.LocalID(id, globalid.TestSuite)
+	if err != nil {
+		return nil, err
+	}
+
+	// 購読対象のスイートの存在確認
+	if _, err := r.TestSuiteUseCase.GetTestSuite(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return subscribeSuiteEvents(ctx, r.EventSubscriber, id,
+		func(e *event.Event) bool {
+			return e.Type == event.TestSuiteStatusChanged && e.EntityID == id
+		},
+		func(ctx context.Context, e *event.Event) (*model.TestSuite, bool) {
+			// 進捗率を含めた最新の状態を送信する
+			result, err := r.TestSuiteUseCase.GetTestSuite(ctx, e.EntityID)
+			if err != nil {
+				return nil, false
+			}
+			return TestSuiteDTOToModel(result), true
+		},
+	)
+}
+
+// TestCaseUpdated は指定されたスイート内のテストケースの変更を監視するサブスクリプションのリゾルバーです
+// 作成・更新・ステータス変更に加え、遅延の検出や工数記録による実績工数の変化も通知します
+func (r *subscriptionResolver) TestCaseUpdated(ctx context.Context, suiteID string) (<-chan *model.TestCase, error) {
+	suiteID, err := globalid.LocalID(suiteID, globalid.TestSuite)
+	if err != nil {
+		return nil, err
+	}
 
-	// 実際のサブスクリプション処理はここに実装
-	// 現在はダミーのチャネルを返すだけ
+	// 購読対象のスイートの存在確認
+	if _, err := r.TestSuiteUseCase.GetTestSuite(ctx, suiteID); err != nil {
+		return nil, err
+	}
 
-	return ch, nil
+	return subscribeSuiteEvents(ctx, r.EventSubscriber, suiteID,
+		func(e *event.Event) bool {
+			switch e.Type {
+			case event.TestCaseCreated, event.TestCaseUpdated, event.TestCaseStatusChanged, event.TestCaseDelayed, event.TestCaseRestored, event.EffortRecorded:
+				return true
+			default:
+				return false
+			}
+		},
+		func(ctx context.Context, e *event.Event) (*model.TestCase, bool) {
+			testCaseID := e.EntityID
+			if e.Type == event.EffortRecorded {
+				testCaseID = e.TestCaseID
+			}
+			result, err := r.TestCaseUseCase.GetTestCase(ctx, testCaseID)
+			if err != nil {
+				return nil, false
+			}
+			return TestCaseDTOToModel(result), true
+		},
+	)
+}
+
+// SuiteActivity は指定されたスイート配下で発生したすべての操作を監視するサブスクリプションのリゾルバーです
+func (r *subscriptionResolver) SuiteActivity(ctx context.Context, suiteID string) (<-chan *model.SuiteActivity, error) {
+	suiteID, err := globalid.LocalID(suiteID, globalid.TestSuite)
+	if err != nil {
+		return nil, err
+	}
+
+	// 購読対象のスイートの存在確認
+	if _, err := r.TestSuiteUseCase.GetTestSuite(ctx, suiteID); err != nil {
+		return nil, err
+	}
+
+	return subscribeSuiteEvents(ctx, r.EventSubscriber, suiteID,
+		func(e *event.Event) bool {
+			return true
+		},
+		func(ctx context.Context, e *event.Event) (*model.SuiteActivity, bool) {
+			return EventToSuiteActivity(e), true
+		},
+	)
+}
+
+// StatusHistory はTestCaseのstatusHistoryフィールドリゾルバーです
+// 指定されたテストケースのステータス変更履歴を古い順に取得します
+func (r *testCaseResolver) StatusHistory(ctx context.Context, obj *model.TestCase) ([]*model.StatusHistory, error) {
+	// objのNULLチェック
+	if obj == nil {
+		return nil, nil
+	}
+
+	historyDTOs, err := r.TestCaseUseCase.GetStatusHistory(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make([]*model.StatusHistory, len(historyDTOs))
+	for i, dto := range historyDTOs {
+		histories[i] = StatusHistoryDTOToModel(dto)
+	}
+
+	return histories, nil
 }
 
 // Cases はTestGroupのcasesフィールドリゾルバーです
@@ -327,6 +870,9 @@ func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 // Subscription returns generated.SubscriptionResolver implementation.
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
+// TestCase returns generated.TestCaseResolver implementation.
+func (r *Resolver) TestCase() generated.TestCaseResolver { return &testCaseResolver{r} }
+
 // TestGroup returns generated.TestGroupResolver implementation.
 func (r *Resolver) TestGroup() generated.TestGroupResolver { return &testGroupResolver{r} }
 
@@ -336,5 +882,6 @@ func (r *Resolver) TestSuite() generated.TestSuiteResolver { return &testSuiteRe
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
+type testCaseResolver struct{ *Resolver }
 type testGroupResolver struct{ *Resolver }
 type testSuiteResolver struct{ *Resolver }
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/auth"
+	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/globalid"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/port"
 )
@@ -56,8 +57,13 @@ func (r *mutationResolver) ChangePassword(ctx context.Context, oldPassword strin
 
 // ResetPassword は管理者が他のユーザーのパスワードをリセットします
 func (r *mutationResolver) ResetPassword(ctx context.Context, userID string, newPassword string) (bool, error) {
+	userID, err := globalid.LocalID(userID, globalid.User)
+	if err != nil {
+		return false, err
+	}
+
 	// ユースケースの呼び出し
-	err := r.UserManagementUseCase.ResetPassword(ctx, userID, newPassword)
+	err = r.UserManagementUseCase.ResetPassword(ctx, userID, newPassword)
 	if err != nil {
 		return false, err
 	}
@@ -67,8 +73,13 @@ func (r *mutationResolver) ResetPassword(ctx context.Context, userID string, new
 
 // DeleteUser はユーザーを削除します
 func (r *mutationResolver) DeleteUser(ctx context.Context, userID string) (bool, error) {
+	userID, err := globalid.LocalID(userID, globalid.User)
+	if err != nil {
+		return false, err
+	}
+
 	// ユースケースの呼び出し
-	err := r.UserManagementUseCase.DeleteUser(ctx, userID)
+	err = r.UserManagementUseCase.DeleteUser(ctx, userID)
 	if err != nil {
 		return false, err
 	}
@@ -79,6 +90,11 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, userID string) (bool,
 // internal/interface/graphql/resolver/user_management.resolvers.go に追加
 // UpdateUser はユーザー情報を更新します
 func (r *mutationResolver) UpdateUser(ctx context.Context, userID string, input model.UpdateUserInput) (*model.User, error) {
+	userID, err := globalid.LocalID(userID, globalid.User)
+	if err != nil {
+		return nil, err
+	}
+
 	// リクエストデータの準備
 	request := &port.UpdateUserRequest{
 		Username: input.Username,
@@ -127,6 +143,11 @@ func (r *queryResolver) Users(ctx context.Context) ([]*model.User, error) {
 
 // User は指定されたIDのユーザーを取得します
 func (r *queryResolver) User(ctx context.Context, id string) (*model.User, error) {
+	id, err := globalid.LocalID(id, globalid.User)
+	if err != nil {
+		return nil, err
+	}
+
 	// ✅ 正しい実装：ユースケース経由でアクセス
 	user, err := r.UserManagementUseCase.FindUserByID(ctx, id)
 	if err != nil {
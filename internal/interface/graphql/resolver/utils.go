@@ -1,6 +1,7 @@
 package resolver
 
 import (
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
 	"github.com/FUJI0130/go-ddd-ca/internal/interface/graphql/model"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
 )
@@ -41,6 +42,50 @@ func mapEnumToStatus(status model.SuiteStatus) string {
 	}
 }
 
+// mapSortFieldFromEnum はGraphQLの並び替え項目をDTOの項目に変換します
+func mapSortFieldFromEnum(field model.TestSuiteSortField) dto.TestSuiteSortField {
+	switch field {
+	case model.TestSuiteSortFieldName:
+		return dto.TestSuiteSortByName
+	case model.TestSuiteSortFieldEstimatedStartDate:
+		return dto.TestSuiteSortByEstimatedStartDate
+	case model.TestSuiteSortFieldEstimatedEndDate:
+		return dto.TestSuiteSortByEstimatedEndDate
+	case model.TestSuiteSortFieldProgress:
+		return dto.TestSuiteSortByProgress
+	case model.TestSuiteSortFieldUpdatedAt:
+		return dto.TestSuiteSortByUpdatedAt
+	default:
+		return dto.TestSuiteSortByCreatedAt
+	}
+}
+
+// testSuiteOrderToDTO はGraphQLの並び順の指定をDTOに変換します（方向の省略時は昇順）
+func testSuiteOrderToDTO(orderBy []*model.TestSuiteOrder) []dto.TestSuiteSortDTO {
+	sort := make([]dto.TestSuiteSortDTO, 0, len(orderBy))
+	for _, order := range orderBy {
+		sort = append(sort, dto.TestSuiteSortDTO{
+			Field: mapSortFieldFromEnum(order.Field),
+			Desc:  order.Direction != nil && *order.Direction == model.SortDirectionDesc,
+		})
+	}
+	return sort
+}
+
+// applyTestSuiteFilter はGraphQLの絞り込み条件をクエリパラメータに設定します
+func applyTestSuiteFilter(params *dto.TestSuiteQueryParamDTO, filter *model.TestSuiteFilter) {
+	if filter == nil {
+		return
+	}
+	params.Search = filter.Search
+	params.CreatedBy = filter.CreatedBy
+	params.PeriodFrom = filter.PeriodFrom
+	params.PeriodTo = filter.PeriodTo
+	params.MinProgress = filter.MinProgress
+	params.MaxProgress = filter.MaxProgress
+	params.HasDelayedCases = filter.HasDelayedCases
+}
+
 // mapGroupStatusToEnum はグループステータス文字列をGraphQLのenum型に変換します
 // 現在はテストスイートと同じステータス体系を使用しています
 func mapGroupStatusToEnum(status string) model.SuiteStatus {
@@ -69,6 +114,28 @@ func mapTestStatusToEnum(status string) model.TestStatus {
 	}
 }
 
+// mapEnumToTestStatus はGraphQLのenum型をドメインのテストステータス文字列に変換します
+func mapEnumToTestStatus(status model.TestStatus) string {
+	switch status {
+	case model.TestStatusCreated:
+		return "作成"
+	case model.TestStatusTesting:
+		return "テスト"
+	case model.TestStatusFixing:
+		return "修正"
+	case model.TestStatusReviewWaiting:
+		return "レビュー待ち"
+	case model.TestStatusReviewing:
+		return "レビュー中"
+	case model.TestStatusCompleted:
+		return "完了"
+	case model.TestStatusRetesting:
+		return "再テスト"
+	default:
+		return "作成" // デフォルト値
+	}
+}
+
 // mapPriorityToEnum は優先度文字列をGraphQLのenum型に変換します
 func mapPriorityToEnum(priority string) model.Priority {
 	switch priority {
@@ -85,6 +152,22 @@ func mapPriorityToEnum(priority string) model.Priority {
 	}
 }
 
+// mapEnumToPriority はGraphQLのenum型をドメインの優先度文字列に変換します
+func mapEnumToPriority(priority model.Priority) string {
+	switch priority {
+	case model.PriorityCritical:
+		return "Critical"
+	case model.PriorityHigh:
+		return "High"
+	case model.PriorityMedium:
+		return "Medium"
+	case model.PriorityLow:
+		return "Low"
+	default:
+		return "Medium" // デフォルト値
+	}
+}
+
 // mapCaseStatusToEnum はケースステータス文字列をGraphQLのenum型に変換します
 // 現在はテストステータスと同じ変換ロジックを使用しています
 func mapCaseStatusToEnum(status string) model.TestStatus {
@@ -135,6 +218,12 @@ func TestSuiteDTOToModel(dto *dto.TestSuiteResponseDTO) *model.TestSuite {
 		return nil
 	}
 
+	var createdBy *string
+	if dto.CreatedBy != "" {
+		cb := dto.CreatedBy
+		createdBy = &cb
+	}
+
 	return &model.TestSuite{
 		ID:                   dto.ID,
 		Name:                 dto.Name,
@@ -143,9 +232,40 @@ func TestSuiteDTOToModel(dto *dto.TestSuiteResponseDTO) *model.TestSuite {
 		EstimatedStartDate:   dto.EstimatedStartDate,
 		EstimatedEndDate:     dto.EstimatedEndDate,
 		RequireEffortComment: dto.RequireEffortComment,
+		ExitCriteria: &model.ExitCriteria{
+			MinCompletionRate: dto.ExitCriteria.MinCompletionRate,
+			AllowOpenCritical: dto.ExitCriteria.AllowOpenCritical,
+		},
+		ExitCriteriaOverride: exitCriteriaOverrideDTOToModel(dto.ExitOverride),
 		Progress:             dto.Progress,
+		CompletedCaseCount:   dto.CompletedCaseCount,
+		TotalCaseCount:       dto.TotalCaseCount,
+		Version:              dto.Version,
+		CreatedBy:            createdBy,
 		CreatedAt:            dto.CreatedAt,
 		UpdatedAt:            dto.UpdatedAt,
+		DeletedAt:            dto.DeletedAt,
+	}
+}
+
+// exitCriteriaOverrideDTOToModel は完了条件のオーバーライド記録をGraphQLモデルに変換します
+func exitCriteriaOverrideDTOToModel(override *dto.ExitCriteriaOverrideDTO) *model.ExitCriteriaOverride {
+	if override == nil {
+		return nil
+	}
+
+	return &model.ExitCriteriaOverride{
+		Reason:       override.Reason,
+		OverriddenBy: override.OverriddenBy,
+		OverriddenAt: override.OverriddenAt,
+	}
+}
+
+// exitCriteriaInputToDTO は完了条件の入力をDTOに変換します
+func exitCriteriaInputToDTO(input *model.ExitCriteriaInput) *dto.ExitCriteriaDTO {
+	return &dto.ExitCriteriaDTO{
+		MinCompletionRate: input.MinCompletionRate,
+		AllowOpenCritical: input.AllowOpenCritical,
 	}
 }
 
@@ -156,14 +276,20 @@ func TestGroupDTOToModel(dto *dto.TestGroupResponseDTO) *model.TestGroup {
 	}
 
 	return &model.TestGroup{
-		ID:           dto.ID,
-		Name:         dto.Name,
-		Description:  dto.Description,
-		DisplayOrder: dto.DisplayOrder,
-		SuiteID:      dto.SuiteID,
-		Status:       mapStatusToEnum(dto.Status),
-		CreatedAt:    dto.CreatedAt,
-		UpdatedAt:    dto.UpdatedAt,
+		ID:                 dto.ID,
+		Name:               dto.Name,
+		Description:        dto.Description,
+		DisplayOrder:       dto.DisplayOrder,
+		SuiteID:            dto.SuiteID,
+		Status:             mapStatusToEnum(dto.Status),
+		StatusLocked:       dto.StatusLocked,
+		Progress:           dto.Progress,
+		CompletedCaseCount: dto.CompletedCaseCount,
+		TotalCaseCount:     dto.TotalCaseCount,
+		Version:            dto.Version,
+		CreatedAt:          dto.CreatedAt,
+		UpdatedAt:          dto.UpdatedAt,
+		DeletedAt:          dto.DeletedAt,
 	}
 }
 
@@ -192,6 +318,12 @@ func TestCaseDTOToModel(dto *dto.TestCaseResponseDTO) *model.TestCase {
 		delayDays = &dd
 	}
 
+	var lockedBy *string
+	if dto.LockedBy != "" {
+		lb := dto.LockedBy
+		lockedBy = &lb
+	}
+
 	return &model.TestCase{
 		ID:            dto.ID,
 		Title:         dto.Title,
@@ -200,10 +332,166 @@ func TestCaseDTOToModel(dto *dto.TestCaseResponseDTO) *model.TestCase {
 		Priority:      mapPriorityToEnum(dto.Priority),
 		PlannedEffort: plannedEffort,
 		ActualEffort:  actualEffort,
+		DueDate:       dto.DueDate,
 		IsDelayed:     dto.IsDelayed,
 		DelayDays:     delayDays,
 		GroupID:       dto.GroupID,
+		LockedBy:      lockedBy,
+		LockExpiresAt: dto.LockExpiresAt,
+		Version:       dto.Version,
 		CreatedAt:     dto.CreatedAt,
 		UpdatedAt:     dto.UpdatedAt,
+		DeletedAt:     dto.DeletedAt,
+	}
+}
+
+// TrashDTOToModel はゴミ箱のDTOをGraphQLモデルに変換します
+func TrashDTOToModel(dto *dto.TrashResponseDTO) *model.Trash {
+	if dto == nil {
+		return nil
+	}
+
+	trash := &model.Trash{
+		TestSuites: make([]*model.TestSuite, len(dto.TestSuites)),
+		TestGroups: make([]*model.TestGroup, len(dto.TestGroups)),
+		TestCases:  make([]*model.TestCase, len(dto.TestCases)),
+	}
+	for i, suite := range dto.TestSuites {
+		trash.TestSuites[i] = TestSuiteDTOToModel(suite)
+	}
+	for i, group := range dto.TestGroups {
+		trash.TestGroups[i] = TestGroupDTOToModel(group)
+	}
+	for i, testCase := range dto.TestCases {
+		trash.TestCases[i] = TestCaseDTOToModel(testCase)
+	}
+	return trash
+}
+
+// StatusHistoryDTOToModel はステータス変更履歴DTOをGraphQLモデルに変換します
+func StatusHistoryDTOToModel(dto *dto.StatusHistoryResponseDTO) *model.StatusHistory {
+	if dto == nil {
+		return nil
+	}
+
+	var reason *string
+	if dto.Reason != "" {
+		r := dto.Reason
+		reason = &r
+	}
+
+	return &model.StatusHistory{
+		ID:        dto.ID,
+		OldStatus: mapTestStatusToEnum(dto.OldStatus),
+		NewStatus: mapTestStatusToEnum(dto.NewStatus),
+		ChangedAt: dto.ChangedAt,
+		ChangedBy: dto.ChangedBy,
+		Reason:    reason,
+	}
+}
+
+// EffortRecordDTOToModel は工数記録DTOをGraphQLモデルに変換します
+func EffortRecordDTOToModel(dto *dto.EffortRecordResponseDTO) *model.EffortRecord {
+	if dto == nil {
+		return nil
+	}
+
+	var comment *string
+	if dto.Comment != "" {
+		c := dto.Comment
+		comment = &c
+	}
+
+	return &model.EffortRecord{
+		ID:           dto.ID,
+		TestCaseID:   dto.TestCaseID,
+		RecordDate:   dto.RecordDate,
+		EffortAmount: dto.EffortAmount,
+		IsAdditional: dto.IsAdditional,
+		Comment:      comment,
+		RecordedBy:   dto.RecordedBy,
+		CreatedAt:    dto.CreatedAt,
+	}
+}
+
+// EffortRecordListDTOToModel は工数記録一覧DTOをGraphQLモデルに変換します
+func EffortRecordListDTOToModel(dto *dto.EffortRecordListResponseDTO) *model.EffortRecordList {
+	records := make([]*model.EffortRecord, len(dto.EffortRecords))
+	for i, record := range dto.EffortRecords {
+		records[i] = EffortRecordDTOToModel(record)
+	}
+
+	return &model.EffortRecordList{
+		Records:     records,
+		TotalEffort: dto.TotalEffort,
+	}
+}
+
+// mapEventTypeToActivityType はドメインイベントの種類をGraphQLのenum型に変換します
+func mapEventTypeToActivityType(eventType event.Type) model.SuiteActivityType {
+	switch eventType {
+	case event.TestSuiteCreated:
+		return model.SuiteActivityTypeTestSuiteCreated
+	case event.TestSuiteUpdated:
+		return model.SuiteActivityTypeTestSuiteUpdated
+	case event.TestSuiteStatusChanged:
+		return model.SuiteActivityTypeTestSuiteStatusChanged
+	case event.TestSuiteDeleted:
+		return model.SuiteActivityTypeTestSuiteDeleted
+	case event.TestSuiteRestored:
+		return model.SuiteActivityTypeTestSuiteRestored
+	case event.TestGroupCreated:
+		return model.SuiteActivityTypeTestGroupCreated
+	case event.TestGroupUpdated:
+		return model.SuiteActivityTypeTestGroupUpdated
+	case event.TestGroupDeleted:
+		return model.SuiteActivityTypeTestGroupDeleted
+	case event.TestGroupRestored:
+		return model.SuiteActivityTypeTestGroupRestored
+	case event.TestCaseCreated:
+		return model.SuiteActivityTypeTestCaseCreated
+	case event.TestCaseUpdated:
+		return model.SuiteActivityTypeTestCaseUpdated
+	case event.TestCaseDeleted:
+		return model.SuiteActivityTypeTestCaseDeleted
+	case event.TestCaseRestored:
+		return model.SuiteActivityTypeTestCaseRestored
+	case event.TestCaseStatusChanged:
+		return model.SuiteActivityTypeTestCaseStatusChanged
+	case event.TestCaseDelayed:
+		return model.SuiteActivityTypeTestCaseDelayed
+	case event.EffortRecorded:
+		return model.SuiteActivityTypeEffortRecorded
+	default:
+		return model.SuiteActivityType(eventType)
+	}
+}
+
+// EventToSuiteActivity はドメインイベントをGraphQLモデルに変換します
+func EventToSuiteActivity(e *event.Event) *model.SuiteActivity {
+	if e == nil {
+		return nil
+	}
+
+	var testCaseID *string
+	if e.TestCaseID != "" {
+		id := e.TestCaseID
+		testCaseID = &id
+	}
+
+	var actorID *string
+	if e.ActorID != "" {
+		id := e.ActorID
+		actorID = &id
+	}
+
+	return &model.SuiteActivity{
+		Type:       mapEventTypeToActivityType(e.Type),
+		SuiteID:    e.SuiteID,
+		EntityType: e.Type.EntityType(),
+		EntityID:   e.EntityID,
+		TestCaseID: testCaseID,
+		ActorID:    actorID,
+		OccurredAt: e.OccurredAt,
 	}
 }
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/port"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	pb "github.com/FUJI0130/go-ddd-ca/proto/testsuite/v1"
+)
+
+// EffortServer は工数記録のgRPCサーバー実装
+type EffortServer struct {
+	pb.UnimplementedEffortServiceServer
+	useCase port.EffortUseCase
+}
+
+// NewEffortServer は新しいEffortServerを作成します
+func NewEffortServer(useCase port.EffortUseCase) *EffortServer {
+	return &EffortServer{
+		useCase: useCase,
+	}
+}
+
+// RecordEffort はテストケースに工数を記録します
+func (s *EffortServer) RecordEffort(ctx context.Context, req *pb.RecordEffortRequest) (*pb.EffortRecord, error) {
+	// プロトコルバッファからDTOへの変換
+	createDTO := &dto.EffortRecordCreateDTO{
+		TestCaseID:   req.GetTestCaseId(),
+		EffortAmount: req.GetEffortAmount(),
+		IsAdditional: req.GetIsAdditional(),
+		Comment:      req.GetComment(),
+		RecordedBy:   req.GetRecordedBy(),
+	}
+	if req.RecordDate != nil {
+		createDTO.RecordDate = req.GetRecordDate().AsTime()
+	}
+
+	result, err := s.useCase.RecordEffort(ctx, createDTO)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	return toProtoEffortRecord(result), nil
+}
+
+// CorrectEffortRecord は工数記録を訂正します
+func (s *EffortServer) CorrectEffortRecord(ctx context.Context, req *pb.CorrectEffortRecordRequest) (*pb.EffortRecord, error) {
+	correctDTO := &dto.EffortRecordCorrectDTO{
+		EffortAmount: req.GetEffortAmount(),
+		Comment:      req.GetComment(),
+		CorrectedBy:  req.GetCorrectedBy(),
+	}
+
+	result, err := s.useCase.CorrectEffortRecord(ctx, req.GetId(), correctDTO)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	return toProtoEffortRecord(result), nil
+}
+
+// ListEffortRecordsByTestCase はテストケースの工数記録一覧を取得します
+func (s *EffortServer) ListEffortRecordsByTestCase(ctx context.Context, req *pb.ListEffortRecordsByTestCaseRequest) (*pb.ListEffortRecordsResponse, error) {
+	result, err := s.useCase.GetEffortRecordsByTestCase(ctx, req.GetTestCaseId())
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	return toProtoEffortRecordList(result), nil
+}
+
+// ListEffortRecordsByUser はユーザーの指定日の工数記録一覧を取得します
+func (s *EffortServer) ListEffortRecordsByUser(ctx context.Context, req *pb.ListEffortRecordsByUserRequest) (*pb.ListEffortRecordsResponse, error) {
+	result, err := s.useCase.GetEffortRecordsByUserAndDate(ctx, req.GetUserId(), req.GetRecordDate().AsTime())
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	return toProtoEffortRecordList(result), nil
+}
+
+// toProtoEffortRecord はDTOからプロトコルバッファへの変換
+func toProtoEffortRecord(dto *dto.EffortRecordResponseDTO) *pb.EffortRecord {
+	return &pb.EffortRecord{
+		Id:           dto.ID,
+		TestCaseId:   dto.TestCaseID,
+		RecordDate:   timestamppb.New(dto.RecordDate),
+		EffortAmount: dto.EffortAmount,
+		IsAdditional: dto.IsAdditional,
+		Comment:      dto.Comment,
+		RecordedBy:   dto.RecordedBy,
+		CreatedAt:    timestamppb.New(dto.CreatedAt),
+	}
+}
+
+// toProtoEffortRecordList は一覧DTOからプロトコルバッファへの変換
+func toProtoEffortRecordList(dto *dto.EffortRecordListResponseDTO) *pb.ListEffortRecordsResponse {
+	records := make([]*pb.EffortRecord, len(dto.EffortRecords))
+	for i, record := range dto.EffortRecords {
+		records[i] = toProtoEffortRecord(record)
+	}
+
+	return &pb.ListEffortRecordsResponse{
+		EffortRecords: records,
+		TotalEffort:   dto.TotalEffort,
+	}
+}
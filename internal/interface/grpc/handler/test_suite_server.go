@@ -2,10 +2,10 @@ package handler
 
 import (
 	"context"
-	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
 	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
 	pb "github.com/FUJI0130/go-ddd-ca/proto/testsuite/v1"
@@ -18,40 +18,29 @@ type TestSuiteInteractorInterface interface {
 	UpdateTestSuite(ctx context.Context, id string, updateDTO *dto.TestSuiteUpdateDTO) (*dto.TestSuiteResponseDTO, error)
 	UpdateTestSuiteStatus(ctx context.Context, id string, statusDTO *dto.TestSuiteStatusUpdateDTO) (*dto.TestSuiteResponseDTO, error)
 	ListTestSuites(ctx context.Context, params *dto.TestSuiteQueryParamDTO) (*dto.TestSuiteListResponseDTO, error)
+	DeleteTestSuite(ctx context.Context, id string) error
+}
+
+// TrashInteractorInterface はゴミ箱内のテストスイートの一覧・復元に使用するインターフェース
+type TrashInteractorInterface interface {
+	ListTrash(ctx context.Context) (*dto.TrashResponseDTO, error)
+	RestoreTestSuite(ctx context.Context, id string) (*dto.TestSuiteResponseDTO, error)
 }
 
 type TestSuiteServer struct {
 	pb.UnimplementedTestSuiteServiceServer
 	interactor TestSuiteInteractorInterface
-	option     TestSuiteServerOption
-}
-
-// TestSuiteServerにオプション構造体を追加
-type TestSuiteServerOption struct {
-	watchInterval time.Duration
-}
-
-// デフォルト値の設定
-var defaultTestSuiteServerOption = TestSuiteServerOption{
-	watchInterval: 10 * time.Second,
-}
-
-// NewTestSuiteServerWithOptionを追加
-func NewTestSuiteServerWithOption(interactor TestSuiteInteractorInterface, opt *TestSuiteServerOption) *TestSuiteServer {
-	option := defaultTestSuiteServerOption
-	if opt != nil {
-		option = *opt
-	}
-	return &TestSuiteServer{
-		interactor: interactor,
-		option:     option,
-	}
+	trash      TrashInteractorInterface
+	subscriber event.Subscriber
 }
 
-func NewTestSuiteServer(interactor TestSuiteInteractorInterface) *TestSuiteServer {
+// NewTestSuiteServer は新しいTestSuiteServerを作成します
+// trashはゴミ箱からの復元・一覧取得に、subscriberはWatchTestSuite・WatchTestSuitesでの変更通知の受信に使用します
+func NewTestSuiteServer(interactor TestSuiteInteractorInterface, trash TrashInteractorInterface, subscriber event.Subscriber) *TestSuiteServer {
 	return &TestSuiteServer{
 		interactor: interactor,
-		option:     defaultTestSuiteServerOption,
+		trash:      trash,
+		subscriber: subscriber,
 	}
 }
 
@@ -63,6 +52,10 @@ func (s *TestSuiteServer) CreateTestSuite(ctx context.Context, req *pb.CreateTes
 		EstimatedStartDate:   req.GetEstimatedStartDate().AsTime(),
 		EstimatedEndDate:     req.GetEstimatedEndDate().AsTime(),
 		RequireEffortComment: req.GetRequireEffortComment(),
+		CreatedBy:            req.GetCreatedBy(),
+	}
+	if req.ExitCriteria != nil {
+		createDTO.ExitCriteria = fromProtoExitCriteria(req.GetExitCriteria())
 	}
 
 	// インタラクターを呼び出し
@@ -90,7 +83,7 @@ func (s *TestSuiteServer) toProtoTestSuite(dto *dto.TestSuiteResponseDTO) *pb.Te
 		status = pb.SuiteStatus_SUITE_STATUS_SUSPENDED
 	}
 
-	return &pb.TestSuite{
+	suite := &pb.TestSuite{
 		Id:                   dto.ID,
 		Name:                 dto.Name,
 		Description:          dto.Description,
@@ -99,9 +92,45 @@ func (s *TestSuiteServer) toProtoTestSuite(dto *dto.TestSuiteResponseDTO) *pb.Te
 		EstimatedEndDate:     timestamppb.New(dto.EstimatedEndDate),
 		RequireEffortComment: dto.RequireEffortComment,
 		Progress:             float32(dto.Progress),
+		CompletedCaseCount:   int32(dto.CompletedCaseCount),
+		TotalCaseCount:       int32(dto.TotalCaseCount),
+		Version:              int32(dto.Version),
+		CreatedBy:            dto.CreatedBy,
 		CreatedAt:            timestamppb.New(dto.CreatedAt),
 		UpdatedAt:            timestamppb.New(dto.UpdatedAt),
+		ExitCriteria: &pb.ExitCriteria{
+			MinCompletionRate: float32(dto.ExitCriteria.MinCompletionRate),
+			AllowOpenCritical: dto.ExitCriteria.AllowOpenCritical,
+		},
+	}
+	if dto.DeletedAt != nil {
+		suite.DeletedAt = timestamppb.New(*dto.DeletedAt)
 	}
+	if dto.ExitOverride != nil {
+		suite.ExitCriteriaOverride = &pb.ExitCriteriaOverride{
+			Reason:       dto.ExitOverride.Reason,
+			OverriddenBy: dto.ExitOverride.OverriddenBy,
+			OverriddenAt: timestamppb.New(dto.ExitOverride.OverriddenAt),
+		}
+	}
+	return suite
+}
+
+// fromProtoExitCriteria はプロトコルバッファの完了条件をDTOに変換します
+func fromProtoExitCriteria(criteria *pb.ExitCriteria) *dto.ExitCriteriaDTO {
+	return &dto.ExitCriteriaDTO{
+		MinCompletionRate: float64(criteria.GetMinCompletionRate()),
+		AllowOpenCritical: criteria.GetAllowOpenCritical(),
+	}
+}
+
+// optionalInt はプロトコルバッファのoptionalな整数をintのポインタに変換します（未設定の場合はnil）
+func optionalInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	v := int(*value)
+	return &v
 }
 
 // GetTestSuite は指定されたIDのテストスイートを取得します
@@ -145,6 +174,15 @@ func (s *TestSuiteServer) UpdateTestSuite(ctx context.Context, req *pb.UpdateTes
 		updateDTO.RequireEffortComment = &reqComment
 	}
 
+	if params.ExpectedVersion != nil {
+		expectedVersion := int(params.GetExpectedVersion())
+		updateDTO.ExpectedVersion = &expectedVersion
+	}
+
+	if params.ExitCriteria != nil {
+		updateDTO.ExitCriteria = fromProtoExitCriteria(params.GetExitCriteria())
+	}
+
 	result, err := s.interactor.UpdateTestSuite(ctx, req.GetId(), updateDTO)
 	if err != nil {
 		// ドメインエラーをgRPCエラーに変換
@@ -155,7 +193,12 @@ func (s *TestSuiteServer) UpdateTestSuite(ctx context.Context, req *pb.UpdateTes
 }
 
 // UpdateTestSuiteStatus はテストスイートのステータスを更新します
+// gRPCのサーバーには認証がなく、changed_byは呼び出し元が指定した値のため、Admin専用の完了条件のオーバーライドは受け付けません
 func (s *TestSuiteServer) UpdateTestSuiteStatus(ctx context.Context, req *pb.UpdateTestSuiteStatusRequest) (*pb.TestSuite, error) {
+	if req.OverrideReason != nil {
+		return nil, errors.ToGRPCError(errors.NewDomainPermissionError("完了条件のオーバーライドはGraphQL APIで認証したAdminのみ実行できます", req.GetChangedBy()))
+	}
+
 	statusMap := map[pb.SuiteStatus]string{
 		pb.SuiteStatus_SUITE_STATUS_PREPARATION: "準備中",
 		pb.SuiteStatus_SUITE_STATUS_IN_PROGRESS: "実行中",
@@ -164,7 +207,8 @@ func (s *TestSuiteServer) UpdateTestSuiteStatus(ctx context.Context, req *pb.Upd
 	}
 
 	statusDTO := &dto.TestSuiteStatusUpdateDTO{
-		Status: statusMap[req.GetStatus()],
+		Status:    statusMap[req.GetStatus()],
+		ChangedBy: req.GetChangedBy(),
 	}
 
 	result, err := s.interactor.UpdateTestSuiteStatus(ctx, req.GetId(), statusDTO)
@@ -197,15 +241,43 @@ func (s *TestSuiteServer) ListTestSuites(ctx context.Context, req *pb.ListTestSu
 		queryDTO.EndDate = &endDate
 	}
 
-	// ページネーション情報の設定
-	page := int(req.GetPage())
-	if page > 0 {
-		queryDTO.Page = &page
+	queryDTO.Search = req.Search
+	queryDTO.CreatedBy = req.CreatedBy
+	if req.PeriodFrom != nil {
+		periodFrom := req.GetPeriodFrom().AsTime()
+		queryDTO.PeriodFrom = &periodFrom
+	}
+	if req.PeriodTo != nil {
+		periodTo := req.GetPeriodTo().AsTime()
+		queryDTO.PeriodTo = &periodTo
 	}
+	queryDTO.MinProgress = req.MinProgress
+	queryDTO.MaxProgress = req.MaxProgress
+	queryDTO.HasDelayedCases = req.HasDelayedCases
+
+	sort, err := dto.ParseTestSuiteSort(req.GetOrderBy())
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+	queryDTO.Sort = sort
+
+	// ページネーション情報の設定（カーソルを指定した場合はキーセット方式）
+	if req.First != nil || req.After != nil || req.Last != nil || req.Before != nil {
+		keyset, err := dto.NewTestSuiteKeyset(optionalInt(req.First), req.After, optionalInt(req.Last), req.Before, queryDTO.SortOrder())
+		if err != nil {
+			return nil, errors.ToGRPCError(err)
+		}
+		queryDTO.Keyset = keyset
+	} else {
+		page := int(req.GetPage())
+		if page > 0 {
+			queryDTO.Page = &page
+		}
 
-	pageSize := int(req.GetPageSize())
-	if pageSize > 0 {
-		queryDTO.PageSize = &pageSize
+		pageSize := int(req.GetPageSize())
+		if pageSize > 0 {
+			queryDTO.PageSize = &pageSize
+		}
 	}
 
 	// インタラクターを呼び出し
@@ -217,8 +289,10 @@ func (s *TestSuiteServer) ListTestSuites(ctx context.Context, req *pb.ListTestSu
 
 	// レスポンスの変換と作成
 	response := &pb.ListTestSuitesResponse{
-		TestSuites: make([]*pb.TestSuite, 0, len(result.TestSuites)),
-		Total:      int32(result.Total),
+		TestSuites:      make([]*pb.TestSuite, 0, len(result.TestSuites)),
+		Total:           int32(result.Total),
+		HasNextPage:     result.HasNextPage,
+		HasPreviousPage: result.HasPreviousPage,
 	}
 
 	for _, suite := range result.TestSuites {
@@ -226,6 +300,48 @@ func (s *TestSuiteServer) ListTestSuites(ctx context.Context, req *pb.ListTestSu
 		response.TestSuites = append(response.TestSuites, pbSuite)
 	}
 
+	if len(result.TestSuites) > 0 {
+		response.StartCursor = dto.NewTestSuiteCursor(&result.TestSuites[0], queryDTO.SortOrder())
+		response.EndCursor = dto.NewTestSuiteCursor(&result.TestSuites[len(result.TestSuites)-1], queryDTO.SortOrder())
+	}
+
+	return response, nil
+}
+
+// DeleteTestSuite はテストスイートを配下のグループ・ケースごとゴミ箱に移動します
+func (s *TestSuiteServer) DeleteTestSuite(ctx context.Context, req *pb.GetTestSuiteRequest) (*pb.DeleteTestSuiteResponse, error) {
+	if err := s.interactor.DeleteTestSuite(ctx, req.GetId()); err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	return &pb.DeleteTestSuiteResponse{Id: req.GetId()}, nil
+}
+
+// RestoreTestSuite はゴミ箱のテストスイートを配下のグループ・ケースごと復元します
+func (s *TestSuiteServer) RestoreTestSuite(ctx context.Context, req *pb.GetTestSuiteRequest) (*pb.TestSuite, error) {
+	result, err := s.trash.RestoreTestSuite(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	return s.toProtoTestSuite(result), nil
+}
+
+// ListDeletedTestSuites はゴミ箱内のテストスイート一覧を取得します
+func (s *TestSuiteServer) ListDeletedTestSuites(ctx context.Context, req *pb.ListDeletedTestSuitesRequest) (*pb.ListTestSuitesResponse, error) {
+	result, err := s.trash.ListTrash(ctx)
+	if err != nil {
+		return nil, errors.ToGRPCError(err)
+	}
+
+	response := &pb.ListTestSuitesResponse{
+		TestSuites: make([]*pb.TestSuite, 0, len(result.TestSuites)),
+		Total:      int32(len(result.TestSuites)),
+	}
+	for _, suite := range result.TestSuites {
+		response.TestSuites = append(response.TestSuites, s.toProtoTestSuite(suite))
+	}
+
 	return response, nil
 }
 
@@ -246,10 +362,18 @@ func statusProtoToString(status pb.SuiteStatus) string {
 }
 
 // WatchTestSuite はテストスイートの変更を監視します
+// 初期状態を送信した後、ドメインイベントを受信するたびに最新の状態を送信します
+// 監視中のテストスイートが削除された場合はNotFoundで終了します
 func (s *TestSuiteServer) WatchTestSuite(req *pb.GetTestSuiteRequest, stream pb.TestSuiteService_WatchTestSuiteServer) error {
 	ctx := stream.Context()
 	id := req.GetId()
 
+	// 初期データの取得中の変更を取りこぼさないよう、先に購読を開始する
+	events, err := s.subscribe(ctx, id)
+	if err != nil {
+		return errors.ToGRPCError(err)
+	}
+
 	// 初期データの送信
 	initialSuite, err := s.interactor.GetTestSuite(ctx, id)
 	if err != nil {
@@ -268,27 +392,116 @@ func (s *TestSuiteServer) WatchTestSuite(req *pb.GetTestSuiteRequest, stream pb.
 		return nil // 初期データ送信後にキャンセルされた場合は正常終了
 	}
 
-	ticker := time.NewTicker(s.option.watchInterval)
-	defer ticker.Stop()
-
 	for {
 		select {
 		case <-ctx.Done():
 			return nil // キャンセルは正常終了として扱う
-		case <-ticker.C:
+		case e, ok := <-events:
+			if !ok {
+				return nil // ブローカーの停止時は正常終了として扱う
+			}
+
+			switch e.Type {
+			case event.TestSuiteDeleted:
+				return errors.ToGRPCError(errors.NewTestSuiteNotFoundError(id))
+			case event.TestSuiteCreated, event.TestSuiteUpdated, event.TestSuiteStatusChanged:
+			default:
+				continue // スイート自体の変更以外は通知しない
+			}
+
 			currentSuite, err := s.interactor.GetTestSuite(ctx, id)
 			if err != nil {
 				return errors.ToGRPCError(err)
 			}
 
-			if currentSuite.UpdatedAt.After(initialSuite.UpdatedAt) {
-				if err := stream.Send(s.toProtoTestSuite(currentSuite)); err != nil {
-					return errors.ToGRPCError(
-						errors.NewSystemError("更新状態の送信に失敗しました", err),
-					)
+			if err := stream.Send(s.toProtoTestSuite(currentSuite)); err != nil {
+				return errors.ToGRPCError(
+					errors.NewSystemError("更新状態の送信に失敗しました", err),
+				)
+			}
+		}
+	}
+}
+
+// WatchTestSuites はすべてのテストスイートの作成・更新・ステータス変更・削除を監視します
+// ステータスが指定された場合、変更後のステータスが一致するテストスイートのみを通知します
+// 削除後のテストスイートはステータスを判定できないため、削除は常に通知します
+func (s *TestSuiteServer) WatchTestSuites(req *pb.WatchTestSuitesRequest, stream pb.TestSuiteService_WatchTestSuitesServer) error {
+	ctx := stream.Context()
+
+	statusFilter := ""
+	if req.Status != nil && req.GetStatus() != pb.SuiteStatus_SUITE_STATUS_UNSPECIFIED {
+		statusFilter = statusProtoToString(req.GetStatus())
+	}
+
+	events, err := s.subscribe(ctx, "")
+	if err != nil {
+		return errors.ToGRPCError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil // キャンセルは正常終了として扱う
+		case e, ok := <-events:
+			if !ok {
+				return nil // ブローカーの停止時は正常終了として扱う
+			}
+
+			eventType := toProtoTestSuiteEventType(e.Type)
+			if eventType == pb.TestSuiteEventType_TEST_SUITE_EVENT_TYPE_UNSPECIFIED {
+				continue // スイート自体の変更以外は通知しない
+			}
+
+			suiteEvent := &pb.TestSuiteEvent{
+				Type:        eventType,
+				TestSuiteId: e.SuiteID,
+				OccurredAt:  timestamppb.New(e.OccurredAt),
+			}
+
+			if e.Type != event.TestSuiteDeleted {
+				suite, err := s.interactor.GetTestSuite(ctx, e.SuiteID)
+				if err != nil {
+					continue // 通知までの間に削除された場合は削除イベントで通知される
+				}
+				if statusFilter != "" && suite.Status != statusFilter {
+					continue
 				}
-				initialSuite = currentSuite
+				suiteEvent.TestSuite = s.toProtoTestSuite(suite)
+			}
+
+			if err := stream.Send(suiteEvent); err != nil {
+				return errors.ToGRPCError(
+					errors.NewSystemError("変更通知の送信に失敗しました", err),
+				)
 			}
 		}
 	}
 }
+
+// subscribe は指定されたスイートのドメインイベントの購読を開始します
+func (s *TestSuiteServer) subscribe(ctx context.Context, suiteID string) (<-chan *event.Event, error) {
+	if s.subscriber == nil {
+		return nil, errors.NewSystemError("変更通知の購読は利用できません", nil)
+	}
+	return s.subscriber.Subscribe(ctx, suiteID)
+}
+
+// toProtoTestSuiteEventType はドメインイベントの種類をプロトコルバッファの変更種別に変換します
+// テストスイート自体の変更以外はUNSPECIFIEDを返します
+func toProtoTestSuiteEventType(eventType event.Type) pb.TestSuiteEventType {
+	switch eventType {
+	case event.TestSuiteCreated:
+		return pb.TestSuiteEventType_TEST_SUITE_EVENT_TYPE_CREATED
+	case event.TestSuiteUpdated:
+		return pb.TestSuiteEventType_TEST_SUITE_EVENT_TYPE_UPDATED
+	case event.TestSuiteStatusChanged:
+		return pb.TestSuiteEventType_TEST_SUITE_EVENT_TYPE_STATUS_CHANGED
+	case event.TestSuiteDeleted:
+		return pb.TestSuiteEventType_TEST_SUITE_EVENT_TYPE_DELETED
+	case event.TestSuiteRestored:
+		return pb.TestSuiteEventType_TEST_SUITE_EVENT_TYPE_RESTORED
+	default:
+		return pb.TestSuiteEventType_TEST_SUITE_EVENT_TYPE_UNSPECIFIED
+	}
+}
@@ -7,10 +7,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/messaging"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
 	pb "github.com/FUJI0130/go-ddd-ca/proto/testsuite/v1"
 )
 
@@ -60,6 +65,32 @@ func (m *MockTestSuiteInteractor) UpdateTestSuiteStatus(ctx context.Context, id
 	return args.Get(0).(*dto.TestSuiteResponseDTO), args.Error(1)
 }
 
+func (m *MockTestSuiteInteractor) DeleteTestSuite(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockTrashInteractor はテスト用のゴミ箱インタラクター
+type MockTrashInteractor struct {
+	mock.Mock
+}
+
+func (m *MockTrashInteractor) ListTrash(ctx context.Context) (*dto.TrashResponseDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TrashResponseDTO), args.Error(1)
+}
+
+func (m *MockTrashInteractor) RestoreTestSuite(ctx context.Context, id string) (*dto.TestSuiteResponseDTO, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TestSuiteResponseDTO), args.Error(1)
+}
+
 func TestListTestSuites(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -167,7 +198,7 @@ func TestListTestSuites(t *testing.T) {
 			tc.setupMock(mockInteractor)
 
 			// サーバーの作成
-			server := NewTestSuiteServer(mockInteractor)
+			server := NewTestSuiteServer(mockInteractor, nil, nil)
 
 			// テストの実行
 			response, err := server.ListTestSuites(context.Background(), tc.request)
@@ -189,6 +220,96 @@ func TestListTestSuites(t *testing.T) {
 	}
 }
 
+func TestListTestSuites_Cursor(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	after := dto.TestSuiteCursor{Values: []any{createdAt}, ID: "TS003-202501"}.Encode(dto.DefaultTestSuiteSort)
+
+	mockInteractor := new(MockTestSuiteInteractor)
+	mockInteractor.On("ListTestSuites", mock.Anything, mock.MatchedBy(func(params *dto.TestSuiteQueryParamDTO) bool {
+		return params.Keyset != nil && params.Keyset.Limit == 2 && !params.Keyset.FromLast &&
+			params.Keyset.After != nil && params.Keyset.After.ID == "TS003-202501" &&
+			params.Keyset.After.Values[0].(time.Time).Equal(createdAt) && params.Page == nil
+	})).Return(&dto.TestSuiteListResponseDTO{
+		TestSuites: []dto.TestSuiteResponseDTO{
+			{ID: "TS002-202501", Status: "準備中", CreatedAt: createdAt.Add(-time.Hour)},
+			{ID: "TS001-202501", Status: "準備中", CreatedAt: createdAt.Add(-2 * time.Hour)},
+		},
+		Total:           3,
+		HasNextPage:     false,
+		HasPreviousPage: true,
+	}, nil)
+
+	server := NewTestSuiteServer(mockInteractor, nil, nil)
+	first := int32(2)
+	response, err := server.ListTestSuites(context.Background(), &pb.ListTestSuitesRequest{First: &first, After: &after})
+
+	assert.NoError(t, err)
+	assert.Len(t, response.TestSuites, 2)
+	assert.False(t, response.HasNextPage)
+	assert.True(t, response.HasPreviousPage)
+	start, err := dto.ParseTestSuiteCursor(response.StartCursor, dto.DefaultTestSuiteSort)
+	assert.NoError(t, err)
+	assert.Equal(t, "TS002-202501", start.ID)
+	end, err := dto.ParseTestSuiteCursor(response.EndCursor, dto.DefaultTestSuiteSort)
+	assert.NoError(t, err)
+	assert.Equal(t, "TS001-202501", end.ID)
+	mockInteractor.AssertExpectations(t)
+
+	t.Run("不正なカーソルはInvalidArgument", func(t *testing.T) {
+		invalid := "not-a-cursor"
+		_, err := NewTestSuiteServer(new(MockTestSuiteInteractor), nil, nil).
+			ListTestSuites(context.Background(), &pb.ListTestSuitesRequest{After: &invalid})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListTestSuites_FilterAndOrder(t *testing.T) {
+	search := "ログイン"
+	createdBy := "U001"
+	minProgress := 20.0
+	hasDelayed := true
+	orderBy := "progress desc,name"
+	periodFrom := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	mockInteractor := new(MockTestSuiteInteractor)
+	mockInteractor.On("ListTestSuites", mock.Anything, mock.MatchedBy(func(params *dto.TestSuiteQueryParamDTO) bool {
+		return *params.Search == search && *params.CreatedBy == createdBy &&
+			params.PeriodFrom.Equal(periodFrom) && params.PeriodTo == nil &&
+			*params.MinProgress == minProgress && params.MaxProgress == nil && *params.HasDelayedCases &&
+			assert.ObjectsAreEqual([]dto.TestSuiteSortDTO{
+				{Field: dto.TestSuiteSortByProgress, Desc: true},
+				{Field: dto.TestSuiteSortByName},
+			}, params.Sort)
+	})).Return(&dto.TestSuiteListResponseDTO{
+		TestSuites: []dto.TestSuiteResponseDTO{
+			{ID: "TS001-202504", Name: "ログイン", Status: "実行中", Progress: 40, CreatedBy: createdBy},
+		},
+		Total: 1,
+	}, nil)
+
+	server := NewTestSuiteServer(mockInteractor, nil, nil)
+	response, err := server.ListTestSuites(context.Background(), &pb.ListTestSuitesRequest{
+		Search:          &search,
+		CreatedBy:       &createdBy,
+		PeriodFrom:      timestamppb.New(periodFrom),
+		MinProgress:     &minProgress,
+		HasDelayedCases: &hasDelayed,
+		OrderBy:         &orderBy,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, response.TestSuites, 1)
+	assert.Equal(t, createdBy, response.TestSuites[0].CreatedBy)
+	mockInteractor.AssertExpectations(t)
+
+	t.Run("不正な並び順はInvalidArgument", func(t *testing.T) {
+		invalid := "priority desc"
+		_, err := NewTestSuiteServer(new(MockTestSuiteInteractor), nil, nil).
+			ListTestSuites(context.Background(), &pb.ListTestSuitesRequest{OrderBy: &invalid})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 // mockStreamはテスト用のストリームモック
 type mockStream struct {
 	mock.Mock
@@ -232,15 +353,15 @@ func (m *mockStream) RecvMsg(msg interface{}) error {
 func TestWatchTestSuite(t *testing.T) {
 	testCases := []struct {
 		name          string
-		setupMock     func(*MockTestSuiteInteractor, *mockStream)
+		setupMock     func(*MockTestSuiteInteractor, *mockStream, *messaging.InMemoryBroker)
 		setupContext  func() (context.Context, context.CancelFunc)
 		request       *pb.GetTestSuiteRequest
 		expectedError bool
-		interval      time.Duration
+		expectedCode  codes.Code
 	}{
 		{
 			name: "正常系：初期データの送信成功",
-			setupMock: func(m *MockTestSuiteInteractor, stream *mockStream) {
+			setupMock: func(m *MockTestSuiteInteractor, stream *mockStream, broker *messaging.InMemoryBroker) {
 				initialSuite := &dto.TestSuiteResponseDTO{
 					ID:        "TS001-202501",
 					Name:      "テストスイート1",
@@ -261,11 +382,57 @@ func TestWatchTestSuite(t *testing.T) {
 				Id: "TS001-202501",
 			},
 			expectedError: false,
-			interval:      10 * time.Millisecond,
+		},
+		{
+			name: "正常系：ステータス変更イベントで最新状態を送信",
+			setupMock: func(m *MockTestSuiteInteractor, stream *mockStream, broker *messaging.InMemoryBroker) {
+				m.On("GetTestSuite", mock.Anything, "TS001-202501").
+					Return(&dto.TestSuiteResponseDTO{ID: "TS001-202501", Status: "準備中"}, nil).Once()
+				m.On("GetTestSuite", mock.Anything, "TS001-202501").
+					Return(&dto.TestSuiteResponseDTO{ID: "TS001-202501", Status: "実行中"}, nil).Once()
+
+				// 初期データの送信後にステータス変更と無関係なイベントを発行
+				stream.On("Send", mock.MatchedBy(func(ts *pb.TestSuite) bool {
+					return ts.Status == pb.SuiteStatus_SUITE_STATUS_PREPARATION
+				})).Run(func(args mock.Arguments) {
+					broker.Publish(context.Background(), event.New(event.TestCaseUpdated, "TS001-202501", "TS001TG01TC001-202501", ""))
+					broker.Publish(context.Background(), event.New(event.TestSuiteStatusChanged, "TS002-202501", "TS002-202501", ""))
+					broker.Publish(context.Background(), event.New(event.TestSuiteStatusChanged, "TS001-202501", "TS001-202501", ""))
+				}).Return(nil).Once()
+				stream.On("Send", mock.MatchedBy(func(ts *pb.TestSuite) bool {
+					return ts.Status == pb.SuiteStatus_SUITE_STATUS_IN_PROGRESS
+				})).Return(nil).Once()
+			},
+			setupContext: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 100*time.Millisecond)
+			},
+			request: &pb.GetTestSuiteRequest{
+				Id: "TS001-202501",
+			},
+			expectedError: false,
+		},
+		{
+			name: "異常系：監視中のテストスイートが削除された",
+			setupMock: func(m *MockTestSuiteInteractor, stream *mockStream, broker *messaging.InMemoryBroker) {
+				m.On("GetTestSuite", mock.Anything, "TS001-202501").
+					Return(&dto.TestSuiteResponseDTO{ID: "TS001-202501", Status: "準備中"}, nil).Once()
+
+				stream.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+					broker.Publish(context.Background(), event.New(event.TestSuiteDeleted, "TS001-202501", "TS001-202501", ""))
+				}).Return(nil).Once()
+			},
+			setupContext: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), time.Second)
+			},
+			request: &pb.GetTestSuiteRequest{
+				Id: "TS001-202501",
+			},
+			expectedError: true,
+			expectedCode:  codes.NotFound,
 		},
 		{
 			name: "異常系：GetTestSuiteエラー",
-			setupMock: func(m *MockTestSuiteInteractor, stream *mockStream) {
+			setupMock: func(m *MockTestSuiteInteractor, stream *mockStream, broker *messaging.InMemoryBroker) {
 				m.On("GetTestSuite", mock.Anything, "TS001-202501").
 					Return(nil, assert.AnError)
 			},
@@ -276,11 +443,10 @@ func TestWatchTestSuite(t *testing.T) {
 				Id: "TS001-202501",
 			},
 			expectedError: true,
-			interval:      10 * time.Millisecond,
 		},
 		{
 			name: "異常系：Stream.Sendエラー",
-			setupMock: func(m *MockTestSuiteInteractor, stream *mockStream) {
+			setupMock: func(m *MockTestSuiteInteractor, stream *mockStream, broker *messaging.InMemoryBroker) {
 				initialSuite := &dto.TestSuiteResponseDTO{
 					ID:        "TS001-202501",
 					Name:      "テストスイート1",
@@ -299,28 +465,29 @@ func TestWatchTestSuite(t *testing.T) {
 				Id: "TS001-202501",
 			},
 			expectedError: true,
-			interval:      10 * time.Millisecond,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			mockInteractor := new(MockTestSuiteInteractor)
+			broker := messaging.NewInMemoryBroker()
+			defer broker.Close()
 			ctx, cancel := tc.setupContext()
 			defer cancel()
 
 			mockStream := &mockStream{ctx: ctx}
-			tc.setupMock(mockInteractor, mockStream)
+			tc.setupMock(mockInteractor, mockStream, broker)
 
-			// オプション付きでサーバーを作成
-			server := NewTestSuiteServerWithOption(mockInteractor, &TestSuiteServerOption{
-				watchInterval: tc.interval,
-			})
+			server := NewTestSuiteServer(mockInteractor, nil, broker)
 
 			err := server.WatchTestSuite(tc.request, mockStream)
 
 			if tc.expectedError {
 				assert.Error(t, err)
+				if tc.expectedCode != codes.OK {
+					assert.Equal(t, tc.expectedCode, status.Code(err))
+				}
 			} else {
 				assert.NoError(t, err)
 			}
@@ -330,3 +497,168 @@ func TestWatchTestSuite(t *testing.T) {
 		})
 	}
 }
+
+// mockEventStreamはWatchTestSuites用のストリームモック
+type mockEventStream struct {
+	mockStream
+}
+
+func (m *mockEventStream) Send(e *pb.TestSuiteEvent) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func TestWatchTestSuites(t *testing.T) {
+	mockInteractor := new(MockTestSuiteInteractor)
+	broker := messaging.NewInMemoryBroker()
+	defer broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockInteractor.On("GetTestSuite", mock.Anything, "TS001-202501").
+		Return(&dto.TestSuiteResponseDTO{ID: "TS001-202501", Status: "準備中"}, nil)
+	mockInteractor.On("GetTestSuite", mock.Anything, "TS002-202501").
+		Return(&dto.TestSuiteResponseDTO{ID: "TS002-202501", Status: "実行中"}, nil)
+
+	stream := &mockEventStream{mockStream: mockStream{ctx: ctx}}
+	stream.On("Send", mock.MatchedBy(func(e *pb.TestSuiteEvent) bool {
+		return e.Type == pb.TestSuiteEventType_TEST_SUITE_EVENT_TYPE_STATUS_CHANGED &&
+			e.TestSuiteId == "TS002-202501" &&
+			e.TestSuite.GetStatus() == pb.SuiteStatus_SUITE_STATUS_IN_PROGRESS
+	})).Return(nil).Once()
+	stream.On("Send", mock.MatchedBy(func(e *pb.TestSuiteEvent) bool {
+		return e.Type == pb.TestSuiteEventType_TEST_SUITE_EVENT_TYPE_DELETED &&
+			e.TestSuiteId == "TS001-202501" &&
+			e.TestSuite == nil
+	})).Run(func(args mock.Arguments) {
+		cancel()
+	}).Return(nil).Once()
+
+	server := NewTestSuiteServer(mockInteractor, nil, broker)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- server.WatchTestSuites(&pb.WatchTestSuitesRequest{
+			Status: pb.SuiteStatus_SUITE_STATUS_IN_PROGRESS.Enum(),
+		}, stream)
+	}()
+
+	// 購読の開始を待ってからイベントを発行
+	assert.Eventually(t, func() bool {
+		return broker.SubscriberCount() == 1
+	}, time.Second, time.Millisecond)
+
+	// ステータスが一致しないスイートは通知されない
+	broker.Publish(ctx, event.New(event.TestSuiteUpdated, "TS001-202501", "TS001-202501", ""))
+	// スイート自体の変更以外は通知されない
+	broker.Publish(ctx, event.New(event.TestCaseCreated, "TS002-202501", "TS002TG01TC001-202501", ""))
+	broker.Publish(ctx, event.New(event.TestSuiteStatusChanged, "TS002-202501", "TS002-202501", ""))
+	// 削除はステータスに関わらず通知される
+	broker.Publish(ctx, event.New(event.TestSuiteDeleted, "TS001-202501", "TS001-202501", ""))
+
+	assert.NoError(t, <-done)
+	stream.AssertExpectations(t)
+}
+
+func TestDeleteAndRestoreTestSuite(t *testing.T) {
+	deletedAt := time.Date(2025, 1, 20, 10, 0, 0, 0, time.UTC)
+	mockInteractor := new(MockTestSuiteInteractor)
+	mockTrash := new(MockTrashInteractor)
+
+	mockInteractor.On("DeleteTestSuite", mock.Anything, "TS001-202501").Return(nil)
+	mockTrash.On("ListTrash", mock.Anything).Return(&dto.TrashResponseDTO{
+		TestSuites: []*dto.TestSuiteResponseDTO{{ID: "TS001-202501", Status: "実行中", DeletedAt: &deletedAt}},
+	}, nil)
+	mockTrash.On("RestoreTestSuite", mock.Anything, "TS001-202501").Return(&dto.TestSuiteResponseDTO{
+		ID:     "TS001-202501",
+		Status: "実行中",
+	}, nil)
+
+	server := NewTestSuiteServer(mockInteractor, mockTrash, nil)
+	req := &pb.GetTestSuiteRequest{Id: "TS001-202501"}
+
+	deleted, err := server.DeleteTestSuite(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "TS001-202501", deleted.GetId())
+
+	trash, err := server.ListDeletedTestSuites(context.Background(), &pb.ListDeletedTestSuitesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), trash.GetTotal())
+	assert.Equal(t, deletedAt, trash.GetTestSuites()[0].GetDeletedAt().AsTime())
+
+	restored, err := server.RestoreTestSuite(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Nil(t, restored.GetDeletedAt())
+	assert.Equal(t, pb.SuiteStatus_SUITE_STATUS_IN_PROGRESS, restored.GetStatus())
+
+	mockInteractor.AssertExpectations(t)
+	mockTrash.AssertExpectations(t)
+}
+
+func TestUpdateTestSuiteStatus_ExitCriteria(t *testing.T) {
+	overriddenAt := time.Date(2025, 1, 31, 18, 0, 0, 0, time.UTC)
+
+	t.Run("完了条件を満たさない場合はFAILED_PRECONDITIONを返す", func(t *testing.T) {
+		mockInteractor := new(MockTestSuiteInteractor)
+		mockInteractor.On("UpdateTestSuiteStatus", mock.Anything, "TS001-202501", &dto.TestSuiteStatusUpdateDTO{
+			Status:    "完了",
+			ChangedBy: "manager-1",
+		}).Return(nil, errors.NewExitCriteriaNotMetError("TS001-202501", 50, 100, []errors.ExitCriteriaBlockingCase{
+			{ID: "TS001TG01TC002-202501", Title: "決済", Status: "テスト", Priority: "Critical"},
+		}))
+
+		server := NewTestSuiteServer(mockInteractor, nil, nil)
+		changedBy := "manager-1"
+		_, err := server.UpdateTestSuiteStatus(context.Background(), &pb.UpdateTestSuiteStatusRequest{
+			Id:        "TS001-202501",
+			Status:    pb.SuiteStatus_SUITE_STATUS_COMPLETED,
+			ChangedBy: &changedBy,
+		})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Contains(t, st.Message(), "TS001TG01TC002-202501")
+		mockInteractor.AssertExpectations(t)
+	})
+
+	t.Run("呼び出し元を認証できないためオーバーライドはPERMISSION_DENIEDを返す", func(t *testing.T) {
+		mockInteractor := new(MockTestSuiteInteractor)
+
+		server := NewTestSuiteServer(mockInteractor, nil, nil)
+		changedBy, reason := "admin-1", "次リリースで対応"
+		_, err := server.UpdateTestSuiteStatus(context.Background(), &pb.UpdateTestSuiteStatusRequest{
+			Id:             "TS001-202501",
+			Status:         pb.SuiteStatus_SUITE_STATUS_COMPLETED,
+			ChangedBy:      &changedBy,
+			OverrideReason: &reason,
+		})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockInteractor.AssertNotCalled(t, "UpdateTestSuiteStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("オーバーライドの記録を返す", func(t *testing.T) {
+		mockInteractor := new(MockTestSuiteInteractor)
+		mockInteractor.On("GetTestSuite", mock.Anything, "TS001-202501").Return(&dto.TestSuiteResponseDTO{
+			ID:           "TS001-202501",
+			Status:       "完了",
+			ExitCriteria: dto.ExitCriteriaDTO{MinCompletionRate: 100},
+			ExitOverride: &dto.ExitCriteriaOverrideDTO{
+				Reason:       "次リリースで対応",
+				OverriddenBy: "admin-1",
+				OverriddenAt: overriddenAt,
+			},
+		}, nil)
+
+		server := NewTestSuiteServer(mockInteractor, nil, nil)
+		result, err := server.GetTestSuite(context.Background(), &pb.GetTestSuiteRequest{Id: "TS001-202501"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, float32(100), result.GetExitCriteria().GetMinCompletionRate())
+		assert.Equal(t, "admin-1", result.GetExitCriteriaOverride().GetOverriddenBy())
+		assert.Equal(t, overriddenAt, result.GetExitCriteriaOverride().GetOverriddenAt().AsTime())
+		mockInteractor.AssertExpectations(t)
+	})
+}
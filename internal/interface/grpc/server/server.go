@@ -18,7 +18,7 @@ type GrpcServer struct {
 }
 
 // NewGrpcServer は新しいGrpcServerインスタンスを作成します
-func NewGrpcServer(port int, testSuiteServer *handler.TestSuiteServer) (*GrpcServer, error) {
+func NewGrpcServer(port int, testSuiteServer *handler.TestSuiteServer, effortServer *handler.EffortServer) (*GrpcServer, error) {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		return nil, fmt.Errorf("failed to listen: %w", err)
@@ -26,6 +26,7 @@ func NewGrpcServer(port int, testSuiteServer *handler.TestSuiteServer) (*GrpcSer
 
 	server := grpc.NewServer()
 	pb.RegisterTestSuiteServiceServer(server, testSuiteServer)
+	pb.RegisterEffortServiceServer(server, effortServer)
 
 	// 開発用にリフレクションサービスを有効化
 	reflection.Register(server)
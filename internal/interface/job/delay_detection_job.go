@@ -0,0 +1,67 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/port"
+)
+
+// DefaultDelayDetectionInterval は遅延検出ジョブのデフォルトの実行間隔です
+const DefaultDelayDetectionInterval = time.Hour
+
+// DelayDetectionJob は一定間隔でテストケースの遅延検出を実行するバックグラウンドジョブです
+type DelayDetectionJob struct {
+	useCase  port.DelayDetectionUseCase
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewDelayDetectionJob は新しいDelayDetectionJobを作成します
+// intervalが0以下の場合はデフォルトの実行間隔を使用します
+func NewDelayDetectionJob(useCase port.DelayDetectionUseCase, interval time.Duration) *DelayDetectionJob {
+	if interval <= 0 {
+		interval = DefaultDelayDetectionInterval
+	}
+	return &DelayDetectionJob{
+		useCase:  useCase,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run はctxが終了するまで遅延検出を繰り返し実行します
+// 起動直後に1回実行し、以降は実行間隔ごとに実行します
+// 検出に失敗した場合はログに記録し、次回の実行で再試行します
+func (j *DelayDetectionJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Delay detection failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce は遅延検出を1回実行します
+func (j *DelayDetectionJob) RunOnce(ctx context.Context) (*dto.DelayDetectionResultDTO, error) {
+	result, err := j.useCase.DetectDelays(ctx, j.now())
+	if err != nil {
+		return nil, err
+	}
+
+	if result.DelayedCount+result.UpdatedCount+result.ClearedCount > 0 {
+		log.Printf("Delay detection: checked=%d delayed=%d updated=%d cleared=%d",
+			result.CheckedCount, result.DelayedCount, result.UpdatedCount, result.ClearedCount)
+	}
+	return result, nil
+}
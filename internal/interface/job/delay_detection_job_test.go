@@ -0,0 +1,82 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubDelayDetectionUseCase は実行回数を記録するテスト用のユースケース
+type stubDelayDetectionUseCase struct {
+	mu    sync.Mutex
+	calls int
+	err   error
+	ran   chan struct{}
+}
+
+func (s *stubDelayDetectionUseCase) DetectDelays(ctx context.Context, now time.Time) (*dto.DelayDetectionResultDTO, error) {
+	s.mu.Lock()
+	s.calls++
+	s.mu.Unlock()
+	s.ran <- struct{}{}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &dto.DelayDetectionResultDTO{CheckedCount: 1}, nil
+}
+
+func (s *stubDelayDetectionUseCase) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func TestDelayDetectionJob_Run(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		{name: "正常系：起動直後と実行間隔ごとに検出する"},
+		{name: "正常系：検出に失敗しても次の実行間隔で再試行する", err: errors.New("database unavailable")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := &stubDelayDetectionUseCase{err: tc.err, ran: make(chan struct{}, 10)}
+			job := NewDelayDetectionJob(useCase, 10*time.Millisecond)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				job.Run(ctx)
+				close(done)
+			}()
+
+			for n := 0; n < 2; n++ {
+				select {
+				case <-useCase.ran:
+				case <-time.After(time.Second):
+					t.Fatalf("%d回目の遅延検出が実行されませんでした", n+1)
+				}
+			}
+
+			cancel()
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("コンテキストの終了後もジョブが停止しませんでした")
+			}
+			assert.GreaterOrEqual(t, useCase.callCount(), 2)
+		})
+	}
+}
+
+func TestNewDelayDetectionJob_DefaultInterval(t *testing.T) {
+	job := NewDelayDetectionJob(&stubDelayDetectionUseCase{}, 0)
+	assert.Equal(t, DefaultDelayDetectionInterval, job.interval)
+}
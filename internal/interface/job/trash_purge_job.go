@@ -0,0 +1,76 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/port"
+)
+
+const (
+	// DefaultTrashPurgeInterval はゴミ箱の完全削除ジョブのデフォルトの実行間隔です
+	DefaultTrashPurgeInterval = 24 * time.Hour
+
+	// DefaultTrashRetention はゴミ箱に移動してから完全に削除するまでのデフォルトの保持期間です
+	DefaultTrashRetention = 30 * 24 * time.Hour
+)
+
+// TrashPurgeJob は保持期間を過ぎたゴミ箱の中身を定期的に完全削除するバックグラウンドジョブです
+type TrashPurgeJob struct {
+	useCase   port.TrashUseCase
+	interval  time.Duration
+	retention time.Duration
+	now       func() time.Time
+}
+
+// NewTrashPurgeJob は新しいTrashPurgeJobを作成します
+// interval・retentionが0以下の場合はそれぞれデフォルト値を使用します
+func NewTrashPurgeJob(useCase port.TrashUseCase, interval, retention time.Duration) *TrashPurgeJob {
+	if interval <= 0 {
+		interval = DefaultTrashPurgeInterval
+	}
+	if retention <= 0 {
+		retention = DefaultTrashRetention
+	}
+	return &TrashPurgeJob{
+		useCase:   useCase,
+		interval:  interval,
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// Run はctxが終了するまで完全削除を繰り返し実行します
+// 起動直後に1回実行し、失敗した場合は次回の実行で再試行します
+func (j *TrashPurgeJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Trash purge failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce は保持期間より前にゴミ箱に移動されたものを1回完全削除します
+func (j *TrashPurgeJob) RunOnce(ctx context.Context) (*dto.TrashPurgeResultDTO, error) {
+	result, err := j.useCase.PurgeDeletedBefore(ctx, j.now().Add(-j.retention))
+	if err != nil {
+		return nil, err
+	}
+
+	if result.PurgedSuiteCount+result.PurgedGroupCount+result.PurgedCaseCount > 0 {
+		log.Printf("Trash purge: suites=%d groups=%d cases=%d",
+			result.PurgedSuiteCount, result.PurgedGroupCount, result.PurgedCaseCount)
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/port"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubTrashUseCase は完全削除の基準日時を記録するテスト用のユースケース
+// ジョブが使用しないメソッドは埋め込んだインターフェースに委ねる
+type stubTrashUseCase struct {
+	port.TrashUseCase
+	before time.Time
+	err    error
+}
+
+func (s *stubTrashUseCase) PurgeDeletedBefore(ctx context.Context, before time.Time) (*dto.TrashPurgeResultDTO, error) {
+	s.before = before
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &dto.TrashPurgeResultDTO{PurgedSuiteCount: 1, PurgedCaseCount: 3}, nil
+}
+
+func TestTrashPurgeJob_RunOnce(t *testing.T) {
+	now := time.Date(2024, 6, 30, 3, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		retention  time.Duration
+		err        error
+		wantBefore time.Time
+		wantErr    bool
+	}{
+		{
+			name:       "正常系：保持期間を過ぎたものを完全削除する",
+			retention:  7 * 24 * time.Hour,
+			wantBefore: time.Date(2024, 6, 23, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "正常系：保持期間の指定がない場合はデフォルト値を使用する",
+			wantBefore: now.Add(-DefaultTrashRetention),
+		},
+		{
+			name:       "異常系：完全削除の失敗を返す",
+			retention:  time.Hour,
+			err:        errors.New("database unavailable"),
+			wantBefore: now.Add(-time.Hour),
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			useCase := &stubTrashUseCase{err: tc.err}
+			job := NewTrashPurgeJob(useCase, 0, tc.retention)
+			job.now = func() time.Time { return now }
+
+			result, err := job.RunOnce(context.Background())
+
+			assert.Equal(t, tc.wantBefore, useCase.before)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 1, result.PurgedSuiteCount)
+			assert.Equal(t, 3, result.PurgedCaseCount)
+		})
+	}
+}
+
+func TestNewTrashPurgeJob_DefaultInterval(t *testing.T) {
+	job := NewTrashPurgeJob(&stubTrashUseCase{}, 0, 0)
+	assert.Equal(t, DefaultTrashPurgeInterval, job.interval)
+	assert.Equal(t, DefaultTrashRetention, job.retention)
+}
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+)
+
+// EffortRecordCreateDTO は工数記録時のリクエストDTO
+type EffortRecordCreateDTO struct {
+	TestCaseID   string    `json:"testCaseId" validate:"required"`
+	RecordDate   time.Time `json:"recordDate" validate:"required"`
+	EffortAmount float64   `json:"effortAmount" validate:"required,gt=0"`
+	IsAdditional bool      `json:"isAdditional"`
+	Comment      string    `json:"comment"`
+	RecordedBy   string    `json:"recordedBy" validate:"required"`
+}
+
+// EffortRecordCorrectDTO は工数記録の訂正時のリクエストDTO
+type EffortRecordCorrectDTO struct {
+	EffortAmount float64 `json:"effortAmount" validate:"required,gt=0"`
+	Comment      string  `json:"comment"`
+	CorrectedBy  string  `json:"correctedBy" validate:"required"`
+}
+
+// EffortRecordResponseDTO は工数記録のレスポンスDTO
+type EffortRecordResponseDTO struct {
+	ID           string    `json:"id"`
+	TestCaseID   string    `json:"testCaseId"`
+	RecordDate   time.Time `json:"recordDate"`
+	EffortAmount float64   `json:"effortAmount"`
+	IsAdditional bool      `json:"isAdditional"`
+	Comment      string    `json:"comment"`
+	RecordedBy   string    `json:"recordedBy"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// EffortRecordListResponseDTO は工数記録一覧のレスポンスDTO
+type EffortRecordListResponseDTO struct {
+	EffortRecords []*EffortRecordResponseDTO `json:"effortRecords"`
+	TotalEffort   float64                    `json:"totalEffort"`
+}
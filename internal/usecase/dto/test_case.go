@@ -6,18 +6,23 @@ import (
 
 // TestCaseResponseDTO はテストケースのレスポンスDTO
 type TestCaseResponseDTO struct {
-	ID            string    `json:"id"`
-	GroupID       string    `json:"groupId"`
-	Title         string    `json:"title"`
-	Description   string    `json:"description"`
-	Status        string    `json:"status"`
-	Priority      string    `json:"priority"`
-	PlannedEffort float64   `json:"plannedEffort"`
-	ActualEffort  float64   `json:"actualEffort"`
-	IsDelayed     bool      `json:"isDelayed"`
-	DelayDays     int       `json:"delayDays"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	ID            string     `json:"id"`
+	GroupID       string     `json:"groupId"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description"`
+	Status        string     `json:"status"`
+	Priority      string     `json:"priority"`
+	PlannedEffort float64    `json:"plannedEffort"`
+	ActualEffort  float64    `json:"actualEffort"`
+	DueDate       *time.Time `json:"dueDate,omitempty"` // テストケース個別の期限
+	IsDelayed     bool       `json:"isDelayed"`
+	DelayDays     int        `json:"delayDays"`
+	LockedBy      string     `json:"lockedBy,omitempty"`      // 有効な編集ロックの保持者
+	LockExpiresAt *time.Time `json:"lockExpiresAt,omitempty"` // 編集ロックのリース期限
+	Version       int        `json:"version"`
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"` // ゴミ箱に移動された日時
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
 }
 
 // TestCaseListResponseDTO はテストケース一覧のレスポンスDTO
@@ -27,9 +32,49 @@ type TestCaseListResponseDTO struct {
 
 // TestCaseCreateDTO はテストケース作成用のDTO
 type TestCaseCreateDTO struct {
-	GroupID       string  `json:"groupId" validate:"required"`
-	Title         string  `json:"title" validate:"required"`
-	Description   string  `json:"description"`
-	Priority      string  `json:"priority"`
-	PlannedEffort float64 `json:"plannedEffort"`
+	GroupID       string     `json:"groupId" validate:"required"`
+	Title         string     `json:"title" validate:"required"`
+	Description   string     `json:"description"`
+	Priority      string     `json:"priority"`
+	PlannedEffort float64    `json:"plannedEffort"`
+	DueDate       *time.Time `json:"dueDate,omitempty"`
+}
+
+// TestCaseStatusUpdateDTO はテストケースのステータス更新用のDTO
+type TestCaseStatusUpdateDTO struct {
+	Status          string `json:"status" validate:"required"`
+	ChangedBy       string `json:"changedBy" validate:"required"`
+	Reason          string `json:"reason"`
+	ExpectedVersion *int   `json:"expectedVersion,omitempty"` // 指定された場合、現在のバージョンと一致しなければ競合エラーとなる
+}
+
+// StatusHistoryResponseDTO はステータス変更履歴のレスポンスDTO
+type StatusHistoryResponseDTO struct {
+	ID         string    `json:"id"`
+	TestCaseID string    `json:"testCaseId"`
+	OldStatus  string    `json:"oldStatus"`
+	NewStatus  string    `json:"newStatus"`
+	ChangedAt  time.Time `json:"changedAt"`
+	ChangedBy  string    `json:"changedBy"`
+	Reason     string    `json:"reason"`
+}
+
+// TestCaseUpdateDTO はテストケース更新用のDTO
+type TestCaseUpdateDTO struct {
+	Title           *string    `json:"title,omitempty" validate:"omitempty,min=1,max=200"`
+	Description     *string    `json:"description,omitempty"`
+	Priority        *string    `json:"priority,omitempty"`
+	PlannedEffort   *float64   `json:"plannedEffort,omitempty"`
+	DueDate         *time.Time `json:"dueDate,omitempty"`
+	UpdatedBy       string     `json:"updatedBy"`                 // 編集ロックの保持者確認に使用
+	ExpectedVersion *int       `json:"expectedVersion,omitempty"` // 指定された場合、現在のバージョンと一致しなければ競合エラーとなる
+}
+
+// DelayDetectionResultDTO は遅延検出の実行結果DTO
+type DelayDetectionResultDTO struct {
+	CheckedCount int      `json:"checkedCount"` // 判定したテストケース数
+	DelayedCount int      `json:"delayedCount"` // 新たに遅延となったテストケース数
+	UpdatedCount int      `json:"updatedCount"` // 遅延日数のみ更新したテストケース数
+	ClearedCount int      `json:"clearedCount"` // 遅延を解除したテストケース数
+	DelayedIDs   []string `json:"delayedIds"`   // 新たに遅延となったテストケースのID
 }
@@ -6,14 +6,20 @@ import (
 
 // TestGroupResponseDTO はテストグループのレスポンスDTO
 type TestGroupResponseDTO struct {
-	ID           string    `json:"id"`
-	SuiteID      string    `json:"suiteId"`
-	Name         string    `json:"name"`
-	Description  string    `json:"description"`
-	DisplayOrder int       `json:"displayOrder"`
-	Status       string    `json:"status"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	ID                 string     `json:"id"`
+	SuiteID            string     `json:"suiteId"`
+	Name               string     `json:"name"`
+	Description        string     `json:"description"`
+	DisplayOrder       int        `json:"displayOrder"`
+	Status             string     `json:"status"`
+	StatusLocked       bool       `json:"statusLocked"` // trueの場合、ステータスは配下のケースから自動算出されない
+	Progress           float64    `json:"progress"`
+	CompletedCaseCount int        `json:"completedCaseCount"`
+	TotalCaseCount     int        `json:"totalCaseCount"`
+	Version            int        `json:"version"`
+	DeletedAt          *time.Time `json:"deletedAt,omitempty"` // ゴミ箱に移動された日時
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
 }
 
 // TestGroupListResponseDTO はテストグループ一覧のレスポンスDTO
@@ -28,3 +34,12 @@ type TestGroupCreateDTO struct {
 	Description  string `json:"description"`
 	DisplayOrder int    `json:"displayOrder"`
 }
+
+// TestGroupUpdateDTO はテストグループ更新用のDTO
+type TestGroupUpdateDTO struct {
+	Name            *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Description     *string `json:"description,omitempty"`
+	Status          *string `json:"status,omitempty"`          // 指定した場合、ステータスをこの値に固定する
+	StatusLocked    *bool   `json:"statusLocked,omitempty"`    // falseを指定すると固定を解除し、配下のケースから再計算する
+	ExpectedVersion *int    `json:"expectedVersion,omitempty"` // 指定された場合、現在のバージョンと一致しなければ競合エラーとなる
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
@@ -8,40 +9,67 @@ import (
 
 // TestSuiteCreateDTO はテストスイート作成時のリクエストDTO
 type TestSuiteCreateDTO struct {
-	Name                 string    `json:"name" validate:"required,min=1,max=100"`
-	Description          string    `json:"description"`
-	EstimatedStartDate   time.Time `json:"estimatedStartDate" validate:"required"`
-	EstimatedEndDate     time.Time `json:"estimatedEndDate" validate:"required,gtfield=EstimatedStartDate"`
-	RequireEffortComment bool      `json:"requireEffortComment"`
+	Name                 string           `json:"name" validate:"required,min=1,max=100"`
+	Description          string           `json:"description"`
+	EstimatedStartDate   time.Time        `json:"estimatedStartDate" validate:"required"`
+	EstimatedEndDate     time.Time        `json:"estimatedEndDate" validate:"required,gtfield=EstimatedStartDate"`
+	RequireEffortComment bool             `json:"requireEffortComment"`
+	ExitCriteria         *ExitCriteriaDTO `json:"exitCriteria,omitempty"` // 省略した場合は全ケース完了を条件とする
+	CreatedBy            string           `json:"-"`                      // 作成するユーザーのID（認証情報から設定する）
+}
+
+// ExitCriteriaDTO はテストスイートを完了にするための条件
+type ExitCriteriaDTO struct {
+	MinCompletionRate float64 `json:"minCompletionRate" validate:"min=0,max=100"` // 完了したテストケースの割合の下限（%）
+	AllowOpenCritical bool    `json:"allowOpenCritical"`                          // 未完了のCriticalケースが残っていても完了を許可するか
+}
+
+// ExitCriteriaOverrideDTO は完了条件を満たさずにテストスイートを完了にした記録
+type ExitCriteriaOverrideDTO struct {
+	Reason       string    `json:"reason"`
+	OverriddenBy string    `json:"overriddenBy"`
+	OverriddenAt time.Time `json:"overriddenAt"`
 }
 
 // TestSuiteResponseDTO はテストスイート情報を返すためのDTO
 type TestSuiteResponseDTO struct {
-	ID                   string    `json:"id"`
-	Name                 string    `json:"name"`
-	Description          string    `json:"description"`
-	Status               string    `json:"status"`
-	EstimatedStartDate   time.Time `json:"estimatedStartDate"`
-	EstimatedEndDate     time.Time `json:"estimatedEndDate"`
-	RequireEffortComment bool      `json:"requireEffortComment"`
-	Progress             float64   `json:"progress"`
-	CreatedAt            time.Time `json:"createdAt"`
-	UpdatedAt            time.Time `json:"updatedAt"`
+	ID                   string                   `json:"id"`
+	Name                 string                   `json:"name"`
+	Description          string                   `json:"description"`
+	Status               string                   `json:"status"`
+	EstimatedStartDate   time.Time                `json:"estimatedStartDate"`
+	EstimatedEndDate     time.Time                `json:"estimatedEndDate"`
+	RequireEffortComment bool                     `json:"requireEffortComment"`
+	Progress             float64                  `json:"progress"`
+	CompletedCaseCount   int                      `json:"completedCaseCount"`
+	TotalCaseCount       int                      `json:"totalCaseCount"`
+	ExitCriteria         ExitCriteriaDTO          `json:"exitCriteria"`
+	ExitOverride         *ExitCriteriaOverrideDTO `json:"exitOverride,omitempty"` // 完了条件を満たさずに完了にした場合のみ設定
+	Version              int                      `json:"version"`
+	DeletedAt            *time.Time               `json:"deletedAt,omitempty"` // ゴミ箱に移動された日時
+	CreatedBy            string                   `json:"createdBy,omitempty"` // 作成したユーザーのID（不明な場合は空文字）
+	CreatedAt            time.Time                `json:"createdAt"`
+	UpdatedAt            time.Time                `json:"updatedAt"`
 }
 
 // TestSuiteListResponseDTO はテストスイート一覧を返すためのDTO
 type TestSuiteListResponseDTO struct {
 	TestSuites []TestSuiteResponseDTO `json:"testSuites"`
 	Total      int                    `json:"total"`
+	// 取得したページの前後に、同じ条件のテストスイートが残っているか
+	HasNextPage     bool `json:"hasNextPage"`
+	HasPreviousPage bool `json:"hasPreviousPage"`
 }
 
 // TestSuiteUpdateDTO は、テストスイート更新時のリクエストデータを表現します
 type TestSuiteUpdateDTO struct {
-	Name                 *string    `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
-	Description          *string    `json:"description,omitempty"`
-	EstimatedStartDate   *time.Time `json:"estimatedStartDate,omitempty" validate:"omitempty"`
-	EstimatedEndDate     *time.Time `json:"estimatedEndDate,omitempty" validate:"omitempty,gtfield=EstimatedStartDate"`
-	RequireEffortComment *bool      `json:"requireEffortComment,omitempty"`
+	Name                 *string          `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Description          *string          `json:"description,omitempty"`
+	EstimatedStartDate   *time.Time       `json:"estimatedStartDate,omitempty" validate:"omitempty"`
+	EstimatedEndDate     *time.Time       `json:"estimatedEndDate,omitempty" validate:"omitempty,gtfield=EstimatedStartDate"`
+	RequireEffortComment *bool            `json:"requireEffortComment,omitempty"`
+	ExitCriteria         *ExitCriteriaDTO `json:"exitCriteria,omitempty"`
+	ExpectedVersion      *int             `json:"expectedVersion,omitempty"` // 指定された場合、現在のバージョンと一致しなければ競合エラーとなる
 }
 
 // Validate は、DTOのカスタムバリデーションを実行します
@@ -56,7 +84,10 @@ func (dto *TestSuiteUpdateDTO) Validate() error {
 }
 
 type TestSuiteStatusUpdateDTO struct {
-	Status string `json:"status" validate:"required,oneof=準備中 実行中 完了 中断"`
+	Status    string `json:"status" validate:"required,oneof=準備中 実行中 完了 中断"`
+	ChangedBy string `json:"changedBy"`
+	// OverrideReason を指定すると、完了条件を満たさない場合でもAdminは完了にできる
+	OverrideReason string `json:"overrideReason,omitempty"`
 }
 
 // Validate は、ステータス更新のバリデーションを実行します
@@ -68,18 +99,67 @@ func (dto *TestSuiteStatusUpdateDTO) Validate() error {
 // TestSuiteQueryParamDTO は、テストスイート一覧取得時のクエリパラメータを表現します
 type TestSuiteQueryParamDTO struct {
 	Status    *string    `json:"status" validate:"omitempty,oneof=準備中 実行中 完了 中断"`
-	StartDate *time.Time `json:"startDate" validate:"omitempty"`
-	EndDate   *time.Time `json:"endDate" validate:"omitempty"`
-	Page      *int       `json:"page" validate:"omitempty,min=1"`
-	PageSize  *int       `json:"pageSize" validate:"omitempty,min=1,max=100"`
+	StartDate *time.Time `json:"startDate" validate:"omitempty"` // 開始予定日がこの日以降のスイートのみを対象にする
+	EndDate   *time.Time `json:"endDate" validate:"omitempty"`   // 終了予定日がこの日以前のスイートのみを対象にする
+	// Search を指定した場合、名前または説明に含まれるスイートのみを対象にする（大文字・小文字は区別しない）
+	Search    *string `json:"search"`
+	CreatedBy *string `json:"createdBy"`
+	// PeriodFrom・PeriodTo を指定した場合、予定期間がその範囲と重なるスイートのみを対象にする
+	PeriodFrom *time.Time `json:"periodFrom"`
+	PeriodTo   *time.Time `json:"periodTo"`
+	// MinProgress・MaxProgress は進捗率（%）の範囲で、境界の値を含む
+	MinProgress *float64 `json:"minProgress" validate:"omitempty,min=0,max=100"`
+	MaxProgress *float64 `json:"maxProgress" validate:"omitempty,min=0,max=100"`
+	// HasDelayedCases を指定した場合、遅延しているテストケースの有無で絞り込む
+	HasDelayedCases *bool `json:"hasDelayedCases"`
+	// Sort は並び順で、省略した場合はDefaultTestSuiteSortを使用する
+	Sort     []TestSuiteSortDTO `json:"sort"`
+	Page     *int               `json:"page" validate:"omitempty,min=1"`
+	PageSize *int               `json:"pageSize" validate:"omitempty,min=1,max=100"`
+	// Keyset を指定した場合はPage・PageSizeを使用せず、キーセット方式で取得する
+	Keyset *TestSuiteKeyset `json:"-"`
 }
 
 // Validate はクエリパラメータのカスタムバリデーションを実行します
+// 一覧取得のユースケースから呼び出すため、ドメインのバリデーションエラーを返します
 func (dto *TestSuiteQueryParamDTO) Validate() error {
-	if dto.StartDate != nil && dto.EndDate != nil {
-		if dto.EndDate.Before(*dto.StartDate) {
-			return errors.ErrInvalidDateRange
+	if dto.StartDate != nil && dto.EndDate != nil && dto.EndDate.Before(*dto.StartDate) {
+		return invalidQueryRangeError("startDate", "endDate", dto.StartDate.Format(time.DateOnly), dto.EndDate.Format(time.DateOnly))
+	}
+	if dto.PeriodFrom != nil && dto.PeriodTo != nil && dto.PeriodTo.Before(*dto.PeriodFrom) {
+		return invalidQueryRangeError("periodFrom", "periodTo", dto.PeriodFrom.Format(time.DateOnly), dto.PeriodTo.Format(time.DateOnly))
+	}
+	if dto.MinProgress != nil && dto.MaxProgress != nil && *dto.MinProgress > *dto.MaxProgress {
+		return invalidQueryRangeError("minProgress", "maxProgress",
+			strconv.FormatFloat(*dto.MinProgress, 'g', -1, 64), strconv.FormatFloat(*dto.MaxProgress, 'g', -1, 64))
+	}
+	return ValidateTestSuiteSort(dto.Sort)
+}
+
+func invalidQueryRangeError(lowerField, upperField, lower, upper string) error {
+	return errors.NewDomainValidationError("範囲の下限が上限を超えています", map[string]string{
+		lowerField: lower,
+		upperField: upper,
+	})
+}
+
+// SortOrder は一覧の並び順を返します（Sortを省略した場合はDefaultTestSuiteSort）
+func (dto *TestSuiteQueryParamDTO) SortOrder() []TestSuiteSortDTO {
+	if len(dto.Sort) == 0 {
+		return DefaultTestSuiteSort
+	}
+	return dto.Sort
+}
+
+// UsesProgress は絞り込みまたは並び替えに進捗率を使用するかを返します
+func (dto *TestSuiteQueryParamDTO) UsesProgress() bool {
+	if dto.MinProgress != nil || dto.MaxProgress != nil {
+		return true
+	}
+	for _, key := range dto.Sort {
+		if key.Field == TestSuiteSortByProgress {
+			return true
 		}
 	}
-	return nil
+	return false
 }
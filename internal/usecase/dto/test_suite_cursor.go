@@ -0,0 +1,146 @@
+package dto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+const (
+	// DefaultTestSuitePageSize は件数が指定されなかった場合に取得するテストスイートの件数
+	DefaultTestSuitePageSize = 10
+	// MaxTestSuitePageSize は1回で取得できるテストスイートの最大件数
+	MaxTestSuitePageSize = 100
+)
+
+// TestSuiteCursor はテストスイート一覧（並び順の各項目・IDの順）における位置を表す
+// クライアントにはEncodeした不透明な文字列として渡す
+type TestSuiteCursor struct {
+	Values []any // 並び順の各項目の値（TestSuiteSortField.Valueと同じ型）
+	ID     string
+}
+
+// cursorPayload はカーソル文字列に埋め込む内容
+type cursorPayload struct {
+	Sort   string   `json:"s"`
+	Values []string `json:"v"`
+	ID     string   `json:"id"`
+}
+
+// NewTestSuiteCursor は指定された並び順の一覧で返したテストスイートの位置を表すカーソル文字列を返します
+func NewTestSuiteCursor(suite *TestSuiteResponseDTO, sort []TestSuiteSortDTO) string {
+	cursor := TestSuiteCursor{ID: suite.ID}
+	for _, key := range sort {
+		cursor.Values = append(cursor.Values, key.Field.Value(suite))
+	}
+	return cursor.Encode(sort)
+}
+
+// Encode はカーソルを不透明な文字列に変換します
+// 並び順も埋め込み、異なる並び順の一覧で使用された場合はParseTestSuiteCursorでエラーにします
+func (c TestSuiteCursor) Encode(sort []TestSuiteSortDTO) string {
+	payload := cursorPayload{Sort: testSuiteSortSignature(sort), Values: make([]string, len(c.Values)), ID: c.ID}
+	for i, value := range c.Values {
+		payload.Values[i] = formatSortValue(value)
+	}
+	raw, _ := json.Marshal(payload)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// ParseTestSuiteCursor はEncodeで作成したカーソル文字列を、指定された並び順の位置として解析します
+func ParseTestSuiteCursor(cursor string, sort []TestSuiteSortDTO) (*TestSuiteCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalidCursorError(cursor)
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(raw, &payload); err != nil || payload.ID == "" || len(payload.Values) != len(sort) {
+		return nil, invalidCursorError(cursor)
+	}
+	if payload.Sort != testSuiteSortSignature(sort) {
+		return nil, errors.NewDomainValidationError("カーソルの並び順が一致しません", map[string]string{
+			"cursor":  cursor,
+			"orderBy": testSuiteSortSignature(sort),
+		})
+	}
+
+	parsed := &TestSuiteCursor{Values: make([]any, len(sort)), ID: payload.ID}
+	for i, key := range sort {
+		value, err := key.Field.parseValue(payload.Values[i])
+		if err != nil {
+			return nil, invalidCursorError(cursor)
+		}
+		parsed.Values[i] = value
+	}
+	return parsed, nil
+}
+
+// TestSuiteKeyset はテストスイート一覧をキーセット方式で取得する条件
+// オフセット方式と異なり、取得中にスイートが作成・削除されても重複や欠落が発生しない
+type TestSuiteKeyset struct {
+	After    *TestSuiteCursor // 指定した場合、並び順でこのカーソルより後のみを対象にする
+	Before   *TestSuiteCursor // 指定した場合、並び順でこのカーソルより前のみを対象にする
+	Limit    int              // 取得する件数
+	FromLast bool             // trueの場合、対象の末尾からLimit件を取得する（並び順は変わらない）
+}
+
+// NewTestSuiteKeyset はRelayのコネクション形式の引数（first/after/last/before）から取得条件を作成します
+// カーソルはsortの並び順で作成されたものである必要があり、firstとlastの両方を省略した場合は先頭からDefaultTestSuitePageSize件を取得します
+func NewTestSuiteKeyset(first *int, after *string, last *int, before *string, sort []TestSuiteSortDTO) (*TestSuiteKeyset, error) {
+	if first != nil && last != nil {
+		return nil, errors.NewDomainValidationError("firstとlastは同時に指定できません", map[string]string{
+			"first": strconv.Itoa(*first),
+			"last":  strconv.Itoa(*last),
+		})
+	}
+
+	keyset := &TestSuiteKeyset{Limit: DefaultTestSuitePageSize}
+	if first != nil {
+		if err := validatePageSize("first", *first); err != nil {
+			return nil, err
+		}
+		keyset.Limit = *first
+	}
+	if last != nil {
+		if err := validatePageSize("last", *last); err != nil {
+			return nil, err
+		}
+		keyset.Limit = *last
+		keyset.FromLast = true
+	}
+
+	if after != nil {
+		cursor, err := ParseTestSuiteCursor(*after, sort)
+		if err != nil {
+			return nil, err
+		}
+		keyset.After = cursor
+	}
+	if before != nil {
+		cursor, err := ParseTestSuiteCursor(*before, sort)
+		if err != nil {
+			return nil, err
+		}
+		keyset.Before = cursor
+	}
+
+	return keyset, nil
+}
+
+func validatePageSize(field string, size int) error {
+	if size < 1 || size > MaxTestSuitePageSize {
+		return errors.NewDomainValidationError("取得件数は1から100の範囲で指定してください", map[string]string{
+			field: strconv.Itoa(size),
+		})
+	}
+	return nil
+}
+
+func invalidCursorError(cursor string) error {
+	return errors.NewDomainValidationError("無効なカーソルです", map[string]string{
+		"cursor": cursor,
+	})
+}
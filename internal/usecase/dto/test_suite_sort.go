@@ -0,0 +1,170 @@
+package dto
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// TestSuiteSortField はテストスイート一覧の並び替えに使用できる項目
+type TestSuiteSortField string
+
+const (
+	TestSuiteSortByName               TestSuiteSortField = "name"
+	TestSuiteSortByEstimatedStartDate TestSuiteSortField = "estimatedStartDate"
+	TestSuiteSortByEstimatedEndDate   TestSuiteSortField = "estimatedEndDate"
+	TestSuiteSortByProgress           TestSuiteSortField = "progress"
+	TestSuiteSortByUpdatedAt          TestSuiteSortField = "updatedAt"
+	TestSuiteSortByCreatedAt          TestSuiteSortField = "createdAt"
+)
+
+// IsValid は並び替えに使用できる項目かどうかを返します
+func (f TestSuiteSortField) IsValid() bool {
+	switch f {
+	case TestSuiteSortByName, TestSuiteSortByEstimatedStartDate, TestSuiteSortByEstimatedEndDate,
+		TestSuiteSortByProgress, TestSuiteSortByUpdatedAt, TestSuiteSortByCreatedAt:
+		return true
+	default:
+		return false
+	}
+}
+
+// Value は並び替えの比較に使用するスイートの値を返します（名前はstring、進捗はfloat64、日時はtime.Time）
+func (f TestSuiteSortField) Value(suite *TestSuiteResponseDTO) any {
+	switch f {
+	case TestSuiteSortByName:
+		return suite.Name
+	case TestSuiteSortByEstimatedStartDate:
+		return suite.EstimatedStartDate
+	case TestSuiteSortByEstimatedEndDate:
+		return suite.EstimatedEndDate
+	case TestSuiteSortByProgress:
+		return RoundProgress(suite.Progress)
+	case TestSuiteSortByUpdatedAt:
+		return suite.UpdatedAt
+	default:
+		return suite.CreatedAt
+	}
+}
+
+// ProgressScale は進捗率で絞り込み・並び替えを行う場合に比較する小数点以下の桁数です
+// 進捗率は加算の順序によって倍精度の末尾の桁が変わるため、カーソルの値とデータベースで計算した値を同じ桁数に丸めて比較します
+const ProgressScale = 4
+
+// RoundProgress は進捗率を小数点以下ProgressScale桁に丸めます（0.5は0から遠い方に丸める）
+func RoundProgress(progress float64) float64 {
+	scale := math.Pow10(ProgressScale)
+	return math.Round(progress*scale) / scale
+}
+
+// formatSortValue はValueで取得した値をカーソルに埋め込む文字列に変換します
+func formatSortValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return ""
+	}
+}
+
+// parseValue はformatSortValueで変換した文字列を項目に応じた型の値に戻します
+func (f TestSuiteSortField) parseValue(raw string) (any, error) {
+	switch f {
+	case TestSuiteSortByName:
+		return raw, nil
+	case TestSuiteSortByProgress:
+		progress, err := strconv.ParseFloat(raw, 64)
+		return RoundProgress(progress), err
+	default:
+		return time.Parse(time.RFC3339Nano, raw)
+	}
+}
+
+// TestSuiteSortDTO はテストスイート一覧の並び替えの1項目を表します
+// 同じ値のスイートは次の項目で比較し、すべての項目が同じ場合はIDで順序を決めます
+type TestSuiteSortDTO struct {
+	Field TestSuiteSortField `json:"field"`
+	Desc  bool               `json:"desc"` // trueの場合は降順
+}
+
+// DefaultTestSuiteSort は並び順が指定されなかった場合の並び順（作成日時の新しい順）です
+var DefaultTestSuiteSort = []TestSuiteSortDTO{{Field: TestSuiteSortByCreatedAt, Desc: true}}
+
+// ParseTestSuiteSort は"progress desc,name"のような並び順の指定を解析します
+// 項目ごとにasc・descで方向を指定でき、省略した場合は昇順になります
+func ParseTestSuiteSort(orderBy string) ([]TestSuiteSortDTO, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+
+	var sort []TestSuiteSortDTO
+	for _, item := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(item)
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, invalidSortError(orderBy)
+		}
+
+		key := TestSuiteSortDTO{Field: TestSuiteSortField(fields[0])}
+		if len(fields) == 2 {
+			switch strings.ToLower(fields[1]) {
+			case "asc":
+			case "desc":
+				key.Desc = true
+			default:
+				return nil, invalidSortError(orderBy)
+			}
+		}
+		sort = append(sort, key)
+	}
+
+	if err := ValidateTestSuiteSort(sort); err != nil {
+		return nil, err
+	}
+	return sort, nil
+}
+
+// ValidateTestSuiteSort は並び替えの項目が有効で、同じ項目を重複して指定していないかを検証します
+func ValidateTestSuiteSort(sort []TestSuiteSortDTO) error {
+	seen := make(map[TestSuiteSortField]bool, len(sort))
+	for _, key := range sort {
+		if !key.Field.IsValid() {
+			return errors.NewDomainValidationError("並び替えに使用できない項目です", map[string]string{
+				"orderBy": string(key.Field),
+			})
+		}
+		if seen[key.Field] {
+			return errors.NewDomainValidationError("同じ項目で複数回並び替えることはできません", map[string]string{
+				"orderBy": string(key.Field),
+			})
+		}
+		seen[key.Field] = true
+	}
+	return nil
+}
+
+// testSuiteSortSignature は並び順を"name:asc,progress:desc"の形式で表します
+// カーソルに埋め込み、異なる並び順で作成されたカーソルの使用を検出します
+func testSuiteSortSignature(sort []TestSuiteSortDTO) string {
+	keys := make([]string, len(sort))
+	for i, key := range sort {
+		direction := "asc"
+		if key.Desc {
+			direction = "desc"
+		}
+		keys[i] = string(key.Field) + ":" + direction
+	}
+	return strings.Join(keys, ",")
+}
+
+func invalidSortError(orderBy string) error {
+	return errors.NewDomainValidationError("並び順の指定が正しくありません（例: \"progress desc,name\"）", map[string]string{
+		"orderBy": orderBy,
+	})
+}
@@ -0,0 +1,16 @@
+package dto
+
+// TrashResponseDTO はゴミ箱の一覧のレスポンスDTO
+// 復元できる単位で返すため、スイートごと削除されたグループ・ケースはスイートにのみ含まれる
+type TrashResponseDTO struct {
+	TestSuites []*TestSuiteResponseDTO `json:"testSuites"`
+	TestGroups []*TestGroupResponseDTO `json:"testGroups"`
+	TestCases  []*TestCaseResponseDTO  `json:"testCases"`
+}
+
+// TrashPurgeResultDTO は保持期間を過ぎたゴミ箱の完全削除の実行結果DTO
+type TrashPurgeResultDTO struct {
+	PurgedSuiteCount int `json:"purgedSuiteCount"`
+	PurgedGroupCount int `json:"purgedGroupCount"`
+	PurgedCaseCount  int `json:"purgedCaseCount"`
+}
@@ -0,0 +1,14 @@
+package interactor
+
+import (
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// checkExpectedVersion は更新要求で指定されたバージョンが現在のバージョンと一致するかを確認します
+// expectedVersionが指定されていない場合は確認せず、リポジトリでの条件付き更新のみで競合を検出します
+func checkExpectedVersion(resource, id string, currentVersion int, expectedVersion *int) error {
+	if expectedVersion == nil || *expectedVersion == currentVersion {
+		return nil
+	}
+	return errors.NewConcurrentModificationError(resource, id, int64(currentVersion), int64(*expectedVersion))
+}
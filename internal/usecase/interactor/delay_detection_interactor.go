@@ -0,0 +1,133 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// detectionTargetSuiteStatuses は遅延検出の対象とするテストスイートのステータスです
+// 完了したスイートのテストケースも、未完了であれば遅延として扱います
+var detectionTargetSuiteStatuses = []valueobject.SuiteStatus{
+	valueobject.SuiteStatusPreparation,
+	valueobject.SuiteStatusInProgress,
+	valueobject.SuiteStatusSuspended,
+	valueobject.SuiteStatusCompleted,
+}
+
+// DelayDetectionInteractor はテストケースの遅延検出のユースケース実装
+type DelayDetectionInteractor struct {
+	testSuiteRepo repository.TestSuiteRepository
+	testGroupRepo repository.TestGroupRepository
+	testCaseRepo  repository.TestCaseRepository
+	publisher     event.Publisher
+}
+
+// NewDelayDetectionInteractor は新しいDelayDetectionInteractorを作成します
+// publisherがnilの場合、ドメインイベントは発行しません
+func NewDelayDetectionInteractor(
+	testSuiteRepo repository.TestSuiteRepository,
+	testGroupRepo repository.TestGroupRepository,
+	testCaseRepo repository.TestCaseRepository,
+	publisher event.Publisher,
+) *DelayDetectionInteractor {
+	return &DelayDetectionInteractor{
+		testSuiteRepo: testSuiteRepo,
+		testGroupRepo: testGroupRepo,
+		testCaseRepo:  testCaseRepo,
+		publisher:     publisher,
+	}
+}
+
+// DetectDelays はすべてのテストケースをスイートの終了予定日または個別の期限と比較し、遅延状態を更新します
+// 遅延状態に変化があったテストケースのみ更新し、新たに遅延となった場合はTestCaseDelayedイベントを発行します
+func (i *DelayDetectionInteractor) DetectDelays(ctx context.Context, now time.Time) (*dto.DelayDetectionResultDTO, error) {
+	result := &dto.DelayDetectionResultDTO{
+		DelayedIDs: []string{},
+	}
+
+	for _, status := range detectionTargetSuiteStatuses {
+		suites, err := i.testSuiteRepo.FindByStatus(ctx, status)
+		if err != nil {
+			if errors.IsDomainError(err) {
+				return nil, err
+			}
+			return nil, errors.NewSystemError("テストスイート一覧の取得に失敗しました", err)
+		}
+
+		for _, suite := range suites {
+			if err := i.detectSuiteDelays(ctx, suite, now, result); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// detectSuiteDelays は指定されたスイートに属するテストケースの遅延状態を更新し、結果を集計します
+func (i *DelayDetectionInteractor) detectSuiteDelays(ctx context.Context, suite *entity.TestSuite, now time.Time, result *dto.DelayDetectionResultDTO) error {
+	groups, err := i.testGroupRepo.FindBySuiteID(ctx, suite.ID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return err
+		}
+		return errors.NewSystemError("テストグループ一覧の取得に失敗しました", err)
+	}
+
+	for _, group := range groups {
+		cases, err := i.testCaseRepo.FindByGroupID(ctx, group.ID)
+		if err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストケース一覧の取得に失敗しました", err)
+		}
+
+		for _, tc := range cases {
+			result.CheckedCount++
+
+			wasDelayed := tc.IsDelayed
+			if !tc.RefreshDelay(tc.Deadline(suite.EstimatedEndDate), now) {
+				continue
+			}
+
+			if err := i.testCaseRepo.UpdateDelay(ctx, tc.ID, tc.Status, tc.IsDelayed, tc.DelayDays); err != nil {
+				// 一覧の取得後にゴミ箱へ移動された、またはステータスが変更された（完了した場合など）ケースは対象外とし、
+				// 残りのケースの検出を続ける（変更後の状態は次回の検出で判定する）
+				if errors.IsNotFoundError(err) || isStatusTransitionConflict(err) {
+					continue
+				}
+				if errors.IsDomainError(err) {
+					return err
+				}
+				return errors.NewSystemError("テストケースの遅延状態の更新に失敗しました", err)
+			}
+
+			switch {
+			case tc.IsDelayed && !wasDelayed:
+				result.DelayedCount++
+				result.DelayedIDs = append(result.DelayedIDs, tc.ID)
+				publishEvent(ctx, i.publisher, event.New(event.TestCaseDelayed, suite.ID, tc.ID, ""))
+			case tc.IsDelayed:
+				result.UpdatedCount++
+			default:
+				result.ClearedCount++
+			}
+		}
+	}
+
+	return nil
+}
+
+// isStatusTransitionConflict はステータスが他の更新により変更されていたことを示すエラーかどうかを判定します
+func isStatusTransitionConflict(err error) bool {
+	_, ok := err.(*errors.StatusTransitionConflictError)
+	return ok
+}
@@ -0,0 +1,135 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDetectDelays(t *testing.T) {
+	now := time.Date(2025, 4, 3, 9, 0, 0, 0, time.UTC)
+	suite := &entity.TestSuite{
+		ID:               "TS001-202501",
+		Status:           valueobject.SuiteStatusInProgress,
+		EstimatedEndDate: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockSuiteRepo := new(MockTestSuiteRepository)
+	mockGroupRepo := new(MockTestGroupRepository)
+	mockCaseRepo := new(MockTestCaseRepository)
+	mockPublisher := new(MockEventPublisher)
+
+	for _, status := range detectionTargetSuiteStatuses {
+		suites := []*entity.TestSuite{}
+		if status == valueobject.SuiteStatusInProgress {
+			suites = append(suites, suite)
+		}
+		mockSuiteRepo.On("FindByStatus", mock.Anything, status).Return(suites, nil)
+	}
+	mockGroupRepo.On("FindBySuiteID", mock.Anything, suite.ID).Return([]*entity.TestGroup{
+		{ID: "TS001TG01-202501", SuiteID: suite.ID},
+	}, nil)
+	mockCaseRepo.On("FindByGroupID", mock.Anything, "TS001TG01-202501").Return([]*entity.TestCase{
+		// スイートの終了予定日を過ぎた未完了のケース
+		{ID: "TS001TG01TC001-202501", Status: entity.TestStatusTesting},
+		// 遅延日数が進んだケース
+		{ID: "TS001TG01TC002-202501", Status: entity.TestStatusFixing, IsDelayed: true, DelayDays: 2},
+		// 遅延のまま完了したケース
+		{ID: "TS001TG01TC003-202501", Status: entity.TestStatusCompleted, IsDelayed: true, DelayDays: 1},
+		// 個別の期限内のケース
+		{ID: "TS001TG01TC004-202501", Status: entity.TestStatusTesting, DueDate: time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)},
+		// 既に同じ日数で遅延として記録済みのケース
+		{ID: "TS001TG01TC005-202501", Status: entity.TestStatusReviewing, IsDelayed: true, DelayDays: 3},
+	}, nil)
+
+	mockCaseRepo.On("UpdateDelay", mock.Anything, "TS001TG01TC001-202501", entity.TestStatusTesting, true, 3).Return(nil)
+	mockCaseRepo.On("UpdateDelay", mock.Anything, "TS001TG01TC002-202501", entity.TestStatusFixing, true, 3).Return(nil)
+	mockCaseRepo.On("UpdateDelay", mock.Anything, "TS001TG01TC003-202501", entity.TestStatusCompleted, false, 0).Return(nil)
+	mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+		return e.Type == event.TestCaseDelayed && e.SuiteID == suite.ID && e.EntityID == "TS001TG01TC001-202501"
+	})).Return().Once()
+
+	interactor := NewDelayDetectionInteractor(mockSuiteRepo, mockGroupRepo, mockCaseRepo, mockPublisher)
+
+	result, err := interactor.DetectDelays(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, result.CheckedCount)
+	assert.Equal(t, 1, result.DelayedCount)
+	assert.Equal(t, 1, result.UpdatedCount)
+	assert.Equal(t, 1, result.ClearedCount)
+	assert.Equal(t, []string{"TS001TG01TC001-202501"}, result.DelayedIDs)
+
+	mockSuiteRepo.AssertExpectations(t)
+	mockGroupRepo.AssertExpectations(t)
+	mockCaseRepo.AssertExpectations(t)
+	mockCaseRepo.AssertNumberOfCalls(t, "UpdateDelay", 3)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestDetectDelays_RepositoryError(t *testing.T) {
+	mockSuiteRepo := new(MockTestSuiteRepository)
+	mockSuiteRepo.On("FindByStatus", mock.Anything, mock.Anything).Return(([]*entity.TestSuite)(nil), assert.AnError)
+
+	interactor := NewDelayDetectionInteractor(mockSuiteRepo, new(MockTestGroupRepository), new(MockTestCaseRepository), nil)
+
+	result, err := interactor.DetectDelays(context.Background(), time.Now())
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "SYSTEM_ERROR")
+}
+
+func TestDetectDelays_SkipsTrashedOrChangedCase(t *testing.T) {
+	now := time.Date(2025, 4, 3, 9, 0, 0, 0, time.UTC)
+	suite := &entity.TestSuite{
+		ID:               "TS001-202501",
+		Status:           valueobject.SuiteStatusInProgress,
+		EstimatedEndDate: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockSuiteRepo := new(MockTestSuiteRepository)
+	mockGroupRepo := new(MockTestGroupRepository)
+	mockCaseRepo := new(MockTestCaseRepository)
+
+	for _, status := range detectionTargetSuiteStatuses {
+		suites := []*entity.TestSuite{}
+		if status == valueobject.SuiteStatusInProgress {
+			suites = append(suites, suite)
+		}
+		mockSuiteRepo.On("FindByStatus", mock.Anything, status).Return(suites, nil)
+	}
+	mockGroupRepo.On("FindBySuiteID", mock.Anything, suite.ID).Return([]*entity.TestGroup{
+		{ID: "TS001TG01-202501", SuiteID: suite.ID},
+	}, nil)
+	mockCaseRepo.On("FindByGroupID", mock.Anything, "TS001TG01-202501").Return([]*entity.TestCase{
+		{ID: "TS001TG01TC001-202501", Status: entity.TestStatusTesting},
+		{ID: "TS001TG01TC002-202501", Status: entity.TestStatusTesting},
+		{ID: "TS001TG01TC003-202501", Status: entity.TestStatusTesting},
+	}, nil)
+
+	// 一覧の取得後にゴミ箱へ移動されたケース
+	mockCaseRepo.On("UpdateDelay", mock.Anything, "TS001TG01TC001-202501", entity.TestStatusTesting, true, 3).
+		Return(errors.NewNotFoundError("TestCase", "TS001TG01TC001-202501"))
+	mockCaseRepo.On("UpdateDelay", mock.Anything, "TS001TG01TC002-202501", entity.TestStatusTesting, true, 3).Return(nil)
+	// 一覧の取得後に完了したケース
+	mockCaseRepo.On("UpdateDelay", mock.Anything, "TS001TG01TC003-202501", entity.TestStatusTesting, true, 3).
+		Return(errors.NewStatusTransitionConflictError("遅延状態の判定後にステータスが変更されています", string(entity.TestStatusCompleted), string(entity.TestStatusTesting)))
+
+	interactor := NewDelayDetectionInteractor(mockSuiteRepo, mockGroupRepo, mockCaseRepo, nil)
+
+	result, err := interactor.DetectDelays(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result.CheckedCount)
+	assert.Equal(t, 1, result.DelayedCount)
+	assert.Equal(t, []string{"TS001TG01TC002-202501"}, result.DelayedIDs)
+	mockCaseRepo.AssertNumberOfCalls(t, "UpdateDelay", 3)
+}
@@ -0,0 +1,302 @@
+package interactor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// EffortInteractor は工数記録のユースケース実装
+type EffortInteractor struct {
+	effortRepo    repository.EffortRecordRepository
+	testSuiteRepo repository.TestSuiteRepository
+	testGroupRepo repository.TestGroupRepository
+	testCaseRepo  repository.TestCaseRepository
+	userRepo      repository.UserRepository
+	publisher     event.Publisher
+	txManager     repository.TransactionManager
+}
+
+// NewEffortInteractor は新しいEffortInteractorを作成します
+// スイートとグループのリポジトリは、テストケースが属するスイートのコメント必須設定の確認に使用します
+// publisherがnilの場合、ドメインイベントは発行しません
+// txManagerを指定すると、工数記録と実績工数への反映を1つのトランザクションで実行します
+func NewEffortInteractor(
+	effortRepo repository.EffortRecordRepository,
+	testSuiteRepo repository.TestSuiteRepository,
+	testGroupRepo repository.TestGroupRepository,
+	testCaseRepo repository.TestCaseRepository,
+	userRepo repository.UserRepository,
+	publisher event.Publisher,
+	txManager repository.TransactionManager,
+) *EffortInteractor {
+	return &EffortInteractor{
+		effortRepo:    effortRepo,
+		testSuiteRepo: testSuiteRepo,
+		testGroupRepo: testGroupRepo,
+		testCaseRepo:  testCaseRepo,
+		userRepo:      userRepo,
+		publisher:     publisher,
+		txManager:     txManager,
+	}
+}
+
+// RecordEffort はテストケースに工数を記録し、テストケースの実績工数に加算します
+func (i *EffortInteractor) RecordEffort(ctx context.Context, createDTO *dto.EffortRecordCreateDTO) (*dto.EffortRecordResponseDTO, error) {
+	// 入力検証
+	if createDTO.TestCaseID == "" {
+		return nil, errors.NewDomainValidationError("テストケースIDは必須です", nil)
+	}
+	if createDTO.RecordedBy == "" {
+		return nil, errors.NewDomainValidationError("記録者は必須です", nil)
+	}
+	if createDTO.EffortAmount <= 0 {
+		return nil, errors.NewDomainValidationError("工数は0より大きい値である必要があります", map[string]string{
+			"effortAmount": "工数は0より大きい値である必要があります",
+		})
+	}
+
+	// 権限の確認
+	user, err := i.findUser(ctx, createDTO.RecordedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !user.CanRecordEffort() {
+		return nil, errors.NewDomainForbiddenError(user.ID, "EffortRecord", "record")
+	}
+
+	// スイートのコメント必須設定の確認
+	suite, err := i.findSuiteByTestCase(ctx, createDTO.TestCaseID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEffortComment(suite, createDTO.Comment); err != nil {
+		return nil, err
+	}
+
+	// 記録日の指定がない場合は当日として扱う
+	recordDate := createDTO.RecordDate
+	if recordDate.IsZero() {
+		recordDate = time.Now()
+	}
+
+	record := entity.NewEffortRecord(
+		createDTO.TestCaseID,
+		recordDate,
+		createDTO.EffortAmount,
+		createDTO.IsAdditional,
+		createDTO.Comment,
+		user.ID,
+	)
+
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		if err := i.effortRepo.Create(ctx, record); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("工数記録の作成に失敗しました", err)
+		}
+
+		// テストケースの実績工数に反映
+		if err := i.testCaseRepo.AddEffort(ctx, record.TestCaseID, record.EffortAmount); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("実績工数の更新に失敗しました", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.publishEffortRecorded(ctx, suite.ID, record, user.ID)
+
+	return newEffortRecordResponseDTO(record), nil
+}
+
+// CorrectEffortRecord は工数記録を訂正し、訂正前との差分をテストケースの実績工数に反映します
+func (i *EffortInteractor) CorrectEffortRecord(ctx context.Context, id string, correctDTO *dto.EffortRecordCorrectDTO) (*dto.EffortRecordResponseDTO, error) {
+	// 入力検証
+	if correctDTO.CorrectedBy == "" {
+		return nil, errors.NewDomainValidationError("訂正者は必須です", nil)
+	}
+	if correctDTO.EffortAmount <= 0 {
+		return nil, errors.NewDomainValidationError("工数は0より大きい値である必要があります", map[string]string{
+			"effortAmount": "工数は0より大きい値である必要があります",
+		})
+	}
+
+	record, err := i.effortRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewEntityNotFoundError("EffortRecord", id)
+	}
+
+	// 権限の確認
+	user, err := i.findUser(ctx, correctDTO.CorrectedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !user.CanCorrectEffortRecord(record) {
+		return nil, errors.NewDomainForbiddenError(user.ID, "EffortRecord", "correct")
+	}
+
+	// スイートのコメント必須設定の確認
+	suite, err := i.findSuiteByTestCase(ctx, record.TestCaseID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEffortComment(suite, correctDTO.Comment); err != nil {
+		return nil, err
+	}
+
+	delta := record.Correct(correctDTO.EffortAmount, correctDTO.Comment)
+
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		if err := i.effortRepo.Update(ctx, record); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("工数記録の訂正に失敗しました", err)
+		}
+
+		// 工数に変更がある場合のみ実績工数に差分を反映
+		if delta != 0 {
+			if err := i.testCaseRepo.AddEffort(ctx, record.TestCaseID, delta); err != nil {
+				if errors.IsDomainError(err) {
+					return err
+				}
+				return errors.NewSystemError("実績工数の更新に失敗しました", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.publishEffortRecorded(ctx, suite.ID, record, user.ID)
+
+	return newEffortRecordResponseDTO(record), nil
+}
+
+// GetEffortRecordsByTestCase は指定されたテストケースの工数記録一覧を取得します
+func (i *EffortInteractor) GetEffortRecordsByTestCase(ctx context.Context, testCaseID string) (*dto.EffortRecordListResponseDTO, error) {
+	records, err := i.effortRepo.FindByTestCaseID(ctx, testCaseID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("工数記録一覧の取得に失敗しました", err)
+	}
+
+	return newEffortRecordListResponseDTO(records), nil
+}
+
+// GetEffortRecordsByUserAndDate は指定されたユーザーの指定日の工数記録一覧を取得します
+func (i *EffortInteractor) GetEffortRecordsByUserAndDate(ctx context.Context, userID string, recordDate time.Time) (*dto.EffortRecordListResponseDTO, error) {
+	records, err := i.effortRepo.FindByRecorderAndDate(ctx, userID, entity.TruncateToDate(recordDate))
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("工数記録一覧の取得に失敗しました", err)
+	}
+
+	return newEffortRecordListResponseDTO(records), nil
+}
+
+// findUser は記録者・訂正者となるユーザーを取得します
+func (i *EffortInteractor) findUser(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := i.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewEntityNotFoundError("User", userID)
+	}
+	return user, nil
+}
+
+// findSuiteByTestCase はテストケースが属するテストスイートを取得します
+func (i *EffortInteractor) findSuiteByTestCase(ctx context.Context, testCaseID string) (*entity.TestSuite, error) {
+	testCase, err := i.testCaseRepo.FindByID(ctx, testCaseID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestCaseNotFoundError(testCaseID)
+	}
+
+	group, err := i.testGroupRepo.FindByID(ctx, testCase.GroupID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestGroupNotFoundError(testCase.GroupID)
+	}
+
+	suite, err := i.testSuiteRepo.FindByID(ctx, group.SuiteID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestSuiteNotFoundError(group.SuiteID)
+	}
+
+	return suite, nil
+}
+
+// publishEffortRecorded は工数記録・訂正のドメインイベントを発行します
+// actorIDには記録者または訂正者のIDを指定します
+func (i *EffortInteractor) publishEffortRecorded(ctx context.Context, suiteID string, record *entity.EffortRecord, actorID string) {
+	e := event.New(event.EffortRecorded, suiteID, record.ID, actorID)
+	e.TestCaseID = record.TestCaseID
+	publishEvent(ctx, i.publisher, e)
+}
+
+// validateEffortComment はスイートでコメントが必須の場合に、コメントの有無を検証します
+func validateEffortComment(suite *entity.TestSuite, comment string) error {
+	if suite.RequireEffortComment && strings.TrimSpace(comment) == "" {
+		return errors.NewDomainValidationError("このテストスイートでは工数記録にコメントが必須です", map[string]string{
+			"comment": "コメントは必須です",
+		})
+	}
+
+	return nil
+}
+
+// newEffortRecordResponseDTO はエンティティからレスポンスDTOを作成します
+func newEffortRecordResponseDTO(record *entity.EffortRecord) *dto.EffortRecordResponseDTO {
+	return &dto.EffortRecordResponseDTO{
+		ID:           record.ID,
+		TestCaseID:   record.TestCaseID,
+		RecordDate:   record.RecordDate,
+		EffortAmount: record.EffortAmount,
+		IsAdditional: record.IsAdditional,
+		Comment:      record.Comment,
+		RecordedBy:   record.RecordedBy,
+		CreatedAt:    record.CreatedAt,
+	}
+}
+
+// newEffortRecordListResponseDTO はエンティティ一覧から合計工数付きの一覧DTOを作成します
+func newEffortRecordListResponseDTO(records []*entity.EffortRecord) *dto.EffortRecordListResponseDTO {
+	result := &dto.EffortRecordListResponseDTO{
+		EffortRecords: make([]*dto.EffortRecordResponseDTO, len(records)),
+	}
+	for j, record := range records {
+		result.EffortRecords[j] = newEffortRecordResponseDTO(record)
+		result.TotalEffort += record.EffortAmount
+	}
+	return result
+}
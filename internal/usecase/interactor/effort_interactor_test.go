@@ -0,0 +1,338 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEffortRecordRepository はテスト用のモックリポジトリ
+type MockEffortRecordRepository struct {
+	mock.Mock
+}
+
+func (m *MockEffortRecordRepository) Create(ctx context.Context, record *entity.EffortRecord) error {
+	args := m.Called(ctx, record)
+	return args.Error(0)
+}
+
+func (m *MockEffortRecordRepository) FindByID(ctx context.Context, id string) (*entity.EffortRecord, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.EffortRecord), args.Error(1)
+}
+
+func (m *MockEffortRecordRepository) Update(ctx context.Context, record *entity.EffortRecord) error {
+	args := m.Called(ctx, record)
+	return args.Error(0)
+}
+
+func (m *MockEffortRecordRepository) FindByTestCaseID(ctx context.Context, testCaseID string) ([]*entity.EffortRecord, error) {
+	args := m.Called(ctx, testCaseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.EffortRecord), args.Error(1)
+}
+
+func (m *MockEffortRecordRepository) FindByRecorderAndDate(ctx context.Context, recordedBy string, recordDate time.Time) ([]*entity.EffortRecord, error) {
+	args := m.Called(ctx, recordedBy, recordDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.EffortRecord), args.Error(1)
+}
+
+// MockUserRepository はテスト用のモックリポジトリ
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) UpdateLastLogin(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) FindAll(ctx context.Context) ([]*entity.User, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) CountByRole(ctx context.Context, role entity.UserRole) (int, error) {
+	args := m.Called(ctx, role)
+	return args.Int(0), args.Error(1)
+}
+
+// effortTestMocks は工数記録のテストで使用するモック一式
+type effortTestMocks struct {
+	effortRepo *MockEffortRecordRepository
+	suiteRepo  *MockTestSuiteRepository
+	groupRepo  *MockTestGroupRepository
+	caseRepo   *MockTestCaseRepository
+	userRepo   *MockUserRepository
+}
+
+func newEffortTestMocks() *effortTestMocks {
+	return &effortTestMocks{
+		effortRepo: new(MockEffortRecordRepository),
+		suiteRepo:  new(MockTestSuiteRepository),
+		groupRepo:  new(MockTestGroupRepository),
+		caseRepo:   new(MockTestCaseRepository),
+		userRepo:   new(MockUserRepository),
+	}
+}
+
+// setupHierarchy はテストケースからスイートまでの取得をモックします
+func (m *effortTestMocks) setupHierarchy(requireComment bool) {
+	m.caseRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:      "TS001TG01TC001-202501",
+		GroupID: "TS001TG01-202501",
+	}, nil)
+	m.groupRepo.On("FindByID", mock.Anything, "TS001TG01-202501").Return(&entity.TestGroup{
+		ID:      "TS001TG01-202501",
+		SuiteID: "TS001-202501",
+	}, nil)
+	m.suiteRepo.On("FindByID", mock.Anything, "TS001-202501").Return(&entity.TestSuite{
+		ID:                   "TS001-202501",
+		RequireEffortComment: requireComment,
+	}, nil)
+}
+
+func TestRecordEffort(t *testing.T) {
+	recordDate := time.Date(2025, 1, 15, 18, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*effortTestMocks)
+		input         *dto.EffortRecordCreateDTO
+		expectedError string
+	}{
+		{
+			name: "正常系：工数を記録し実績工数に加算する",
+			setupMock: func(m *effortTestMocks) {
+				m.userRepo.On("FindByID", mock.Anything, "user-1").Return(&entity.User{ID: "user-1", Role: entity.RoleTester}, nil)
+				m.setupHierarchy(false)
+				m.effortRepo.On("Create", mock.Anything, mock.MatchedBy(func(r *entity.EffortRecord) bool {
+					return r.RecordDate.Equal(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)) && r.RecordedBy == "user-1"
+				})).Run(func(args mock.Arguments) {
+					args.Get(1).(*entity.EffortRecord).SetIDFromInt(1)
+				}).Return(nil)
+				m.caseRepo.On("AddEffort", mock.Anything, "TS001TG01TC001-202501", 1.5).Return(nil)
+			},
+			input: &dto.EffortRecordCreateDTO{
+				TestCaseID:   "TS001TG01TC001-202501",
+				RecordDate:   recordDate,
+				EffortAmount: 1.5,
+				RecordedBy:   "user-1",
+			},
+		},
+		{
+			name:      "異常系：工数が0以下",
+			setupMock: func(m *effortTestMocks) {},
+			input: &dto.EffortRecordCreateDTO{
+				TestCaseID:   "TS001TG01TC001-202501",
+				EffortAmount: 0,
+				RecordedBy:   "user-1",
+			},
+			expectedError: "VALIDATION_ERROR",
+		},
+		{
+			name: "異常系：コメント必須のスイートでコメントがない",
+			setupMock: func(m *effortTestMocks) {
+				m.userRepo.On("FindByID", mock.Anything, "user-1").Return(&entity.User{ID: "user-1", Role: entity.RoleTester}, nil)
+				m.setupHierarchy(true)
+			},
+			input: &dto.EffortRecordCreateDTO{
+				TestCaseID:   "TS001TG01TC001-202501",
+				RecordDate:   recordDate,
+				EffortAmount: 1.5,
+				Comment:      "  ",
+				RecordedBy:   "user-1",
+			},
+			expectedError: "VALIDATION_ERROR",
+		},
+		{
+			name: "異常系：テストケースが存在しない",
+			setupMock: func(m *effortTestMocks) {
+				m.userRepo.On("FindByID", mock.Anything, "user-1").Return(&entity.User{ID: "user-1", Role: entity.RoleTester}, nil)
+				m.caseRepo.On("FindByID", mock.Anything, "TS999TG01TC001-202501").Return(nil, errors.NewNotFoundError("TestCase", "TS999TG01TC001-202501"))
+			},
+			input: &dto.EffortRecordCreateDTO{
+				TestCaseID:   "TS999TG01TC001-202501",
+				EffortAmount: 1.5,
+				RecordedBy:   "user-1",
+			},
+			expectedError: "NOT_FOUND",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newEffortTestMocks()
+			tc.setupMock(m)
+
+			interactor := NewEffortInteractor(m.effortRepo, m.suiteRepo, m.groupRepo, m.caseRepo, m.userRepo, nil, nil)
+
+			result, err := interactor.RecordEffort(context.Background(), tc.input)
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				m.effortRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				m.caseRepo.AssertNotCalled(t, "AddEffort", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "1", result.ID)
+				assert.Equal(t, tc.input.EffortAmount, result.EffortAmount)
+			}
+
+			m.effortRepo.AssertExpectations(t)
+			m.caseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCorrectEffortRecord(t *testing.T) {
+	newRecord := func() *entity.EffortRecord {
+		return &entity.EffortRecord{
+			ID:           "1",
+			TestCaseID:   "TS001TG01TC001-202501",
+			EffortAmount: 2.0,
+			Comment:      "初回記録",
+			RecordedBy:   "user-1",
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*effortTestMocks)
+		correctedBy   string
+		amount        float64
+		expectedError string
+	}{
+		{
+			name: "正常系：記録者本人が訂正し差分を反映する",
+			setupMock: func(m *effortTestMocks) {
+				m.effortRepo.On("FindByID", mock.Anything, "1").Return(newRecord(), nil)
+				m.userRepo.On("FindByID", mock.Anything, "user-1").Return(&entity.User{ID: "user-1", Role: entity.RoleTester}, nil)
+				m.setupHierarchy(false)
+				m.effortRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+				m.caseRepo.On("AddEffort", mock.Anything, "TS001TG01TC001-202501", -0.5).Return(nil)
+			},
+			correctedBy: "user-1",
+			amount:      1.5,
+		},
+		{
+			name: "正常系：Managerは他のユーザーの記録を訂正できる",
+			setupMock: func(m *effortTestMocks) {
+				m.effortRepo.On("FindByID", mock.Anything, "1").Return(newRecord(), nil)
+				m.userRepo.On("FindByID", mock.Anything, "manager-1").Return(&entity.User{ID: "manager-1", Role: entity.RoleManager}, nil)
+				m.setupHierarchy(false)
+				m.effortRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+				m.caseRepo.On("AddEffort", mock.Anything, "TS001TG01TC001-202501", 1.0).Return(nil)
+			},
+			correctedBy: "manager-1",
+			amount:      3.0,
+		},
+		{
+			name: "異常系：他のTesterの記録は訂正できない",
+			setupMock: func(m *effortTestMocks) {
+				m.effortRepo.On("FindByID", mock.Anything, "1").Return(newRecord(), nil)
+				m.userRepo.On("FindByID", mock.Anything, "user-2").Return(&entity.User{ID: "user-2", Role: entity.RoleTester}, nil)
+			},
+			correctedBy:   "user-2",
+			amount:        3.0,
+			expectedError: "PERMISSION_ERROR",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newEffortTestMocks()
+			tc.setupMock(m)
+
+			interactor := NewEffortInteractor(m.effortRepo, m.suiteRepo, m.groupRepo, m.caseRepo, m.userRepo, nil, nil)
+
+			result, err := interactor.CorrectEffortRecord(context.Background(), "1", &dto.EffortRecordCorrectDTO{
+				EffortAmount: tc.amount,
+				Comment:      "訂正",
+				CorrectedBy:  tc.correctedBy,
+			})
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				m.effortRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.amount, result.EffortAmount)
+				assert.Equal(t, "訂正", result.Comment)
+			}
+
+			m.effortRepo.AssertExpectations(t)
+			m.caseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetEffortRecordsByUserAndDate(t *testing.T) {
+	m := newEffortTestMocks()
+	date := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	m.effortRepo.On("FindByRecorderAndDate", mock.Anything, "user-1", date).Return([]*entity.EffortRecord{
+		{ID: "1", TestCaseID: "TS001TG01TC001-202501", EffortAmount: 1.5, RecordedBy: "user-1", RecordDate: date},
+		{ID: "2", TestCaseID: "TS001TG01TC002-202501", EffortAmount: 2.0, RecordedBy: "user-1", RecordDate: date},
+	}, nil)
+
+	interactor := NewEffortInteractor(m.effortRepo, m.suiteRepo, m.groupRepo, m.caseRepo, m.userRepo, nil, nil)
+
+	result, err := interactor.GetEffortRecordsByUserAndDate(context.Background(), "user-1", date.Add(10*time.Hour))
+
+	assert.NoError(t, err)
+	assert.Len(t, result.EffortRecords, 2)
+	assert.Equal(t, 3.5, result.TotalEffort)
+	m.effortRepo.AssertExpectations(t)
+}
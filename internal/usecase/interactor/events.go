@@ -0,0 +1,16 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+)
+
+// publishEvent はパブリッシャーが設定されている場合にドメインイベントを発行します
+// イベントの発行は永続化が成功した後に行い、発行の失敗はユースケースの結果に影響させません
+func publishEvent(ctx context.Context, publisher event.Publisher, e *event.Event) {
+	if publisher == nil {
+		return
+	}
+	publisher.Publish(ctx, e)
+}
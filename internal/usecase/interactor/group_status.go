@@ -0,0 +1,59 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// syncGroupStatus はグループ配下のテストケースからグループのステータスを再計算し、変更があった場合に保存します
+// ステータスが変わったグループを返し、固定されている場合や変更がない場合はnilを返します
+// テストケースの変更と同じトランザクションの中で呼び出してください
+// グループを更新用に読み込んでから算出するため、同じグループのケースを変更する他のトランザクションとは直列に算出されます
+func syncGroupStatus(ctx context.Context, groupRepo repository.TestGroupRepository, caseRepo repository.TestCaseRepository, groupID string) (*entity.TestGroup, error) {
+	group, err := groupRepo.FindByIDForUpdate(ctx, groupID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestGroupNotFoundError(groupID)
+	}
+	if group.StatusLocked {
+		return nil, nil
+	}
+
+	cases, err := caseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("テストグループのステータス算出に失敗しました", err)
+	}
+	if !group.RecalculateStatus(cases) {
+		return nil, nil
+	}
+
+	if err := groupRepo.UpdateStatus(ctx, group.ID, group.Status); err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("テストグループのステータス更新に失敗しました", err)
+	}
+	group.Version++ // リポジトリでのステータス更新に合わせてバージョンを進める
+
+	return group, nil
+}
+
+// publishGroupStatusChanged はステータスが再計算されたグループの更新イベントを発行します
+// nilのグループは無視するため、syncGroupStatusの結果をそのまま渡せます
+func publishGroupStatusChanged(ctx context.Context, publisher event.Publisher, actorID string, groups ...*entity.TestGroup) {
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		publishEvent(ctx, publisher, event.New(event.TestGroupUpdated, group.SuiteID, group.ID, actorID))
+	}
+}
@@ -2,9 +2,11 @@ package interactor
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
 	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
@@ -12,15 +14,40 @@ import (
 
 // TestCaseInteractor はテストケースのユースケース実装
 type TestCaseInteractor struct {
-	testCaseRepo repository.TestCaseRepository
-	idGenerator  repository.TestCaseIDGenerator
+	testCaseRepo      repository.TestCaseRepository
+	testGroupRepo     repository.TestGroupRepository
+	statusHistoryRepo repository.StatusHistoryRepository
+	userRepo          repository.UserRepository
+	idGenerator       repository.TestCaseIDGenerator
+	publisher         event.Publisher
+	txManager         repository.TransactionManager
+	lockLease         time.Duration
 }
 
 // NewTestCaseInteractor は新しいTestCaseInteractorを作成します
-func NewTestCaseInteractor(testCaseRepo repository.TestCaseRepository, idGenerator repository.TestCaseIDGenerator) *TestCaseInteractor {
+// グループのリポジトリは移動先グループの存在確認に、
+// ステータス変更履歴のリポジトリはステータス遷移の監査記録に、
+// ユーザーのリポジトリは編集ロックの強制解除の権限確認に使用します
+// publisherがnilの場合、ドメインイベントは発行しません
+// txManagerがnilの場合、ステータス更新と変更履歴の記録はトランザクションにまとめられません
+func NewTestCaseInteractor(
+	testCaseRepo repository.TestCaseRepository,
+	testGroupRepo repository.TestGroupRepository,
+	statusHistoryRepo repository.StatusHistoryRepository,
+	userRepo repository.UserRepository,
+	idGenerator repository.TestCaseIDGenerator,
+	publisher event.Publisher,
+	txManager repository.TransactionManager,
+) *TestCaseInteractor {
 	return &TestCaseInteractor{
-		testCaseRepo: testCaseRepo,
-		idGenerator:  idGenerator,
+		testCaseRepo:      testCaseRepo,
+		testGroupRepo:     testGroupRepo,
+		statusHistoryRepo: statusHistoryRepo,
+		userRepo:          userRepo,
+		idGenerator:       idGenerator,
+		publisher:         publisher,
+		txManager:         txManager,
+		lockLease:         entity.DefaultLockLease,
 	}
 }
 
@@ -35,20 +62,22 @@ func (i *TestCaseInteractor) GetCasesByGroupID(ctx context.Context, groupID stri
 	// エンティティからDTOに変換
 	result := make([]*dto.TestCaseResponseDTO, len(cases))
 	for j, tc := range cases {
-		result[j] = &dto.TestCaseResponseDTO{
-			ID:            tc.ID,
-			GroupID:       tc.GroupID,
-			Title:         tc.Title,
-			Description:   tc.Description,
-			Status:        string(tc.Status),
-			Priority:      string(tc.Priority),
-			PlannedEffort: tc.PlannedEffort,
-			ActualEffort:  tc.ActualEffort,
-			IsDelayed:     tc.IsDelayed,
-			DelayDays:     tc.DelayDays,
-			CreatedAt:     tc.CreatedAt,
-			UpdatedAt:     tc.UpdatedAt,
-		}
+		result[j] = newTestCaseResponseDTO(tc)
+	}
+
+	return result, nil
+}
+
+// GetCasesByGroupIDs は複数のグループに属するケースをグループIDごとにまとめて取得する
+func (i *TestCaseInteractor) GetCasesByGroupIDs(ctx context.Context, groupIDs []string) (map[string][]*dto.TestCaseResponseDTO, error) {
+	cases, err := i.testCaseRepo.FindByGroupIDs(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]*dto.TestCaseResponseDTO, len(groupIDs))
+	for _, tc := range cases {
+		result[tc.GroupID] = append(result[tc.GroupID], newTestCaseResponseDTO(tc))
 	}
 
 	return result, nil
@@ -79,6 +108,12 @@ func (i *TestCaseInteractor) CreateTestCase(ctx context.Context, createDTO *dto.
 		priority = entity.PriorityMedium // デフォルト値
 	}
 
+	// 個別の期限（未指定の場合はスイートの終了予定日を期限とする）
+	var dueDate time.Time
+	if createDTO.DueDate != nil {
+		dueDate = *createDTO.DueDate
+	}
+
 	// エンティティの作成
 	now := time.Now()
 	testCase := &entity.TestCase{
@@ -90,36 +125,521 @@ func (i *TestCaseInteractor) CreateTestCase(ctx context.Context, createDTO *dto.
 		Priority:      priority,
 		PlannedEffort: createDTO.PlannedEffort,
 		ActualEffort:  0, // 新規作成時は0
+		DueDate:       dueDate,
 		IsDelayed:     false,
 		DelayDays:     0,
 		CurrentEditor: "",
 		IsLocked:      false,
+		Version:       entity.InitialVersion,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}
 
-	// リポジトリに保存
-	err = i.testCaseRepo.Create(ctx, testCase)
+	// リポジトリに保存し、グループのステータスに反映
+	var group *entity.TestGroup
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		if err := i.testCaseRepo.Create(ctx, testCase); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストケースの作成に失敗しました", err)
+		}
+
+		var err error
+		group, err = syncGroupStatus(ctx, i.testGroupRepo, i.testCaseRepo, testCase.GroupID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.publishCaseEvent(ctx, event.TestCaseCreated, testCase, "")
+	publishGroupStatusChanged(ctx, i.publisher, "", group)
+
+	// レスポンスDTOの作成
+	return newTestCaseResponseDTO(testCase), nil
+}
+
+// GetTestCase は指定されたIDのテストケースを取得します
+func (i *TestCaseInteractor) GetTestCase(ctx context.Context, id string) (*dto.TestCaseResponseDTO, error) {
+	testCase, err := i.findTestCase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTestCaseResponseDTO(testCase), nil
+}
+
+// UpdateTestCase はテストケースのタイトル、説明、優先度、予定工数を更新します
+// ステータスはUpdateTestCaseStatusで遷移ルールに従って更新します
+// 他のユーザーが編集ロックを保持している場合は競合エラーとなります
+func (i *TestCaseInteractor) UpdateTestCase(ctx context.Context, id string, updateDTO *dto.TestCaseUpdateDTO) (*dto.TestCaseResponseDTO, error) {
+	testCase, err := i.findTestCase(ctx, id)
 	if err != nil {
+		return nil, err
+	}
+	if err := checkEditLock(testCase, updateDTO.UpdatedBy); err != nil {
+		return nil, err
+	}
+	if err := checkExpectedVersion("TestCase", testCase.ID, testCase.Version, updateDTO.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	// 更新が存在する場合のみ値を更新
+	if updateDTO.Title != nil {
+		if *updateDTO.Title == "" {
+			return nil, errors.NewDomainValidationError("タイトルは必須です", nil)
+		}
+		testCase.Title = *updateDTO.Title
+	}
+	if updateDTO.Description != nil {
+		testCase.Description = *updateDTO.Description
+	}
+	if updateDTO.Priority != nil {
+		priority := entity.Priority(*updateDTO.Priority)
+		if !priority.IsValid() {
+			return nil, errors.NewDomainValidationError("無効な優先度です", map[string]string{
+				"priority": *updateDTO.Priority,
+			})
+		}
+		testCase.Priority = priority
+	}
+	if updateDTO.PlannedEffort != nil {
+		if *updateDTO.PlannedEffort < 0 {
+			return nil, errors.NewDomainValidationError("予定工数は0以上である必要があります", map[string]string{
+				"plannedEffort": "予定工数は0以上である必要があります",
+			})
+		}
+		testCase.PlannedEffort = *updateDTO.PlannedEffort
+	}
+	if updateDTO.DueDate != nil {
+		testCase.DueDate = *updateDTO.DueDate
+	}
+	testCase.UpdatedAt = time.Now()
+
+	// 読み込み後に他のユーザーがロックを取得した場合も更新しないよう、ロックの保持者を更新の条件とする
+	if err := i.testCaseRepo.UpdateAsEditor(ctx, testCase, updateDTO.UpdatedBy); err != nil {
 		if errors.IsDomainError(err) {
 			return nil, err
 		}
-		return nil, errors.NewSystemError("テストケースの作成に失敗しました", err)
+		return nil, errors.NewSystemError("テストケースの更新に失敗しました", err)
+	}
+
+	i.publishCaseEvent(ctx, event.TestCaseUpdated, testCase, "")
+
+	return newTestCaseResponseDTO(testCase), nil
+}
+
+// DeleteTestCase は指定されたIDのテストケースをゴミ箱に移動します
+// 他のユーザーが編集ロックを保持している場合は競合エラーとなります
+func (i *TestCaseInteractor) DeleteTestCase(ctx context.Context, id string, deletedBy string) error {
+	testCase, err := i.findTestCase(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkEditLock(testCase, deletedBy); err != nil {
+		return err
+	}
+
+	var group *entity.TestGroup
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		if err := i.testCaseRepo.SoftDelete(ctx, id, time.Now()); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストケースの削除に失敗しました", err)
+		}
+
+		var err error
+		group, err = syncGroupStatus(ctx, i.testGroupRepo, i.testCaseRepo, testCase.GroupID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	i.publishCaseEvent(ctx, event.TestCaseDeleted, testCase, deletedBy)
+	publishGroupStatusChanged(ctx, i.publisher, deletedBy, group)
+
+	return nil
+}
+
+// MoveTestCase はテストケースを別のグループに移動します
+// 他のユーザーが編集ロックを保持している場合は競合エラーとなります
+func (i *TestCaseInteractor) MoveTestCase(ctx context.Context, id string, targetGroupID string, movedBy string) (*dto.TestCaseResponseDTO, error) {
+	if targetGroupID == "" {
+		return nil, errors.NewDomainValidationError("移動先のグループIDは必須です", nil)
+	}
+
+	testCase, err := i.findTestCase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkEditLock(testCase, movedBy); err != nil {
+		return nil, err
+	}
+
+	// 移動先グループの存在確認
+	if _, err := i.testGroupRepo.FindByID(ctx, targetGroupID); err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestGroupNotFoundError(targetGroupID)
+	}
+
+	// 同じグループへの移動は何もしない
+	if testCase.GroupID == targetGroupID {
+		return newTestCaseResponseDTO(testCase), nil
+	}
+
+	sourceGroupID := testCase.GroupID
+	testCase.MoveToGroup(targetGroupID)
+
+	// 移動元と移動先の両方のグループのステータスに反映
+	// 逆方向の移動と互いにロックを待ち合わないよう、グループはID順に更新用に読み込む
+	groupIDs := []string{sourceGroupID, targetGroupID}
+	sort.Strings(groupIDs)
+	var changedGroups []*entity.TestGroup
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		if err := i.testCaseRepo.UpdateAsEditor(ctx, testCase, movedBy); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストケースの移動に失敗しました", err)
+		}
+
+		for _, groupID := range groupIDs {
+			group, err := syncGroupStatus(ctx, i.testGroupRepo, i.testCaseRepo, groupID)
+			if err != nil {
+				return err
+			}
+			changedGroups = append(changedGroups, group)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.publishCaseEvent(ctx, event.TestCaseUpdated, testCase, movedBy)
+	publishGroupStatusChanged(ctx, i.publisher, movedBy, changedGroups...)
+
+	return newTestCaseResponseDTO(testCase), nil
+}
+
+// findTestCase はテストケースを取得し、見つからない場合はドメインエラーを返します
+func (i *TestCaseInteractor) findTestCase(ctx context.Context, id string) (*entity.TestCase, error) {
+	testCase, err := i.testCaseRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestCaseNotFoundError(id)
+	}
+	return testCase, nil
+}
+
+// UpdateTestCaseStatus はステータス遷移ルールに従ってテストケースのステータスを更新し、変更履歴を記録します
+// 他のユーザーが編集ロックを保持している場合や、読み込み後に他の更新が行われていた場合は競合エラーとなります
+func (i *TestCaseInteractor) UpdateTestCaseStatus(ctx context.Context, id string, statusDTO *dto.TestCaseStatusUpdateDTO) (*dto.TestCaseResponseDTO, error) {
+	// 入力検証
+	if statusDTO.ChangedBy == "" {
+		return nil, errors.NewDomainValidationError("変更者は必須です", nil)
+	}
+	newStatus := entity.TestStatus(statusDTO.Status)
+	if !newStatus.IsValid() {
+		return nil, errors.NewDomainValidationError("無効なステータス値です", map[string]string{
+			"status": statusDTO.Status,
+		})
+	}
+
+	// 既存のテストケースを取得
+	testCase, err := i.findTestCase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkEditLock(testCase, statusDTO.ChangedBy); err != nil {
+		return nil, err
+	}
+	if err := checkExpectedVersion("TestCase", testCase.ID, testCase.Version, statusDTO.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	// ステータス遷移の検証と変更
+	currentStatus := testCase.Status
+	history := testCase.TransitionStatus(newStatus, statusDTO.ChangedBy, statusDTO.Reason)
+	if history == nil {
+		return nil, errors.NewStatusTransitionConflictError(
+			"このステータスへの変更は許可されていません",
+			string(currentStatus),
+			string(newStatus),
+		)
+	}
+
+	// ステータス、変更履歴、遅延状態、グループのステータスの更新を1つのトランザクションで実行
+	var group *entity.TestGroup
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		// 読み込み時のステータス・バージョンのままの場合のみ更新する
+		if err := i.testCaseRepo.UpdateStatus(ctx, testCase.ID, currentStatus, testCase.Status, testCase.Version); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストケースのステータス更新に失敗しました", err)
+		}
+
+		// 変更履歴の記録
+		if err := i.statusHistoryRepo.Create(ctx, history); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("ステータス変更履歴の記録に失敗しました", err)
+		}
+
+		// 完了したテストケースは遅延検出ジョブを待たずに遅延を解除する
+		if testCase.Status == entity.TestStatusCompleted && testCase.IsDelayed {
+			if err := i.testCaseRepo.UpdateDelay(ctx, testCase.ID, testCase.Status, false, 0); err != nil {
+				if errors.IsDomainError(err) {
+					return err
+				}
+				return errors.NewSystemError("テストケースの遅延状態の更新に失敗しました", err)
+			}
+		}
+
+		// 応答・イベントにはリポジトリが更新した後のバージョンを使用する
+		var err error
+		if testCase, err = i.findTestCase(ctx, testCase.ID); err != nil {
+			return err
+		}
+		group, err = syncGroupStatus(ctx, i.testGroupRepo, i.testCaseRepo, testCase.GroupID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	i.publishCaseEvent(ctx, event.TestCaseStatusChanged, testCase, statusDTO.ChangedBy)
+	publishGroupStatusChanged(ctx, i.publisher, statusDTO.ChangedBy, group)
+
+	return newTestCaseResponseDTO(testCase), nil
+}
+
+// GetStatusHistory は指定されたテストケースのステータス変更履歴を古い順に取得します
+func (i *TestCaseInteractor) GetStatusHistory(ctx context.Context, testCaseID string) ([]*dto.StatusHistoryResponseDTO, error) {
+	histories, err := i.statusHistoryRepo.FindByTestCaseID(ctx, testCaseID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("ステータス変更履歴の取得に失敗しました", err)
+	}
+
+	result := make([]*dto.StatusHistoryResponseDTO, len(histories))
+	for j, history := range histories {
+		result[j] = &dto.StatusHistoryResponseDTO{
+			ID:         history.ID,
+			TestCaseID: history.TestCaseID,
+			OldStatus:  string(history.OldStatus),
+			NewStatus:  string(history.NewStatus),
+			ChangedAt:  history.ChangedAt,
+			ChangedBy:  history.ChangedBy,
+			Reason:     history.Reason,
+		}
+	}
+
+	return result, nil
+}
+
+// publishCaseEvent はテストケースが属するスイートを特定してドメインイベントを発行します
+// スイートを特定できない場合はイベントを発行しません
+func (i *TestCaseInteractor) publishCaseEvent(ctx context.Context, eventType event.Type, testCase *entity.TestCase, actorID string) {
+	if i.publisher == nil {
+		return
+	}
+
+	group, err := i.testGroupRepo.FindByID(ctx, testCase.GroupID)
+	if err != nil {
+		return
+	}
+
+	publishEvent(ctx, i.publisher, event.New(eventType, group.SuiteID, testCase.ID, actorID))
+}
+
+// LockTestCase は指定されたテストケースの編集ロックを取得します
+// 自身が保持しているロックに対して呼び出した場合はリース期限を延長します
+func (i *TestCaseInteractor) LockTestCase(ctx context.Context, id string, editor string) (*dto.TestCaseResponseDTO, error) {
+	if editor == "" {
+		return nil, errors.NewDomainValidationError("編集者は必須です", nil)
+	}
+
+	testCase, err := i.findTestCase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkEditLock(testCase, editor); err != nil {
+		return nil, err
+	}
+
+	wasLocked := testCase.LockHolder(time.Now()) == editor
+	if err := i.acquireLock(ctx, testCase, editor); err != nil {
+		return nil, err
+	}
+
+	if !wasLocked {
+		i.publishCaseEvent(ctx, event.TestCaseUpdated, testCase, editor)
+	}
+
+	return newTestCaseResponseDTO(testCase), nil
+}
+
+// RenewTestCaseLock は保持している編集ロックのリース期限を延長します
+// リース期限が切れたロックは延長できないため、再度LockTestCaseで取得する必要があります
+func (i *TestCaseInteractor) RenewTestCaseLock(ctx context.Context, id string, editor string) (*dto.TestCaseResponseDTO, error) {
+	if editor == "" {
+		return nil, errors.NewDomainValidationError("編集者は必須です", nil)
+	}
+
+	testCase, err := i.findTestCase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if testCase.LockHolder(time.Now()) != editor {
+		return nil, errors.NewDomainConflictError("TestCase", id, "編集ロックを保持していないか、リース期限が切れています")
+	}
+
+	if err := i.acquireLock(ctx, testCase, editor); err != nil {
+		return nil, err
+	}
+
+	return newTestCaseResponseDTO(testCase), nil
+}
+
+// UnlockTestCase は保持している編集ロックを解除します
+// 有効なロックが存在しない場合は何もしません
+func (i *TestCaseInteractor) UnlockTestCase(ctx context.Context, id string, editor string) (*dto.TestCaseResponseDTO, error) {
+	testCase, err := i.findTestCase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !testCase.IsLockActive(time.Now()) {
+		return newTestCaseResponseDTO(testCase), nil
+	}
+	if err := checkEditLock(testCase, editor); err != nil {
+		return nil, err
+	}
+
+	if err := i.releaseLock(ctx, testCase, editor); err != nil {
+		return nil, err
+	}
+
+	i.publishCaseEvent(ctx, event.TestCaseUpdated, testCase, editor)
+
+	return newTestCaseResponseDTO(testCase), nil
+}
+
+// ForceUnlockTestCase は他のユーザーが保持する編集ロックを強制解除します
+// AdminまたはManagerのみ実行できます
+func (i *TestCaseInteractor) ForceUnlockTestCase(ctx context.Context, id string, userID string) (*dto.TestCaseResponseDTO, error) {
+	user, err := i.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewEntityNotFoundError("User", userID)
+	}
+	if !user.CanForceUnlockTestCase() {
+		return nil, errors.NewDomainForbiddenError(user.ID, "TestCase", "force_unlock")
+	}
+
+	testCase, err := i.findTestCase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.releaseLock(ctx, testCase, ""); err != nil {
+		return nil, err
+	}
+
+	i.publishCaseEvent(ctx, event.TestCaseUpdated, testCase, user.ID)
+
+	return newTestCaseResponseDTO(testCase), nil
+}
+
+// acquireLock はリポジトリで編集ロックを取得し、エンティティに反映します
+// 取得までの間に他のユーザーがロックを取得した場合は競合エラーとなります
+func (i *TestCaseInteractor) acquireLock(ctx context.Context, testCase *entity.TestCase, editor string) error {
+	expiresAt := time.Now().Add(i.lockLease)
+
+	acquired, err := i.testCaseRepo.AcquireLock(ctx, testCase.ID, editor, expiresAt)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return err
+		}
+		return errors.NewSystemError("編集ロックの取得に失敗しました", err)
+	}
+	if !acquired {
+		return errors.NewEditLockConflictError("TestCase", testCase.ID, "", time.Time{})
+	}
+
+	testCase.Lock(editor, expiresAt)
+	return nil
+}
+
+// releaseLock はリポジトリで編集ロックを解除し、エンティティに反映します
+func (i *TestCaseInteractor) releaseLock(ctx context.Context, testCase *entity.TestCase, editor string) error {
+	if err := i.testCaseRepo.ReleaseLock(ctx, testCase.ID, editor); err != nil {
+		if errors.IsDomainError(err) {
+			return err
+		}
+		return errors.NewSystemError("編集ロックの解除に失敗しました", err)
+	}
+
+	testCase.Unlock()
+	return nil
+}
+
+// checkEditLock は指定されたユーザーがテストケースを編集できるかを確認します
+// 他のユーザーが有効な編集ロックを保持している場合は競合エラーを返します
+func checkEditLock(testCase *entity.TestCase, editor string) error {
+	if testCase.CanBeEditedBy(editor, time.Now()) {
+		return nil
+	}
+	return errors.NewEditLockConflictError("TestCase", testCase.ID, testCase.CurrentEditor, testCase.LockExpiresAt)
+}
+
+// newTestCaseResponseDTO はエンティティからレスポンスDTOを作成します
+func newTestCaseResponseDTO(tc *entity.TestCase) *dto.TestCaseResponseDTO {
+	// 期限切れのロックは応答に含めない
+	now := time.Now()
+	var lockExpiresAt *time.Time
+	if tc.IsLockActive(now) {
+		expiresAt := tc.LockExpiresAt
+		lockExpiresAt = &expiresAt
+	}
+
+	var dueDate *time.Time
+	if !tc.DueDate.IsZero() {
+		d := tc.DueDate
+		dueDate = &d
 	}
 
-	// レスポンスDTOの作成
 	return &dto.TestCaseResponseDTO{
-		ID:            testCase.ID,
-		GroupID:       testCase.GroupID,
-		Title:         testCase.Title,
-		Description:   testCase.Description,
-		Status:        string(testCase.Status),
-		Priority:      string(testCase.Priority),
-		PlannedEffort: testCase.PlannedEffort,
-		ActualEffort:  testCase.ActualEffort,
-		IsDelayed:     testCase.IsDelayed,
-		DelayDays:     testCase.DelayDays,
-		CreatedAt:     testCase.CreatedAt,
-		UpdatedAt:     testCase.UpdatedAt,
-	}, nil
+		ID:            tc.ID,
+		GroupID:       tc.GroupID,
+		Title:         tc.Title,
+		Description:   tc.Description,
+		Status:        string(tc.Status),
+		Priority:      string(tc.Priority),
+		PlannedEffort: tc.PlannedEffort,
+		ActualEffort:  tc.ActualEffort,
+		DueDate:       dueDate,
+		IsDelayed:     tc.IsDelayed,
+		DelayDays:     tc.DelayDays,
+		LockedBy:      tc.LockHolder(now),
+		LockExpiresAt: lockExpiresAt,
+		Version:       tc.Version,
+		DeletedAt:     deletedAtPtr(tc.DeletedAt),
+		CreatedAt:     tc.CreatedAt,
+		UpdatedAt:     tc.UpdatedAt,
+	}
 }
@@ -7,6 +7,9 @@ import (
 	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -34,6 +37,11 @@ func (m *MockTestCaseRepository) Update(ctx context.Context, testCase *entity.Te
 	return args.Error(0)
 }
 
+func (m *MockTestCaseRepository) UpdateAsEditor(ctx context.Context, testCase *entity.TestCase, editor string) error {
+	args := m.Called(ctx, testCase, editor)
+	return args.Error(0)
+}
+
 func (m *MockTestCaseRepository) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -47,8 +55,16 @@ func (m *MockTestCaseRepository) FindByGroupID(ctx context.Context, groupID stri
 	return args.Get(0).([]*entity.TestCase), args.Error(1)
 }
 
-func (m *MockTestCaseRepository) UpdateStatus(ctx context.Context, id string, status entity.TestStatus) error {
-	args := m.Called(ctx, id, status)
+func (m *MockTestCaseRepository) FindByGroupIDs(ctx context.Context, groupIDs []string) ([]*entity.TestCase, error) {
+	args := m.Called(ctx, groupIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TestCase), args.Error(1)
+}
+
+func (m *MockTestCaseRepository) UpdateStatus(ctx context.Context, id string, from, to entity.TestStatus, expectedVersion int) error {
+	args := m.Called(ctx, id, from, to, expectedVersion)
 	return args.Error(0)
 }
 
@@ -57,6 +73,11 @@ func (m *MockTestCaseRepository) AddEffort(ctx context.Context, id string, effor
 	return args.Error(0)
 }
 
+func (m *MockTestCaseRepository) UpdateDelay(ctx context.Context, id string, status entity.TestStatus, isDelayed bool, delayDays int) error {
+	args := m.Called(ctx, id, status, isDelayed, delayDays)
+	return args.Error(0)
+}
+
 func (m *MockTestCaseRepository) FindByStatus(ctx context.Context, status entity.TestStatus) ([]*entity.TestCase, error) {
 	args := m.Called(ctx, status)
 	if args.Get(0) == nil {
@@ -65,6 +86,57 @@ func (m *MockTestCaseRepository) FindByStatus(ctx context.Context, status entity
 	return args.Get(0).([]*entity.TestCase), args.Error(1)
 }
 
+func (m *MockTestCaseRepository) AcquireLock(ctx context.Context, id, editor string, expiresAt time.Time) (bool, error) {
+	args := m.Called(ctx, id, editor, expiresAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTestCaseRepository) ReleaseLock(ctx context.Context, id, editor string) error {
+	args := m.Called(ctx, id, editor)
+	return args.Error(0)
+}
+
+func (m *MockTestCaseRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	args := m.Called(ctx, id, deletedAt)
+	return args.Error(0)
+}
+
+func (m *MockTestCaseRepository) Restore(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTestCaseRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestCase, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TestCase), args.Error(1)
+}
+
+func (m *MockTestCaseRepository) FindDeleted(ctx context.Context) ([]*entity.TestCase, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TestCase), args.Error(1)
+}
+
+func (m *MockTestCaseRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	args := m.Called(ctx, before)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTestCaseRepository) SoftDeleteByGroupID(ctx context.Context, parentID string, deletedAt time.Time) error {
+	args := m.Called(ctx, parentID, deletedAt)
+	return args.Error(0)
+}
+
+func (m *MockTestCaseRepository) RestoreByGroupID(ctx context.Context, parentID string, deletedAt time.Time) error {
+	args := m.Called(ctx, parentID, deletedAt)
+	return args.Error(0)
+}
+
 // MockTestCaseIDGenerator はテスト用のモックIDジェネレーター
 type MockTestCaseIDGenerator struct {
 	mock.Mock
@@ -75,6 +147,24 @@ func (m *MockTestCaseIDGenerator) GenerateID(groupID string) (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+// MockStatusHistoryRepository はテスト用のモックリポジトリ
+type MockStatusHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockStatusHistoryRepository) Create(ctx context.Context, history *entity.StatusHistory) error {
+	args := m.Called(ctx, history)
+	return args.Error(0)
+}
+
+func (m *MockStatusHistoryRepository) FindByTestCaseID(ctx context.Context, testCaseID string) ([]*entity.StatusHistory, error) {
+	args := m.Called(ctx, testCaseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.StatusHistory), args.Error(1)
+}
+
 func TestGetCasesByGroupID(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -149,7 +239,7 @@ func TestGetCasesByGroupID(t *testing.T) {
 			tc.setupMock(mockRepo)
 
 			// インタラクターの作成
-			interactor := NewTestCaseInteractor(mockRepo, mockIDGen)
+			interactor := NewTestCaseInteractor(mockRepo, new(MockTestGroupRepository), new(MockStatusHistoryRepository), new(MockUserRepository), mockIDGen, nil, nil)
 
 			// テスト実行
 			cases, err := interactor.GetCasesByGroupID(context.Background(), tc.groupID)
@@ -173,3 +263,678 @@ func TestGetCasesByGroupID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCasesByGroupIDs(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	groupIDs := []string{"TS001TG01-202501", "TS001TG02-202501", "TS001TG03-202501"}
+	mockRepo.On("FindByGroupIDs", mock.Anything, groupIDs).Return([]*entity.TestCase{
+		{ID: "TS001TG01TC001-202501", GroupID: "TS001TG01-202501", Title: "ログイン", Status: "完了", Priority: "高"},
+		{ID: "TS001TG01TC002-202501", GroupID: "TS001TG01-202501", Title: "ログアウト", Status: "作成", Priority: "中"},
+		{ID: "TS001TG02TC001-202501", GroupID: "TS001TG02-202501", Title: "検索", Status: "テスト", Priority: "低"},
+	}, nil)
+
+	interactor := NewTestCaseInteractor(mockRepo, new(MockTestGroupRepository), new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+	cases, err := interactor.GetCasesByGroupIDs(context.Background(), groupIDs)
+
+	assert.NoError(t, err)
+	assert.Len(t, cases["TS001TG01-202501"], 2)
+	assert.Equal(t, "ログアウト", cases["TS001TG01-202501"][1].Title)
+	assert.Len(t, cases["TS001TG02-202501"], 1)
+	assert.Empty(t, cases["TS001TG03-202501"])
+	mockRepo.AssertExpectations(t)
+
+	t.Run("リポジトリのエラーはそのまま返す", func(t *testing.T) {
+		failing := new(MockTestCaseRepository)
+		failing.On("FindByGroupIDs", mock.Anything, groupIDs).Return(nil, fmt.Errorf("repository error"))
+
+		interactor := NewTestCaseInteractor(failing, new(MockTestGroupRepository), new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+		cases, err := interactor.GetCasesByGroupIDs(context.Background(), groupIDs)
+
+		assert.Error(t, err)
+		assert.Nil(t, cases)
+	})
+}
+
+func TestUpdateTestCaseStatus(t *testing.T) {
+	testCases := []struct {
+		name          string
+		currentStatus entity.TestStatus
+		newStatus     string
+		setupMock     func(*MockTestCaseRepository, *MockStatusHistoryRepository)
+		expectedError string
+	}{
+		{
+			name:          "正常系：レビュー中から完了へ遷移し履歴を記録する",
+			currentStatus: entity.TestStatusReviewing,
+			newStatus:     string(entity.TestStatusCompleted),
+			setupMock: func(r *MockTestCaseRepository, h *MockStatusHistoryRepository) {
+				r.On("UpdateStatus", mock.Anything, "TS001TG01TC001-202501", entity.TestStatusReviewing, entity.TestStatusCompleted, mock.Anything).Return(nil)
+				h.On("Create", mock.Anything, mock.MatchedBy(func(history *entity.StatusHistory) bool {
+					return history.OldStatus == entity.TestStatusReviewing &&
+						history.NewStatus == entity.TestStatusCompleted &&
+						history.ChangedBy == "user-1" &&
+						history.Reason == "レビュー承認"
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "異常系：許可されていない遷移",
+			currentStatus: entity.TestStatusCreated,
+			newStatus:     string(entity.TestStatusCompleted),
+			setupMock:     func(r *MockTestCaseRepository, h *MockStatusHistoryRepository) {},
+			expectedError: "CONFLICT",
+		},
+		{
+			name:          "異常系：無効なステータス値",
+			currentStatus: entity.TestStatusCreated,
+			newStatus:     "不明",
+			setupMock:     func(r *MockTestCaseRepository, h *MockStatusHistoryRepository) {},
+			expectedError: "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestCaseRepository)
+			mockHistoryRepo := new(MockStatusHistoryRepository)
+			mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+				ID:      "TS001TG01TC001-202501",
+				GroupID: "TS001TG01-202501",
+				Status:  tc.currentStatus,
+			}, nil).Maybe()
+			tc.setupMock(mockRepo, mockHistoryRepo)
+
+			// グループのステータスの再計算はTestUpdateTestCaseStatus_SyncsGroupStatusで検証する
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockGroupRepo.On("FindByIDForUpdate", mock.Anything, "TS001TG01-202501").Return(&entity.TestGroup{
+				ID:           "TS001TG01-202501",
+				StatusLocked: true,
+			}, nil).Maybe()
+
+			interactor := NewTestCaseInteractor(mockRepo, mockGroupRepo, mockHistoryRepo, new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+			result, err := interactor.UpdateTestCaseStatus(context.Background(), "TS001TG01TC001-202501", &dto.TestCaseStatusUpdateDTO{
+				Status:    tc.newStatus,
+				ChangedBy: "user-1",
+				Reason:    "レビュー承認",
+			})
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				mockHistoryRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.newStatus, result.Status)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockHistoryRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// MockEventPublisher はテスト用のモックイベントパブリッシャー
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockEventPublisher) Publish(ctx context.Context, e *event.Event) {
+	m.Called(ctx, e)
+}
+
+func TestUpdateTestCaseStatus_PublishesEvent(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	mockGroupRepo := new(MockTestGroupRepository)
+	mockHistoryRepo := new(MockStatusHistoryRepository)
+	mockPublisher := new(MockEventPublisher)
+
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:      "TS001TG01TC001-202501",
+		GroupID: "TS001TG01-202501",
+		Status:  entity.TestStatusCreated,
+	}, nil)
+	mockRepo.On("UpdateStatus", mock.Anything, "TS001TG01TC001-202501", entity.TestStatusCreated, entity.TestStatusTesting, mock.Anything).Return(nil)
+	mockHistoryRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	group := &entity.TestGroup{
+		ID:      "TS001TG01-202501",
+		SuiteID: "TS001-202501",
+		Status:  valueobject.SuiteStatusInProgress,
+	}
+	mockGroupRepo.On("FindByIDForUpdate", mock.Anything, "TS001TG01-202501").Return(group, nil)
+	mockGroupRepo.On("FindByID", mock.Anything, "TS001TG01-202501").Return(group, nil)
+	mockRepo.On("FindByGroupID", mock.Anything, "TS001TG01-202501").Return([]*entity.TestCase{
+		{ID: "TS001TG01TC001-202501", GroupID: "TS001TG01-202501", Status: entity.TestStatusTesting},
+	}, nil)
+	mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+		return e.Type == event.TestCaseStatusChanged &&
+			e.SuiteID == "TS001-202501" &&
+			e.EntityID == "TS001TG01TC001-202501" &&
+			e.ActorID == "user-1"
+	})).Once()
+
+	interactor := NewTestCaseInteractor(mockRepo, mockGroupRepo, mockHistoryRepo, new(MockUserRepository), new(MockTestCaseIDGenerator), mockPublisher, nil)
+
+	_, err := interactor.UpdateTestCaseStatus(context.Background(), "TS001TG01TC001-202501", &dto.TestCaseStatusUpdateDTO{
+		Status:    string(entity.TestStatusTesting),
+		ChangedBy: "user-1",
+	})
+
+	assert.NoError(t, err)
+	mockPublisher.AssertExpectations(t)
+}
+
+// MockTransactionManager はトランザクション管理のモック
+// RunInTransactionはtxContextKeyを格納したcontextでfnを実行し、fnの結果をそのまま返す
+type MockTransactionManager struct {
+	mock.Mock
+}
+
+type txContextKey struct{}
+
+func (m *MockTransactionManager) RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.Called(ctx)
+	return fn(context.WithValue(ctx, txContextKey{}, true))
+}
+
+// inTransaction はcontextがMockTransactionManagerのトランザクション内のものかを判定する
+func inTransaction(ctx context.Context) bool {
+	return ctx.Value(txContextKey{}) != nil
+}
+
+func TestUpdateTestCaseStatus_RunsInTransaction(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	mockHistoryRepo := new(MockStatusHistoryRepository)
+	mockPublisher := new(MockEventPublisher)
+	mockTxManager := new(MockTransactionManager)
+
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:      "TS001TG01TC001-202501",
+		GroupID: "TS001TG01-202501",
+		Status:  entity.TestStatusCreated,
+		Version: 1,
+	}, nil)
+	mockRepo.On("UpdateStatus", mock.MatchedBy(inTransaction), "TS001TG01TC001-202501", entity.TestStatusCreated, entity.TestStatusTesting, mock.Anything).Return(nil)
+	mockHistoryRepo.On("Create", mock.MatchedBy(inTransaction), mock.Anything).Return(fmt.Errorf("history insert failed"))
+	mockTxManager.On("RunInTransaction", mock.Anything).Once()
+
+	interactor := NewTestCaseInteractor(mockRepo, new(MockTestGroupRepository), mockHistoryRepo, new(MockUserRepository), new(MockTestCaseIDGenerator), mockPublisher, mockTxManager)
+
+	result, err := interactor.UpdateTestCaseStatus(context.Background(), "TS001TG01TC001-202501", &dto.TestCaseStatusUpdateDTO{
+		Status:    string(entity.TestStatusTesting),
+		ChangedBy: "user-1",
+	})
+
+	// 履歴の記録に失敗した場合はトランザクション全体が失敗し、イベントは発行されない
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+	mockHistoryRepo.AssertExpectations(t)
+	mockTxManager.AssertExpectations(t)
+	mockPublisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
+
+func TestUpdateTestCaseStatus_SyncsGroupStatus(t *testing.T) {
+	testCases := []struct {
+		name          string
+		group         *entity.TestGroup
+		otherStatus   entity.TestStatus
+		expectedGroup valueobject.SuiteStatus
+		expectUpdate  bool
+	}{
+		{
+			name:          "正常系：最初のケースが開始されるとグループは実行中になる",
+			group:         &entity.TestGroup{ID: "TS001TG01-202501", SuiteID: "TS001-202501", Status: valueobject.SuiteStatusPreparation, Version: 2},
+			otherStatus:   entity.TestStatusCreated,
+			expectedGroup: valueobject.SuiteStatusInProgress,
+			expectUpdate:  true,
+		},
+		{
+			name:          "正常系：ステータスが変わらない場合は更新しない",
+			group:         &entity.TestGroup{ID: "TS001TG01-202501", SuiteID: "TS001-202501", Status: valueobject.SuiteStatusInProgress, Version: 2},
+			otherStatus:   entity.TestStatusFixing,
+			expectedGroup: valueobject.SuiteStatusInProgress,
+		},
+		{
+			name:          "正常系：ステータスを固定したグループは再計算しない",
+			group:         &entity.TestGroup{ID: "TS001TG01-202501", SuiteID: "TS001-202501", Status: valueobject.SuiteStatusSuspended, StatusLocked: true, Version: 2},
+			expectedGroup: valueobject.SuiteStatusSuspended,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestCaseRepository)
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockHistoryRepo := new(MockStatusHistoryRepository)
+			mockPublisher := new(MockEventPublisher)
+
+			mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+				ID:      "TS001TG01TC001-202501",
+				GroupID: "TS001TG01-202501",
+				Status:  entity.TestStatusCreated,
+			}, nil)
+			mockRepo.On("UpdateStatus", mock.Anything, "TS001TG01TC001-202501", entity.TestStatusCreated, entity.TestStatusTesting, mock.Anything).Return(nil)
+			mockHistoryRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+			mockGroupRepo.On("FindByIDForUpdate", mock.Anything, "TS001TG01-202501").Return(tc.group, nil)
+			mockGroupRepo.On("FindByID", mock.Anything, "TS001TG01-202501").Return(tc.group, nil)
+			if !tc.group.StatusLocked {
+				mockRepo.On("FindByGroupID", mock.Anything, "TS001TG01-202501").Return([]*entity.TestCase{
+					{ID: "TS001TG01TC001-202501", GroupID: "TS001TG01-202501", Status: entity.TestStatusTesting},
+					{ID: "TS001TG01TC002-202501", GroupID: "TS001TG01-202501", Status: tc.otherStatus},
+				}, nil)
+			}
+			mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+				return e.Type == event.TestCaseStatusChanged
+			})).Once()
+			if tc.expectUpdate {
+				mockGroupRepo.On("UpdateStatus", mock.Anything, "TS001TG01-202501", tc.expectedGroup).Return(nil)
+				mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+					return e.Type == event.TestGroupUpdated &&
+						e.EntityID == "TS001TG01-202501" &&
+						e.ActorID == "user-1"
+				})).Once()
+			}
+
+			interactor := NewTestCaseInteractor(mockRepo, mockGroupRepo, mockHistoryRepo, new(MockUserRepository), new(MockTestCaseIDGenerator), mockPublisher, nil)
+
+			_, err := interactor.UpdateTestCaseStatus(context.Background(), "TS001TG01TC001-202501", &dto.TestCaseStatusUpdateDTO{
+				Status:    string(entity.TestStatusTesting),
+				ChangedBy: "user-1",
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedGroup, tc.group.Status)
+			if !tc.expectUpdate {
+				mockGroupRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+			}
+			mockRepo.AssertExpectations(t)
+			mockGroupRepo.AssertExpectations(t)
+			mockPublisher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMoveTestCase(t *testing.T) {
+	testCases := []struct {
+		name          string
+		targetGroupID string
+		setupMock     func(*MockTestCaseRepository, *MockTestGroupRepository)
+		expectedError string
+	}{
+		{
+			name:          "正常系：別のグループに移動する",
+			targetGroupID: "TS001TG02-202501",
+			setupMock: func(r *MockTestCaseRepository, g *MockTestGroupRepository) {
+				target := &entity.TestGroup{
+					ID:     "TS001TG02-202501",
+					Status: valueobject.SuiteStatusPreparation,
+				}
+				g.On("FindByID", mock.Anything, "TS001TG02-202501").Return(target, nil)
+				g.On("FindByIDForUpdate", mock.Anything, "TS001TG02-202501").Return(target, nil)
+				r.On("UpdateAsEditor", mock.Anything, mock.MatchedBy(func(tc *entity.TestCase) bool {
+					return tc.GroupID == "TS001TG02-202501"
+				}), "user-1").Return(nil)
+				// 移動元はステータスを固定しているため再計算しない
+				g.On("FindByIDForUpdate", mock.Anything, "TS001TG01-202501").Return(&entity.TestGroup{
+					ID:           "TS001TG01-202501",
+					StatusLocked: true,
+				}, nil)
+				r.On("FindByGroupID", mock.Anything, "TS001TG02-202501").Return([]*entity.TestCase{
+					{ID: "TS001TG01TC001-202501", GroupID: "TS001TG02-202501", Status: entity.TestStatusCreated},
+				}, nil)
+			},
+		},
+		{
+			name:          "異常系：移動先のグループが存在しない",
+			targetGroupID: "TS001TG99-202501",
+			setupMock: func(r *MockTestCaseRepository, g *MockTestGroupRepository) {
+				g.On("FindByID", mock.Anything, "TS001TG99-202501").Return(nil, fmt.Errorf("not found"))
+			},
+			expectedError: "NOT_FOUND",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestCaseRepository)
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+				ID:      "TS001TG01TC001-202501",
+				GroupID: "TS001TG01-202501",
+				Status:  entity.TestStatusCreated,
+			}, nil)
+			tc.setupMock(mockRepo, mockGroupRepo)
+
+			interactor := NewTestCaseInteractor(mockRepo, mockGroupRepo, new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+			result, err := interactor.MoveTestCase(context.Background(), "TS001TG01TC001-202501", tc.targetGroupID, "user-1")
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				mockRepo.AssertNotCalled(t, "UpdateAsEditor", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.targetGroupID, result.GroupID)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockGroupRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLockTestCase(t *testing.T) {
+	lockedUntil := time.Now().Add(time.Minute)
+
+	testCases := []struct {
+		name          string
+		current       *entity.TestCase
+		editor        string
+		setupMock     func(*MockTestCaseRepository)
+		expectedError string
+	}{
+		{
+			name:    "正常系：ロックされていないケースのロックを取得",
+			current: &entity.TestCase{ID: "TS001TG01TC001-202501"},
+			editor:  "user-1",
+			setupMock: func(r *MockTestCaseRepository) {
+				r.On("AcquireLock", mock.Anything, "TS001TG01TC001-202501", "user-1", mock.AnythingOfType("time.Time")).Return(true, nil)
+			},
+		},
+		{
+			name: "正常系：自身が保持するロックを延長",
+			current: &entity.TestCase{
+				ID: "TS001TG01TC001-202501", IsLocked: true, CurrentEditor: "user-1", LockExpiresAt: lockedUntil,
+			},
+			editor: "user-1",
+			setupMock: func(r *MockTestCaseRepository) {
+				r.On("AcquireLock", mock.Anything, "TS001TG01TC001-202501", "user-1", mock.MatchedBy(func(expiresAt time.Time) bool {
+					return expiresAt.After(lockedUntil)
+				})).Return(true, nil)
+			},
+		},
+		{
+			name: "正常系：期限切れのロックは他のユーザーが取得できる",
+			current: &entity.TestCase{
+				ID: "TS001TG01TC001-202501", IsLocked: true, CurrentEditor: "user-2", LockExpiresAt: time.Now().Add(-time.Second),
+			},
+			editor: "user-1",
+			setupMock: func(r *MockTestCaseRepository) {
+				r.On("AcquireLock", mock.Anything, "TS001TG01TC001-202501", "user-1", mock.AnythingOfType("time.Time")).Return(true, nil)
+			},
+		},
+		{
+			name: "異常系：他のユーザーがロック中",
+			current: &entity.TestCase{
+				ID: "TS001TG01TC001-202501", IsLocked: true, CurrentEditor: "user-2", LockExpiresAt: lockedUntil,
+			},
+			editor:        "user-1",
+			setupMock:     func(r *MockTestCaseRepository) {},
+			expectedError: "CONFLICT",
+		},
+		{
+			name:    "異常系：取得までの間に他のユーザーがロックを取得",
+			current: &entity.TestCase{ID: "TS001TG01TC001-202501"},
+			editor:  "user-1",
+			setupMock: func(r *MockTestCaseRepository) {
+				r.On("AcquireLock", mock.Anything, "TS001TG01TC001-202501", "user-1", mock.AnythingOfType("time.Time")).Return(false, nil)
+			},
+			expectedError: "CONFLICT",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestCaseRepository)
+			mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(tc.current, nil)
+			tc.setupMock(mockRepo)
+
+			interactor := NewTestCaseInteractor(mockRepo, new(MockTestGroupRepository), new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+			result, err := interactor.LockTestCase(context.Background(), "TS001TG01TC001-202501", tc.editor)
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.editor, result.LockedBy)
+				assert.NotNil(t, result.LockExpiresAt)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateTestCase_RejectsNonLockHolder(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:            "TS001TG01TC001-202501",
+		Title:         "ログイン",
+		IsLocked:      true,
+		CurrentEditor: "user-2",
+		LockExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+
+	interactor := NewTestCaseInteractor(mockRepo, new(MockTestGroupRepository), new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+	title := "ログイン（更新）"
+	result, err := interactor.UpdateTestCase(context.Background(), "TS001TG01TC001-202501", &dto.TestCaseUpdateDTO{
+		Title:     &title,
+		UpdatedBy: "user-1",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "CONFLICT")
+	mockRepo.AssertNotCalled(t, "UpdateAsEditor", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeleteTestCase_RejectsNonLockHolder(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:            "TS001TG01TC001-202501",
+		GroupID:       "TS001TG01-202501",
+		IsLocked:      true,
+		CurrentEditor: "user-2",
+		LockExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+
+	interactor := NewTestCaseInteractor(mockRepo, new(MockTestGroupRepository), new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+	err := interactor.DeleteTestCase(context.Background(), "TS001TG01TC001-202501", "user-1")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "CONFLICT")
+	mockRepo.AssertNotCalled(t, "SoftDelete", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeleteTestCase_AllowsLockHolder(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	mockGroupRepo := new(MockTestGroupRepository)
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:            "TS001TG01TC001-202501",
+		GroupID:       "TS001TG01-202501",
+		IsLocked:      true,
+		CurrentEditor: "user-1",
+		LockExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+	mockRepo.On("SoftDelete", mock.Anything, "TS001TG01TC001-202501", mock.Anything).Return(nil)
+	// グループはステータスを固定しているため再計算しない
+	mockGroupRepo.On("FindByIDForUpdate", mock.Anything, "TS001TG01-202501").Return(&entity.TestGroup{
+		ID:           "TS001TG01-202501",
+		StatusLocked: true,
+	}, nil)
+
+	interactor := NewTestCaseInteractor(mockRepo, mockGroupRepo, new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+	err := interactor.DeleteTestCase(context.Background(), "TS001TG01TC001-202501", "user-1")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockGroupRepo.AssertExpectations(t)
+}
+
+func TestMoveTestCase_RejectsNonLockHolder(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	mockGroupRepo := new(MockTestGroupRepository)
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:            "TS001TG01TC001-202501",
+		GroupID:       "TS001TG01-202501",
+		IsLocked:      true,
+		CurrentEditor: "user-2",
+		LockExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+
+	interactor := NewTestCaseInteractor(mockRepo, mockGroupRepo, new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+	result, err := interactor.MoveTestCase(context.Background(), "TS001TG01TC001-202501", "TS001TG02-202501", "user-1")
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "CONFLICT")
+	mockRepo.AssertNotCalled(t, "UpdateAsEditor", mock.Anything, mock.Anything, mock.Anything)
+	mockGroupRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestForceUnlockTestCase(t *testing.T) {
+	testCases := []struct {
+		name          string
+		role          entity.UserRole
+		expectedError string
+	}{
+		{name: "正常系：Managerは強制解除できる", role: entity.RoleManager},
+		{name: "正常系：Adminは強制解除できる", role: entity.RoleAdmin},
+		{name: "異常系：Testerは強制解除できない", role: entity.RoleTester, expectedError: "PERMISSION_ERROR"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestCaseRepository)
+			mockUserRepo := new(MockUserRepository)
+			mockUserRepo.On("FindByID", mock.Anything, "user-9").Return(&entity.User{ID: "user-9", Role: tc.role}, nil)
+			mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+				ID:            "TS001TG01TC001-202501",
+				IsLocked:      true,
+				CurrentEditor: "user-2",
+				LockExpiresAt: time.Now().Add(time.Minute),
+			}, nil).Maybe()
+			if tc.expectedError == "" {
+				mockRepo.On("ReleaseLock", mock.Anything, "TS001TG01TC001-202501", "").Return(nil)
+			}
+
+			interactor := NewTestCaseInteractor(mockRepo, new(MockTestGroupRepository), new(MockStatusHistoryRepository), mockUserRepo, new(MockTestCaseIDGenerator), nil, nil)
+
+			result, err := interactor.ForceUnlockTestCase(context.Background(), "TS001TG01TC001-202501", "user-9")
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				mockRepo.AssertNotCalled(t, "ReleaseLock", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "", result.LockedBy)
+				assert.Nil(t, result.LockExpiresAt)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateTestCaseStatus_ClearsDelayOnCompletion(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	mockHistoryRepo := new(MockStatusHistoryRepository)
+
+	mockGroupRepo := new(MockTestGroupRepository)
+
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:        "TS001TG01TC001-202501",
+		GroupID:   "TS001TG01-202501",
+		Status:    entity.TestStatusReviewing,
+		IsDelayed: true,
+		DelayDays: 4,
+		Version:   3,
+	}, nil).Once()
+	// 更新後は遅延が解除された状態を読み直す
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:      "TS001TG01TC001-202501",
+		GroupID: "TS001TG01-202501",
+		Status:  entity.TestStatusCompleted,
+		Version: 4,
+	}, nil).Once()
+	mockRepo.On("UpdateStatus", mock.Anything, "TS001TG01TC001-202501", entity.TestStatusReviewing, entity.TestStatusCompleted, 3).Return(nil)
+	mockRepo.On("UpdateDelay", mock.Anything, "TS001TG01TC001-202501", entity.TestStatusCompleted, false, 0).Return(nil)
+	mockHistoryRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.StatusHistory")).Return(nil)
+	mockGroupRepo.On("FindByIDForUpdate", mock.Anything, "TS001TG01-202501").Return(&entity.TestGroup{
+		ID:           "TS001TG01-202501",
+		StatusLocked: true,
+	}, nil)
+
+	interactor := NewTestCaseInteractor(mockRepo, mockGroupRepo, mockHistoryRepo, new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+	result, err := interactor.UpdateTestCaseStatus(context.Background(), "TS001TG01TC001-202501", &dto.TestCaseStatusUpdateDTO{
+		Status:    string(entity.TestStatusCompleted),
+		ChangedBy: "user-1",
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, result.IsDelayed)
+	assert.Equal(t, 0, result.DelayDays)
+	assert.Equal(t, 4, result.Version)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateTestCaseStatus_VersionMismatch(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:      "TS001TG01TC001-202501",
+		GroupID: "TS001TG01-202501",
+		Status:  entity.TestStatusCreated,
+		Version: 3,
+	}, nil)
+
+	interactor := NewTestCaseInteractor(mockRepo, new(MockTestGroupRepository), new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+	expectedVersion := 2
+	result, err := interactor.UpdateTestCaseStatus(context.Background(), "TS001TG01TC001-202501", &dto.TestCaseStatusUpdateDTO{
+		Status:          string(entity.TestStatusTesting),
+		ChangedBy:       "user-1",
+		ExpectedVersion: &expectedVersion,
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "CONFLICT")
+	mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateTestCase_VersionMismatch(t *testing.T) {
+	mockRepo := new(MockTestCaseRepository)
+	mockRepo.On("FindByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:      "TS001TG01TC001-202501",
+		Title:   "ログイン",
+		Version: 5,
+	}, nil)
+
+	interactor := NewTestCaseInteractor(mockRepo, new(MockTestGroupRepository), new(MockStatusHistoryRepository), new(MockUserRepository), new(MockTestCaseIDGenerator), nil, nil)
+
+	title := "ログイン（更新）"
+	result, err := interactor.UpdateTestCase(context.Background(), "TS001TG01TC001-202501", &dto.TestCaseUpdateDTO{
+		Title:           &title,
+		UpdatedBy:       "user-1",
+		ExpectedVersion: intPtr(4),
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "CONFLICT")
+	mockRepo.AssertNotCalled(t, "UpdateAsEditor", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
@@ -14,14 +15,23 @@ import (
 // TestGroupInteractor はテストグループのユースケース実装
 type TestGroupInteractor struct {
 	testGroupRepo repository.TestGroupRepository
+	testCaseRepo  repository.TestCaseRepository
 	idGenerator   repository.TestGroupIDGenerator
+	publisher     event.Publisher
+	txManager     repository.TransactionManager
 }
 
 // NewTestGroupInteractor は新しいTestGroupInteractorを作成します
-func NewTestGroupInteractor(testGroupRepo repository.TestGroupRepository, idGenerator repository.TestGroupIDGenerator) *TestGroupInteractor {
+// テストケースのリポジトリはグループの進捗率の集計に使用します
+// publisherがnilの場合、ドメインイベントは発行しません
+// txManagerがnilの場合、並べ替えや配下のケースを含めた削除は1件ずつ確定します
+func NewTestGroupInteractor(testGroupRepo repository.TestGroupRepository, testCaseRepo repository.TestCaseRepository, idGenerator repository.TestGroupIDGenerator, publisher event.Publisher, txManager repository.TransactionManager) *TestGroupInteractor {
 	return &TestGroupInteractor{
 		testGroupRepo: testGroupRepo,
+		testCaseRepo:  testCaseRepo,
 		idGenerator:   idGenerator,
+		publisher:     publisher,
+		txManager:     txManager,
 	}
 }
 
@@ -36,21 +46,69 @@ func (i *TestGroupInteractor) GetGroupsBySuiteID(ctx context.Context, suiteID st
 	// エンティティからDTOに変換
 	result := make([]*dto.TestGroupResponseDTO, len(groups))
 	for j, group := range groups {
-		result[j] = &dto.TestGroupResponseDTO{
-			ID:           group.ID,
-			SuiteID:      group.SuiteID,
-			Name:         group.Name,
-			Description:  group.Description,
-			DisplayOrder: group.DisplayOrder,
-			Status:       group.Status.String(),
-			CreatedAt:    group.CreatedAt,
-			UpdatedAt:    group.UpdatedAt,
+		responseDTO, err := i.toResponseDTO(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		result[j] = responseDTO
+	}
+
+	return result, nil
+}
+
+// GetGroupsBySuiteIDs は複数のスイートに属するグループをスイートIDごとにまとめて取得する
+// グループと進捗計算に使用するケースをそれぞれ1回の問い合わせで取得します
+func (i *TestGroupInteractor) GetGroupsBySuiteIDs(ctx context.Context, suiteIDs []string) (map[string][]*dto.TestGroupResponseDTO, error) {
+	groups, err := i.testGroupRepo.FindBySuiteIDs(ctx, suiteIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := make([]string, len(groups))
+	for j, group := range groups {
+		groupIDs[j] = group.ID
+	}
+	cases, err := i.testCaseRepo.FindByGroupIDs(ctx, groupIDs)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
 		}
+		return nil, errors.NewSystemError("テストグループの進捗計算に失敗しました", err)
+	}
+	casesByGroup := make(map[string][]*entity.TestCase, len(groups))
+	for _, tc := range cases {
+		casesByGroup[tc.GroupID] = append(casesByGroup[tc.GroupID], tc)
+	}
+
+	result := make(map[string][]*dto.TestGroupResponseDTO, len(suiteIDs))
+	for _, group := range groups {
+		summary := group.GetProgressSummary(casesByGroup[group.ID])
+		result[group.SuiteID] = append(result[group.SuiteID], newTestGroupResponseDTO(group, summary))
 	}
 
 	return result, nil
 }
 
+// newTestGroupResponseDTO はエンティティと進捗サマリーからレスポンスDTOを作成します
+func newTestGroupResponseDTO(group *entity.TestGroup, summary *entity.ProgressSummary) *dto.TestGroupResponseDTO {
+	return &dto.TestGroupResponseDTO{
+		ID:                 group.ID,
+		SuiteID:            group.SuiteID,
+		Name:               group.Name,
+		Description:        group.Description,
+		DisplayOrder:       group.DisplayOrder,
+		Status:             group.Status.String(),
+		StatusLocked:       group.StatusLocked,
+		Progress:           summary.ProgressPercentage,
+		CompletedCaseCount: summary.CompletedCount,
+		TotalCaseCount:     summary.TotalCount,
+		Version:            group.Version,
+		DeletedAt:          deletedAtPtr(group.DeletedAt),
+		CreatedAt:          group.CreatedAt,
+		UpdatedAt:          group.UpdatedAt,
+	}
+}
+
 // CreateTestGroup は新しいテストグループを作成します
 func (i *TestGroupInteractor) CreateTestGroup(ctx context.Context, createDTO *dto.TestGroupCreateDTO) (*dto.TestGroupResponseDTO, error) {
 	// 入力検証
@@ -61,6 +119,19 @@ func (i *TestGroupInteractor) CreateTestGroup(ctx context.Context, createDTO *dt
 		return nil, errors.NewDomainValidationError("グループ名は必須です", nil)
 	}
 
+	// 表示順の指定がない場合は末尾に追加
+	displayOrder := createDTO.DisplayOrder
+	if displayOrder <= 0 {
+		groups, err := i.testGroupRepo.FindBySuiteID(ctx, createDTO.SuiteID)
+		if err != nil {
+			if errors.IsDomainError(err) {
+				return nil, err
+			}
+			return nil, errors.NewSystemError("テストグループ一覧の取得に失敗しました", err)
+		}
+		displayOrder = len(groups) + 1
+	}
+
 	// IDの生成
 	id, err := i.idGenerator.GenerateID(createDTO.SuiteID)
 	if err != nil {
@@ -80,8 +151,9 @@ func (i *TestGroupInteractor) CreateTestGroup(ctx context.Context, createDTO *dt
 		SuiteID:      createDTO.SuiteID,
 		Name:         createDTO.Name,
 		Description:  createDTO.Description,
-		DisplayOrder: createDTO.DisplayOrder,
+		DisplayOrder: displayOrder,
 		Status:       status,
+		Version:      entity.InitialVersion,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}
@@ -95,15 +167,224 @@ func (i *TestGroupInteractor) CreateTestGroup(ctx context.Context, createDTO *dt
 		return nil, errors.NewSystemError("テストグループの作成に失敗しました", err)
 	}
 
-	// レスポンスDTOの作成
-	return &dto.TestGroupResponseDTO{
-		ID:           group.ID,
-		SuiteID:      group.SuiteID,
-		Name:         group.Name,
-		Description:  group.Description,
-		DisplayOrder: group.DisplayOrder,
-		Status:       group.Status.String(),
-		CreatedAt:    group.CreatedAt,
-		UpdatedAt:    group.UpdatedAt,
-	}, nil
+	publishEvent(ctx, i.publisher, event.New(event.TestGroupCreated, group.SuiteID, group.ID, ""))
+
+	// レスポンスDTOの作成（作成直後はケースが存在しないため進捗は0）
+	return newTestGroupResponseDTO(group, &entity.ProgressSummary{}), nil
+}
+
+// GetTestGroup は指定されたIDのテストグループを取得します
+func (i *TestGroupInteractor) GetTestGroup(ctx context.Context, id string) (*dto.TestGroupResponseDTO, error) {
+	group, err := i.findGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.toResponseDTO(ctx, group)
+}
+
+// UpdateTestGroup はテストグループの名前と説明、ステータスの固定を更新します
+// ステータスを指定するとその値に固定され、固定を解除すると配下のケースから再計算されます
+func (i *TestGroupInteractor) UpdateTestGroup(ctx context.Context, id string, updateDTO *dto.TestGroupUpdateDTO) (*dto.TestGroupResponseDTO, error) {
+	group, err := i.findGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkExpectedVersion("TestGroup", group.ID, group.Version, updateDTO.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	// 更新が存在する場合のみ値を更新
+	if updateDTO.Name != nil {
+		if *updateDTO.Name == "" {
+			return nil, errors.NewDomainValidationError("グループ名は必須です", nil)
+		}
+		group.Name = *updateDTO.Name
+	}
+	if updateDTO.Description != nil {
+		group.Description = *updateDTO.Description
+	}
+	if err := i.applyStatusLock(ctx, group, updateDTO); err != nil {
+		return nil, err
+	}
+	group.UpdatedAt = time.Now()
+
+	if err := i.testGroupRepo.Update(ctx, group); err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("テストグループの更新に失敗しました", err)
+	}
+
+	publishEvent(ctx, i.publisher, event.New(event.TestGroupUpdated, group.SuiteID, group.ID, ""))
+
+	return i.toResponseDTO(ctx, group)
+}
+
+// DeleteTestGroup は指定されたIDのテストグループを配下のテストケースとともにゴミ箱に移動します
+func (i *TestGroupInteractor) DeleteTestGroup(ctx context.Context, id string) error {
+	group, err := i.findGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	deletedAt := time.Now()
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		if err := i.testCaseRepo.SoftDeleteByGroupID(ctx, id, deletedAt); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストケースの削除に失敗しました", err)
+		}
+		if err := i.testGroupRepo.SoftDelete(ctx, id, deletedAt); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストグループの削除に失敗しました", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	publishEvent(ctx, i.publisher, event.New(event.TestGroupDeleted, group.SuiteID, group.ID, ""))
+
+	return nil
+}
+
+// ReorderTestGroups はスイート内のグループを指定されたID順に並べ替えます
+// groupIDsにはスイートに属するすべてのグループIDを過不足なく指定する必要があります
+func (i *TestGroupInteractor) ReorderTestGroups(ctx context.Context, suiteID string, groupIDs []string) ([]*dto.TestGroupResponseDTO, error) {
+	groups, err := i.testGroupRepo.FindBySuiteID(ctx, suiteID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("テストグループ一覧の取得に失敗しました", err)
+	}
+
+	// 指定されたIDがスイートのグループと一致するか検証
+	groupsByID := make(map[string]*entity.TestGroup, len(groups))
+	for _, group := range groups {
+		groupsByID[group.ID] = group
+	}
+	if len(groupIDs) != len(groups) {
+		return nil, errors.NewDomainValidationError("スイートに属するすべてのグループを指定してください", map[string]string{
+			"groupIds": "グループ数が一致しません",
+		})
+	}
+
+	ordered := make([]*entity.TestGroup, 0, len(groupIDs))
+	for _, id := range groupIDs {
+		group, ok := groupsByID[id]
+		if !ok {
+			return nil, errors.NewDomainValidationError("スイートに属さないグループ、または重複したグループが指定されています", map[string]string{
+				"groupId": id,
+			})
+		}
+		delete(groupsByID, id)
+		ordered = append(ordered, group)
+	}
+
+	// 表示順が変わるグループのみ更新し、途中で失敗した場合は並べ替え全体を取り消す
+	var changed []int
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		for j, group := range ordered {
+			displayOrder := j + 1
+			if group.DisplayOrder == displayOrder {
+				continue
+			}
+			if err := i.testGroupRepo.UpdateDisplayOrder(ctx, group.ID, displayOrder); err != nil {
+				if errors.IsDomainError(err) {
+					return err
+				}
+				return errors.NewSystemError("テストグループの並べ替えに失敗しました", err)
+			}
+			changed = append(changed, j)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, j := range changed {
+		group := ordered[j]
+		group.UpdateDisplayOrder(j + 1)
+		group.Version++ // リポジトリでの表示順序の更新に合わせてバージョンを進める
+		publishEvent(ctx, i.publisher, event.New(event.TestGroupUpdated, group.SuiteID, group.ID, ""))
+	}
+
+	result := make([]*dto.TestGroupResponseDTO, len(ordered))
+	for j, group := range ordered {
+		responseDTO, err := i.toResponseDTO(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		result[j] = responseDTO
+	}
+
+	return result, nil
+}
+
+// applyStatusLock は更新DTOに従ってグループのステータスを固定または固定解除します
+func (i *TestGroupInteractor) applyStatusLock(ctx context.Context, group *entity.TestGroup, updateDTO *dto.TestGroupUpdateDTO) error {
+	if updateDTO.Status != nil {
+		if updateDTO.StatusLocked != nil && !*updateDTO.StatusLocked {
+			return errors.NewDomainValidationError("ステータスを指定する場合は固定を解除できません", map[string]string{
+				"statusLocked": "ステータスの指定と固定の解除は同時に行えません",
+			})
+		}
+		status, err := valueobject.NewSuiteStatus(*updateDTO.Status)
+		if err != nil {
+			return errors.NewDomainValidationError("無効なステータス値です", map[string]string{
+				"status": *updateDTO.Status,
+			})
+		}
+		group.LockStatus(status)
+		return nil
+	}
+
+	if updateDTO.StatusLocked == nil || *updateDTO.StatusLocked == group.StatusLocked {
+		return nil
+	}
+	if *updateDTO.StatusLocked {
+		group.LockStatus(group.Status)
+		return nil
+	}
+
+	cases, err := i.testCaseRepo.FindByGroupID(ctx, group.ID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return err
+		}
+		return errors.NewSystemError("テストグループのステータス算出に失敗しました", err)
+	}
+	group.UnlockStatus(cases)
+	return nil
+}
+
+// findGroup はテストグループを取得し、見つからない場合はドメインエラーを返します
+func (i *TestGroupInteractor) findGroup(ctx context.Context, id string) (*entity.TestGroup, error) {
+	group, err := i.testGroupRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestGroupNotFoundError(id)
+	}
+	return group, nil
+}
+
+// toResponseDTO はグループ配下のケースから進捗を集計してレスポンスDTOを作成します
+func (i *TestGroupInteractor) toResponseDTO(ctx context.Context, group *entity.TestGroup) (*dto.TestGroupResponseDTO, error) {
+	cases, err := i.testCaseRepo.FindByGroupID(ctx, group.ID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("テストグループの進捗計算に失敗しました", err)
+	}
+	return newTestGroupResponseDTO(group, group.GetProgressSummary(cases)), nil
 }
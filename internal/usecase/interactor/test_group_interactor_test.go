@@ -8,6 +8,7 @@ import (
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,6 +26,14 @@ func (m *MockTestGroupRepository) FindByID(ctx context.Context, id string) (*ent
 	return args.Get(0).(*entity.TestGroup), args.Error(1)
 }
 
+func (m *MockTestGroupRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.TestGroup, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TestGroup), args.Error(1)
+}
+
 func (m *MockTestGroupRepository) Create(ctx context.Context, group *entity.TestGroup) error {
 	args := m.Called(ctx, group)
 	return args.Error(0)
@@ -48,6 +57,14 @@ func (m *MockTestGroupRepository) FindBySuiteID(ctx context.Context, suiteID str
 	return args.Get(0).([]*entity.TestGroup), args.Error(1)
 }
 
+func (m *MockTestGroupRepository) FindBySuiteIDs(ctx context.Context, suiteIDs []string) ([]*entity.TestGroup, error) {
+	args := m.Called(ctx, suiteIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TestGroup), args.Error(1)
+}
+
 func (m *MockTestGroupRepository) UpdateStatus(ctx context.Context, id string, status valueobject.SuiteStatus) error {
 	args := m.Called(ctx, id, status)
 	return args.Error(0)
@@ -58,6 +75,47 @@ func (m *MockTestGroupRepository) UpdateDisplayOrder(ctx context.Context, id str
 	return args.Error(0)
 }
 
+func (m *MockTestGroupRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	args := m.Called(ctx, id, deletedAt)
+	return args.Error(0)
+}
+
+func (m *MockTestGroupRepository) Restore(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTestGroupRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestGroup, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TestGroup), args.Error(1)
+}
+
+func (m *MockTestGroupRepository) FindDeleted(ctx context.Context) ([]*entity.TestGroup, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TestGroup), args.Error(1)
+}
+
+func (m *MockTestGroupRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	args := m.Called(ctx, before)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTestGroupRepository) SoftDeleteBySuiteID(ctx context.Context, parentID string, deletedAt time.Time) error {
+	args := m.Called(ctx, parentID, deletedAt)
+	return args.Error(0)
+}
+
+func (m *MockTestGroupRepository) RestoreBySuiteID(ctx context.Context, parentID string, deletedAt time.Time) error {
+	args := m.Called(ctx, parentID, deletedAt)
+	return args.Error(0)
+}
+
 // MockTestGroupIDGenerator はテスト用のモックIDジェネレーター
 type MockTestGroupIDGenerator struct {
 	mock.Mock
@@ -130,11 +188,13 @@ func TestGetGroupsBySuiteID(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// モックのセットアップ
 			mockRepo := new(MockTestGroupRepository)
+			mockCaseRepo := new(MockTestCaseRepository)
 			mockIDGen := new(MockTestGroupIDGenerator)
 			tc.setupMock(mockRepo)
+			mockCaseRepo.On("FindByGroupID", mock.Anything, mock.Anything).Return([]*entity.TestCase{}, nil).Maybe()
 
 			// インタラクターの作成
-			interactor := NewTestGroupInteractor(mockRepo, mockIDGen)
+			interactor := NewTestGroupInteractor(mockRepo, mockCaseRepo, mockIDGen, nil, nil)
 
 			// テスト実行
 			groups, err := interactor.GetGroupsBySuiteID(context.Background(), tc.suiteID)
@@ -153,3 +213,173 @@ func TestGetGroupsBySuiteID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGroupsBySuiteIDs(t *testing.T) {
+	mockRepo := new(MockTestGroupRepository)
+	mockCaseRepo := new(MockTestCaseRepository)
+	suiteIDs := []string{"TS001-202501", "TS002-202501", "TS003-202501"}
+	mockRepo.On("FindBySuiteIDs", mock.Anything, suiteIDs).Return([]*entity.TestGroup{
+		{ID: "TS001TG01-202501", SuiteID: "TS001-202501", DisplayOrder: 1, Status: valueobject.SuiteStatusInProgress},
+		{ID: "TS001TG02-202501", SuiteID: "TS001-202501", DisplayOrder: 2, Status: valueobject.SuiteStatusPreparation},
+		{ID: "TS002TG01-202501", SuiteID: "TS002-202501", DisplayOrder: 1, Status: valueobject.SuiteStatusPreparation},
+	}, nil)
+	mockCaseRepo.On("FindByGroupIDs", mock.Anything, []string{"TS001TG01-202501", "TS001TG02-202501", "TS002TG01-202501"}).Return([]*entity.TestCase{
+		{ID: "TS001TG01TC001-202501", GroupID: "TS001TG01-202501", Status: "完了", Priority: "中"},
+		{ID: "TS001TG01TC002-202501", GroupID: "TS001TG01-202501", Status: "作成", Priority: "中"},
+	}, nil)
+
+	interactor := NewTestGroupInteractor(mockRepo, mockCaseRepo, new(MockTestGroupIDGenerator), nil, nil)
+	groups, err := interactor.GetGroupsBySuiteIDs(context.Background(), suiteIDs)
+
+	assert.NoError(t, err)
+	assert.Len(t, groups["TS001-202501"], 2)
+	assert.Equal(t, "TS001TG02-202501", groups["TS001-202501"][1].ID)
+	assert.Equal(t, 2, groups["TS001-202501"][0].TotalCaseCount)
+	assert.Equal(t, 1, groups["TS001-202501"][0].CompletedCaseCount)
+	assert.Equal(t, 0, groups["TS001-202501"][1].TotalCaseCount)
+	assert.Len(t, groups["TS002-202501"], 1)
+	assert.Empty(t, groups["TS003-202501"])
+
+	// ケースはスイートごと・グループごとではなく1回だけ取得する
+	mockRepo.AssertNumberOfCalls(t, "FindBySuiteIDs", 1)
+	mockCaseRepo.AssertNumberOfCalls(t, "FindByGroupIDs", 1)
+	mockCaseRepo.AssertNotCalled(t, "FindByGroupID", mock.Anything, mock.Anything)
+}
+
+func TestReorderTestGroups(t *testing.T) {
+	newGroups := func() []*entity.TestGroup {
+		return []*entity.TestGroup{
+			{ID: "TS001TG01-202501", SuiteID: "TS001-202501", DisplayOrder: 1},
+			{ID: "TS001TG02-202501", SuiteID: "TS001-202501", DisplayOrder: 2},
+			{ID: "TS001TG03-202501", SuiteID: "TS001-202501", DisplayOrder: 3},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		groupIDs      []string
+		setupMock     func(*MockTestGroupRepository)
+		expectedOrder []string
+		expectedError bool
+	}{
+		{
+			name:     "正常系：表示順が変わるグループのみ更新する",
+			groupIDs: []string{"TS001TG03-202501", "TS001TG02-202501", "TS001TG01-202501"},
+			setupMock: func(r *MockTestGroupRepository) {
+				r.On("UpdateDisplayOrder", mock.Anything, "TS001TG03-202501", 1).Return(nil)
+				r.On("UpdateDisplayOrder", mock.Anything, "TS001TG01-202501", 3).Return(nil)
+			},
+			expectedOrder: []string{"TS001TG03-202501", "TS001TG02-202501", "TS001TG01-202501"},
+		},
+		{
+			name:          "異常系：グループ数が一致しない",
+			groupIDs:      []string{"TS001TG02-202501", "TS001TG01-202501"},
+			setupMock:     func(r *MockTestGroupRepository) {},
+			expectedError: true,
+		},
+		{
+			name:          "異常系：重複したグループが指定されている",
+			groupIDs:      []string{"TS001TG01-202501", "TS001TG01-202501", "TS001TG02-202501"},
+			setupMock:     func(r *MockTestGroupRepository) {},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestGroupRepository)
+			mockCaseRepo := new(MockTestCaseRepository)
+			mockRepo.On("FindBySuiteID", mock.Anything, "TS001-202501").Return(newGroups(), nil)
+			mockCaseRepo.On("FindByGroupID", mock.Anything, mock.Anything).Return([]*entity.TestCase{}, nil).Maybe()
+			tc.setupMock(mockRepo)
+
+			interactor := NewTestGroupInteractor(mockRepo, mockCaseRepo, new(MockTestGroupIDGenerator), nil, nil)
+
+			groups, err := interactor.ReorderTestGroups(context.Background(), "TS001-202501", tc.groupIDs)
+
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, groups)
+				mockRepo.AssertNotCalled(t, "UpdateDisplayOrder", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				for j, id := range tc.expectedOrder {
+					assert.Equal(t, id, groups[j].ID)
+					assert.Equal(t, j+1, groups[j].DisplayOrder)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateTestGroup_StatusLock(t *testing.T) {
+	suspended := valueobject.SuiteStatusSuspended.String()
+	unlocked := false
+
+	testCases := []struct {
+		name           string
+		locked         bool
+		updateDTO      *dto.TestGroupUpdateDTO
+		expectedStatus string
+		expectedLocked bool
+		expectedError  string
+	}{
+		{
+			name:           "正常系：ステータスを指定すると固定される",
+			updateDTO:      &dto.TestGroupUpdateDTO{Status: &suspended},
+			expectedStatus: suspended,
+			expectedLocked: true,
+		},
+		{
+			name:           "正常系：固定を解除するとケースから再計算する",
+			locked:         true,
+			updateDTO:      &dto.TestGroupUpdateDTO{StatusLocked: &unlocked},
+			expectedStatus: valueobject.SuiteStatusCompleted.String(),
+		},
+		{
+			name:          "異常系：ステータスの指定と固定の解除は同時に行えない",
+			updateDTO:     &dto.TestGroupUpdateDTO{Status: &suspended, StatusLocked: &unlocked},
+			expectedError: "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestGroupRepository)
+			mockCaseRepo := new(MockTestCaseRepository)
+			mockRepo.On("FindByID", mock.Anything, "TS001TG01-202501").Return(&entity.TestGroup{
+				ID:           "TS001TG01-202501",
+				SuiteID:      "TS001-202501",
+				Status:       valueobject.SuiteStatusInProgress,
+				StatusLocked: tc.locked,
+			}, nil)
+			mockCaseRepo.On("FindByGroupID", mock.Anything, "TS001TG01-202501").Return([]*entity.TestCase{
+				{ID: "TS001TG01TC001-202501", GroupID: "TS001TG01-202501", Status: entity.TestStatusCompleted},
+			}, nil).Maybe()
+			if tc.expectedError == "" {
+				mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(g *entity.TestGroup) bool {
+					return g.Status.String() == tc.expectedStatus && g.StatusLocked == tc.expectedLocked
+				})).Return(nil)
+			}
+
+			interactor := NewTestGroupInteractor(mockRepo, mockCaseRepo, new(MockTestGroupIDGenerator), nil, nil)
+
+			result, err := interactor.UpdateTestGroup(context.Background(), "TS001TG01-202501", tc.updateDTO)
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, result.Status)
+				assert.Equal(t, tc.expectedLocked, result.StatusLocked)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -2,10 +2,11 @@ package interactor
 
 import (
 	"context"
-	"fmt"
+	"strings"
 	"time"
 
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
@@ -14,22 +15,62 @@ import (
 
 // TestSuiteInteractor はテストスイートのユースケースを実装します
 type TestSuiteInteractor struct {
-	repository  repository.TestSuiteRepository
-	idGenerator repository.TestSuiteIDGenerator
+	repository      repository.TestSuiteRepository
+	groupRepository repository.TestGroupRepository
+	caseRepository  repository.TestCaseRepository
+	userRepository  repository.UserRepository
+	idGenerator     repository.TestSuiteIDGenerator
+	publisher       event.Publisher
+	txManager       repository.TransactionManager
 }
 
 // NewTestSuiteInteractor は新しいTestSuiteInteractorを作成します
-func NewTestSuiteInteractor(repo repository.TestSuiteRepository, idGenerator repository.TestSuiteIDGenerator) *TestSuiteInteractor {
+// グループとケースのリポジトリは進捗率の集計と完了条件の評価に使用します
+// userRepoは完了条件を満たさないスイートを完了にする際の権限確認に使用します
+// publisherがnilの場合、ドメインイベントは発行しません
+// txManagerは配下のグループ・ケースを含めた削除を1つのトランザクションで実行するために使用します
+func NewTestSuiteInteractor(
+	repo repository.TestSuiteRepository,
+	groupRepo repository.TestGroupRepository,
+	caseRepo repository.TestCaseRepository,
+	userRepo repository.UserRepository,
+	idGenerator repository.TestSuiteIDGenerator,
+	publisher event.Publisher,
+	txManager repository.TransactionManager,
+) *TestSuiteInteractor {
 	return &TestSuiteInteractor{
-		repository:  repo,
-		idGenerator: idGenerator,
+		repository:      repo,
+		groupRepository: groupRepo,
+		caseRepository:  caseRepo,
+		userRepository:  userRepo,
+		idGenerator:     idGenerator,
+		publisher:       publisher,
+		txManager:       txManager,
 	}
 }
 
 // CreateTestSuite は新しいテストスイートを作成します
 func (i *TestSuiteInteractor) CreateTestSuite(ctx context.Context, createDTO *dto.TestSuiteCreateDTO) (*dto.TestSuiteResponseDTO, error) {
+	// 完了条件の指定がない場合は全ケース完了を条件とする
+	exitCriteria := entity.DefaultExitCriteria()
+	if createDTO.ExitCriteria != nil {
+		criteria, err := newExitCriteria(createDTO.ExitCriteria)
+		if err != nil {
+			return nil, err
+		}
+		exitCriteria = criteria
+	}
+
+	// IDの生成（入力検証の後に行い、無効な入力で採番を消費しない）
+	id, err := i.idGenerator.GenerateID()
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("テストスイートIDの生成に失敗しました", err)
+	}
+
 	currentTime := time.Now()
-	id := fmt.Sprintf("TS%03d-%04d%02d", 1, currentTime.Year(), currentTime.Month())
 
 	suite := &entity.TestSuite{
 		ID:                   id,
@@ -39,6 +80,9 @@ func (i *TestSuiteInteractor) CreateTestSuite(ctx context.Context, createDTO *dt
 		EstimatedStartDate:   createDTO.EstimatedStartDate,
 		EstimatedEndDate:     createDTO.EstimatedEndDate,
 		RequireEffortComment: createDTO.RequireEffortComment,
+		ExitCriteria:         exitCriteria,
+		Version:              entity.InitialVersion,
+		CreatedBy:            createDTO.CreatedBy,
 		CreatedAt:            currentTime,
 		UpdatedAt:            currentTime,
 	}
@@ -51,19 +95,10 @@ func (i *TestSuiteInteractor) CreateTestSuite(ctx context.Context, createDTO *dt
 		return nil, errors.NewSystemError("テストスイートの作成に失敗しました", err)
 	}
 
-	// レスポンスDTOの作成
-	responseDTO := &dto.TestSuiteResponseDTO{
-		ID:                   suite.ID,
-		Name:                 suite.Name,
-		Description:          suite.Description,
-		Status:               suite.Status.String(),
-		EstimatedStartDate:   suite.EstimatedStartDate,
-		EstimatedEndDate:     suite.EstimatedEndDate,
-		RequireEffortComment: suite.RequireEffortComment,
-		Progress:             0.0,
-		CreatedAt:            suite.CreatedAt,
-		UpdatedAt:            suite.UpdatedAt,
-	}
+	publishEvent(ctx, i.publisher, event.New(event.TestSuiteCreated, suite.ID, suite.ID, suite.CreatedBy))
+
+	// レスポンスDTOの作成（作成直後はグループ・ケースが存在しないため進捗は0）
+	responseDTO := newTestSuiteResponseDTO(suite, &entity.ProgressSummary{})
 
 	return responseDTO, nil
 }
@@ -79,8 +114,61 @@ func (i *TestSuiteInteractor) GetTestSuite(ctx context.Context, id string) (*dto
 		return nil, errors.NewTestSuiteNotFoundError(id)
 	}
 
+	// 進捗サマリーの集計
+	summary, err := i.progressSummary(ctx, suite)
+	if err != nil {
+		return nil, err
+	}
+
 	// レスポンスDTOの作成
-	responseDTO := &dto.TestSuiteResponseDTO{
+	responseDTO := newTestSuiteResponseDTO(suite, summary)
+
+	return responseDTO, nil
+}
+
+// progressSummary はスイート配下のグループとテストケースを取得し、進捗サマリーを計算します
+func (i *TestSuiteInteractor) progressSummary(ctx context.Context, suite *entity.TestSuite) (*entity.ProgressSummary, error) {
+	return suiteProgressSummary(ctx, i.groupRepository, i.caseRepository, suite)
+}
+
+// suiteProgressSummary はリポジトリからスイート配下のグループとテストケースを取得し、進捗サマリーを計算します
+func suiteProgressSummary(ctx context.Context, groupRepo repository.TestGroupRepository, caseRepo repository.TestCaseRepository, suite *entity.TestSuite) (*entity.ProgressSummary, error) {
+	groups, casesByGroup, err := findSuiteCases(ctx, groupRepo, caseRepo, suite.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return suite.GetProgressSummary(groups, casesByGroup), nil
+}
+
+// findSuiteCases はスイート配下のグループと、グループIDごとのテストケース一覧を取得します
+func findSuiteCases(ctx context.Context, groupRepo repository.TestGroupRepository, caseRepo repository.TestCaseRepository, suiteID string) ([]*entity.TestGroup, map[string][]*entity.TestCase, error) {
+	groups, err := groupRepo.FindBySuiteID(ctx, suiteID)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, nil, err
+		}
+		return nil, nil, errors.NewSystemError("テストスイートの進捗計算に失敗しました", err)
+	}
+
+	casesByGroup := make(map[string][]*entity.TestCase, len(groups))
+	for _, group := range groups {
+		cases, err := caseRepo.FindByGroupID(ctx, group.ID)
+		if err != nil {
+			if errors.IsDomainError(err) {
+				return nil, nil, err
+			}
+			return nil, nil, errors.NewSystemError("テストスイートの進捗計算に失敗しました", err)
+		}
+		casesByGroup[group.ID] = cases
+	}
+
+	return groups, casesByGroup, nil
+}
+
+// newTestSuiteResponseDTO はエンティティと進捗サマリーからレスポンスDTOを作成します
+func newTestSuiteResponseDTO(suite *entity.TestSuite, summary *entity.ProgressSummary) *dto.TestSuiteResponseDTO {
+	return &dto.TestSuiteResponseDTO{
 		ID:                   suite.ID,
 		Name:                 suite.Name,
 		Description:          suite.Description,
@@ -88,25 +176,20 @@ func (i *TestSuiteInteractor) GetTestSuite(ctx context.Context, id string) (*dto
 		EstimatedStartDate:   suite.EstimatedStartDate,
 		EstimatedEndDate:     suite.EstimatedEndDate,
 		RequireEffortComment: suite.RequireEffortComment,
-		Progress:             i.calculateProgress(suite),
-		CreatedAt:            suite.CreatedAt,
-		UpdatedAt:            suite.UpdatedAt,
-	}
-
-	return responseDTO, nil
-}
-
-// calculateProgress はテストスイートの進捗率を計算します
-func (i *TestSuiteInteractor) calculateProgress(suite *entity.TestSuite) float64 {
-	// 状態に基づく基本進捗率の定義
-	statusProgress := map[valueobject.SuiteStatus]float64{
-		valueobject.SuiteStatusPreparation: 0.0,   // 準備中
-		valueobject.SuiteStatusInProgress:  50.0,  // 実行中
-		valueobject.SuiteStatusCompleted:   100.0, // 完了
-		valueobject.SuiteStatusSuspended:   75.0,  // 中断
+		Progress:             summary.ProgressPercentage,
+		CompletedCaseCount:   summary.CompletedCount,
+		TotalCaseCount:       summary.TotalCount,
+		ExitCriteria: dto.ExitCriteriaDTO{
+			MinCompletionRate: suite.ExitCriteria.MinCompletionRate,
+			AllowOpenCritical: suite.ExitCriteria.AllowOpenCritical,
+		},
+		ExitOverride: newExitCriteriaOverrideDTO(suite.ExitOverride),
+		Version:      suite.Version,
+		DeletedAt:    deletedAtPtr(suite.DeletedAt),
+		CreatedBy:    suite.CreatedBy,
+		CreatedAt:    suite.CreatedAt,
+		UpdatedAt:    suite.UpdatedAt,
 	}
-
-	return statusProgress[suite.Status]
 }
 
 // UpdateTestSuite はテストスイートを更新します
@@ -120,6 +203,9 @@ func (i *TestSuiteInteractor) UpdateTestSuite(ctx context.Context, id string, up
 		}
 		return nil, errors.NewTestSuiteNotFoundError(id)
 	}
+	if err := checkExpectedVersion("TestSuite", suite.ID, suite.Version, updateDTO.ExpectedVersion); err != nil {
+		return nil, err
+	}
 
 	// 更新が存在する場合のみ値を更新
 	if updateDTO.Name != nil {
@@ -137,6 +223,13 @@ func (i *TestSuiteInteractor) UpdateTestSuite(ctx context.Context, id string, up
 	if updateDTO.RequireEffortComment != nil {
 		suite.RequireEffortComment = *updateDTO.RequireEffortComment
 	}
+	if updateDTO.ExitCriteria != nil {
+		criteria, err := newExitCriteria(updateDTO.ExitCriteria)
+		if err != nil {
+			return nil, err
+		}
+		suite.ExitCriteria = criteria
+	}
 
 	suite.UpdatedAt = time.Now()
 
@@ -149,20 +242,17 @@ func (i *TestSuiteInteractor) UpdateTestSuite(ctx context.Context, id string, up
 		return nil, errors.NewSystemError("テストスイートの更新に失敗しました", err)
 	}
 
-	// レスポンスDTOの作成
-	responseDTO := &dto.TestSuiteResponseDTO{
-		ID:                   suite.ID,
-		Name:                 suite.Name,
-		Description:          suite.Description,
-		Status:               suite.Status.String(),
-		EstimatedStartDate:   suite.EstimatedStartDate,
-		EstimatedEndDate:     suite.EstimatedEndDate,
-		RequireEffortComment: suite.RequireEffortComment,
-		Progress:             i.calculateProgress(suite),
-		CreatedAt:            suite.CreatedAt,
-		UpdatedAt:            suite.UpdatedAt,
+	publishEvent(ctx, i.publisher, event.New(event.TestSuiteUpdated, suite.ID, suite.ID, ""))
+
+	// 進捗サマリーの集計
+	summary, err := i.progressSummary(ctx, suite)
+	if err != nil {
+		return nil, err
 	}
 
+	// レスポンスDTOの作成
+	responseDTO := newTestSuiteResponseDTO(suite, summary)
+
 	return responseDTO, nil
 }
 
@@ -196,6 +286,16 @@ func (i *TestSuiteInteractor) UpdateTestSuiteStatus(ctx context.Context, id stri
 		)
 	}
 
+	// 完了への遷移では完了条件を評価し、オーバーライドした場合はその記録を残す
+	// 記録は次に完了にするまで保持し、条件を満たして完了にした時点で消去する
+	if newStatus == valueobject.SuiteStatusCompleted {
+		override, err := i.checkExitCriteria(ctx, suite, statusDTO)
+		if err != nil {
+			return nil, err
+		}
+		suite.ExitOverride = override
+	}
+
 	// ステータスの更新
 	suite.Status = newStatus
 	suite.UpdatedAt = time.Now()
@@ -209,24 +309,102 @@ func (i *TestSuiteInteractor) UpdateTestSuiteStatus(ctx context.Context, id stri
 		return nil, errors.NewSystemError("テストスイートのステータス更新に失敗しました", err)
 	}
 
-	// レスポンスDTOの作成
-	responseDTO := &dto.TestSuiteResponseDTO{
-		ID:                   suite.ID,
-		Name:                 suite.Name,
-		Description:          suite.Description,
-		Status:               suite.Status.String(),
-		EstimatedStartDate:   suite.EstimatedStartDate,
-		EstimatedEndDate:     suite.EstimatedEndDate,
-		RequireEffortComment: suite.RequireEffortComment,
-		Progress:             i.calculateProgress(suite),
-		CreatedAt:            suite.CreatedAt,
-		UpdatedAt:            suite.UpdatedAt,
+	publishEvent(ctx, i.publisher, event.New(event.TestSuiteStatusChanged, suite.ID, suite.ID, statusDTO.ChangedBy))
+
+	// 進捗サマリーの集計
+	summary, err := i.progressSummary(ctx, suite)
+	if err != nil {
+		return nil, err
 	}
 
+	// レスポンスDTOの作成
+	responseDTO := newTestSuiteResponseDTO(suite, summary)
+
 	return responseDTO, nil
 }
 
-// DeleteTestSuite は指定されたIDのテストスイートを削除します
+// checkExitCriteria はスイート配下のテストケースが完了条件を満たすかを評価します
+// 満たさない場合、Adminが理由を指定していればオーバーライドの記録を返し、
+// それ以外は完了を妨げているテストケースを列挙したエラーを返します
+func (i *TestSuiteInteractor) checkExitCriteria(ctx context.Context, suite *entity.TestSuite, statusDTO *dto.TestSuiteStatusUpdateDTO) (*entity.ExitCriteriaOverride, error) {
+	groups, casesByGroup, err := findSuiteCases(ctx, i.groupRepository, i.caseRepository, suite.ID)
+	if err != nil {
+		return nil, err
+	}
+	var cases []*entity.TestCase
+	for _, group := range groups {
+		cases = append(cases, casesByGroup[group.ID]...)
+	}
+
+	evaluation := suite.ExitCriteria.Evaluate(cases)
+	if evaluation.Satisfied() {
+		return nil, nil
+	}
+
+	reason := strings.TrimSpace(statusDTO.OverrideReason)
+	if reason == "" {
+		blockingCases := make([]errors.ExitCriteriaBlockingCase, len(evaluation.BlockingCases))
+		for j, tc := range evaluation.BlockingCases {
+			blockingCases[j] = errors.ExitCriteriaBlockingCase{
+				ID:       tc.ID,
+				Title:    tc.Title,
+				Status:   string(tc.Status),
+				Priority: string(tc.Priority),
+			}
+		}
+		return nil, errors.NewExitCriteriaNotMetError(suite.ID, evaluation.CompletionRate, suite.ExitCriteria.MinCompletionRate, blockingCases)
+	}
+
+	// オーバーライドはAdminのみ実行できる
+	if statusDTO.ChangedBy == "" {
+		return nil, errors.NewDomainValidationError("完了条件のオーバーライドには変更者の指定が必要です", nil)
+	}
+	user, err := i.userRepository.FindByID(ctx, statusDTO.ChangedBy)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewEntityNotFoundError("User", statusDTO.ChangedBy)
+	}
+	if !user.CanOverrideExitCriteria() {
+		return nil, errors.NewDomainForbiddenError(user.ID, "TestSuite", "override_exit_criteria")
+	}
+
+	return &entity.ExitCriteriaOverride{
+		Reason:       reason,
+		OverriddenBy: user.ID,
+		OverriddenAt: time.Now(),
+	}, nil
+}
+
+// newExitCriteria はDTOから完了条件を作成し、値を検証します
+func newExitCriteria(criteriaDTO *dto.ExitCriteriaDTO) (entity.ExitCriteria, error) {
+	criteria := entity.ExitCriteria{
+		MinCompletionRate: criteriaDTO.MinCompletionRate,
+		AllowOpenCritical: criteriaDTO.AllowOpenCritical,
+	}
+	if err := criteria.Validate(); err != nil {
+		return entity.ExitCriteria{}, errors.NewDomainValidationError("完了条件が不正です", map[string]string{
+			"minCompletionRate": "0から100の範囲で指定してください",
+		})
+	}
+	return criteria, nil
+}
+
+// newExitCriteriaOverrideDTO は完了条件のオーバーライドの記録をDTOに変換します（記録がない場合はnil）
+func newExitCriteriaOverrideDTO(override *entity.ExitCriteriaOverride) *dto.ExitCriteriaOverrideDTO {
+	if override == nil {
+		return nil
+	}
+	return &dto.ExitCriteriaOverrideDTO{
+		Reason:       override.Reason,
+		OverriddenBy: override.OverriddenBy,
+		OverriddenAt: override.OverriddenAt,
+	}
+}
+
+// DeleteTestSuite は指定されたIDのテストスイートを配下のグループ・ケースとともにゴミ箱に移動します
+// 配下の行にはスイートと同じ削除日時を設定し、復元時にまとめて戻せるようにします
 func (i *TestSuiteInteractor) DeleteTestSuite(ctx context.Context, id string) error {
 	// 存在確認
 	_, err := i.repository.FindByID(ctx, id)
@@ -237,14 +415,43 @@ func (i *TestSuiteInteractor) DeleteTestSuite(ctx context.Context, id string) er
 		return errors.NewTestSuiteNotFoundError(id)
 	}
 
-	// 削除の実行
-	if err := i.repository.Delete(ctx, id); err != nil {
-		if errors.IsDomainError(err) {
-			return err
+	deletedAt := time.Now()
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		groups, err := i.groupRepository.FindBySuiteID(ctx, id)
+		if err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストグループ一覧の取得に失敗しました", err)
+		}
+		for _, group := range groups {
+			if err := i.caseRepository.SoftDeleteByGroupID(ctx, group.ID, deletedAt); err != nil {
+				if errors.IsDomainError(err) {
+					return err
+				}
+				return errors.NewSystemError("テストケースの削除に失敗しました", err)
+			}
+		}
+		if err := i.groupRepository.SoftDeleteBySuiteID(ctx, id, deletedAt); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストグループの削除に失敗しました", err)
+		}
+		if err := i.repository.SoftDelete(ctx, id, deletedAt); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストスイートの削除に失敗しました", err)
 		}
-		return errors.NewSystemError("テストスイートの削除に失敗しました", err)
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	publishEvent(ctx, i.publisher, event.New(event.TestSuiteDeleted, id, id, ""))
+
 	return nil
 }
 
@@ -285,9 +492,23 @@ func isValidStatusTransition(current, new valueobject.SuiteStatus) bool {
 }
 
 // ListTestSuites はテストスイート一覧を取得します
+// キーセット方式では、取得したページの先（Lastの場合は手前）にスイートが残っているかを判定するため1件多く取得します
 func (i *TestSuiteInteractor) ListTestSuites(ctx context.Context, params *dto.TestSuiteQueryParamDTO) (*dto.TestSuiteListResponseDTO, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := params
+	if params.Keyset != nil {
+		keyset := *params.Keyset
+		keyset.Limit++
+		copied := *params
+		copied.Keyset = &keyset
+		query = &copied
+	}
+
 	// リポジトリからデータを取得
-	suites, total, err := i.repository.FindWithFilters(ctx, params)
+	suites, total, err := i.repository.FindWithFilters(ctx, query)
 	if err != nil {
 		// ドメインエラーに変換
 		if errors.IsDomainError(err) {
@@ -302,20 +523,39 @@ func (i *TestSuiteInteractor) ListTestSuites(ctx context.Context, params *dto.Te
 		Total:      total,
 	}
 
+	if keyset := params.Keyset; keyset != nil {
+		hasMore := len(suites) > keyset.Limit
+		if keyset.FromLast {
+			if hasMore {
+				suites = suites[len(suites)-keyset.Limit:]
+			}
+			response.HasPreviousPage = hasMore
+			response.HasNextPage = keyset.Before != nil
+		} else {
+			if hasMore {
+				suites = suites[:keyset.Limit]
+			}
+			response.HasNextPage = hasMore
+			response.HasPreviousPage = keyset.After != nil
+		}
+	} else {
+		page, pageSize := 1, dto.DefaultTestSuitePageSize
+		if params.Page != nil {
+			page = *params.Page
+		}
+		if params.PageSize != nil {
+			pageSize = *params.PageSize
+		}
+		response.HasNextPage = page*pageSize < total
+		response.HasPreviousPage = page > 1
+	}
+
 	for _, suite := range suites {
-		responseDTO := dto.TestSuiteResponseDTO{
-			ID:                   suite.ID,
-			Name:                 suite.Name,
-			Description:          suite.Description,
-			Status:               suite.Status.String(),
-			EstimatedStartDate:   suite.EstimatedStartDate,
-			EstimatedEndDate:     suite.EstimatedEndDate,
-			RequireEffortComment: suite.RequireEffortComment,
-			Progress:             i.calculateProgress(suite),
-			CreatedAt:            suite.CreatedAt,
-			UpdatedAt:            suite.UpdatedAt,
+		summary, err := i.progressSummary(ctx, suite)
+		if err != nil {
+			return nil, err
 		}
-		response.TestSuites = append(response.TestSuites, responseDTO)
+		response.TestSuites = append(response.TestSuites, *newTestSuiteResponseDTO(suite, summary))
 	}
 
 	return response, nil
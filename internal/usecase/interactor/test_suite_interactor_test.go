@@ -9,6 +9,7 @@ import (
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
 	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
 	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -73,6 +74,37 @@ func (m *MockTestSuiteRepository) FindWithFilters(ctx context.Context, params *d
 	return args.Get(0).([]*entity.TestSuite), args.Int(1), args.Error(2)
 }
 
+func (m *MockTestSuiteRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
+	args := m.Called(ctx, id, deletedAt)
+	return args.Error(0)
+}
+
+func (m *MockTestSuiteRepository) Restore(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTestSuiteRepository) FindDeletedByID(ctx context.Context, id string) (*entity.TestSuite, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TestSuite), args.Error(1)
+}
+
+func (m *MockTestSuiteRepository) FindDeleted(ctx context.Context) ([]*entity.TestSuite, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TestSuite), args.Error(1)
+}
+
+func (m *MockTestSuiteRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	args := m.Called(ctx, before)
+	return args.Int(0), args.Error(1)
+}
+
 func TestListTestSuites(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -145,18 +177,30 @@ func TestListTestSuites(t *testing.T) {
 			expectedTotal: 0,
 			expectedError: true,
 		},
+		{
+			name:      "進捗率の下限が上限を超える場合はリポジトリを呼び出さない",
+			setupMock: func(r *MockTestSuiteRepository) {},
+			inputParams: &dto.TestSuiteQueryParamDTO{
+				MinProgress: func(f float64) *float64 { return &f }(80),
+				MaxProgress: func(f float64) *float64 { return &f }(20),
+			},
+			expectedError: true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// モックリポジトリのセットアップ
 			mockRepo := new(MockTestSuiteRepository)
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockCaseRepo := new(MockTestCaseRepository)
 			mockIDGen := new(MockTestSuiteIDGenerator)
 			tc.setupMock(mockRepo)
+			mockGroupRepo.On("FindBySuiteID", mock.Anything, mock.Anything).Return([]*entity.TestGroup{}, nil).Maybe()
 			mockIDGen.On("GenerateID").Return("TS001-202501", nil) // IDの期待値を設定
 
 			// インタラクターの作成
-			interactor := NewTestSuiteInteractor(mockRepo, mockIDGen)
+			interactor := NewTestSuiteInteractor(mockRepo, mockGroupRepo, mockCaseRepo, new(MockUserRepository), mockIDGen, nil, nil)
 
 			// テストの実行
 			result, err := interactor.ListTestSuites(context.Background(), tc.inputParams)
@@ -178,25 +222,106 @@ func TestListTestSuites(t *testing.T) {
 	}
 }
 
+func TestListTestSuites_Keyset(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	// 作成日時の降順に並んだ4件（TS004が最新）
+	suites := make([]*entity.TestSuite, 0, 4)
+	for i := 4; i >= 1; i-- {
+		suites = append(suites, &entity.TestSuite{
+			ID:        fmt.Sprintf("TS%03d", i),
+			Status:    valueobject.SuiteStatusPreparation,
+			CreatedAt: base.AddDate(0, 0, i),
+		})
+	}
+	cursor := dto.TestSuiteCursor{Values: []any{base.AddDate(0, 0, 5)}, ID: "TS005"}
+
+	testCases := []struct {
+		name         string
+		keyset       dto.TestSuiteKeyset
+		found        []*entity.TestSuite
+		wantIDs      []string
+		wantNext     bool
+		wantPrevious bool
+	}{
+		{
+			name:     "1件多く取得できた場合は次のページがある",
+			keyset:   dto.TestSuiteKeyset{Limit: 3},
+			found:    suites,
+			wantIDs:  []string{"TS004", "TS003", "TS002"},
+			wantNext: true,
+		},
+		{
+			name:         "afterを指定した場合は前のページがある",
+			keyset:       dto.TestSuiteKeyset{Limit: 4, After: &cursor},
+			found:        suites,
+			wantIDs:      []string{"TS004", "TS003", "TS002", "TS001"},
+			wantPrevious: true,
+		},
+		{
+			name:         "末尾から取得した場合は先頭側の余分な1件を除く",
+			keyset:       dto.TestSuiteKeyset{Limit: 3, FromLast: true, Before: &cursor},
+			found:        suites,
+			wantIDs:      []string{"TS003", "TS002", "TS001"},
+			wantNext:     true,
+			wantPrevious: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestSuiteRepository)
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockGroupRepo.On("FindBySuiteID", mock.Anything, mock.Anything).Return([]*entity.TestGroup{}, nil).Maybe()
+
+			// リポジトリには指定より1件多い件数で問い合わせる
+			mockRepo.On("FindWithFilters", mock.Anything, mock.MatchedBy(func(params *dto.TestSuiteQueryParamDTO) bool {
+				return params.Keyset != nil && params.Keyset.Limit == tc.keyset.Limit+1
+			})).Return(tc.found, 10, nil)
+
+			interactor := NewTestSuiteInteractor(mockRepo, mockGroupRepo, new(MockTestCaseRepository), new(MockUserRepository), new(MockTestSuiteIDGenerator), nil, nil)
+
+			keyset := tc.keyset
+			params := &dto.TestSuiteQueryParamDTO{Keyset: &keyset}
+			result, err := interactor.ListTestSuites(context.Background(), params)
+
+			assert.NoError(t, err)
+			ids := make([]string, 0, len(result.TestSuites))
+			for _, suite := range result.TestSuites {
+				ids = append(ids, suite.ID)
+			}
+			assert.Equal(t, tc.wantIDs, ids)
+			assert.Equal(t, tc.wantNext, result.HasNextPage)
+			assert.Equal(t, tc.wantPrevious, result.HasPreviousPage)
+			assert.Equal(t, 10, result.Total)
+			// 呼び出し元の条件は変更しない
+			assert.Equal(t, tc.keyset.Limit, params.Keyset.Limit)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestGetTestSuite(t *testing.T) {
 	// テストケースの構造体
 	testCases := []struct {
-		name             string
-		setupMock        func(*MockTestSuiteRepository)
-		inputID          string
-		expectedError    bool
-		expectedStatus   valueobject.SuiteStatus
-		expectedProgress float64
+		name              string
+		setupMock         func(*MockTestSuiteRepository, *MockTestGroupRepository, *MockTestCaseRepository)
+		inputID           string
+		expectedError     bool
+		expectedStatus    valueobject.SuiteStatus
+		expectedProgress  float64
+		expectedCompleted int
+		expectedTotal     int
 	}{
 		{
-			name: "準備中のテストスイート取得",
-			setupMock: func(r *MockTestSuiteRepository) {
+			name: "グループが存在しないテストスイート取得",
+			setupMock: func(r *MockTestSuiteRepository, g *MockTestGroupRepository, c *MockTestCaseRepository) {
 				r.On("FindByID", mock.Anything, "TS001-202501").Return(&entity.TestSuite{
 					ID:        "TS001-202501",
 					Status:    valueobject.SuiteStatusPreparation,
 					CreatedAt: time.Now(),
 					UpdatedAt: time.Now(),
 				}, nil)
+				g.On("FindBySuiteID", mock.Anything, "TS001-202501").Return([]*entity.TestGroup{}, nil)
 			},
 			inputID:          "TS001-202501",
 			expectedError:    false,
@@ -204,71 +329,90 @@ func TestGetTestSuite(t *testing.T) {
 			expectedProgress: 0.0,
 		},
 		{
-			name: "実行中のテストスイート取得",
-			setupMock: func(r *MockTestSuiteRepository) {
+			name: "優先度で加重した進捗率の取得",
+			setupMock: func(r *MockTestSuiteRepository, g *MockTestGroupRepository, c *MockTestCaseRepository) {
 				r.On("FindByID", mock.Anything, "TS002-202501").Return(&entity.TestSuite{
 					ID:        "TS002-202501",
 					Status:    valueobject.SuiteStatusInProgress,
 					CreatedAt: time.Now(),
 					UpdatedAt: time.Now(),
 				}, nil)
-			},
-			inputID:          "TS002-202501",
-			expectedError:    false,
-			expectedStatus:   valueobject.SuiteStatusInProgress,
-			expectedProgress: 50.0,
-		},
-		{
-			name: "完了のテストスイート取得",
-			setupMock: func(r *MockTestSuiteRepository) {
-				r.On("FindByID", mock.Anything, "TS003-202501").Return(&entity.TestSuite{
-					ID:        "TS003-202501",
-					Status:    valueobject.SuiteStatusCompleted,
-					CreatedAt: time.Now(),
-					UpdatedAt: time.Now(),
+				g.On("FindBySuiteID", mock.Anything, "TS002-202501").Return([]*entity.TestGroup{
+					{ID: "TS002TG01-202501", SuiteID: "TS002-202501"},
+					{ID: "TS002TG02-202501", SuiteID: "TS002-202501"},
+				}, nil)
+				c.On("FindByGroupID", mock.Anything, "TS002TG01-202501").Return([]*entity.TestCase{
+					{ID: "TC1", GroupID: "TS002TG01-202501", Status: entity.TestStatusCompleted, Priority: entity.PriorityHigh},
+				}, nil)
+				c.On("FindByGroupID", mock.Anything, "TS002TG02-202501").Return([]*entity.TestCase{
+					{ID: "TC2", GroupID: "TS002TG02-202501", Status: entity.TestStatusTesting, Priority: entity.PriorityLow},
 				}, nil)
 			},
-			inputID:          "TS003-202501",
-			expectedError:    false,
-			expectedStatus:   valueobject.SuiteStatusCompleted,
-			expectedProgress: 100.0,
+			inputID:           "TS002-202501",
+			expectedError:     false,
+			expectedStatus:    valueobject.SuiteStatusInProgress,
+			expectedProgress:  81.25, // (1.0*3 + 0.25*1) / 4
+			expectedCompleted: 1,
+			expectedTotal:     2,
 		},
 		{
-			name: "中断のテストスイート取得",
-			setupMock: func(r *MockTestSuiteRepository) {
+			name: "中断中でもケースの状態から進捗率を計算",
+			setupMock: func(r *MockTestSuiteRepository, g *MockTestGroupRepository, c *MockTestCaseRepository) {
 				r.On("FindByID", mock.Anything, "TS004-202501").Return(&entity.TestSuite{
 					ID:        "TS004-202501",
 					Status:    valueobject.SuiteStatusSuspended,
 					CreatedAt: time.Now(),
 					UpdatedAt: time.Now(),
 				}, nil)
+				g.On("FindBySuiteID", mock.Anything, "TS004-202501").Return([]*entity.TestGroup{
+					{ID: "TS004TG01-202501", SuiteID: "TS004-202501"},
+				}, nil)
+				c.On("FindByGroupID", mock.Anything, "TS004TG01-202501").Return([]*entity.TestCase{
+					{ID: "TC1", GroupID: "TS004TG01-202501", Status: entity.TestStatusCreated, Priority: entity.PriorityMedium},
+					{ID: "TC2", GroupID: "TS004TG01-202501", Status: entity.TestStatusReviewWaiting, Priority: entity.PriorityMedium},
+				}, nil)
 			},
 			inputID:          "TS004-202501",
 			expectedError:    false,
 			expectedStatus:   valueobject.SuiteStatusSuspended,
-			expectedProgress: 75.0,
+			expectedProgress: 25.0,
+			expectedTotal:    2,
 		},
 		{
 			name: "存在しないIDのテストスイート取得",
-			setupMock: func(r *MockTestSuiteRepository) {
+			setupMock: func(r *MockTestSuiteRepository, g *MockTestGroupRepository, c *MockTestCaseRepository) {
 				r.On("FindByID", mock.Anything, "invalid-id").Return(nil, entity.ErrTestSuiteNotFound)
 			},
 			inputID:        "invalid-id",
 			expectedError:  true,
 			expectedStatus: "",
 		},
+		{
+			name: "進捗計算時のリポジトリエラー",
+			setupMock: func(r *MockTestSuiteRepository, g *MockTestGroupRepository, c *MockTestCaseRepository) {
+				r.On("FindByID", mock.Anything, "TS005-202501").Return(&entity.TestSuite{
+					ID:     "TS005-202501",
+					Status: valueobject.SuiteStatusInProgress,
+				}, nil)
+				g.On("FindBySuiteID", mock.Anything, "TS005-202501").Return(nil, fmt.Errorf("database error"))
+			},
+			inputID:       "TS005-202501",
+			expectedError: true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// モックリポジトリのセットアップ
 			mockRepo := new(MockTestSuiteRepository)
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockCaseRepo := new(MockTestCaseRepository)
 			mockIDGen := new(MockTestSuiteIDGenerator)
-			tc.setupMock(mockRepo)
+			tc.setupMock(mockRepo, mockGroupRepo, mockCaseRepo)
 			mockIDGen.On("GenerateID").Return("TS001-202501", nil) // IDの期待値を設定
 
 			// インタラクターの作成
-			interactor := NewTestSuiteInteractor(mockRepo, mockIDGen)
+			interactor := NewTestSuiteInteractor(mockRepo, mockGroupRepo, mockCaseRepo, new(MockUserRepository), mockIDGen, nil, nil)
 
 			// テストの実行
 			result, err := interactor.GetTestSuite(context.Background(), tc.inputID)
@@ -282,10 +426,289 @@ func TestGetTestSuite(t *testing.T) {
 				assert.NotNil(t, result)
 				assert.Equal(t, string(tc.expectedStatus), result.Status)
 				assert.Equal(t, tc.expectedProgress, result.Progress)
+				assert.Equal(t, tc.expectedCompleted, result.CompletedCaseCount)
+				assert.Equal(t, tc.expectedTotal, result.TotalCaseCount)
 			}
 
 			// モックの呼び出しを検証
 			mockRepo.AssertExpectations(t)
+			mockGroupRepo.AssertExpectations(t)
+			mockCaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateTestSuite_OptimisticConcurrency(t *testing.T) {
+	newName := "更新後のスイート"
+
+	testCases := []struct {
+		name                 string
+		expectedVersion      *int
+		setupMock            func(*MockTestSuiteRepository, *MockTestGroupRepository)
+		expectedError        string
+		expectedVersionAfter int
+	}{
+		{
+			name:            "正常系：バージョンが一致する場合は更新できる",
+			expectedVersion: intPtr(3),
+			setupMock: func(r *MockTestSuiteRepository, g *MockTestGroupRepository) {
+				r.On("Update", mock.Anything, mock.MatchedBy(func(s *entity.TestSuite) bool {
+					return s.Version == 3 && s.Name == newName
+				})).Run(func(args mock.Arguments) {
+					// リポジトリは更新成功時にバージョンを進める
+					args.Get(1).(*entity.TestSuite).Version++
+				}).Return(nil)
+				g.On("FindBySuiteID", mock.Anything, "TS001-202501").Return([]*entity.TestGroup{}, nil)
+			},
+			expectedVersionAfter: 4,
+		},
+		{
+			name:            "異常系：指定されたバージョンが古い場合は競合エラー",
+			expectedVersion: intPtr(2),
+			setupMock:       func(r *MockTestSuiteRepository, g *MockTestGroupRepository) {},
+			expectedError:   "CONFLICT",
+		},
+		{
+			name: "異常系：読み込み後に他の更新が行われた場合はリポジトリの競合エラーを返す",
+			setupMock: func(r *MockTestSuiteRepository, g *MockTestGroupRepository) {
+				r.On("Update", mock.Anything, mock.AnythingOfType("*entity.TestSuite")).
+					Return(errors.NewConcurrentModificationError("TestSuite", "TS001-202501", 4, 3))
+			},
+			expectedError: "CONFLICT",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestSuiteRepository)
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockRepo.On("FindByID", mock.Anything, "TS001-202501").Return(&entity.TestSuite{
+				ID:      "TS001-202501",
+				Name:    "スイート",
+				Status:  valueobject.SuiteStatusInProgress,
+				Version: 3,
+			}, nil)
+			tc.setupMock(mockRepo, mockGroupRepo)
+
+			interactor := NewTestSuiteInteractor(mockRepo, mockGroupRepo, new(MockTestCaseRepository), new(MockUserRepository), new(MockTestSuiteIDGenerator), nil, nil)
+
+			result, err := interactor.UpdateTestSuite(context.Background(), "TS001-202501", &dto.TestSuiteUpdateDTO{
+				Name:            &newName,
+				ExpectedVersion: tc.expectedVersion,
+			})
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				if tc.expectedVersion != nil {
+					mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedVersionAfter, result.Version)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestDeleteTestSuite_CascadesSoftDelete(t *testing.T) {
+	mockRepo := new(MockTestSuiteRepository)
+	mockGroupRepo := new(MockTestGroupRepository)
+	mockCaseRepo := new(MockTestCaseRepository)
+	mockTxManager := new(MockTransactionManager)
+
+	mockRepo.On("FindByID", mock.Anything, "TS001-202501").Return(&entity.TestSuite{ID: "TS001-202501"}, nil)
+	mockGroupRepo.On("FindBySuiteID", mock.MatchedBy(inTransaction), "TS001-202501").Return([]*entity.TestGroup{
+		{ID: "TS001TG01-202501", SuiteID: "TS001-202501"},
+		{ID: "TS001TG02-202501", SuiteID: "TS001-202501"},
+	}, nil)
+
+	// 配下のケース・グループ・スイートには同じ削除日時を記録する
+	var deletedAt time.Time
+	sameDeletedAt := func(at time.Time) bool {
+		if deletedAt.IsZero() {
+			deletedAt = at
+		}
+		return at.Equal(deletedAt)
+	}
+	mockCaseRepo.On("SoftDeleteByGroupID", mock.MatchedBy(inTransaction), "TS001TG01-202501", mock.MatchedBy(sameDeletedAt)).Return(nil)
+	mockCaseRepo.On("SoftDeleteByGroupID", mock.MatchedBy(inTransaction), "TS001TG02-202501", mock.MatchedBy(sameDeletedAt)).Return(nil)
+	mockGroupRepo.On("SoftDeleteBySuiteID", mock.MatchedBy(inTransaction), "TS001-202501", mock.MatchedBy(sameDeletedAt)).Return(nil)
+	mockRepo.On("SoftDelete", mock.MatchedBy(inTransaction), "TS001-202501", mock.MatchedBy(sameDeletedAt)).Return(nil)
+	mockTxManager.On("RunInTransaction", mock.Anything).Once()
+
+	interactor := NewTestSuiteInteractor(mockRepo, mockGroupRepo, mockCaseRepo, new(MockUserRepository), new(MockTestSuiteIDGenerator), nil, mockTxManager)
+
+	err := interactor.DeleteTestSuite(context.Background(), "TS001-202501")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockGroupRepo.AssertExpectations(t)
+	mockCaseRepo.AssertExpectations(t)
+	mockTxManager.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestCreateTestSuite_UsesIDGenerator(t *testing.T) {
+	t.Run("正常系：同じ月に作成したスイートにもジェネレーターの採番したIDを使用する", func(t *testing.T) {
+		mockRepo := new(MockTestSuiteRepository)
+		mockIDGen := new(MockTestSuiteIDGenerator)
+		mockIDGen.On("GenerateID").Return("TS001-202501", nil).Once()
+		mockIDGen.On("GenerateID").Return("TS002-202501", nil).Once()
+		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.TestSuite")).Return(nil).Twice()
+
+		interactor := NewTestSuiteInteractor(mockRepo, new(MockTestGroupRepository), new(MockTestCaseRepository), new(MockUserRepository), mockIDGen, nil, nil)
+		createDTO := &dto.TestSuiteCreateDTO{
+			Name:               "結合テスト",
+			EstimatedStartDate: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+			EstimatedEndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+		}
+
+		first, err := interactor.CreateTestSuite(context.Background(), createDTO)
+		assert.NoError(t, err)
+		second, err := interactor.CreateTestSuite(context.Background(), createDTO)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "TS001-202501", first.ID)
+		assert.Equal(t, "TS002-202501", second.ID)
+		mockIDGen.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系：IDの生成に失敗した場合は保存しない", func(t *testing.T) {
+		mockRepo := new(MockTestSuiteRepository)
+		mockIDGen := new(MockTestSuiteIDGenerator)
+		mockIDGen.On("GenerateID").Return("", fmt.Errorf("sequence unavailable"))
+
+		interactor := NewTestSuiteInteractor(mockRepo, new(MockTestGroupRepository), new(MockTestCaseRepository), new(MockUserRepository), mockIDGen, nil, nil)
+
+		result, err := interactor.CreateTestSuite(context.Background(), &dto.TestSuiteCreateDTO{Name: "結合テスト"})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "SYSTEM_ERROR")
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdateTestSuiteStatus_ExitCriteria(t *testing.T) {
+	openCases := []*entity.TestCase{
+		{ID: "TS001TG01TC001-202501", GroupID: "TS001TG01-202501", Title: "ログイン", Status: entity.TestStatusCompleted, Priority: entity.PriorityHigh},
+		{ID: "TS001TG01TC002-202501", GroupID: "TS001TG01-202501", Title: "決済", Status: entity.TestStatusTesting, Priority: entity.PriorityCritical},
+	}
+
+	testCases := []struct {
+		name           string
+		criteria       entity.ExitCriteria
+		statusDTO      *dto.TestSuiteStatusUpdateDTO
+		setupUser      func(*MockUserRepository)
+		expectedError  string
+		expectOverride bool
+	}{
+		{
+			name:          "異常系：未完了のケースがあれば阻害要因を列挙して拒否する",
+			criteria:      entity.DefaultExitCriteria(),
+			statusDTO:     &dto.TestSuiteStatusUpdateDTO{Status: "完了", ChangedBy: "manager-1"},
+			setupUser:     func(u *MockUserRepository) {},
+			expectedError: "TS001TG01TC002-202501「決済」(テスト, Critical)",
+		},
+		{
+			name:          "異常系：完了率を満たしていても未完了のCriticalケースがあれば拒否する",
+			criteria:      entity.ExitCriteria{MinCompletionRate: 50},
+			statusDTO:     &dto.TestSuiteStatusUpdateDTO{Status: "完了", ChangedBy: "manager-1"},
+			setupUser:     func(u *MockUserRepository) {},
+			expectedError: "完了率 50.0% / 必要 50.0%",
+		},
+		{
+			name:      "正常系：Criticalケースの残存を許可していれば完了率のみで判定する",
+			criteria:  entity.ExitCriteria{MinCompletionRate: 50, AllowOpenCritical: true},
+			statusDTO: &dto.TestSuiteStatusUpdateDTO{Status: "完了", ChangedBy: "manager-1"},
+			setupUser: func(u *MockUserRepository) {},
+		},
+		{
+			name:     "正常系：Adminは理由を指定して完了にでき、記録が残る",
+			criteria: entity.DefaultExitCriteria(),
+			statusDTO: &dto.TestSuiteStatusUpdateDTO{
+				Status:         "完了",
+				ChangedBy:      "admin-1",
+				OverrideReason: "決済は次リリースで対応するため",
+			},
+			setupUser: func(u *MockUserRepository) {
+				u.On("FindByID", mock.Anything, "admin-1").Return(&entity.User{ID: "admin-1", Role: entity.RoleAdmin}, nil)
+			},
+			expectOverride: true,
+		},
+		{
+			name:     "異常系：Admin以外は理由を指定しても完了にできない",
+			criteria: entity.DefaultExitCriteria(),
+			statusDTO: &dto.TestSuiteStatusUpdateDTO{
+				Status:         "完了",
+				ChangedBy:      "manager-1",
+				OverrideReason: "急ぎのため",
+			},
+			setupUser: func(u *MockUserRepository) {
+				u.On("FindByID", mock.Anything, "manager-1").Return(&entity.User{ID: "manager-1", Role: entity.RoleManager}, nil)
+			},
+			expectedError: "PERMISSION_ERROR",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockTestSuiteRepository)
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockCaseRepo := new(MockTestCaseRepository)
+			mockUserRepo := new(MockUserRepository)
+
+			mockRepo.On("FindByID", mock.Anything, "TS001-202501").Return(&entity.TestSuite{
+				ID:           "TS001-202501",
+				Status:       valueobject.SuiteStatusInProgress,
+				ExitCriteria: tc.criteria,
+				Version:      3,
+			}, nil)
+			mockGroupRepo.On("FindBySuiteID", mock.Anything, "TS001-202501").Return([]*entity.TestGroup{
+				{ID: "TS001TG01-202501", SuiteID: "TS001-202501"},
+			}, nil)
+			mockCaseRepo.On("FindByGroupID", mock.Anything, "TS001TG01-202501").Return(openCases, nil)
+			if tc.expectedError == "" {
+				mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(s *entity.TestSuite) bool {
+					if s.Status != valueobject.SuiteStatusCompleted {
+						return false
+					}
+					if !tc.expectOverride {
+						return s.ExitOverride == nil
+					}
+					return s.ExitOverride != nil &&
+						s.ExitOverride.OverriddenBy == "admin-1" &&
+						s.ExitOverride.Reason == tc.statusDTO.OverrideReason
+				})).Return(nil)
+			}
+			tc.setupUser(mockUserRepo)
+
+			interactor := NewTestSuiteInteractor(mockRepo, mockGroupRepo, mockCaseRepo, mockUserRepo, new(MockTestSuiteIDGenerator), nil, nil)
+
+			result, err := interactor.UpdateTestSuiteStatus(context.Background(), "TS001-202501", tc.statusDTO)
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "完了", result.Status)
+				assert.Equal(t, tc.expectOverride, result.ExitOverride != nil)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockUserRepo.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,17 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+)
+
+// runInTransaction はトランザクション管理が設定されている場合にfnを1つのトランザクションとして実行します
+// 設定されていない場合はfnをそのまま実行するため、各リポジトリ操作は個別に確定します
+// fnの中ではイベントを発行せず、コミット後に発行してください
+func runInTransaction(ctx context.Context, txManager repository.TransactionManager, fn func(ctx context.Context) error) error {
+	if txManager == nil {
+		return fn(ctx)
+	}
+	return txManager.RunInTransaction(ctx, fn)
+}
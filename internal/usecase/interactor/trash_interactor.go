@@ -0,0 +1,291 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/repository"
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+	"github.com/FUJI0130/go-ddd-ca/pkg/errors"
+)
+
+// TrashInteractor はゴミ箱の一覧・復元・完全削除のユースケース実装
+type TrashInteractor struct {
+	testSuiteRepo repository.TestSuiteRepository
+	testGroupRepo repository.TestGroupRepository
+	testCaseRepo  repository.TestCaseRepository
+	publisher     event.Publisher
+	txManager     repository.TransactionManager
+}
+
+// NewTrashInteractor は新しいTrashInteractorを作成します
+// publisherがnilの場合、復元のドメインイベントは発行しません
+// txManagerがnilの場合、配下を含めた復元や完全削除は1件ずつ確定します
+func NewTrashInteractor(
+	testSuiteRepo repository.TestSuiteRepository,
+	testGroupRepo repository.TestGroupRepository,
+	testCaseRepo repository.TestCaseRepository,
+	publisher event.Publisher,
+	txManager repository.TransactionManager,
+) *TrashInteractor {
+	return &TrashInteractor{
+		testSuiteRepo: testSuiteRepo,
+		testGroupRepo: testGroupRepo,
+		testCaseRepo:  testCaseRepo,
+		publisher:     publisher,
+		txManager:     txManager,
+	}
+}
+
+// ListTrash はゴミ箱の内容を取得します
+// スイートごと削除されたグループ・ケースはスイートの復元で戻るため、個別には含めません
+// ゴミ箱内のスイート・グループの進捗は集計しません
+func (i *TrashInteractor) ListTrash(ctx context.Context) (*dto.TrashResponseDTO, error) {
+	suites, err := i.testSuiteRepo.FindDeleted(ctx)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("削除済みテストスイートの取得に失敗しました", err)
+	}
+	groups, err := i.testGroupRepo.FindDeleted(ctx)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("削除済みテストグループの取得に失敗しました", err)
+	}
+	cases, err := i.testCaseRepo.FindDeleted(ctx)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("削除済みテストケースの取得に失敗しました", err)
+	}
+
+	result := &dto.TrashResponseDTO{
+		TestSuites: make([]*dto.TestSuiteResponseDTO, len(suites)),
+		TestGroups: make([]*dto.TestGroupResponseDTO, len(groups)),
+		TestCases:  make([]*dto.TestCaseResponseDTO, len(cases)),
+	}
+	for j, suite := range suites {
+		result.TestSuites[j] = newTestSuiteResponseDTO(suite, &entity.ProgressSummary{})
+	}
+	for j, group := range groups {
+		result.TestGroups[j] = newTestGroupResponseDTO(group, &entity.ProgressSummary{})
+	}
+	for j, tc := range cases {
+		result.TestCases[j] = newTestCaseResponseDTO(tc)
+	}
+
+	return result, nil
+}
+
+// RestoreTestSuite はテストスイートと、スイートと同じ日時に削除されたグループ・ケースを復元します
+// スイートより前に個別に削除されていたグループ・ケースはゴミ箱に残ります
+func (i *TrashInteractor) RestoreTestSuite(ctx context.Context, id string) (*dto.TestSuiteResponseDTO, error) {
+	suite, err := i.testSuiteRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestSuiteNotFoundError(id)
+	}
+
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		if err := i.testSuiteRepo.Restore(ctx, id); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストスイートの復元に失敗しました", err)
+		}
+		if err := i.testGroupRepo.RestoreBySuiteID(ctx, id, suite.DeletedAt); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストグループの復元に失敗しました", err)
+		}
+
+		// 復元したグループ配下のうち、スイートと一緒に削除されたケースを復元する
+		groups, err := i.testGroupRepo.FindBySuiteID(ctx, id)
+		if err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストグループ一覧の取得に失敗しました", err)
+		}
+		for _, group := range groups {
+			if err := i.testCaseRepo.RestoreByGroupID(ctx, group.ID, suite.DeletedAt); err != nil {
+				if errors.IsDomainError(err) {
+					return err
+				}
+				return errors.NewSystemError("テストケースの復元に失敗しました", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, i.publisher, event.New(event.TestSuiteRestored, id, id, ""))
+
+	restored, err := i.testSuiteRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestSuiteNotFoundError(id)
+	}
+	summary, err := suiteProgressSummary(ctx, i.testGroupRepo, i.testCaseRepo, restored)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTestSuiteResponseDTO(restored, summary), nil
+}
+
+// RestoreTestGroup はテストグループと、グループと同じ日時に削除されたケースを復元します
+// 所属するスイートがゴミ箱にある場合は、先にスイートを復元する必要があります
+func (i *TrashInteractor) RestoreTestGroup(ctx context.Context, id string) (*dto.TestGroupResponseDTO, error) {
+	group, err := i.testGroupRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestGroupNotFoundError(id)
+	}
+
+	if _, err := i.testSuiteRepo.FindByID(ctx, group.SuiteID); err != nil {
+		return nil, errors.NewDomainConflictError("TestGroup", id, "所属するテストスイートがゴミ箱にあるため復元できません")
+	}
+
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		if err := i.testGroupRepo.Restore(ctx, id); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストグループの復元に失敗しました", err)
+		}
+		if err := i.testCaseRepo.RestoreByGroupID(ctx, id, group.DeletedAt); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストケースの復元に失敗しました", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, i.publisher, event.New(event.TestGroupRestored, group.SuiteID, id, ""))
+
+	restored, err := i.testGroupRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestGroupNotFoundError(id)
+	}
+	cases, err := i.testCaseRepo.FindByGroupID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewSystemError("テストグループの進捗計算に失敗しました", err)
+	}
+
+	return newTestGroupResponseDTO(restored, restored.GetProgressSummary(cases)), nil
+}
+
+// RestoreTestCase はテストケースを復元します
+// 所属するグループがゴミ箱にある場合は、先にグループを復元する必要があります
+func (i *TrashInteractor) RestoreTestCase(ctx context.Context, id string) (*dto.TestCaseResponseDTO, error) {
+	testCase, err := i.testCaseRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestCaseNotFoundError(id)
+	}
+
+	group, err := i.testGroupRepo.FindByID(ctx, testCase.GroupID)
+	if err != nil {
+		return nil, errors.NewDomainConflictError("TestCase", id, "所属するテストグループがゴミ箱にあるため復元できません")
+	}
+
+	// 復元したケースをグループのステータスに反映
+	var changedGroup *entity.TestGroup
+	err = runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		if err := i.testCaseRepo.Restore(ctx, id); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストケースの復元に失敗しました", err)
+		}
+
+		var err error
+		changedGroup, err = syncGroupStatus(ctx, i.testGroupRepo, i.testCaseRepo, group.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, i.publisher, event.New(event.TestCaseRestored, group.SuiteID, id, ""))
+	publishGroupStatusChanged(ctx, i.publisher, "", changedGroup)
+
+	restored, err := i.testCaseRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.IsDomainError(err) {
+			return nil, err
+		}
+		return nil, errors.NewTestCaseNotFoundError(id)
+	}
+
+	return newTestCaseResponseDTO(restored), nil
+}
+
+// PurgeDeletedBefore はbeforeより前にゴミ箱に移動されたケース・グループ・スイートを完全に削除します
+// 外部キーの参照順に従い、ケース、グループ、スイートの順に削除します
+func (i *TrashInteractor) PurgeDeletedBefore(ctx context.Context, before time.Time) (*dto.TrashPurgeResultDTO, error) {
+	result := &dto.TrashPurgeResultDTO{}
+	err := runInTransaction(ctx, i.txManager, func(ctx context.Context) error {
+		var err error
+		if result.PurgedCaseCount, err = i.testCaseRepo.PurgeDeletedBefore(ctx, before); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストケースの完全削除に失敗しました", err)
+		}
+		if result.PurgedGroupCount, err = i.testGroupRepo.PurgeDeletedBefore(ctx, before); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストグループの完全削除に失敗しました", err)
+		}
+		if result.PurgedSuiteCount, err = i.testSuiteRepo.PurgeDeletedBefore(ctx, before); err != nil {
+			if errors.IsDomainError(err) {
+				return err
+			}
+			return errors.NewSystemError("テストスイートの完全削除に失敗しました", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// deletedAtPtr はレスポンスDTO用に削除日時をポインタに変換します（削除されていない場合はnil）
+func deletedAtPtr(deletedAt time.Time) *time.Time {
+	if deletedAt.IsZero() {
+		return nil
+	}
+	return &deletedAt
+}
@@ -0,0 +1,194 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/entity"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/event"
+	"github.com/FUJI0130/go-ddd-ca/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRestoreTestSuite_RestoresCascadedChildren(t *testing.T) {
+	deletedAt := time.Date(2025, 1, 20, 10, 0, 0, 0, time.UTC)
+	mockSuiteRepo := new(MockTestSuiteRepository)
+	mockGroupRepo := new(MockTestGroupRepository)
+	mockCaseRepo := new(MockTestCaseRepository)
+	mockPublisher := new(MockEventPublisher)
+	mockTxManager := new(MockTransactionManager)
+
+	mockSuiteRepo.On("FindDeletedByID", mock.Anything, "TS001-202501").Return(&entity.TestSuite{
+		ID:        "TS001-202501",
+		Status:    valueobject.SuiteStatusInProgress,
+		DeletedAt: deletedAt,
+	}, nil)
+	mockSuiteRepo.On("Restore", mock.MatchedBy(inTransaction), "TS001-202501").Return(nil)
+	// スイートと同じ日時に削除されたグループ・ケースだけを復元する
+	mockGroupRepo.On("RestoreBySuiteID", mock.MatchedBy(inTransaction), "TS001-202501", deletedAt).Return(nil)
+	mockGroupRepo.On("FindBySuiteID", mock.Anything, "TS001-202501").Return([]*entity.TestGroup{
+		{ID: "TS001TG01-202501", SuiteID: "TS001-202501"},
+	}, nil)
+	mockCaseRepo.On("RestoreByGroupID", mock.MatchedBy(inTransaction), "TS001TG01-202501", deletedAt).Return(nil)
+	mockCaseRepo.On("FindByGroupID", mock.Anything, "TS001TG01-202501").Return([]*entity.TestCase{
+		{ID: "TS001TG01TC001-202501", Status: entity.TestStatusCompleted},
+	}, nil)
+	mockSuiteRepo.On("FindByID", mock.Anything, "TS001-202501").Return(&entity.TestSuite{
+		ID:     "TS001-202501",
+		Status: valueobject.SuiteStatusInProgress,
+	}, nil)
+	mockTxManager.On("RunInTransaction", mock.Anything).Once()
+	mockPublisher.On("Publish", mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+		return e.Type == event.TestSuiteRestored && e.SuiteID == "TS001-202501"
+	})).Once()
+
+	interactor := NewTrashInteractor(mockSuiteRepo, mockGroupRepo, mockCaseRepo, mockPublisher, mockTxManager)
+
+	result, err := interactor.RestoreTestSuite(context.Background(), "TS001-202501")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "TS001-202501", result.ID)
+	assert.Nil(t, result.DeletedAt)
+	mockSuiteRepo.AssertExpectations(t)
+	mockGroupRepo.AssertExpectations(t)
+	mockCaseRepo.AssertExpectations(t)
+	mockTxManager.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestRestoreTestGroup(t *testing.T) {
+	deletedAt := time.Date(2025, 1, 20, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		setupMock     func(*MockTestSuiteRepository, *MockTestGroupRepository, *MockTestCaseRepository)
+		expectedError string
+	}{
+		{
+			name: "正常系：グループと一緒に削除されたケースを復元する",
+			setupMock: func(s *MockTestSuiteRepository, g *MockTestGroupRepository, c *MockTestCaseRepository) {
+				s.On("FindByID", mock.Anything, "TS001-202501").Return(&entity.TestSuite{ID: "TS001-202501"}, nil)
+				g.On("Restore", mock.Anything, "TS001TG01-202501").Return(nil)
+				c.On("RestoreByGroupID", mock.Anything, "TS001TG01-202501", deletedAt).Return(nil)
+				g.On("FindByID", mock.Anything, "TS001TG01-202501").Return(&entity.TestGroup{
+					ID:      "TS001TG01-202501",
+					SuiteID: "TS001-202501",
+				}, nil)
+				c.On("FindByGroupID", mock.Anything, "TS001TG01-202501").Return([]*entity.TestCase{}, nil)
+			},
+		},
+		{
+			name: "異常系：所属するスイートがゴミ箱にある場合は復元できない",
+			setupMock: func(s *MockTestSuiteRepository, g *MockTestGroupRepository, c *MockTestCaseRepository) {
+				s.On("FindByID", mock.Anything, "TS001-202501").Return(nil, fmt.Errorf("not found"))
+			},
+			expectedError: "CONFLICT",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSuiteRepo := new(MockTestSuiteRepository)
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockCaseRepo := new(MockTestCaseRepository)
+			mockGroupRepo.On("FindDeletedByID", mock.Anything, "TS001TG01-202501").Return(&entity.TestGroup{
+				ID:        "TS001TG01-202501",
+				SuiteID:   "TS001-202501",
+				DeletedAt: deletedAt,
+			}, nil)
+			tc.setupMock(mockSuiteRepo, mockGroupRepo, mockCaseRepo)
+
+			interactor := NewTrashInteractor(mockSuiteRepo, mockGroupRepo, mockCaseRepo, nil, nil)
+
+			result, err := interactor.RestoreTestGroup(context.Background(), "TS001TG01-202501")
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				mockGroupRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "TS001TG01-202501", result.ID)
+			}
+
+			mockSuiteRepo.AssertExpectations(t)
+			mockGroupRepo.AssertExpectations(t)
+			mockCaseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRestoreTestCase_RejectsWhenGroupDeleted(t *testing.T) {
+	mockGroupRepo := new(MockTestGroupRepository)
+	mockCaseRepo := new(MockTestCaseRepository)
+
+	mockCaseRepo.On("FindDeletedByID", mock.Anything, "TS001TG01TC001-202501").Return(&entity.TestCase{
+		ID:        "TS001TG01TC001-202501",
+		GroupID:   "TS001TG01-202501",
+		DeletedAt: time.Now(),
+	}, nil)
+	mockGroupRepo.On("FindByID", mock.Anything, "TS001TG01-202501").Return(nil, fmt.Errorf("not found"))
+
+	interactor := NewTrashInteractor(new(MockTestSuiteRepository), mockGroupRepo, mockCaseRepo, nil, nil)
+
+	result, err := interactor.RestoreTestCase(context.Background(), "TS001TG01TC001-202501")
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "CONFLICT")
+	mockCaseRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+}
+
+func TestPurgeDeletedBefore(t *testing.T) {
+	before := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		groupErr      error
+		expectedError string
+	}{
+		{name: "正常系：ケース・グループ・スイートの順に完全削除する"},
+		{name: "異常系：途中で失敗した場合はスイートを削除しない", groupErr: fmt.Errorf("delete failed"), expectedError: "SYSTEM_ERROR"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSuiteRepo := new(MockTestSuiteRepository)
+			mockGroupRepo := new(MockTestGroupRepository)
+			mockCaseRepo := new(MockTestCaseRepository)
+			mockTxManager := new(MockTransactionManager)
+
+			mockTxManager.On("RunInTransaction", mock.Anything).Once()
+			mockCaseRepo.On("PurgeDeletedBefore", mock.MatchedBy(inTransaction), before).Return(5, nil)
+			mockGroupRepo.On("PurgeDeletedBefore", mock.MatchedBy(inTransaction), before).Return(2, tc.groupErr)
+			if tc.groupErr == nil {
+				mockSuiteRepo.On("PurgeDeletedBefore", mock.MatchedBy(inTransaction), before).Return(1, nil)
+			}
+
+			interactor := NewTrashInteractor(mockSuiteRepo, mockGroupRepo, mockCaseRepo, nil, mockTxManager)
+
+			result, err := interactor.PurgeDeletedBefore(context.Background(), before)
+
+			if tc.expectedError != "" {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				mockSuiteRepo.AssertNotCalled(t, "PurgeDeletedBefore", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, result.PurgedSuiteCount)
+				assert.Equal(t, 2, result.PurgedGroupCount)
+				assert.Equal(t, 5, result.PurgedCaseCount)
+			}
+
+			mockSuiteRepo.AssertExpectations(t)
+			mockGroupRepo.AssertExpectations(t)
+			mockCaseRepo.AssertExpectations(t)
+			mockTxManager.AssertExpectations(t)
+		})
+	}
+}
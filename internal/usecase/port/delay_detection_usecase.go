@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+)
+
+// DelayDetectionUseCase はテストケースの遅延検出のユースケースインターフェース
+type DelayDetectionUseCase interface {
+	// DetectDelays は未完了のテストケースを期限と比較し、遅延状態を更新する
+	DetectDelays(ctx context.Context, now time.Time) (*dto.DelayDetectionResultDTO, error)
+}
@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+)
+
+// EffortUseCase は工数記録のユースケースインターフェース
+type EffortUseCase interface {
+	// RecordEffort はテストケースに工数を記録する
+	RecordEffort(ctx context.Context, createDTO *dto.EffortRecordCreateDTO) (*dto.EffortRecordResponseDTO, error)
+
+	// CorrectEffortRecord は既存の工数記録を訂正する
+	CorrectEffortRecord(ctx context.Context, id string, correctDTO *dto.EffortRecordCorrectDTO) (*dto.EffortRecordResponseDTO, error)
+
+	// GetEffortRecordsByTestCase は指定されたテストケースの工数記録一覧を取得する
+	GetEffortRecordsByTestCase(ctx context.Context, testCaseID string) (*dto.EffortRecordListResponseDTO, error)
+
+	// GetEffortRecordsByUserAndDate は指定されたユーザーの指定日の工数記録一覧を取得する
+	GetEffortRecordsByUserAndDate(ctx context.Context, userID string, recordDate time.Time) (*dto.EffortRecordListResponseDTO, error)
+}
@@ -10,4 +10,42 @@ import (
 type TestCaseUseCase interface {
 	// GetCasesByGroupID は指定されたグループIDに属するケース一覧を取得する
 	GetCasesByGroupID(ctx context.Context, groupID string) ([]*dto.TestCaseResponseDTO, error)
+
+	// GetCasesByGroupIDs は複数のグループに属するケースをグループIDごとにまとめて取得する
+	GetCasesByGroupIDs(ctx context.Context, groupIDs []string) (map[string][]*dto.TestCaseResponseDTO, error)
+
+	// GetTestCase は指定されたIDのケースを取得する
+	GetTestCase(ctx context.Context, id string) (*dto.TestCaseResponseDTO, error)
+
+	// CreateTestCase は新しいケースを作成する
+	CreateTestCase(ctx context.Context, createDTO *dto.TestCaseCreateDTO) (*dto.TestCaseResponseDTO, error)
+
+	// UpdateTestCase は指定されたIDのケースを更新する
+	UpdateTestCase(ctx context.Context, id string, updateDTO *dto.TestCaseUpdateDTO) (*dto.TestCaseResponseDTO, error)
+
+	// DeleteTestCase は指定されたIDのケースを削除する
+	// deletedByは編集ロックの保持者確認に使用する
+	DeleteTestCase(ctx context.Context, id string, deletedBy string) error
+
+	// MoveTestCase は指定されたIDのケースを別のグループに移動する
+	// movedByは編集ロックの保持者確認に使用する
+	MoveTestCase(ctx context.Context, id string, targetGroupID string, movedBy string) (*dto.TestCaseResponseDTO, error)
+
+	// UpdateTestCaseStatus はステータス遷移ルールに従ってテストケースのステータスを更新する
+	UpdateTestCaseStatus(ctx context.Context, id string, statusDTO *dto.TestCaseStatusUpdateDTO) (*dto.TestCaseResponseDTO, error)
+
+	// GetStatusHistory は指定されたテストケースのステータス変更履歴を取得する
+	GetStatusHistory(ctx context.Context, testCaseID string) ([]*dto.StatusHistoryResponseDTO, error)
+
+	// LockTestCase は指定されたテストケースの編集ロックを取得する
+	LockTestCase(ctx context.Context, id string, editor string) (*dto.TestCaseResponseDTO, error)
+
+	// RenewTestCaseLock は保持している編集ロックのリース期限を延長する（ハートビート）
+	RenewTestCaseLock(ctx context.Context, id string, editor string) (*dto.TestCaseResponseDTO, error)
+
+	// UnlockTestCase は保持している編集ロックを解除する
+	UnlockTestCase(ctx context.Context, id string, editor string) (*dto.TestCaseResponseDTO, error)
+
+	// ForceUnlockTestCase は他のユーザーが保持する編集ロックを強制解除する（Admin/Managerのみ）
+	ForceUnlockTestCase(ctx context.Context, id string, userID string) (*dto.TestCaseResponseDTO, error)
 }
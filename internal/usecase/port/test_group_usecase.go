@@ -10,6 +10,21 @@ import (
 type TestGroupUseCase interface {
 	// GetGroupsBySuiteID は指定されたスイートIDに属するグループ一覧を取得する
 	GetGroupsBySuiteID(ctx context.Context, suiteID string) ([]*dto.TestGroupResponseDTO, error)
+
+	// GetGroupsBySuiteIDs は複数のスイートに属するグループをスイートIDごとにまとめて取得する
+	GetGroupsBySuiteIDs(ctx context.Context, suiteIDs []string) (map[string][]*dto.TestGroupResponseDTO, error)
 	// 追加するメソッド
 	CreateTestGroup(ctx context.Context, dto *dto.TestGroupCreateDTO) (*dto.TestGroupResponseDTO, error)
+
+	// GetTestGroup は指定されたIDのグループを取得する
+	GetTestGroup(ctx context.Context, id string) (*dto.TestGroupResponseDTO, error)
+
+	// UpdateTestGroup は指定されたIDのグループを更新する
+	UpdateTestGroup(ctx context.Context, id string, updateDTO *dto.TestGroupUpdateDTO) (*dto.TestGroupResponseDTO, error)
+
+	// DeleteTestGroup は指定されたIDのグループを削除する
+	DeleteTestGroup(ctx context.Context, id string) error
+
+	// ReorderTestGroups はスイート内のグループを指定されたID順に並べ替える
+	ReorderTestGroups(ctx context.Context, suiteID string, groupIDs []string) ([]*dto.TestGroupResponseDTO, error)
 }
@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/FUJI0130/go-ddd-ca/internal/usecase/dto"
+)
+
+// TrashUseCase は論理削除されたテストスイート・グループ・ケースを扱うゴミ箱のユースケースインターフェース
+type TrashUseCase interface {
+	// ListTrash は復元可能な単位でゴミ箱の内容を取得する
+	ListTrash(ctx context.Context) (*dto.TrashResponseDTO, error)
+
+	// RestoreTestSuite はテストスイートを、一緒に削除されたグループ・ケースとともに復元する
+	RestoreTestSuite(ctx context.Context, id string) (*dto.TestSuiteResponseDTO, error)
+
+	// RestoreTestGroup はテストグループを、一緒に削除されたケースとともに復元する
+	RestoreTestGroup(ctx context.Context, id string) (*dto.TestGroupResponseDTO, error)
+
+	// RestoreTestCase はテストケースを復元する
+	RestoreTestCase(ctx context.Context, id string) (*dto.TestCaseResponseDTO, error)
+
+	// PurgeDeletedBefore はbeforeより前にゴミ箱に移動されたものを完全に削除する
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (*dto.TrashPurgeResultDTO, error)
+}
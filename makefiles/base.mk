@@ -37,7 +37,9 @@ help:
 	@echo "  db-up             - データベースコンテナを起動"
 	@echo "  db-down           - データベースコンテナを停止"
 	@echo "  migrate           - マイグレーションを実行"
-	@echo "  migrate-down      - マイグレーションをロールバック"
+	@echo "  migrate-down      - 直前のマイグレーションを1件ロールバック"
+	@echo "  migrate-status    - 適用済みのバージョンと未適用のマイグレーションを表示"
+	@echo "  migrate-to        - 指定バージョンまで移行 (VERSION=12)"
 	@echo "  test-db-up        - テスト用データベースを起動"
 	@echo "  test-db-down      - テスト用データベースを停止"
 	@echo ""
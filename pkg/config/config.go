@@ -13,6 +13,8 @@ type Config struct {
 	Server      ServerConfig
 	Database    DatabaseConfig
 	Auth        AuthConfig
+	ID          IDConfig
+	GraphQL     GraphQLConfig
 }
 
 // ServerConfig はWebサーバーの設定を保持します
@@ -28,6 +30,20 @@ type AuthConfig struct {
 	TokenDuration time.Duration
 }
 
+// IDConfig はエンティティIDの採番設定を保持します
+// Strategyはsequence（TS001-202501形式）、ulid、prefix（Prefix-TS001形式）のいずれかです
+type IDConfig struct {
+	Strategy string
+	Prefix   string
+}
+
+// GraphQLConfig はGraphQLサーバーのクエリの制限を保持します
+// MaxDepthはクエリのネストの深さ、MaxComplexityはフィールドごとのコストの合計の上限で、0の場合は制限しません
+type GraphQLConfig struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
 // LoadConfig は設定ファイルと環境変数から設定を読み込みます
 func LoadConfig(configPath string) (*Config, error) {
 	// 環境変数プロバイダーを最優先に設定
@@ -77,17 +93,20 @@ func LoadConfig(configPath string) (*Config, error) {
 
 	// デフォルト値プロバイダーの作成
 	defaultConfig := map[string]interface{}{
-		"server.port":         8080,
-		"server.readTimeout":  "15s",
-		"server.writeTimeout": "15s",
-		"database.driver":     "postgres",
-		"database.user":       "testuser",
-		"database.password":   "testpass",
-		"database.host":       "localhost",
-		"database.port":       5432,
-		"database.dbname":     "test_management",
-		"database.sslmode":    "disable",
-		"auth.tokenDuration":  "24h",
+		"server.port":           8080,
+		"server.readTimeout":    "15s",
+		"server.writeTimeout":   "15s",
+		"database.driver":       "postgres",
+		"database.user":         "testuser",
+		"database.password":     "testpass",
+		"database.host":         "localhost",
+		"database.port":         5432,
+		"database.dbname":       "test_management",
+		"database.sslmode":      "disable",
+		"auth.tokenDuration":    "24h",
+		"id.strategy":           "sequence",
+		"graphql.maxDepth":      10,
+		"graphql.maxComplexity": 10000,
 	}
 
 	// StaticConfigProviderの作成
@@ -112,6 +131,14 @@ func LoadConfig(configPath string) (*Config, error) {
 			JWTSecret:     chainedProvider.GetString("auth.jwtSecret", ""),
 			TokenDuration: parseDuration(chainedProvider.GetString("auth.tokenDuration", "24h")),
 		},
+		ID: IDConfig{
+			Strategy: chainedProvider.GetString("id.strategy", "sequence"),
+			Prefix:   chainedProvider.GetString("id.prefix", ""),
+		},
+		GraphQL: GraphQLConfig{
+			MaxDepth:      chainedProvider.GetInt("graphql.maxDepth", 10),
+			MaxComplexity: chainedProvider.GetInt("graphql.maxComplexity", 10000),
+		},
 	}
 
 	// デバッグモードでログ出力
@@ -121,14 +148,20 @@ func LoadConfig(configPath string) (*Config, error) {
 		log.Printf("Server.Port: %d (source: %s)", config.Server.Port, getSettingSource(chainedProvider, "server.port"))
 		log.Printf("Server.ReadTimeout: %s (source: %s)", config.Server.ReadTimeout, getSettingSource(chainedProvider, "server.readTimeout"))
 		log.Printf("Server.WriteTimeout: %s (source: %s)", config.Server.WriteTimeout, getSettingSource(chainedProvider, "server.writeTimeout"))
-		log.Printf("Database.Driver: %s (source: %s)", config.Database.Driver, getSettingSource(chainedProvider, "database.driver"))
+		log.Printf("Database.Driver: %s (source: %s)", config.Database.Driver, getSettingSource(chainedProvider, "database.driver", "DB_DRIVER"))
 		log.Printf("Database.Host: %s (source: %s)", config.Database.Host, getSettingSource(chainedProvider, "database.host", "DB_HOST"))
 		log.Printf("Database.Port: %d (source: %s)", config.Database.Port, getSettingSource(chainedProvider, "database.port", "DB_PORT"))
 		log.Printf("Database.User: %s (source: %s)", config.Database.User, getSettingSource(chainedProvider, "database.user", "DB_USER", "DB_USERNAME"))
 		log.Printf("Database.DBName: %s (source: %s)", config.Database.DBName, getSettingSource(chainedProvider, "database.dbname", "DB_NAME"))
 		log.Printf("Database.SSLMode: %s (source: %s)", config.Database.SSLMode, getSettingSource(chainedProvider, "database.sslmode", "DB_SSLMODE"))
+		log.Printf("Database.Path: %s (source: %s)", config.Database.Path, getSettingSource(chainedProvider, "database.path", "DB_PATH"))
+		log.Printf("Database.AutoMigrate: %t (source: %s)", config.Database.AutoMigrate, getSettingSource(chainedProvider, "database.autoMigrate", "DB_AUTO_MIGRATE"))
 		log.Printf("Auth.JWTSecret: %s (source: %s)", "***" /* セキュリティのため表示しない */, getSettingSource(chainedProvider, "auth.jwtSecret"))
 		log.Printf("Auth.TokenDuration: %s (source: %s)", config.Auth.TokenDuration, getSettingSource(chainedProvider, "auth.tokenDuration"))
+		log.Printf("ID.Strategy: %s (source: %s)", config.ID.Strategy, getSettingSource(chainedProvider, "id.strategy"))
+		log.Printf("ID.Prefix: %s (source: %s)", config.ID.Prefix, getSettingSource(chainedProvider, "id.prefix"))
+		log.Printf("GraphQL.MaxDepth: %d (source: %s)", config.GraphQL.MaxDepth, getSettingSource(chainedProvider, "graphql.maxDepth", "GRAPHQL_MAX_DEPTH"))
+		log.Printf("GraphQL.MaxComplexity: %d (source: %s)", config.GraphQL.MaxComplexity, getSettingSource(chainedProvider, "graphql.maxComplexity", "GRAPHQL_MAX_COMPLEXITY"))
 		log.Println("====================================================")
 	}
 
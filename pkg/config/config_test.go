@@ -68,6 +68,43 @@ func TestLoadConfig(t *testing.T) {
 		os.Unsetenv("DB_HOST")
 	})
 
+	// IDの採番設定
+	t.Run("IDの採番設定", func(t *testing.T) {
+		config, err := LoadConfig("../../configs")
+		assert.NoError(t, err)
+		assert.Equal(t, "sequence", config.ID.Strategy)
+
+		os.Setenv("ID_STRATEGY", "prefix")
+		os.Setenv("ID_PREFIX", "ACME")
+
+		config, err = LoadConfig("../../configs")
+		assert.NoError(t, err)
+		assert.Equal(t, "prefix", config.ID.Strategy)
+		assert.Equal(t, "ACME", config.ID.Prefix)
+
+		os.Unsetenv("ID_STRATEGY")
+		os.Unsetenv("ID_PREFIX")
+	})
+
+	// GraphQLのクエリの制限
+	t.Run("GraphQLのクエリの制限", func(t *testing.T) {
+		config, err := LoadConfig("../../configs")
+		assert.NoError(t, err)
+		assert.Equal(t, 10, config.GraphQL.MaxDepth)
+		assert.Equal(t, 10000, config.GraphQL.MaxComplexity)
+
+		os.Setenv("GRAPHQL_MAX_DEPTH", "5")
+		os.Setenv("GRAPHQL_MAX_COMPLEXITY", "500")
+
+		config, err = LoadConfig("../../configs")
+		assert.NoError(t, err)
+		assert.Equal(t, 5, config.GraphQL.MaxDepth)
+		assert.Equal(t, 500, config.GraphQL.MaxComplexity)
+
+		os.Unsetenv("GRAPHQL_MAX_DEPTH")
+		os.Unsetenv("GRAPHQL_MAX_COMPLEXITY")
+	})
+
 	// 期間のパース
 	t.Run("期間のパース", func(t *testing.T) {
 		assert.Equal(t, 15*time.Second, parseDuration("15s"))
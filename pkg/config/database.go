@@ -6,11 +6,14 @@ import (
 	"log"
 	"time"
 
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/migration"
 	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/postgres"
+	"github.com/FUJI0130/go-ddd-ca/internal/infrastructure/persistence/sqlite"
 )
 
 // DatabaseConfig はデータベース接続の設定を保持します
 type DatabaseConfig struct {
+	// Driver は使用するデータベース（postgres または sqlite）です
 	Driver   string
 	Host     string
 	Port     int
@@ -18,6 +21,10 @@ type DatabaseConfig struct {
 	Password string
 	DBName   string
 	SSLMode  string
+	// Path はdriverがsqliteの場合のデータベースファイルのパスです（存在しない場合は作成します）
+	Path string
+	// AutoMigrate がtrueの場合、サーバー起動時に埋め込みのマイグレーションを適用します
+	AutoMigrate bool
 }
 
 // NewDatabaseConfigFromProvider は設定プロバイダーからデータベース設定を作成します
@@ -25,24 +32,39 @@ func NewDatabaseConfigFromProvider(provider ConfigProvider) *DatabaseConfig {
 	// データベース設定をプロバイダーから一貫して取得
 	// 環境変数のマッピングはプロバイダー内部で処理される
 	config := &DatabaseConfig{
-		Driver:   provider.GetString("database.driver", "postgres"),
-		Host:     provider.GetString("database.host", "localhost"),
-		Port:     provider.GetInt("database.port", 5432),
-		User:     provider.GetString("database.user", "postgres"),
-		Password: provider.GetString("database.password", ""),
-		DBName:   provider.GetString("database.dbname", "postgres"),
-		SSLMode:  provider.GetString("database.sslmode", "disable"),
+		Driver:      provider.GetString("database.driver", "postgres"),
+		Host:        provider.GetString("database.host", "localhost"),
+		Port:        provider.GetInt("database.port", 5432),
+		User:        provider.GetString("database.user", "postgres"),
+		Password:    provider.GetString("database.password", ""),
+		DBName:      provider.GetString("database.dbname", "postgres"),
+		SSLMode:     provider.GetString("database.sslmode", "disable"),
+		Path:        provider.GetString("database.path", "data/test_management.db"),
+		AutoMigrate: provider.GetBool("database.autoMigrate", false),
 	}
 
 	// ソース情報のログ出力（パスワードは除く）
+	if dialect, err := migration.ParseDialect(config.Driver); err == nil && dialect == migration.DialectSQLite {
+		log.Printf("Database connection settings from %s: driver=sqlite, path=%s", provider.Source(), config.Path)
+		return config
+	}
 	log.Printf("Database connection settings from %s: host=%s, port=%d, user=%s, dbname=%s, sslmode=%s",
 		provider.Source(), config.Host, config.Port, config.User, config.DBName, config.SSLMode)
 
 	return config
 }
 
-// NewDatabaseConnection はデータベース接続を作成します
+// NewDatabaseConnection はdriverに応じたデータベース接続を作成します
 func (c *DatabaseConfig) NewDatabaseConnection() (*sql.DB, error) {
+	dialect, err := migration.ParseDialect(c.Driver)
+	if err != nil {
+		return nil, err
+	}
+	if dialect == migration.DialectSQLite {
+		log.Printf("Opening SQLite database: %s", c.Path)
+		return sqlite.NewDB(sqlite.DBConfig{Path: c.Path})
+	}
+
 	// PostgreSQL接続文字列の生成
 	dsn := fmt.Sprintf(
 		"postgresql://%s:%s@%s:%d/%s?sslmode=%s",
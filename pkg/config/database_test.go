@@ -12,13 +12,14 @@ func TestNewDatabaseConfigFromProvider(t *testing.T) {
 	// 静的プロバイダーのテスト
 	t.Run("静的プロバイダーからのデータベース設定", func(t *testing.T) {
 		staticProvider := NewStaticConfigProvider(map[string]interface{}{
-			"database.driver":   "postgres",
-			"database.host":     "static-host",
-			"database.port":     5678,
-			"database.user":     "static-user",
-			"database.password": "static-pass",
-			"database.dbname":   "static-db",
-			"database.sslmode":  "require",
+			"database.driver":      "postgres",
+			"database.host":        "static-host",
+			"database.port":        5678,
+			"database.user":        "static-user",
+			"database.password":    "static-pass",
+			"database.dbname":      "static-db",
+			"database.sslmode":     "require",
+			"database.autoMigrate": true,
 		})
 
 		dbConfig := NewDatabaseConfigFromProvider(staticProvider)
@@ -29,6 +30,7 @@ func TestNewDatabaseConfigFromProvider(t *testing.T) {
 		assert.Equal(t, "static-pass", dbConfig.Password)
 		assert.Equal(t, "static-db", dbConfig.DBName)
 		assert.Equal(t, "require", dbConfig.SSLMode)
+		assert.True(t, dbConfig.AutoMigrate)
 	})
 
 	// 環境変数プロバイダーのテスト
@@ -39,6 +41,7 @@ func TestNewDatabaseConfigFromProvider(t *testing.T) {
 		os.Setenv("DB_PASSWORD", "env-pass")
 		os.Setenv("DB_NAME", "env-db")
 		os.Setenv("DB_SSLMODE", "disable")
+		os.Setenv("DB_AUTO_MIGRATE", "true")
 
 		envProvider := NewEnvConfigProvider("")
 		dbConfig := NewDatabaseConfigFromProvider(envProvider)
@@ -49,6 +52,7 @@ func TestNewDatabaseConfigFromProvider(t *testing.T) {
 		assert.Equal(t, "env-pass", dbConfig.Password)
 		assert.Equal(t, "env-db", dbConfig.DBName)
 		assert.Equal(t, "disable", dbConfig.SSLMode)
+		assert.True(t, dbConfig.AutoMigrate)
 
 		// 環境変数をクリア
 		os.Unsetenv("DB_HOST")
@@ -57,6 +61,7 @@ func TestNewDatabaseConfigFromProvider(t *testing.T) {
 		os.Unsetenv("DB_PASSWORD")
 		os.Unsetenv("DB_NAME")
 		os.Unsetenv("DB_SSLMODE")
+		os.Unsetenv("DB_AUTO_MIGRATE")
 	})
 
 	// チェーンプロバイダーでの環境変数優先のテスト
@@ -93,6 +98,7 @@ func TestNewDatabaseConfigFromProvider(t *testing.T) {
 		assert.Equal(t, "", dbConfig.Password)
 		assert.Equal(t, "postgres", dbConfig.DBName)
 		assert.Equal(t, "disable", dbConfig.SSLMode)
+		assert.False(t, dbConfig.AutoMigrate)
 	})
 }
 
@@ -8,13 +8,18 @@ import (
 
 // 設定キーから環境変数キーへのマッピング
 var configToEnvMap = map[string]string{
-	"database.host":     "DB_HOST",
-	"database.port":     "DB_PORT",
-	"database.user":     "DB_USER",
-	"database.username": "DB_USERNAME", // 互換性のため両方サポート
-	"database.password": "DB_PASSWORD",
-	"database.dbname":   "DB_NAME",
-	"database.sslmode":  "DB_SSLMODE",
+	"database.driver":       "DB_DRIVER",
+	"database.host":         "DB_HOST",
+	"database.port":         "DB_PORT",
+	"database.user":         "DB_USER",
+	"database.username":     "DB_USERNAME", // 互換性のため両方サポート
+	"database.password":     "DB_PASSWORD",
+	"database.dbname":       "DB_NAME",
+	"database.sslmode":      "DB_SSLMODE",
+	"database.path":         "DB_PATH",
+	"database.autoMigrate":  "DB_AUTO_MIGRATE",
+	"graphql.maxDepth":      "GRAPHQL_MAX_DEPTH",
+	"graphql.maxComplexity": "GRAPHQL_MAX_COMPLEXITY",
 }
 
 // EnvConfigProvider は環境変数から設定を読み込むプロバイダー
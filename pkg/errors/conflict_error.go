@@ -2,6 +2,8 @@ package errors
 
 import (
 	"fmt"
+	"strings"
+	"time"
 )
 
 // ConflictError は競合エラー
@@ -106,3 +108,98 @@ func (e *StatusTransitionConflictError) Details() map[string]interface{} {
 	details["new_status"] = e.NewStatus
 	return details
 }
+
+// EditLockConflictError は編集ロックの競合エラー
+type EditLockConflictError struct {
+	ConflictError
+	LockedBy      string
+	LockExpiresAt time.Time
+}
+
+// NewEditLockConflictError は新しいEditLockConflictErrorを生成
+func NewEditLockConflictError(resource, id, lockedBy string, lockExpiresAt time.Time) *EditLockConflictError {
+	return &EditLockConflictError{
+		ConflictError: *NewDomainConflictError(
+			resource,
+			id,
+			fmt.Sprintf("%s (ID: %s) は他のユーザーが編集中です", resource, id),
+		),
+		LockedBy:      lockedBy,
+		LockExpiresAt: lockExpiresAt,
+	}
+}
+
+// Details はロックの保持者と期限を含む詳細情報を返す
+func (e *EditLockConflictError) Details() map[string]interface{} {
+	details := e.ConflictError.Details()
+	details["lockedBy"] = e.LockedBy
+	if !e.LockExpiresAt.IsZero() {
+		details["lockExpiresAt"] = e.LockExpiresAt.Format(time.RFC3339)
+	}
+	return details
+}
+
+// ExitCriteriaBlockingCase は完了条件を満たさない原因となっているテストケース
+type ExitCriteriaBlockingCase struct {
+	ID       string
+	Title    string
+	Status   string
+	Priority string
+}
+
+// maxListedBlockingCases はエラーメッセージに列挙するテストケースの上限（残りは件数のみ表示）
+const maxListedBlockingCases = 10
+
+// ExitCriteriaNotMetError はテストスイートが完了条件を満たしていないエラー
+type ExitCriteriaNotMetError struct {
+	ConflictError
+	CompletionRate         float64
+	RequiredCompletionRate float64
+	BlockingCases          []ExitCriteriaBlockingCase
+}
+
+// NewExitCriteriaNotMetError は新しいExitCriteriaNotMetErrorを生成
+// メッセージには完了率と、完了を妨げているテストケースを列挙する
+func NewExitCriteriaNotMetError(suiteID string, completionRate, requiredRate float64, blockingCases []ExitCriteriaBlockingCase) *ExitCriteriaNotMetError {
+	listed := make([]string, 0, maxListedBlockingCases)
+	for i, tc := range blockingCases {
+		if i == maxListedBlockingCases {
+			break
+		}
+		listed = append(listed, fmt.Sprintf("%s「%s」(%s, %s)", tc.ID, tc.Title, tc.Status, tc.Priority))
+	}
+	list := strings.Join(listed, ", ")
+	if rest := len(blockingCases) - len(listed); rest > 0 {
+		list += fmt.Sprintf(" ほか%d件", rest)
+	}
+
+	return &ExitCriteriaNotMetError{
+		ConflictError: *NewDomainConflictError(
+			"TestSuite",
+			suiteID,
+			fmt.Sprintf("テストスイート (ID: %s) は完了条件を満たしていません（完了率 %.1f%% / 必要 %.1f%%）。未完了のテストケース: %s",
+				suiteID, completionRate, requiredRate, list),
+		),
+		CompletionRate:         completionRate,
+		RequiredCompletionRate: requiredRate,
+		BlockingCases:          blockingCases,
+	}
+}
+
+// Details は完了率と完了を妨げているテストケースを含む詳細情報を返す
+func (e *ExitCriteriaNotMetError) Details() map[string]interface{} {
+	details := e.ConflictError.Details()
+	details["completionRate"] = e.CompletionRate
+	details["requiredCompletionRate"] = e.RequiredCompletionRate
+	blocking := make([]map[string]string, len(e.BlockingCases))
+	for i, tc := range e.BlockingCases {
+		blocking[i] = map[string]string{
+			"id":       tc.ID,
+			"title":    tc.Title,
+			"status":   tc.Status,
+			"priority": tc.Priority,
+		}
+	}
+	details["blockingCases"] = blocking
+	return details
+}
@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -70,6 +71,27 @@ func TestEntityNotFoundError(t *testing.T) {
 	assert.True(t, IsDomainError(err))
 }
 
+func TestExitCriteriaNotMetError(t *testing.T) {
+	blocking := make([]ExitCriteriaBlockingCase, 12)
+	for i := range blocking {
+		blocking[i] = ExitCriteriaBlockingCase{ID: fmt.Sprintf("TC%03d", i+1), Title: "ログイン", Status: "テスト", Priority: "Critical"}
+	}
+	err := NewExitCriteriaNotMetError("TS001-202501", 40, 100, blocking)
+
+	// メッセージには完了率と阻害要因のケースを列挙し、上限を超えた分は件数で示す
+	assert.Equal(t, "CONFLICT", err.ErrorCode())
+	assert.Contains(t, err.ErrorMessage(), "完了率 40.0% / 必要 100.0%")
+	assert.Contains(t, err.ErrorMessage(), "TC001「ログイン」(テスト, Critical)")
+	assert.Contains(t, err.ErrorMessage(), "TC010")
+	assert.NotContains(t, err.ErrorMessage(), "TC011")
+	assert.Contains(t, err.ErrorMessage(), "ほか2件")
+
+	details := err.Details()
+	assert.Equal(t, 40.0, details["completionRate"])
+	assert.Len(t, details["blockingCases"], 12)
+	assert.True(t, IsDomainError(err))
+}
+
 func TestSystemError(t *testing.T) {
 	// SystemErrorの作成
 	originalErr := assert.AnError
@@ -47,8 +47,10 @@ func determineGRPCCode(err DomainError) codes.Code {
 		return codes.InvalidArgument
 	case *NotFoundError, *EntityNotFoundError, *TestSuiteNotFoundError, *TestGroupNotFoundError, *TestCaseNotFoundError:
 		return codes.NotFound
-	case *ConflictError, *ConcurrentModificationError, *AlreadyExistsError:
+	case *ConflictError, *ConcurrentModificationError, *AlreadyExistsError, *EditLockConflictError:
 		return codes.Aborted
+	case *ExitCriteriaNotMetError:
+		return codes.FailedPrecondition
 	case *UnauthorizedError:
 		return codes.Unauthenticated
 	case *PermissionError, *ForbiddenError:
@@ -30,6 +30,11 @@ func TestToGRPCError(t *testing.T) {
 			err:      NewDomainConflictError("TestSuite", "TS001", ""),
 			wantCode: codes.Aborted,
 		},
+		{
+			name:     "ExitCriteriaNotMetError",
+			err:      NewExitCriteriaNotMetError("TS001", 50, 100, []ExitCriteriaBlockingCase{{ID: "TS001TG01TC001"}}),
+			wantCode: codes.FailedPrecondition,
+		},
 		{
 			name:     "UnauthorizedError",
 			err:      NewDomainUnauthorizedError(),
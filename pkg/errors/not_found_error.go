@@ -2,6 +2,7 @@ package errors
 
 import (
 	"fmt"
+	"strings"
 )
 
 // NotFoundError は基本的な未検出エラー
@@ -88,3 +89,17 @@ func NewTestCaseNotFoundError(id string) *TestCaseNotFoundError {
 		EntityNotFoundError: *NewEntityNotFoundError("TestCase", id),
 	}
 }
+
+// IsNotFoundError は指定されたエラーが未検出エラーかどうかを確認
+// ドメインの未検出エラーに加え、リポジトリが返すAPIErrorの未検出エラーも対象とする
+func IsNotFoundError(err error) bool {
+	switch e := err.(type) {
+	case *APIError:
+		return e.Code == "NOT_FOUND"
+	case DomainError:
+		code := e.ErrorCode()
+		return code == "NOT_FOUND" || strings.HasSuffix(code, "_NOT_FOUND")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,664 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: proto/testsuite/v1/effort.proto
+
+package testsuitev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 工数記録定義
+type EffortRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TestCaseId   string                 `protobuf:"bytes,2,opt,name=test_case_id,json=testCaseId,proto3" json:"test_case_id,omitempty"`
+	RecordDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+	EffortAmount float64                `protobuf:"fixed64,4,opt,name=effort_amount,json=effortAmount,proto3" json:"effort_amount,omitempty"`
+	IsAdditional bool                   `protobuf:"varint,5,opt,name=is_additional,json=isAdditional,proto3" json:"is_additional,omitempty"`
+	Comment      string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	RecordedBy   string                 `protobuf:"bytes,7,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *EffortRecord) Reset() {
+	*x = EffortRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_effort_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EffortRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffortRecord) ProtoMessage() {}
+
+func (x *EffortRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_effort_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffortRecord.ProtoReflect.Descriptor instead.
+func (*EffortRecord) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_effort_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EffortRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EffortRecord) GetTestCaseId() string {
+	if x != nil {
+		return x.TestCaseId
+	}
+	return ""
+}
+
+func (x *EffortRecord) GetRecordDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordDate
+	}
+	return nil
+}
+
+func (x *EffortRecord) GetEffortAmount() float64 {
+	if x != nil {
+		return x.EffortAmount
+	}
+	return 0
+}
+
+func (x *EffortRecord) GetIsAdditional() bool {
+	if x != nil {
+		return x.IsAdditional
+	}
+	return false
+}
+
+func (x *EffortRecord) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *EffortRecord) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+func (x *EffortRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 工数記録リクエスト
+type RecordEffortRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCaseId string `protobuf:"bytes,1,opt,name=test_case_id,json=testCaseId,proto3" json:"test_case_id,omitempty"`
+	// 省略時は当日として記録
+	RecordDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=record_date,json=recordDate,proto3,oneof" json:"record_date,omitempty"`
+	EffortAmount float64                `protobuf:"fixed64,3,opt,name=effort_amount,json=effortAmount,proto3" json:"effort_amount,omitempty"`
+	IsAdditional bool                   `protobuf:"varint,4,opt,name=is_additional,json=isAdditional,proto3" json:"is_additional,omitempty"`
+	Comment      string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	RecordedBy   string                 `protobuf:"bytes,6,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+}
+
+func (x *RecordEffortRequest) Reset() {
+	*x = RecordEffortRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_effort_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordEffortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEffortRequest) ProtoMessage() {}
+
+func (x *RecordEffortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_effort_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEffortRequest.ProtoReflect.Descriptor instead.
+func (*RecordEffortRequest) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_effort_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordEffortRequest) GetTestCaseId() string {
+	if x != nil {
+		return x.TestCaseId
+	}
+	return ""
+}
+
+func (x *RecordEffortRequest) GetRecordDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordDate
+	}
+	return nil
+}
+
+func (x *RecordEffortRequest) GetEffortAmount() float64 {
+	if x != nil {
+		return x.EffortAmount
+	}
+	return 0
+}
+
+func (x *RecordEffortRequest) GetIsAdditional() bool {
+	if x != nil {
+		return x.IsAdditional
+	}
+	return false
+}
+
+func (x *RecordEffortRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RecordEffortRequest) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+// 工数記録の訂正リクエスト
+type CorrectEffortRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EffortAmount float64 `protobuf:"fixed64,2,opt,name=effort_amount,json=effortAmount,proto3" json:"effort_amount,omitempty"`
+	Comment      string  `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	CorrectedBy  string  `protobuf:"bytes,4,opt,name=corrected_by,json=correctedBy,proto3" json:"corrected_by,omitempty"`
+}
+
+func (x *CorrectEffortRecordRequest) Reset() {
+	*x = CorrectEffortRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_effort_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorrectEffortRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectEffortRecordRequest) ProtoMessage() {}
+
+func (x *CorrectEffortRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_effort_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectEffortRecordRequest.ProtoReflect.Descriptor instead.
+func (*CorrectEffortRecordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_effort_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CorrectEffortRecordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CorrectEffortRecordRequest) GetEffortAmount() float64 {
+	if x != nil {
+		return x.EffortAmount
+	}
+	return 0
+}
+
+func (x *CorrectEffortRecordRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CorrectEffortRecordRequest) GetCorrectedBy() string {
+	if x != nil {
+		return x.CorrectedBy
+	}
+	return ""
+}
+
+// テストケースごとの工数記録一覧取得リクエスト
+type ListEffortRecordsByTestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCaseId string `protobuf:"bytes,1,opt,name=test_case_id,json=testCaseId,proto3" json:"test_case_id,omitempty"`
+}
+
+func (x *ListEffortRecordsByTestCaseRequest) Reset() {
+	*x = ListEffortRecordsByTestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_effort_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEffortRecordsByTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffortRecordsByTestCaseRequest) ProtoMessage() {}
+
+func (x *ListEffortRecordsByTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_effort_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffortRecordsByTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*ListEffortRecordsByTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_effort_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListEffortRecordsByTestCaseRequest) GetTestCaseId() string {
+	if x != nil {
+		return x.TestCaseId
+	}
+	return ""
+}
+
+// ユーザーの日別工数記録一覧取得リクエスト
+type ListEffortRecordsByUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecordDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+}
+
+func (x *ListEffortRecordsByUserRequest) Reset() {
+	*x = ListEffortRecordsByUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_effort_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEffortRecordsByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffortRecordsByUserRequest) ProtoMessage() {}
+
+func (x *ListEffortRecordsByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_effort_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffortRecordsByUserRequest.ProtoReflect.Descriptor instead.
+func (*ListEffortRecordsByUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_effort_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListEffortRecordsByUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListEffortRecordsByUserRequest) GetRecordDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordDate
+	}
+	return nil
+}
+
+// 工数記録一覧取得レスポンス
+type ListEffortRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EffortRecords []*EffortRecord `protobuf:"bytes,1,rep,name=effort_records,json=effortRecords,proto3" json:"effort_records,omitempty"`
+	TotalEffort   float64         `protobuf:"fixed64,2,opt,name=total_effort,json=totalEffort,proto3" json:"total_effort,omitempty"`
+}
+
+func (x *ListEffortRecordsResponse) Reset() {
+	*x = ListEffortRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_effort_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEffortRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffortRecordsResponse) ProtoMessage() {}
+
+func (x *ListEffortRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_effort_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffortRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListEffortRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_effort_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListEffortRecordsResponse) GetEffortRecords() []*EffortRecord {
+	if x != nil {
+		return x.EffortRecords
+	}
+	return nil
+}
+
+func (x *ListEffortRecordsResponse) GetTotalEffort() float64 {
+	if x != nil {
+		return x.TotalEffort
+	}
+	return 0
+}
+
+var File_proto_testsuite_v1_effort_proto protoreflect.FileDescriptor
+
+var file_proto_testsuite_v1_effort_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xbd, 0x02, 0x0a, 0x0c, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73,
+	0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x8e, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x66, 0x66, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x41, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x42,
+	0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x45, 0x66, 0x66,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x22, 0x46, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x1e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x66, 0x66,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x36, 0x38,
+	0x33, 0x31, 0x39, 0x34, 0x34, 0x2f, 0x47, 0x4f, 0x2d, 0x44, 0x44, 0x44, 0x2d, 0x43, 0x41, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_testsuite_v1_effort_proto_rawDescOnce sync.Once
+	file_proto_testsuite_v1_effort_proto_rawDescData = file_proto_testsuite_v1_effort_proto_rawDesc
+)
+
+func file_proto_testsuite_v1_effort_proto_rawDescGZIP() []byte {
+	file_proto_testsuite_v1_effort_proto_rawDescOnce.Do(func() {
+		file_proto_testsuite_v1_effort_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_testsuite_v1_effort_proto_rawDescData)
+	})
+	return file_proto_testsuite_v1_effort_proto_rawDescData
+}
+
+var file_proto_testsuite_v1_effort_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_testsuite_v1_effort_proto_goTypes = []interface{}{
+	(*EffortRecord)(nil),                       // 0: testsuite.v1.EffortRecord
+	(*RecordEffortRequest)(nil),                // 1: testsuite.v1.RecordEffortRequest
+	(*CorrectEffortRecordRequest)(nil),         // 2: testsuite.v1.CorrectEffortRecordRequest
+	(*ListEffortRecordsByTestCaseRequest)(nil), // 3: testsuite.v1.ListEffortRecordsByTestCaseRequest
+	(*ListEffortRecordsByUserRequest)(nil),     // 4: testsuite.v1.ListEffortRecordsByUserRequest
+	(*ListEffortRecordsResponse)(nil),          // 5: testsuite.v1.ListEffortRecordsResponse
+	(*timestamppb.Timestamp)(nil),              // 6: google.protobuf.Timestamp
+}
+var file_proto_testsuite_v1_effort_proto_depIdxs = []int32{
+	6, // 0: testsuite.v1.EffortRecord.record_date:type_name -> google.protobuf.Timestamp
+	6, // 1: testsuite.v1.EffortRecord.created_at:type_name -> google.protobuf.Timestamp
+	6, // 2: testsuite.v1.RecordEffortRequest.record_date:type_name -> google.protobuf.Timestamp
+	6, // 3: testsuite.v1.ListEffortRecordsByUserRequest.record_date:type_name -> google.protobuf.Timestamp
+	0, // 4: testsuite.v1.ListEffortRecordsResponse.effort_records:type_name -> testsuite.v1.EffortRecord
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_testsuite_v1_effort_proto_init() }
+func file_proto_testsuite_v1_effort_proto_init() {
+	if File_proto_testsuite_v1_effort_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_testsuite_v1_effort_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffortRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_effort_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordEffortRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_effort_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorrectEffortRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_effort_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEffortRecordsByTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_effort_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEffortRecordsByUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_effort_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEffortRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_testsuite_v1_effort_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_testsuite_v1_effort_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_testsuite_v1_effort_proto_goTypes,
+		DependencyIndexes: file_proto_testsuite_v1_effort_proto_depIdxs,
+		MessageInfos:      file_proto_testsuite_v1_effort_proto_msgTypes,
+	}.Build()
+	File_proto_testsuite_v1_effort_proto = out.File
+	file_proto_testsuite_v1_effort_proto_rawDesc = nil
+	file_proto_testsuite_v1_effort_proto_goTypes = nil
+	file_proto_testsuite_v1_effort_proto_depIdxs = nil
+}
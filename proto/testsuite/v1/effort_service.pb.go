@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: proto/testsuite/v1/effort_service.proto
+
+package testsuitev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_proto_testsuite_v1_effort_service_proto protoreflect.FileDescriptor
+
+var file_proto_testsuite_v1_effort_service_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x66, 0x66, 0x6f,
+	0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa7, 0x03, 0x0a, 0x0d, 0x45, 0x66, 0x66,
+	0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x66, 0x66,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x5b, 0x0a, 0x13, 0x43, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x28, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x78, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66,
+	0x66, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x79, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x30, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75,
+	0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x70, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x66, 0x66, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x36, 0x38, 0x33, 0x31, 0x39, 0x34,
+	0x34, 0x2f, 0x47, 0x4f, 0x2d, 0x44, 0x44, 0x44, 0x2d, 0x43, 0x41, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var file_proto_testsuite_v1_effort_service_proto_goTypes = []interface{}{
+	(*RecordEffortRequest)(nil),                // 0: testsuite.v1.RecordEffortRequest
+	(*CorrectEffortRecordRequest)(nil),         // 1: testsuite.v1.CorrectEffortRecordRequest
+	(*ListEffortRecordsByTestCaseRequest)(nil), // 2: testsuite.v1.ListEffortRecordsByTestCaseRequest
+	(*ListEffortRecordsByUserRequest)(nil),     // 3: testsuite.v1.ListEffortRecordsByUserRequest
+	(*EffortRecord)(nil),                       // 4: testsuite.v1.EffortRecord
+	(*ListEffortRecordsResponse)(nil),          // 5: testsuite.v1.ListEffortRecordsResponse
+}
+var file_proto_testsuite_v1_effort_service_proto_depIdxs = []int32{
+	0, // 0: testsuite.v1.EffortService.RecordEffort:input_type -> testsuite.v1.RecordEffortRequest
+	1, // 1: testsuite.v1.EffortService.CorrectEffortRecord:input_type -> testsuite.v1.CorrectEffortRecordRequest
+	2, // 2: testsuite.v1.EffortService.ListEffortRecordsByTestCase:input_type -> testsuite.v1.ListEffortRecordsByTestCaseRequest
+	3, // 3: testsuite.v1.EffortService.ListEffortRecordsByUser:input_type -> testsuite.v1.ListEffortRecordsByUserRequest
+	4, // 4: testsuite.v1.EffortService.RecordEffort:output_type -> testsuite.v1.EffortRecord
+	4, // 5: testsuite.v1.EffortService.CorrectEffortRecord:output_type -> testsuite.v1.EffortRecord
+	5, // 6: testsuite.v1.EffortService.ListEffortRecordsByTestCase:output_type -> testsuite.v1.ListEffortRecordsResponse
+	5, // 7: testsuite.v1.EffortService.ListEffortRecordsByUser:output_type -> testsuite.v1.ListEffortRecordsResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_testsuite_v1_effort_service_proto_init() }
+func file_proto_testsuite_v1_effort_service_proto_init() {
+	if File_proto_testsuite_v1_effort_service_proto != nil {
+		return
+	}
+	file_proto_testsuite_v1_effort_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_testsuite_v1_effort_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_testsuite_v1_effort_service_proto_goTypes,
+		DependencyIndexes: file_proto_testsuite_v1_effort_service_proto_depIdxs,
+	}.Build()
+	File_proto_testsuite_v1_effort_service_proto = out.File
+	file_proto_testsuite_v1_effort_service_proto_rawDesc = nil
+	file_proto_testsuite_v1_effort_service_proto_goTypes = nil
+	file_proto_testsuite_v1_effort_service_proto_depIdxs = nil
+}
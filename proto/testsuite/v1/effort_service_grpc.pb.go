@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: proto/testsuite/v1/effort_service.proto
+
+package testsuitev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EffortServiceClient is the client API for EffortService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EffortServiceClient interface {
+	// 工数の記録
+	RecordEffort(ctx context.Context, in *RecordEffortRequest, opts ...grpc.CallOption) (*EffortRecord, error)
+	// 工数記録の訂正
+	CorrectEffortRecord(ctx context.Context, in *CorrectEffortRecordRequest, opts ...grpc.CallOption) (*EffortRecord, error)
+	// テストケースごとの工数記録一覧の取得
+	ListEffortRecordsByTestCase(ctx context.Context, in *ListEffortRecordsByTestCaseRequest, opts ...grpc.CallOption) (*ListEffortRecordsResponse, error)
+	// ユーザーの日別工数記録一覧の取得
+	ListEffortRecordsByUser(ctx context.Context, in *ListEffortRecordsByUserRequest, opts ...grpc.CallOption) (*ListEffortRecordsResponse, error)
+}
+
+type effortServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEffortServiceClient(cc grpc.ClientConnInterface) EffortServiceClient {
+	return &effortServiceClient{cc}
+}
+
+func (c *effortServiceClient) RecordEffort(ctx context.Context, in *RecordEffortRequest, opts ...grpc.CallOption) (*EffortRecord, error) {
+	out := new(EffortRecord)
+	err := c.cc.Invoke(ctx, "/testsuite.v1.EffortService/RecordEffort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *effortServiceClient) CorrectEffortRecord(ctx context.Context, in *CorrectEffortRecordRequest, opts ...grpc.CallOption) (*EffortRecord, error) {
+	out := new(EffortRecord)
+	err := c.cc.Invoke(ctx, "/testsuite.v1.EffortService/CorrectEffortRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *effortServiceClient) ListEffortRecordsByTestCase(ctx context.Context, in *ListEffortRecordsByTestCaseRequest, opts ...grpc.CallOption) (*ListEffortRecordsResponse, error) {
+	out := new(ListEffortRecordsResponse)
+	err := c.cc.Invoke(ctx, "/testsuite.v1.EffortService/ListEffortRecordsByTestCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *effortServiceClient) ListEffortRecordsByUser(ctx context.Context, in *ListEffortRecordsByUserRequest, opts ...grpc.CallOption) (*ListEffortRecordsResponse, error) {
+	out := new(ListEffortRecordsResponse)
+	err := c.cc.Invoke(ctx, "/testsuite.v1.EffortService/ListEffortRecordsByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EffortServiceServer is the server API for EffortService service.
+// All implementations must embed UnimplementedEffortServiceServer
+// for forward compatibility
+type EffortServiceServer interface {
+	// 工数の記録
+	RecordEffort(context.Context, *RecordEffortRequest) (*EffortRecord, error)
+	// 工数記録の訂正
+	CorrectEffortRecord(context.Context, *CorrectEffortRecordRequest) (*EffortRecord, error)
+	// テストケースごとの工数記録一覧の取得
+	ListEffortRecordsByTestCase(context.Context, *ListEffortRecordsByTestCaseRequest) (*ListEffortRecordsResponse, error)
+	// ユーザーの日別工数記録一覧の取得
+	ListEffortRecordsByUser(context.Context, *ListEffortRecordsByUserRequest) (*ListEffortRecordsResponse, error)
+	mustEmbedUnimplementedEffortServiceServer()
+}
+
+// UnimplementedEffortServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEffortServiceServer struct {
+}
+
+func (UnimplementedEffortServiceServer) RecordEffort(context.Context, *RecordEffortRequest) (*EffortRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordEffort not implemented")
+}
+func (UnimplementedEffortServiceServer) CorrectEffortRecord(context.Context, *CorrectEffortRecordRequest) (*EffortRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CorrectEffortRecord not implemented")
+}
+func (UnimplementedEffortServiceServer) ListEffortRecordsByTestCase(context.Context, *ListEffortRecordsByTestCaseRequest) (*ListEffortRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEffortRecordsByTestCase not implemented")
+}
+func (UnimplementedEffortServiceServer) ListEffortRecordsByUser(context.Context, *ListEffortRecordsByUserRequest) (*ListEffortRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEffortRecordsByUser not implemented")
+}
+func (UnimplementedEffortServiceServer) mustEmbedUnimplementedEffortServiceServer() {}
+
+// UnsafeEffortServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EffortServiceServer will
+// result in compilation errors.
+type UnsafeEffortServiceServer interface {
+	mustEmbedUnimplementedEffortServiceServer()
+}
+
+func RegisterEffortServiceServer(s grpc.ServiceRegistrar, srv EffortServiceServer) {
+	s.RegisterService(&EffortService_ServiceDesc, srv)
+}
+
+func _EffortService_RecordEffort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordEffortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EffortServiceServer).RecordEffort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testsuite.v1.EffortService/RecordEffort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EffortServiceServer).RecordEffort(ctx, req.(*RecordEffortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EffortService_CorrectEffortRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectEffortRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EffortServiceServer).CorrectEffortRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testsuite.v1.EffortService/CorrectEffortRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EffortServiceServer).CorrectEffortRecord(ctx, req.(*CorrectEffortRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EffortService_ListEffortRecordsByTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEffortRecordsByTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EffortServiceServer).ListEffortRecordsByTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testsuite.v1.EffortService/ListEffortRecordsByTestCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EffortServiceServer).ListEffortRecordsByTestCase(ctx, req.(*ListEffortRecordsByTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EffortService_ListEffortRecordsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEffortRecordsByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EffortServiceServer).ListEffortRecordsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testsuite.v1.EffortService/ListEffortRecordsByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EffortServiceServer).ListEffortRecordsByUser(ctx, req.(*ListEffortRecordsByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EffortService_ServiceDesc is the grpc.ServiceDesc for EffortService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EffortService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testsuite.v1.EffortService",
+	HandlerType: (*EffortServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordEffort",
+			Handler:    _EffortService_RecordEffort_Handler,
+		},
+		{
+			MethodName: "CorrectEffortRecord",
+			Handler:    _EffortService_CorrectEffortRecord_Handler,
+		},
+		{
+			MethodName: "ListEffortRecordsByTestCase",
+			Handler:    _EffortService_ListEffortRecordsByTestCase_Handler,
+		},
+		{
+			MethodName: "ListEffortRecordsByUser",
+			Handler:    _EffortService_ListEffortRecordsByUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/testsuite/v1/effort_service.proto",
+}
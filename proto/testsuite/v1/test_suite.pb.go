@@ -77,6 +77,65 @@ func (SuiteStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{0}
 }
 
+// テストスイートの変更種別
+type TestSuiteEventType int32
+
+const (
+	TestSuiteEventType_TEST_SUITE_EVENT_TYPE_UNSPECIFIED    TestSuiteEventType = 0
+	TestSuiteEventType_TEST_SUITE_EVENT_TYPE_CREATED        TestSuiteEventType = 1
+	TestSuiteEventType_TEST_SUITE_EVENT_TYPE_UPDATED        TestSuiteEventType = 2
+	TestSuiteEventType_TEST_SUITE_EVENT_TYPE_STATUS_CHANGED TestSuiteEventType = 3
+	TestSuiteEventType_TEST_SUITE_EVENT_TYPE_DELETED        TestSuiteEventType = 4
+	TestSuiteEventType_TEST_SUITE_EVENT_TYPE_RESTORED       TestSuiteEventType = 5
+)
+
+// Enum value maps for TestSuiteEventType.
+var (
+	TestSuiteEventType_name = map[int32]string{
+		0: "TEST_SUITE_EVENT_TYPE_UNSPECIFIED",
+		1: "TEST_SUITE_EVENT_TYPE_CREATED",
+		2: "TEST_SUITE_EVENT_TYPE_UPDATED",
+		3: "TEST_SUITE_EVENT_TYPE_STATUS_CHANGED",
+		4: "TEST_SUITE_EVENT_TYPE_DELETED",
+		5: "TEST_SUITE_EVENT_TYPE_RESTORED",
+	}
+	TestSuiteEventType_value = map[string]int32{
+		"TEST_SUITE_EVENT_TYPE_UNSPECIFIED":    0,
+		"TEST_SUITE_EVENT_TYPE_CREATED":        1,
+		"TEST_SUITE_EVENT_TYPE_UPDATED":        2,
+		"TEST_SUITE_EVENT_TYPE_STATUS_CHANGED": 3,
+		"TEST_SUITE_EVENT_TYPE_DELETED":        4,
+		"TEST_SUITE_EVENT_TYPE_RESTORED":       5,
+	}
+)
+
+func (x TestSuiteEventType) Enum() *TestSuiteEventType {
+	p := new(TestSuiteEventType)
+	*p = x
+	return p
+}
+
+func (x TestSuiteEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestSuiteEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_testsuite_v1_test_suite_proto_enumTypes[1].Descriptor()
+}
+
+func (TestSuiteEventType) Type() protoreflect.EnumType {
+	return &file_proto_testsuite_v1_test_suite_proto_enumTypes[1]
+}
+
+func (x TestSuiteEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestSuiteEventType.Descriptor instead.
+func (TestSuiteEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{1}
+}
+
 // テストスイート定義
 type TestSuite struct {
 	state         protoimpl.MessageState
@@ -93,6 +152,20 @@ type TestSuite struct {
 	Progress             float32                `protobuf:"fixed32,8,opt,name=progress,proto3" json:"progress,omitempty"`
 	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 配下のテストケースのうち完了したケース数
+	CompletedCaseCount int32 `protobuf:"varint,11,opt,name=completed_case_count,json=completedCaseCount,proto3" json:"completed_case_count,omitempty"`
+	// 配下のテストケースの総数
+	TotalCaseCount int32 `protobuf:"varint,12,opt,name=total_case_count,json=totalCaseCount,proto3" json:"total_case_count,omitempty"`
+	// 楽観的排他制御のバージョン（更新時にexpected_versionとして指定する）
+	Version int32 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	// ゴミ箱に移動した日時（ゴミ箱にない場合は未設定）
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// 完了（COMPLETED）への遷移時に評価する完了条件
+	ExitCriteria *ExitCriteria `protobuf:"bytes,15,opt,name=exit_criteria,json=exitCriteria,proto3" json:"exit_criteria,omitempty"`
+	// 完了条件を満たさずにAdminが完了にした場合の記録（オーバーライドしていない場合は未設定）
+	ExitCriteriaOverride *ExitCriteriaOverride `protobuf:"bytes,16,opt,name=exit_criteria_override,json=exitCriteriaOverride,proto3" json:"exit_criteria_override,omitempty"`
+	// 作成したユーザーのID（不明な場合は空）
+	CreatedBy string `protobuf:"bytes,17,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 }
 
 func (x *TestSuite) Reset() {
@@ -197,6 +270,177 @@ func (x *TestSuite) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *TestSuite) GetCompletedCaseCount() int32 {
+	if x != nil {
+		return x.CompletedCaseCount
+	}
+	return 0
+}
+
+func (x *TestSuite) GetTotalCaseCount() int32 {
+	if x != nil {
+		return x.TotalCaseCount
+	}
+	return 0
+}
+
+func (x *TestSuite) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TestSuite) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TestSuite) GetExitCriteria() *ExitCriteria {
+	if x != nil {
+		return x.ExitCriteria
+	}
+	return nil
+}
+
+func (x *TestSuite) GetExitCriteriaOverride() *ExitCriteriaOverride {
+	if x != nil {
+		return x.ExitCriteriaOverride
+	}
+	return nil
+}
+
+func (x *TestSuite) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// 完了条件
+type ExitCriteria struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 完了に必要なテストケースの完了率（0〜100、件数ベース）
+	MinCompletionRate float32 `protobuf:"fixed32,1,opt,name=min_completion_rate,json=minCompletionRate,proto3" json:"min_completion_rate,omitempty"`
+	// 未完了のCriticalケースが残っていても完了を許可するか
+	AllowOpenCritical bool `protobuf:"varint,2,opt,name=allow_open_critical,json=allowOpenCritical,proto3" json:"allow_open_critical,omitempty"`
+}
+
+func (x *ExitCriteria) Reset() {
+	*x = ExitCriteria{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitCriteria) ProtoMessage() {}
+
+func (x *ExitCriteria) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitCriteria.ProtoReflect.Descriptor instead.
+func (*ExitCriteria) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExitCriteria) GetMinCompletionRate() float32 {
+	if x != nil {
+		return x.MinCompletionRate
+	}
+	return 0
+}
+
+func (x *ExitCriteria) GetAllowOpenCritical() bool {
+	if x != nil {
+		return x.AllowOpenCritical
+	}
+	return false
+}
+
+// 完了条件のオーバーライド記録
+type ExitCriteriaOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason       string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	OverriddenBy string                 `protobuf:"bytes,2,opt,name=overridden_by,json=overriddenBy,proto3" json:"overridden_by,omitempty"`
+	OverriddenAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=overridden_at,json=overriddenAt,proto3" json:"overridden_at,omitempty"`
+}
+
+func (x *ExitCriteriaOverride) Reset() {
+	*x = ExitCriteriaOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitCriteriaOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitCriteriaOverride) ProtoMessage() {}
+
+func (x *ExitCriteriaOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitCriteriaOverride.ProtoReflect.Descriptor instead.
+func (*ExitCriteriaOverride) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExitCriteriaOverride) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ExitCriteriaOverride) GetOverriddenBy() string {
+	if x != nil {
+		return x.OverriddenBy
+	}
+	return ""
+}
+
+func (x *ExitCriteriaOverride) GetOverriddenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OverriddenAt
+	}
+	return nil
+}
+
 // テストスイート作成リクエスト
 type CreateTestSuiteRequest struct {
 	state         protoimpl.MessageState
@@ -208,12 +452,16 @@ type CreateTestSuiteRequest struct {
 	EstimatedStartDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=estimated_start_date,json=estimatedStartDate,proto3" json:"estimated_start_date,omitempty"`
 	EstimatedEndDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=estimated_end_date,json=estimatedEndDate,proto3" json:"estimated_end_date,omitempty"`
 	RequireEffortComment bool                   `protobuf:"varint,5,opt,name=require_effort_comment,json=requireEffortComment,proto3" json:"require_effort_comment,omitempty"`
+	// 省略時は「全ケース完了・Critical残存不可」
+	ExitCriteria *ExitCriteria `protobuf:"bytes,6,opt,name=exit_criteria,json=exitCriteria,proto3,oneof" json:"exit_criteria,omitempty"`
+	// テストスイートを作成するユーザーのID
+	CreatedBy *string `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
 }
 
 func (x *CreateTestSuiteRequest) Reset() {
 	*x = CreateTestSuiteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[1]
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -226,7 +474,7 @@ func (x *CreateTestSuiteRequest) String() string {
 func (*CreateTestSuiteRequest) ProtoMessage() {}
 
 func (x *CreateTestSuiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[1]
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +487,7 @@ func (x *CreateTestSuiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTestSuiteRequest.ProtoReflect.Descriptor instead.
 func (*CreateTestSuiteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{1}
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateTestSuiteRequest) GetName() string {
@@ -277,6 +525,20 @@ func (x *CreateTestSuiteRequest) GetRequireEffortComment() bool {
 	return false
 }
 
+func (x *CreateTestSuiteRequest) GetExitCriteria() *ExitCriteria {
+	if x != nil {
+		return x.ExitCriteria
+	}
+	return nil
+}
+
+func (x *CreateTestSuiteRequest) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
 // テストスイート取得リクエスト
 type GetTestSuiteRequest struct {
 	state         protoimpl.MessageState
@@ -289,7 +551,7 @@ type GetTestSuiteRequest struct {
 func (x *GetTestSuiteRequest) Reset() {
 	*x = GetTestSuiteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[2]
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -302,7 +564,7 @@ func (x *GetTestSuiteRequest) String() string {
 func (*GetTestSuiteRequest) ProtoMessage() {}
 
 func (x *GetTestSuiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[2]
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +577,7 @@ func (x *GetTestSuiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTestSuiteRequest.ProtoReflect.Descriptor instead.
 func (*GetTestSuiteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{2}
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetTestSuiteRequest) GetId() string {
@@ -338,7 +600,7 @@ type UpdateTestSuiteRequest struct {
 func (x *UpdateTestSuiteRequest) Reset() {
 	*x = UpdateTestSuiteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[3]
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -351,7 +613,7 @@ func (x *UpdateTestSuiteRequest) String() string {
 func (*UpdateTestSuiteRequest) ProtoMessage() {}
 
 func (x *UpdateTestSuiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[3]
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +626,7 @@ func (x *UpdateTestSuiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTestSuiteRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTestSuiteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{3}
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateTestSuiteRequest) GetId() string {
@@ -392,12 +654,15 @@ type UpdateTestSuiteParams struct {
 	EstimatedStartDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=estimated_start_date,json=estimatedStartDate,proto3,oneof" json:"estimated_start_date,omitempty"`
 	EstimatedEndDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=estimated_end_date,json=estimatedEndDate,proto3,oneof" json:"estimated_end_date,omitempty"`
 	RequireEffortComment *bool                  `protobuf:"varint,5,opt,name=require_effort_comment,json=requireEffortComment,proto3,oneof" json:"require_effort_comment,omitempty"`
+	// 指定した場合、現在のバージョンと一致しなければABORTEDとなる
+	ExpectedVersion *int32        `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	ExitCriteria    *ExitCriteria `protobuf:"bytes,7,opt,name=exit_criteria,json=exitCriteria,proto3,oneof" json:"exit_criteria,omitempty"`
 }
 
 func (x *UpdateTestSuiteParams) Reset() {
 	*x = UpdateTestSuiteParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[4]
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -410,7 +675,7 @@ func (x *UpdateTestSuiteParams) String() string {
 func (*UpdateTestSuiteParams) ProtoMessage() {}
 
 func (x *UpdateTestSuiteParams) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[4]
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +688,7 @@ func (x *UpdateTestSuiteParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTestSuiteParams.ProtoReflect.Descriptor instead.
 func (*UpdateTestSuiteParams) Descriptor() ([]byte, []int) {
-	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{4}
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateTestSuiteParams) GetName() string {
@@ -461,6 +726,20 @@ func (x *UpdateTestSuiteParams) GetRequireEffortComment() bool {
 	return false
 }
 
+func (x *UpdateTestSuiteParams) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateTestSuiteParams) GetExitCriteria() *ExitCriteria {
+	if x != nil {
+		return x.ExitCriteria
+	}
+	return nil
+}
+
 // ステータス更新リクエスト
 type UpdateTestSuiteStatusRequest struct {
 	state         protoimpl.MessageState
@@ -469,12 +748,17 @@ type UpdateTestSuiteStatusRequest struct {
 
 	Id     string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Status SuiteStatus `protobuf:"varint,2,opt,name=status,proto3,enum=testsuite.v1.SuiteStatus" json:"status,omitempty"`
+	// 完了条件のオーバーライドの理由（認証がないため、指定した場合はPERMISSION_DENIEDとなる）
+	// オーバーライドはGraphQL APIで認証したAdminのみ実行できる
+	OverrideReason *string `protobuf:"bytes,3,opt,name=override_reason,json=overrideReason,proto3,oneof" json:"override_reason,omitempty"`
+	// ステータスを変更するユーザーのID
+	ChangedBy *string `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3,oneof" json:"changed_by,omitempty"`
 }
 
 func (x *UpdateTestSuiteStatusRequest) Reset() {
 	*x = UpdateTestSuiteStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[5]
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -487,7 +771,7 @@ func (x *UpdateTestSuiteStatusRequest) String() string {
 func (*UpdateTestSuiteStatusRequest) ProtoMessage() {}
 
 func (x *UpdateTestSuiteStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[5]
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +784,7 @@ func (x *UpdateTestSuiteStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTestSuiteStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTestSuiteStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{5}
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateTestSuiteStatusRequest) GetId() string {
@@ -517,6 +801,20 @@ func (x *UpdateTestSuiteStatusRequest) GetStatus() SuiteStatus {
 	return SuiteStatus_SUITE_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateTestSuiteStatusRequest) GetOverrideReason() string {
+	if x != nil && x.OverrideReason != nil {
+		return *x.OverrideReason
+	}
+	return ""
+}
+
+func (x *UpdateTestSuiteStatusRequest) GetChangedBy() string {
+	if x != nil && x.ChangedBy != nil {
+		return *x.ChangedBy
+	}
+	return ""
+}
+
 // テストスイート一覧取得リクエスト
 type ListTestSuitesRequest struct {
 	state         protoimpl.MessageState
@@ -528,12 +826,32 @@ type ListTestSuitesRequest struct {
 	EndDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
 	Page      *int32                 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
 	PageSize  *int32                 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// カーソルによるページング（いずれかを指定した場合、page・page_sizeは使用しない）
+	// first/afterで先頭側から、last/beforeで末尾側から取得する（並び順はorder_byの順）
+	First  *int32  `protobuf:"varint,6,opt,name=first,proto3,oneof" json:"first,omitempty"`
+	After  *string `protobuf:"bytes,7,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	Last   *int32  `protobuf:"varint,8,opt,name=last,proto3,oneof" json:"last,omitempty"`
+	Before *string `protobuf:"bytes,9,opt,name=before,proto3,oneof" json:"before,omitempty"`
+	// 名前または説明に含まれる文字列（大文字・小文字は区別しない）
+	Search    *string `protobuf:"bytes,10,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	CreatedBy *string `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	// 予定期間がこの範囲と重なるテストスイートのみを返す
+	PeriodFrom *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=period_from,json=periodFrom,proto3,oneof" json:"period_from,omitempty"`
+	PeriodTo   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=period_to,json=periodTo,proto3,oneof" json:"period_to,omitempty"`
+	// 進捗率（0〜100）の範囲（境界の値を含む）
+	MinProgress *float64 `protobuf:"fixed64,14,opt,name=min_progress,json=minProgress,proto3,oneof" json:"min_progress,omitempty"`
+	MaxProgress *float64 `protobuf:"fixed64,15,opt,name=max_progress,json=maxProgress,proto3,oneof" json:"max_progress,omitempty"`
+	// 遅延しているテストケースの有無
+	HasDelayedCases *bool `protobuf:"varint,16,opt,name=has_delayed_cases,json=hasDelayedCases,proto3,oneof" json:"has_delayed_cases,omitempty"`
+	// 並び順（例: "progress desc,name"）。項目はname・estimatedStartDate・estimatedEndDate・progress・updatedAt・createdAt
+	// 省略時は作成日時の新しい順で、カーソルは同じ並び順を指定した場合のみ使用できる
+	OrderBy *string `protobuf:"bytes,17,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
 }
 
 func (x *ListTestSuitesRequest) Reset() {
 	*x = ListTestSuitesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[6]
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -546,7 +864,7 @@ func (x *ListTestSuitesRequest) String() string {
 func (*ListTestSuitesRequest) ProtoMessage() {}
 
 func (x *ListTestSuitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[6]
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +877,7 @@ func (x *ListTestSuitesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTestSuitesRequest.ProtoReflect.Descriptor instead.
 func (*ListTestSuitesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{6}
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListTestSuitesRequest) GetStatus() SuiteStatus {
@@ -597,6 +915,90 @@ func (x *ListTestSuitesRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListTestSuitesRequest) GetFirst() int32 {
+	if x != nil && x.First != nil {
+		return *x.First
+	}
+	return 0
+}
+
+func (x *ListTestSuitesRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+func (x *ListTestSuitesRequest) GetLast() int32 {
+	if x != nil && x.Last != nil {
+		return *x.Last
+	}
+	return 0
+}
+
+func (x *ListTestSuitesRequest) GetBefore() string {
+	if x != nil && x.Before != nil {
+		return *x.Before
+	}
+	return ""
+}
+
+func (x *ListTestSuitesRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *ListTestSuitesRequest) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ListTestSuitesRequest) GetPeriodFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodFrom
+	}
+	return nil
+}
+
+func (x *ListTestSuitesRequest) GetPeriodTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodTo
+	}
+	return nil
+}
+
+func (x *ListTestSuitesRequest) GetMinProgress() float64 {
+	if x != nil && x.MinProgress != nil {
+		return *x.MinProgress
+	}
+	return 0
+}
+
+func (x *ListTestSuitesRequest) GetMaxProgress() float64 {
+	if x != nil && x.MaxProgress != nil {
+		return *x.MaxProgress
+	}
+	return 0
+}
+
+func (x *ListTestSuitesRequest) GetHasDelayedCases() bool {
+	if x != nil && x.HasDelayedCases != nil {
+		return *x.HasDelayedCases
+	}
+	return false
+}
+
+func (x *ListTestSuitesRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
 // テストスイート一覧取得レスポンス
 type ListTestSuitesResponse struct {
 	state         protoimpl.MessageState
@@ -605,12 +1007,18 @@ type ListTestSuitesResponse struct {
 
 	TestSuites []*TestSuite `protobuf:"bytes,1,rep,name=test_suites,json=testSuites,proto3" json:"test_suites,omitempty"`
 	Total      int32        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// 取得したページの前後に、同じ条件のテストスイートが残っているか
+	HasNextPage     bool `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	HasPreviousPage bool `protobuf:"varint,4,opt,name=has_previous_page,json=hasPreviousPage,proto3" json:"has_previous_page,omitempty"`
+	// 取得したページの先頭・末尾のテストスイートの位置（after・beforeに指定する）
+	StartCursor string `protobuf:"bytes,5,opt,name=start_cursor,json=startCursor,proto3" json:"start_cursor,omitempty"`
+	EndCursor   string `protobuf:"bytes,6,opt,name=end_cursor,json=endCursor,proto3" json:"end_cursor,omitempty"`
 }
 
 func (x *ListTestSuitesResponse) Reset() {
 	*x = ListTestSuitesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[7]
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -623,7 +1031,7 @@ func (x *ListTestSuitesResponse) String() string {
 func (*ListTestSuitesResponse) ProtoMessage() {}
 
 func (x *ListTestSuitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[7]
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +1044,7 @@ func (x *ListTestSuitesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTestSuitesResponse.ProtoReflect.Descriptor instead.
 func (*ListTestSuitesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{7}
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListTestSuitesResponse) GetTestSuites() []*TestSuite {
@@ -653,6 +1061,243 @@ func (x *ListTestSuitesResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *ListTestSuitesResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListTestSuitesResponse) GetHasPreviousPage() bool {
+	if x != nil {
+		return x.HasPreviousPage
+	}
+	return false
+}
+
+func (x *ListTestSuitesResponse) GetStartCursor() string {
+	if x != nil {
+		return x.StartCursor
+	}
+	return ""
+}
+
+func (x *ListTestSuitesResponse) GetEndCursor() string {
+	if x != nil {
+		return x.EndCursor
+	}
+	return ""
+}
+
+// テストスイート削除レスポンス
+type DeleteTestSuiteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteTestSuiteResponse) Reset() {
+	*x = DeleteTestSuiteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTestSuiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTestSuiteResponse) ProtoMessage() {}
+
+func (x *DeleteTestSuiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTestSuiteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTestSuiteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteTestSuiteResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ゴミ箱内のテストスイート一覧取得リクエスト
+type ListDeletedTestSuitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDeletedTestSuitesRequest) Reset() {
+	*x = ListDeletedTestSuitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedTestSuitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTestSuitesRequest) ProtoMessage() {}
+
+func (x *ListDeletedTestSuitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTestSuitesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedTestSuitesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{11}
+}
+
+// テストスイート一覧の監視リクエスト
+type WatchTestSuitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 指定した場合、変更後のステータスが一致するテストスイートのみを通知
+	Status *SuiteStatus `protobuf:"varint,1,opt,name=status,proto3,enum=testsuite.v1.SuiteStatus,oneof" json:"status,omitempty"`
+}
+
+func (x *WatchTestSuitesRequest) Reset() {
+	*x = WatchTestSuitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTestSuitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTestSuitesRequest) ProtoMessage() {}
+
+func (x *WatchTestSuitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTestSuitesRequest.ProtoReflect.Descriptor instead.
+func (*WatchTestSuitesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchTestSuitesRequest) GetStatus() SuiteStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SuiteStatus_SUITE_STATUS_UNSPECIFIED
+}
+
+// テストスイートの変更通知
+type TestSuiteEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        TestSuiteEventType `protobuf:"varint,1,opt,name=type,proto3,enum=testsuite.v1.TestSuiteEventType" json:"type,omitempty"`
+	TestSuiteId string             `protobuf:"bytes,2,opt,name=test_suite_id,json=testSuiteId,proto3" json:"test_suite_id,omitempty"`
+	// 変更後のテストスイート（削除の場合は未設定）
+	TestSuite  *TestSuite             `protobuf:"bytes,3,opt,name=test_suite,json=testSuite,proto3" json:"test_suite,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *TestSuiteEvent) Reset() {
+	*x = TestSuiteEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestSuiteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSuiteEvent) ProtoMessage() {}
+
+func (x *TestSuiteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_testsuite_v1_test_suite_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSuiteEvent.ProtoReflect.Descriptor instead.
+func (*TestSuiteEvent) Descriptor() ([]byte, []int) {
+	return file_proto_testsuite_v1_test_suite_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TestSuiteEvent) GetType() TestSuiteEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TestSuiteEventType_TEST_SUITE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *TestSuiteEvent) GetTestSuiteId() string {
+	if x != nil {
+		return x.TestSuiteId
+	}
+	return ""
+}
+
+func (x *TestSuiteEvent) GetTestSuite() *TestSuite {
+	if x != nil {
+		return x.TestSuite
+	}
+	return nil
+}
+
+func (x *TestSuiteEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
 var File_proto_testsuite_v1_test_suite_proto protoreflect.FileDescriptor
 
 var file_proto_testsuite_v1_test_suite_proto_rawDesc = []byte{
@@ -661,7 +1306,7 @@ var file_proto_testsuite_v1_test_suite_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65,
 	0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x03, 0x0a, 0x09, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x06, 0x0a, 0x09, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69,
 	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
@@ -691,66 +1336,131 @@ var file_proto_testsuite_v1_test_suite_proto_rawDesc = []byte{
 	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9c, 0x02, 0x0a, 0x16,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x14,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x43, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61,
+	0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0d,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52,
+	0x0c, 0x65, 0x78, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x58, 0x0a,
+	0x16, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x5f, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69,
+	0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x52, 0x14, 0x65, 0x78, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x6e, 0x0a, 0x0c, 0x45, 0x78, 0x69, 0x74, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x69, 0x74, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x3f, 0x0a, 0x0d,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0c, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x74, 0x22, 0xa7, 0x03,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c,
+	0x0a, 0x14, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x12,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x45,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x5f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x45,
+	0x66, 0x66, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0d,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x48,
+	0x00, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x65,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb5, 0x04, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x51, 0x0a, 0x14, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x12, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x4d, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x10, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x39, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x65, 0x66, 0x66,
+	0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x04, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x45, 0x66, 0x66, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0d,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x48,
+	0x06, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x0a, 0x15, 0x5f,
 	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
-	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x65, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x64,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f,
-	0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x45, 0x66, 0x66,
-	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x65, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53,
-	0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x98, 0x03, 0x0a, 0x15, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88,
-	0x01, 0x01, 0x12, 0x51, 0x0a, 0x14, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x12,
-	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52,
-	0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f,
-	0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x45,
-	0x66, 0x66, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x65, 0x73, 0x74,
-	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x5f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73,
-	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc4, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0xd6, 0x01,
+	0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79,
+	0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0xb7, 0x07, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54,
 	0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x19, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
@@ -766,18 +1476,91 @@ var file_proto_testsuite_v1_test_suite_proto_rawDesc = []byte{
 	0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x05, 0x48, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
 	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e,
-	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x68, 0x0a,
-	0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f,
-	0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74,
-	0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x2a, 0x9f, 0x01, 0x0a, 0x0b, 0x53, 0x75, 0x69, 0x74,
+	0x48, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05,
+	0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x07, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08,
+	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x0b, 0x52,
+	0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x3c,
+	0x0a, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x0c, 0x52,
+	0x08, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x54, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c,
+	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x48, 0x0e, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11,
+	0x68, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0f, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x43, 0x61, 0x73, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x10, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x74, 0x6f, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x63, 0x61,
+	0x73, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x22, 0xfa, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x68,
+	0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x29, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75,
+	0x69, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52,
+	0x09, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x9f, 0x01, 0x0a, 0x0b, 0x53, 0x75, 0x69, 0x74,
 	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x55, 0x49, 0x54, 0x45,
 	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
 	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x55, 0x49, 0x54, 0x45, 0x5f, 0x53,
@@ -787,12 +1570,27 @@ var file_proto_testsuite_v1_test_suite_proto_rawDesc = []byte{
 	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x55, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
 	0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a,
 	0x16, 0x53, 0x55, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55,
-	0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74,
-	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
-	0x6f, 0x36, 0x38, 0x33, 0x31, 0x39, 0x34, 0x34, 0x2f, 0x47, 0x4f, 0x2d, 0x44, 0x44, 0x44, 0x2d,
-	0x43, 0x41, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69,
-	0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xf2, 0x01, 0x0a, 0x12, 0x54, 0x65,
+	0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x21, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x49, 0x54, 0x45, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x45, 0x53, 0x54, 0x5f,
+	0x53, 0x55, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x45,
+	0x53, 0x54, 0x5f, 0x53, 0x55, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x28, 0x0a,
+	0x24, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x45, 0x53, 0x54, 0x5f,
+	0x53, 0x55, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x45,
+	0x53, 0x54, 0x5f, 0x53, 0x55, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x05, 0x42, 0x46,
+	0x5a, 0x44, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x36, 0x38, 0x33, 0x31, 0x39, 0x34, 0x34, 0x2f, 0x47, 0x4f,
+	0x2d, 0x44, 0x44, 0x44, 0x2d, 0x43, 0x41, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -807,41 +1605,60 @@ func file_proto_testsuite_v1_test_suite_proto_rawDescGZIP() []byte {
 	return file_proto_testsuite_v1_test_suite_proto_rawDescData
 }
 
-var file_proto_testsuite_v1_test_suite_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_testsuite_v1_test_suite_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_testsuite_v1_test_suite_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_testsuite_v1_test_suite_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_testsuite_v1_test_suite_proto_goTypes = []interface{}{
 	(SuiteStatus)(0),                     // 0: testsuite.v1.SuiteStatus
-	(*TestSuite)(nil),                    // 1: testsuite.v1.TestSuite
-	(*CreateTestSuiteRequest)(nil),       // 2: testsuite.v1.CreateTestSuiteRequest
-	(*GetTestSuiteRequest)(nil),          // 3: testsuite.v1.GetTestSuiteRequest
-	(*UpdateTestSuiteRequest)(nil),       // 4: testsuite.v1.UpdateTestSuiteRequest
-	(*UpdateTestSuiteParams)(nil),        // 5: testsuite.v1.UpdateTestSuiteParams
-	(*UpdateTestSuiteStatusRequest)(nil), // 6: testsuite.v1.UpdateTestSuiteStatusRequest
-	(*ListTestSuitesRequest)(nil),        // 7: testsuite.v1.ListTestSuitesRequest
-	(*ListTestSuitesResponse)(nil),       // 8: testsuite.v1.ListTestSuitesResponse
-	(*timestamppb.Timestamp)(nil),        // 9: google.protobuf.Timestamp
+	(TestSuiteEventType)(0),              // 1: testsuite.v1.TestSuiteEventType
+	(*TestSuite)(nil),                    // 2: testsuite.v1.TestSuite
+	(*ExitCriteria)(nil),                 // 3: testsuite.v1.ExitCriteria
+	(*ExitCriteriaOverride)(nil),         // 4: testsuite.v1.ExitCriteriaOverride
+	(*CreateTestSuiteRequest)(nil),       // 5: testsuite.v1.CreateTestSuiteRequest
+	(*GetTestSuiteRequest)(nil),          // 6: testsuite.v1.GetTestSuiteRequest
+	(*UpdateTestSuiteRequest)(nil),       // 7: testsuite.v1.UpdateTestSuiteRequest
+	(*UpdateTestSuiteParams)(nil),        // 8: testsuite.v1.UpdateTestSuiteParams
+	(*UpdateTestSuiteStatusRequest)(nil), // 9: testsuite.v1.UpdateTestSuiteStatusRequest
+	(*ListTestSuitesRequest)(nil),        // 10: testsuite.v1.ListTestSuitesRequest
+	(*ListTestSuitesResponse)(nil),       // 11: testsuite.v1.ListTestSuitesResponse
+	(*DeleteTestSuiteResponse)(nil),      // 12: testsuite.v1.DeleteTestSuiteResponse
+	(*ListDeletedTestSuitesRequest)(nil), // 13: testsuite.v1.ListDeletedTestSuitesRequest
+	(*WatchTestSuitesRequest)(nil),       // 14: testsuite.v1.WatchTestSuitesRequest
+	(*TestSuiteEvent)(nil),               // 15: testsuite.v1.TestSuiteEvent
+	(*timestamppb.Timestamp)(nil),        // 16: google.protobuf.Timestamp
 }
 var file_proto_testsuite_v1_test_suite_proto_depIdxs = []int32{
 	0,  // 0: testsuite.v1.TestSuite.status:type_name -> testsuite.v1.SuiteStatus
-	9,  // 1: testsuite.v1.TestSuite.estimated_start_date:type_name -> google.protobuf.Timestamp
-	9,  // 2: testsuite.v1.TestSuite.estimated_end_date:type_name -> google.protobuf.Timestamp
-	9,  // 3: testsuite.v1.TestSuite.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 4: testsuite.v1.TestSuite.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 5: testsuite.v1.CreateTestSuiteRequest.estimated_start_date:type_name -> google.protobuf.Timestamp
-	9,  // 6: testsuite.v1.CreateTestSuiteRequest.estimated_end_date:type_name -> google.protobuf.Timestamp
-	5,  // 7: testsuite.v1.UpdateTestSuiteRequest.params:type_name -> testsuite.v1.UpdateTestSuiteParams
-	9,  // 8: testsuite.v1.UpdateTestSuiteParams.estimated_start_date:type_name -> google.protobuf.Timestamp
-	9,  // 9: testsuite.v1.UpdateTestSuiteParams.estimated_end_date:type_name -> google.protobuf.Timestamp
-	0,  // 10: testsuite.v1.UpdateTestSuiteStatusRequest.status:type_name -> testsuite.v1.SuiteStatus
-	0,  // 11: testsuite.v1.ListTestSuitesRequest.status:type_name -> testsuite.v1.SuiteStatus
-	9,  // 12: testsuite.v1.ListTestSuitesRequest.start_date:type_name -> google.protobuf.Timestamp
-	9,  // 13: testsuite.v1.ListTestSuitesRequest.end_date:type_name -> google.protobuf.Timestamp
-	1,  // 14: testsuite.v1.ListTestSuitesResponse.test_suites:type_name -> testsuite.v1.TestSuite
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	16, // 1: testsuite.v1.TestSuite.estimated_start_date:type_name -> google.protobuf.Timestamp
+	16, // 2: testsuite.v1.TestSuite.estimated_end_date:type_name -> google.protobuf.Timestamp
+	16, // 3: testsuite.v1.TestSuite.created_at:type_name -> google.protobuf.Timestamp
+	16, // 4: testsuite.v1.TestSuite.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 5: testsuite.v1.TestSuite.deleted_at:type_name -> google.protobuf.Timestamp
+	3,  // 6: testsuite.v1.TestSuite.exit_criteria:type_name -> testsuite.v1.ExitCriteria
+	4,  // 7: testsuite.v1.TestSuite.exit_criteria_override:type_name -> testsuite.v1.ExitCriteriaOverride
+	16, // 8: testsuite.v1.ExitCriteriaOverride.overridden_at:type_name -> google.protobuf.Timestamp
+	16, // 9: testsuite.v1.CreateTestSuiteRequest.estimated_start_date:type_name -> google.protobuf.Timestamp
+	16, // 10: testsuite.v1.CreateTestSuiteRequest.estimated_end_date:type_name -> google.protobuf.Timestamp
+	3,  // 11: testsuite.v1.CreateTestSuiteRequest.exit_criteria:type_name -> testsuite.v1.ExitCriteria
+	8,  // 12: testsuite.v1.UpdateTestSuiteRequest.params:type_name -> testsuite.v1.UpdateTestSuiteParams
+	16, // 13: testsuite.v1.UpdateTestSuiteParams.estimated_start_date:type_name -> google.protobuf.Timestamp
+	16, // 14: testsuite.v1.UpdateTestSuiteParams.estimated_end_date:type_name -> google.protobuf.Timestamp
+	3,  // 15: testsuite.v1.UpdateTestSuiteParams.exit_criteria:type_name -> testsuite.v1.ExitCriteria
+	0,  // 16: testsuite.v1.UpdateTestSuiteStatusRequest.status:type_name -> testsuite.v1.SuiteStatus
+	0,  // 17: testsuite.v1.ListTestSuitesRequest.status:type_name -> testsuite.v1.SuiteStatus
+	16, // 18: testsuite.v1.ListTestSuitesRequest.start_date:type_name -> google.protobuf.Timestamp
+	16, // 19: testsuite.v1.ListTestSuitesRequest.end_date:type_name -> google.protobuf.Timestamp
+	16, // 20: testsuite.v1.ListTestSuitesRequest.period_from:type_name -> google.protobuf.Timestamp
+	16, // 21: testsuite.v1.ListTestSuitesRequest.period_to:type_name -> google.protobuf.Timestamp
+	2,  // 22: testsuite.v1.ListTestSuitesResponse.test_suites:type_name -> testsuite.v1.TestSuite
+	0,  // 23: testsuite.v1.WatchTestSuitesRequest.status:type_name -> testsuite.v1.SuiteStatus
+	1,  // 24: testsuite.v1.TestSuiteEvent.type:type_name -> testsuite.v1.TestSuiteEventType
+	2,  // 25: testsuite.v1.TestSuiteEvent.test_suite:type_name -> testsuite.v1.TestSuite
+	16, // 26: testsuite.v1.TestSuiteEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_proto_testsuite_v1_test_suite_proto_init() }
@@ -863,7 +1680,7 @@ func file_proto_testsuite_v1_test_suite_proto_init() {
 			}
 		}
 		file_proto_testsuite_v1_test_suite_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTestSuiteRequest); i {
+			switch v := v.(*ExitCriteria); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -875,7 +1692,7 @@ func file_proto_testsuite_v1_test_suite_proto_init() {
 			}
 		}
 		file_proto_testsuite_v1_test_suite_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTestSuiteRequest); i {
+			switch v := v.(*ExitCriteriaOverride); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -887,7 +1704,7 @@ func file_proto_testsuite_v1_test_suite_proto_init() {
 			}
 		}
 		file_proto_testsuite_v1_test_suite_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTestSuiteRequest); i {
+			switch v := v.(*CreateTestSuiteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -899,7 +1716,7 @@ func file_proto_testsuite_v1_test_suite_proto_init() {
 			}
 		}
 		file_proto_testsuite_v1_test_suite_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTestSuiteParams); i {
+			switch v := v.(*GetTestSuiteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -911,7 +1728,7 @@ func file_proto_testsuite_v1_test_suite_proto_init() {
 			}
 		}
 		file_proto_testsuite_v1_test_suite_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTestSuiteStatusRequest); i {
+			switch v := v.(*UpdateTestSuiteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -923,7 +1740,7 @@ func file_proto_testsuite_v1_test_suite_proto_init() {
 			}
 		}
 		file_proto_testsuite_v1_test_suite_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTestSuitesRequest); i {
+			switch v := v.(*UpdateTestSuiteParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -935,6 +1752,30 @@ func file_proto_testsuite_v1_test_suite_proto_init() {
 			}
 		}
 		file_proto_testsuite_v1_test_suite_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTestSuiteStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_test_suite_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTestSuitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_test_suite_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListTestSuitesResponse); i {
 			case 0:
 				return &v.state
@@ -946,16 +1787,67 @@ func file_proto_testsuite_v1_test_suite_proto_init() {
 				return nil
 			}
 		}
+		file_proto_testsuite_v1_test_suite_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTestSuiteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_test_suite_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedTestSuitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_test_suite_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTestSuitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_testsuite_v1_test_suite_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSuiteEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_proto_testsuite_v1_test_suite_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_proto_testsuite_v1_test_suite_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_proto_testsuite_v1_test_suite_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_proto_testsuite_v1_test_suite_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_proto_testsuite_v1_test_suite_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_proto_testsuite_v1_test_suite_proto_msgTypes[12].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_testsuite_v1_test_suite_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
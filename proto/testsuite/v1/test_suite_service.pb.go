@@ -28,7 +28,7 @@ var file_proto_testsuite_v1_test_suite_service_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x23, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f,
 	0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x32, 0x8d, 0x04, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x53, 0x65,
+	0x32, 0xfe, 0x06, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
 	0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
 	0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
@@ -56,16 +56,40 @@ var file_proto_testsuite_v1_test_suite_service_proto_rawDesc = []byte{
 	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x65, 0x73,
 	0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65,
 	0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4e, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69,
-	0x74, 0x65, 0x12, 0x21, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x30, 0x01,
-	0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
-	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x36, 0x38, 0x33, 0x31, 0x39, 0x34, 0x34, 0x2f,
-	0x47, 0x4f, 0x2d, 0x44, 0x44, 0x44, 0x2d, 0x43, 0x41, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x65, 0x73,
-	0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x5b, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75,
+	0x69, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x12, 0x21, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x69, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74,
+	0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x30, 0x01, 0x12, 0x57, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x36, 0x38, 0x33, 0x31, 0x39, 0x34, 0x34,
+	0x2f, 0x47, 0x4f, 0x2d, 0x44, 0x44, 0x44, 0x2d, 0x43, 0x41, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x75, 0x69, 0x74, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var file_proto_testsuite_v1_test_suite_service_proto_goTypes = []interface{}{
@@ -74,27 +98,39 @@ var file_proto_testsuite_v1_test_suite_service_proto_goTypes = []interface{}{
 	(*UpdateTestSuiteRequest)(nil),       // 2: testsuite.v1.UpdateTestSuiteRequest
 	(*UpdateTestSuiteStatusRequest)(nil), // 3: testsuite.v1.UpdateTestSuiteStatusRequest
 	(*ListTestSuitesRequest)(nil),        // 4: testsuite.v1.ListTestSuitesRequest
-	(*TestSuite)(nil),                    // 5: testsuite.v1.TestSuite
-	(*ListTestSuitesResponse)(nil),       // 6: testsuite.v1.ListTestSuitesResponse
+	(*ListDeletedTestSuitesRequest)(nil), // 5: testsuite.v1.ListDeletedTestSuitesRequest
+	(*WatchTestSuitesRequest)(nil),       // 6: testsuite.v1.WatchTestSuitesRequest
+	(*TestSuite)(nil),                    // 7: testsuite.v1.TestSuite
+	(*ListTestSuitesResponse)(nil),       // 8: testsuite.v1.ListTestSuitesResponse
+	(*DeleteTestSuiteResponse)(nil),      // 9: testsuite.v1.DeleteTestSuiteResponse
+	(*TestSuiteEvent)(nil),               // 10: testsuite.v1.TestSuiteEvent
 }
 var file_proto_testsuite_v1_test_suite_service_proto_depIdxs = []int32{
-	0, // 0: testsuite.v1.TestSuiteService.CreateTestSuite:input_type -> testsuite.v1.CreateTestSuiteRequest
-	1, // 1: testsuite.v1.TestSuiteService.GetTestSuite:input_type -> testsuite.v1.GetTestSuiteRequest
-	2, // 2: testsuite.v1.TestSuiteService.UpdateTestSuite:input_type -> testsuite.v1.UpdateTestSuiteRequest
-	3, // 3: testsuite.v1.TestSuiteService.UpdateTestSuiteStatus:input_type -> testsuite.v1.UpdateTestSuiteStatusRequest
-	4, // 4: testsuite.v1.TestSuiteService.ListTestSuites:input_type -> testsuite.v1.ListTestSuitesRequest
-	1, // 5: testsuite.v1.TestSuiteService.WatchTestSuite:input_type -> testsuite.v1.GetTestSuiteRequest
-	5, // 6: testsuite.v1.TestSuiteService.CreateTestSuite:output_type -> testsuite.v1.TestSuite
-	5, // 7: testsuite.v1.TestSuiteService.GetTestSuite:output_type -> testsuite.v1.TestSuite
-	5, // 8: testsuite.v1.TestSuiteService.UpdateTestSuite:output_type -> testsuite.v1.TestSuite
-	5, // 9: testsuite.v1.TestSuiteService.UpdateTestSuiteStatus:output_type -> testsuite.v1.TestSuite
-	6, // 10: testsuite.v1.TestSuiteService.ListTestSuites:output_type -> testsuite.v1.ListTestSuitesResponse
-	5, // 11: testsuite.v1.TestSuiteService.WatchTestSuite:output_type -> testsuite.v1.TestSuite
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: testsuite.v1.TestSuiteService.CreateTestSuite:input_type -> testsuite.v1.CreateTestSuiteRequest
+	1,  // 1: testsuite.v1.TestSuiteService.GetTestSuite:input_type -> testsuite.v1.GetTestSuiteRequest
+	2,  // 2: testsuite.v1.TestSuiteService.UpdateTestSuite:input_type -> testsuite.v1.UpdateTestSuiteRequest
+	3,  // 3: testsuite.v1.TestSuiteService.UpdateTestSuiteStatus:input_type -> testsuite.v1.UpdateTestSuiteStatusRequest
+	4,  // 4: testsuite.v1.TestSuiteService.ListTestSuites:input_type -> testsuite.v1.ListTestSuitesRequest
+	1,  // 5: testsuite.v1.TestSuiteService.DeleteTestSuite:input_type -> testsuite.v1.GetTestSuiteRequest
+	1,  // 6: testsuite.v1.TestSuiteService.RestoreTestSuite:input_type -> testsuite.v1.GetTestSuiteRequest
+	5,  // 7: testsuite.v1.TestSuiteService.ListDeletedTestSuites:input_type -> testsuite.v1.ListDeletedTestSuitesRequest
+	1,  // 8: testsuite.v1.TestSuiteService.WatchTestSuite:input_type -> testsuite.v1.GetTestSuiteRequest
+	6,  // 9: testsuite.v1.TestSuiteService.WatchTestSuites:input_type -> testsuite.v1.WatchTestSuitesRequest
+	7,  // 10: testsuite.v1.TestSuiteService.CreateTestSuite:output_type -> testsuite.v1.TestSuite
+	7,  // 11: testsuite.v1.TestSuiteService.GetTestSuite:output_type -> testsuite.v1.TestSuite
+	7,  // 12: testsuite.v1.TestSuiteService.UpdateTestSuite:output_type -> testsuite.v1.TestSuite
+	7,  // 13: testsuite.v1.TestSuiteService.UpdateTestSuiteStatus:output_type -> testsuite.v1.TestSuite
+	8,  // 14: testsuite.v1.TestSuiteService.ListTestSuites:output_type -> testsuite.v1.ListTestSuitesResponse
+	9,  // 15: testsuite.v1.TestSuiteService.DeleteTestSuite:output_type -> testsuite.v1.DeleteTestSuiteResponse
+	7,  // 16: testsuite.v1.TestSuiteService.RestoreTestSuite:output_type -> testsuite.v1.TestSuite
+	8,  // 17: testsuite.v1.TestSuiteService.ListDeletedTestSuites:output_type -> testsuite.v1.ListTestSuitesResponse
+	7,  // 18: testsuite.v1.TestSuiteService.WatchTestSuite:output_type -> testsuite.v1.TestSuite
+	10, // 19: testsuite.v1.TestSuiteService.WatchTestSuites:output_type -> testsuite.v1.TestSuiteEvent
+	10, // [10:20] is the sub-list for method output_type
+	0,  // [0:10] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_proto_testsuite_v1_test_suite_service_proto_init() }
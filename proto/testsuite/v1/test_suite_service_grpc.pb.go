@@ -32,8 +32,16 @@ type TestSuiteServiceClient interface {
 	UpdateTestSuiteStatus(ctx context.Context, in *UpdateTestSuiteStatusRequest, opts ...grpc.CallOption) (*TestSuite, error)
 	// テストスイート一覧の取得
 	ListTestSuites(ctx context.Context, in *ListTestSuitesRequest, opts ...grpc.CallOption) (*ListTestSuitesResponse, error)
+	// テストスイートの削除（配下のグループ・ケースごとゴミ箱に移動）
+	DeleteTestSuite(ctx context.Context, in *GetTestSuiteRequest, opts ...grpc.CallOption) (*DeleteTestSuiteResponse, error)
+	// ゴミ箱のテストスイートを配下のグループ・ケースごと復元
+	RestoreTestSuite(ctx context.Context, in *GetTestSuiteRequest, opts ...grpc.CallOption) (*TestSuite, error)
+	// ゴミ箱内のテストスイート一覧の取得
+	ListDeletedTestSuites(ctx context.Context, in *ListDeletedTestSuitesRequest, opts ...grpc.CallOption) (*ListTestSuitesResponse, error)
 	// テストスイートのストリーミング監視
 	WatchTestSuite(ctx context.Context, in *GetTestSuiteRequest, opts ...grpc.CallOption) (TestSuiteService_WatchTestSuiteClient, error)
+	// テストスイート一覧のストリーミング監視（作成・更新・ステータス変更・削除を通知）
+	WatchTestSuites(ctx context.Context, in *WatchTestSuitesRequest, opts ...grpc.CallOption) (TestSuiteService_WatchTestSuitesClient, error)
 }
 
 type testSuiteServiceClient struct {
@@ -89,6 +97,33 @@ func (c *testSuiteServiceClient) ListTestSuites(ctx context.Context, in *ListTes
 	return out, nil
 }
 
+func (c *testSuiteServiceClient) DeleteTestSuite(ctx context.Context, in *GetTestSuiteRequest, opts ...grpc.CallOption) (*DeleteTestSuiteResponse, error) {
+	out := new(DeleteTestSuiteResponse)
+	err := c.cc.Invoke(ctx, "/testsuite.v1.TestSuiteService/DeleteTestSuite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSuiteServiceClient) RestoreTestSuite(ctx context.Context, in *GetTestSuiteRequest, opts ...grpc.CallOption) (*TestSuite, error) {
+	out := new(TestSuite)
+	err := c.cc.Invoke(ctx, "/testsuite.v1.TestSuiteService/RestoreTestSuite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSuiteServiceClient) ListDeletedTestSuites(ctx context.Context, in *ListDeletedTestSuitesRequest, opts ...grpc.CallOption) (*ListTestSuitesResponse, error) {
+	out := new(ListTestSuitesResponse)
+	err := c.cc.Invoke(ctx, "/testsuite.v1.TestSuiteService/ListDeletedTestSuites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *testSuiteServiceClient) WatchTestSuite(ctx context.Context, in *GetTestSuiteRequest, opts ...grpc.CallOption) (TestSuiteService_WatchTestSuiteClient, error) {
 	stream, err := c.cc.NewStream(ctx, &TestSuiteService_ServiceDesc.Streams[0], "/testsuite.v1.TestSuiteService/WatchTestSuite", opts...)
 	if err != nil {
@@ -121,6 +156,38 @@ func (x *testSuiteServiceWatchTestSuiteClient) Recv() (*TestSuite, error) {
 	return m, nil
 }
 
+func (c *testSuiteServiceClient) WatchTestSuites(ctx context.Context, in *WatchTestSuitesRequest, opts ...grpc.CallOption) (TestSuiteService_WatchTestSuitesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TestSuiteService_ServiceDesc.Streams[1], "/testsuite.v1.TestSuiteService/WatchTestSuites", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &testSuiteServiceWatchTestSuitesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TestSuiteService_WatchTestSuitesClient interface {
+	Recv() (*TestSuiteEvent, error)
+	grpc.ClientStream
+}
+
+type testSuiteServiceWatchTestSuitesClient struct {
+	grpc.ClientStream
+}
+
+func (x *testSuiteServiceWatchTestSuitesClient) Recv() (*TestSuiteEvent, error) {
+	m := new(TestSuiteEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TestSuiteServiceServer is the server API for TestSuiteService service.
 // All implementations must embed UnimplementedTestSuiteServiceServer
 // for forward compatibility
@@ -135,8 +202,16 @@ type TestSuiteServiceServer interface {
 	UpdateTestSuiteStatus(context.Context, *UpdateTestSuiteStatusRequest) (*TestSuite, error)
 	// テストスイート一覧の取得
 	ListTestSuites(context.Context, *ListTestSuitesRequest) (*ListTestSuitesResponse, error)
+	// テストスイートの削除（配下のグループ・ケースごとゴミ箱に移動）
+	DeleteTestSuite(context.Context, *GetTestSuiteRequest) (*DeleteTestSuiteResponse, error)
+	// ゴミ箱のテストスイートを配下のグループ・ケースごと復元
+	RestoreTestSuite(context.Context, *GetTestSuiteRequest) (*TestSuite, error)
+	// ゴミ箱内のテストスイート一覧の取得
+	ListDeletedTestSuites(context.Context, *ListDeletedTestSuitesRequest) (*ListTestSuitesResponse, error)
 	// テストスイートのストリーミング監視
 	WatchTestSuite(*GetTestSuiteRequest, TestSuiteService_WatchTestSuiteServer) error
+	// テストスイート一覧のストリーミング監視（作成・更新・ステータス変更・削除を通知）
+	WatchTestSuites(*WatchTestSuitesRequest, TestSuiteService_WatchTestSuitesServer) error
 	mustEmbedUnimplementedTestSuiteServiceServer()
 }
 
@@ -159,9 +234,21 @@ func (UnimplementedTestSuiteServiceServer) UpdateTestSuiteStatus(context.Context
 func (UnimplementedTestSuiteServiceServer) ListTestSuites(context.Context, *ListTestSuitesRequest) (*ListTestSuitesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTestSuites not implemented")
 }
+func (UnimplementedTestSuiteServiceServer) DeleteTestSuite(context.Context, *GetTestSuiteRequest) (*DeleteTestSuiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTestSuite not implemented")
+}
+func (UnimplementedTestSuiteServiceServer) RestoreTestSuite(context.Context, *GetTestSuiteRequest) (*TestSuite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTestSuite not implemented")
+}
+func (UnimplementedTestSuiteServiceServer) ListDeletedTestSuites(context.Context, *ListDeletedTestSuitesRequest) (*ListTestSuitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedTestSuites not implemented")
+}
 func (UnimplementedTestSuiteServiceServer) WatchTestSuite(*GetTestSuiteRequest, TestSuiteService_WatchTestSuiteServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchTestSuite not implemented")
 }
+func (UnimplementedTestSuiteServiceServer) WatchTestSuites(*WatchTestSuitesRequest, TestSuiteService_WatchTestSuitesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTestSuites not implemented")
+}
 func (UnimplementedTestSuiteServiceServer) mustEmbedUnimplementedTestSuiteServiceServer() {}
 
 // UnsafeTestSuiteServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -265,6 +352,60 @@ func _TestSuiteService_ListTestSuites_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TestSuiteService_DeleteTestSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTestSuiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSuiteServiceServer).DeleteTestSuite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testsuite.v1.TestSuiteService/DeleteTestSuite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSuiteServiceServer).DeleteTestSuite(ctx, req.(*GetTestSuiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSuiteService_RestoreTestSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTestSuiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSuiteServiceServer).RestoreTestSuite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testsuite.v1.TestSuiteService/RestoreTestSuite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSuiteServiceServer).RestoreTestSuite(ctx, req.(*GetTestSuiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSuiteService_ListDeletedTestSuites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedTestSuitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSuiteServiceServer).ListDeletedTestSuites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testsuite.v1.TestSuiteService/ListDeletedTestSuites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSuiteServiceServer).ListDeletedTestSuites(ctx, req.(*ListDeletedTestSuitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TestSuiteService_WatchTestSuite_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetTestSuiteRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -286,6 +427,27 @@ func (x *testSuiteServiceWatchTestSuiteServer) Send(m *TestSuite) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TestSuiteService_WatchTestSuites_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTestSuitesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TestSuiteServiceServer).WatchTestSuites(m, &testSuiteServiceWatchTestSuitesServer{stream})
+}
+
+type TestSuiteService_WatchTestSuitesServer interface {
+	Send(*TestSuiteEvent) error
+	grpc.ServerStream
+}
+
+type testSuiteServiceWatchTestSuitesServer struct {
+	grpc.ServerStream
+}
+
+func (x *testSuiteServiceWatchTestSuitesServer) Send(m *TestSuiteEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // TestSuiteService_ServiceDesc is the grpc.ServiceDesc for TestSuiteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -313,6 +475,18 @@ var TestSuiteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTestSuites",
 			Handler:    _TestSuiteService_ListTestSuites_Handler,
 		},
+		{
+			MethodName: "DeleteTestSuite",
+			Handler:    _TestSuiteService_DeleteTestSuite_Handler,
+		},
+		{
+			MethodName: "RestoreTestSuite",
+			Handler:    _TestSuiteService_RestoreTestSuite_Handler,
+		},
+		{
+			MethodName: "ListDeletedTestSuites",
+			Handler:    _TestSuiteService_ListDeletedTestSuites_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -320,6 +494,11 @@ var TestSuiteService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _TestSuiteService_WatchTestSuite_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchTestSuites",
+			Handler:       _TestSuiteService_WatchTestSuites_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/testsuite/v1/test_suite_service.proto",
 }
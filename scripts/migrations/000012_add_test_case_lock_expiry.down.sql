@@ -0,0 +1,4 @@
+-- 000012_add_test_case_lock_expiry.down.sql
+
+ALTER TABLE test_cases
+  DROP COLUMN IF EXISTS lock_expires_at;
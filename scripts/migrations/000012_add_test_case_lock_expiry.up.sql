@@ -0,0 +1,10 @@
+-- 000012_add_test_case_lock_expiry.up.sql
+
+-- 編集ロックのリース期限
+ALTER TABLE test_cases
+  ADD COLUMN lock_expires_at TIMESTAMP;
+
+-- リース期限のない既存のロックは解除しておく
+UPDATE test_cases
+  SET is_locked = false, current_editor = NULL
+  WHERE is_locked = true;
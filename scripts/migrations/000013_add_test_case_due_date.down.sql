@@ -0,0 +1,4 @@
+-- 000013_add_test_case_due_date.down.sql
+
+ALTER TABLE test_cases
+  DROP COLUMN IF EXISTS due_date;
@@ -0,0 +1,5 @@
+-- 000013_add_test_case_due_date.up.sql
+
+-- テストケース個別の期限（未設定の場合はスイートの終了予定日で遅延を判定する）
+ALTER TABLE test_cases
+  ADD COLUMN due_date DATE;
@@ -0,0 +1,10 @@
+-- 000014_add_aggregate_versions.down.sql
+
+ALTER TABLE test_cases
+  DROP COLUMN IF EXISTS version;
+
+ALTER TABLE test_groups
+  DROP COLUMN IF EXISTS version;
+
+ALTER TABLE test_suites
+  DROP COLUMN IF EXISTS version;
@@ -0,0 +1,11 @@
+-- 000014_add_aggregate_versions.up.sql
+
+-- 楽観的排他制御のバージョン（更新のたびに1ずつ増加）
+ALTER TABLE test_suites
+  ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE test_groups
+  ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE test_cases
+  ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
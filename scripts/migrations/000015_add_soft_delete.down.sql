@@ -0,0 +1,14 @@
+-- 000015_add_soft_delete.down.sql
+
+DROP INDEX IF EXISTS idx_test_cases_deleted_at;
+DROP INDEX IF EXISTS idx_test_groups_deleted_at;
+DROP INDEX IF EXISTS idx_test_suites_deleted_at;
+
+ALTER TABLE test_cases
+  DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE test_groups
+  DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE test_suites
+  DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,17 @@
+-- 000015_add_soft_delete.up.sql
+
+-- 論理削除された日時（NULLの場合は削除されていない）
+-- スイート・グループの削除時は配下の行にも同じ日時を設定し、復元時の対象判定に使用する
+ALTER TABLE test_suites
+  ADD COLUMN deleted_at TIMESTAMP;
+
+ALTER TABLE test_groups
+  ADD COLUMN deleted_at TIMESTAMP;
+
+ALTER TABLE test_cases
+  ADD COLUMN deleted_at TIMESTAMP;
+
+-- ゴミ箱の一覧と保持期間経過後の完全削除で使用する
+CREATE INDEX idx_test_suites_deleted_at ON test_suites(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_test_groups_deleted_at ON test_groups(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_test_cases_deleted_at ON test_cases(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,11 @@
+-- 000016_add_suite_exit_criteria.down.sql
+
+ALTER TABLE test_suites
+  DROP CONSTRAINT IF EXISTS chk_test_suites_exit_min_completion_rate;
+
+ALTER TABLE test_suites
+  DROP COLUMN IF EXISTS exit_override_at,
+  DROP COLUMN IF EXISTS exit_override_by,
+  DROP COLUMN IF EXISTS exit_override_reason,
+  DROP COLUMN IF EXISTS exit_allow_open_critical,
+  DROP COLUMN IF EXISTS exit_min_completion_rate;
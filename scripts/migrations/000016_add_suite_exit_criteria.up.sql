@@ -0,0 +1,16 @@
+-- 000016_add_suite_exit_criteria.up.sql
+
+-- テストスイートの完了条件（既存のスイートは全ケース完了を条件とする）
+ALTER TABLE test_suites
+  ADD COLUMN exit_min_completion_rate NUMERIC(5,2) NOT NULL DEFAULT 100,
+  ADD COLUMN exit_allow_open_critical BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE test_suites
+  ADD CONSTRAINT chk_test_suites_exit_min_completion_rate
+  CHECK (exit_min_completion_rate >= 0 AND exit_min_completion_rate <= 100);
+
+-- 完了条件を満たさずにAdminが完了にした場合の記録
+ALTER TABLE test_suites
+  ADD COLUMN exit_override_reason TEXT,
+  ADD COLUMN exit_override_by VARCHAR(50),
+  ADD COLUMN exit_override_at TIMESTAMP;
@@ -0,0 +1,4 @@
+-- 000017_add_group_status_locked.down.sql
+
+ALTER TABLE test_groups
+  DROP COLUMN IF EXISTS status_locked;
@@ -0,0 +1,6 @@
+-- 000017_add_group_status_locked.up.sql
+
+-- テストグループのステータスを手動で固定するフラグ
+-- 固定されていないグループのステータスは配下のテストケースから自動的に算出される
+ALTER TABLE test_groups
+  ADD COLUMN status_locked BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,6 @@
+-- 000018_add_suite_created_by.down.sql
+
+DROP INDEX IF EXISTS idx_test_suites_created_by;
+
+ALTER TABLE test_suites
+  DROP COLUMN IF EXISTS created_by;
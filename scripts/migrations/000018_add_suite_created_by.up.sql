@@ -0,0 +1,8 @@
+-- 000018_add_suite_created_by.up.sql
+
+-- テストスイートを作成したユーザー（一覧の絞り込みに使用する）
+-- 追加前に作成されたスイートは作成者が不明なためNULLのままとする
+ALTER TABLE test_suites
+  ADD COLUMN created_by VARCHAR(50);
+
+CREATE INDEX idx_test_suites_created_by ON test_suites(created_by);
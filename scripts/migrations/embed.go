@@ -0,0 +1,10 @@
+// Package migrations はデータベースのマイグレーションSQLをバイナリに埋め込みます
+// ファイル名は golang-migrate と同じ 000001_name.up.sql / 000001_name.down.sql 形式です
+package migrations
+
+import "embed"
+
+// Files は埋め込まれたマイグレーションSQLです
+//
+//go:embed *.sql
+var Files embed.FS
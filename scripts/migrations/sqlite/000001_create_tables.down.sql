@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS status_history;
+DROP TABLE IF EXISTS effort_records;
+DROP TABLE IF EXISTS test_cases;
+DROP TABLE IF EXISTS test_groups;
+DROP TABLE IF EXISTS test_suites;
@@ -0,0 +1,126 @@
+-- 000001_create_tables.up.sql
+-- PostgreSQLの000001〜000017を適用した後と同じスキーマ
+-- 日付はYYYY-MM-DD、日時はUTCのYYYY-MM-DD HH:MM:SS.fffffffff形式のテキストで保存する
+
+CREATE TABLE test_suites (
+    id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    status TEXT NOT NULL DEFAULT '準備中'
+        CHECK (status IN ('準備中', '実行中', '完了', '中断')),
+    estimated_start_date DATE,
+    estimated_end_date DATE,
+    require_effort_comment BOOLEAN NOT NULL DEFAULT 0,
+    exit_min_completion_rate REAL NOT NULL DEFAULT 100
+        CHECK (exit_min_completion_rate >= 0 AND exit_min_completion_rate <= 100),
+    exit_allow_open_critical BOOLEAN NOT NULL DEFAULT 0,
+    exit_override_reason TEXT,
+    exit_override_by VARCHAR(50),
+    exit_override_at TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE test_groups (
+    id VARCHAR(50) PRIMARY KEY,
+    suite_id VARCHAR(50) NOT NULL REFERENCES test_suites(id),
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    display_order INTEGER NOT NULL,
+    status TEXT NOT NULL DEFAULT '準備中'
+        CHECK (status IN ('準備中', '実行中', '完了', '中断')),
+    status_locked BOOLEAN NOT NULL DEFAULT 0,
+    version INTEGER NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE test_cases (
+    id VARCHAR(50) PRIMARY KEY,
+    group_id VARCHAR(50) NOT NULL REFERENCES test_groups(id),
+    title VARCHAR(200) NOT NULL,
+    description TEXT,
+    status TEXT NOT NULL DEFAULT '作成'
+        CHECK (status IN ('作成', 'テスト', '修正', 'レビュー待ち', 'レビュー中', '完了', '再テスト')),
+    priority TEXT NOT NULL DEFAULT 'Medium'
+        CHECK (priority IN ('Critical', 'High', 'Medium', 'Low')),
+    planned_effort REAL,
+    actual_effort REAL NOT NULL DEFAULT 0,
+    due_date DATE,
+    is_delayed BOOLEAN NOT NULL DEFAULT 0,
+    delay_days INTEGER NOT NULL DEFAULT 0,
+    current_editor VARCHAR(100),
+    is_locked BOOLEAN NOT NULL DEFAULT 0,
+    lock_expires_at TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE effort_records (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    test_case_id VARCHAR(50) NOT NULL REFERENCES test_cases(id),
+    record_date DATE NOT NULL,
+    effort_amount REAL NOT NULL CHECK (effort_amount > 0),
+    is_additional BOOLEAN NOT NULL DEFAULT 0,
+    comment TEXT,
+    recorded_by VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE status_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    test_case_id VARCHAR(50) NOT NULL REFERENCES test_cases(id),
+    old_status TEXT NOT NULL
+        CHECK (old_status IN ('作成', 'テスト', '修正', 'レビュー待ち', 'レビュー中', '完了', '再テスト')),
+    new_status TEXT NOT NULL
+        CHECK (new_status IN ('作成', 'テスト', '修正', 'レビュー待ち', 'レビュー中', '完了', '再テスト')),
+    changed_at TIMESTAMP NOT NULL,
+    changed_by VARCHAR(100) NOT NULL,
+    reason TEXT
+);
+
+CREATE TABLE users (
+    id VARCHAR(50) PRIMARY KEY,
+    username VARCHAR(100) NOT NULL UNIQUE,
+    password_hash VARCHAR(255) NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('Admin', 'Manager', 'Tester')),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    last_login_at TIMESTAMP
+);
+
+CREATE TABLE refresh_tokens (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id VARCHAR(50) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token VARCHAR(255) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    revoked BOOLEAN NOT NULL DEFAULT 0,
+    issued_at TIMESTAMP NOT NULL,
+    last_used_at TIMESTAMP,
+    client_info TEXT,
+    ip_address TEXT,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_test_groups_order ON test_groups(display_order, suite_id);
+CREATE INDEX idx_test_groups_suite_id ON test_groups(suite_id);
+CREATE INDEX idx_test_cases_group_id ON test_cases(group_id);
+CREATE INDEX idx_test_cases_priority ON test_cases(priority);
+CREATE INDEX idx_test_cases_status ON test_cases(status);
+CREATE INDEX idx_effort_records_test_case_id ON effort_records(test_case_id);
+CREATE INDEX idx_effort_records_date ON effort_records(record_date);
+CREATE INDEX idx_status_history_test_case_id ON status_history(test_case_id);
+CREATE INDEX idx_users_role ON users(role);
+CREATE INDEX idx_refresh_tokens_user_id ON refresh_tokens(user_id);
+CREATE INDEX idx_refresh_tokens_expires_at ON refresh_tokens(expires_at);
+
+-- ゴミ箱の一覧と保持期間経過後の完全削除で使用する
+CREATE INDEX idx_test_suites_deleted_at ON test_suites(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_test_groups_deleted_at ON test_groups(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_test_cases_deleted_at ON test_cases(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,13 @@
+-- 000002_create_id_sequences.up.sql
+-- SQLiteにはシーケンスがないため、IDジェネレーターはこのテーブルの値を1ずつ進めて採番する
+-- nameはPostgreSQLのシーケンス名と合わせる
+CREATE TABLE id_sequences (
+    name TEXT PRIMARY KEY,
+    value INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO id_sequences (name, value) VALUES
+    ('test_suite_seq', 0),
+    ('test_group_seq', 0),
+    ('test_case_seq', 0),
+    ('user_seq', 0);
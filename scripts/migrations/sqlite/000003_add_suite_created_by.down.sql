@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_test_suites_created_by;
+
+ALTER TABLE test_suites DROP COLUMN created_by;
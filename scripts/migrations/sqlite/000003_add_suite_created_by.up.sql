@@ -0,0 +1,5 @@
+-- 000003_add_suite_created_by.up.sql
+-- PostgreSQLの000018_add_suite_created_byに対応
+ALTER TABLE test_suites ADD COLUMN created_by VARCHAR(50);
+
+CREATE INDEX idx_test_suites_created_by ON test_suites(created_by);
@@ -0,0 +1,11 @@
+// Package sqlite はSQLite用のマイグレーションSQLをバイナリに埋め込みます
+// PostgreSQLのマイグレーション（scripts/migrations）と同じ最終スキーマを、
+// 列挙型の代わりにCHECK制約、シーケンスの代わりにid_sequencesテーブルで表現します
+package sqlite
+
+import "embed"
+
+// Files は埋め込まれたSQLite用のマイグレーションSQLです
+//
+//go:embed *.sql
+var Files embed.FS
@@ -0,0 +1,115 @@
+package customerrors
+
+import (
+	"database/sql"
+	"errors"
+)
+
+// SQLite の拡張結果コード（制約違反）
+// https://www.sqlite.org/rescode.html#extrc
+const (
+	SQLiteCheckViolationCode      = 275  // SQLITE_CONSTRAINT_CHECK
+	SQLiteForeignKeyViolationCode = 787  // SQLITE_CONSTRAINT_FOREIGNKEY
+	SQLiteNotNullViolationCode    = 1299 // SQLITE_CONSTRAINT_NOTNULL
+	SQLitePrimaryKeyViolationCode = 1555 // SQLITE_CONSTRAINT_PRIMARYKEY
+	SQLiteUniqueViolationCode     = 2067 // SQLITE_CONSTRAINT_UNIQUE
+)
+
+// sqliteError はSQLiteドライバーのエラーが実装する結果コードの取得メソッド
+// ドライバーのパッケージに依存しないよう、メソッドのみで判定します
+type sqliteError interface {
+	error
+	Code() int
+}
+
+// sqliteErrorCode はSQLiteのエラーであれば拡張結果コードを返します
+func sqliteErrorCode(err error) (int, bool) {
+	var sqliteErr sqliteError
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code(), true
+	}
+	return 0, false
+}
+
+// IsSQLiteUniqueViolation はSQLiteの一意性制約違反（主キーの重複を含む）エラーかチェック
+func IsSQLiteUniqueViolation(err error) bool {
+	code, ok := sqliteErrorCode(err)
+	return ok && (code == SQLiteUniqueViolationCode || code == SQLitePrimaryKeyViolationCode)
+}
+
+// IsSQLiteForeignKeyViolation はSQLiteの外部キー制約違反エラーかチェック
+func IsSQLiteForeignKeyViolation(err error) bool {
+	code, ok := sqliteErrorCode(err)
+	return ok && code == SQLiteForeignKeyViolationCode
+}
+
+// IsSQLiteCheckViolation はSQLiteのチェック制約違反エラーかチェック
+func IsSQLiteCheckViolation(err error) bool {
+	code, ok := sqliteErrorCode(err)
+	return ok && code == SQLiteCheckViolationCode
+}
+
+// IsSQLiteNotNullViolation はSQLiteのNOT NULL制約違反エラーかチェック
+func IsSQLiteNotNullViolation(err error) bool {
+	code, ok := sqliteErrorCode(err)
+	return ok && code == SQLiteNotNullViolationCode
+}
+
+// ConvertSQLiteError はSQLiteのエラーをConvertDBErrorと同じドメインエラーに変換します
+// SQLiteのエラーには制約名が含まれないため、コンテキストにはエラーメッセージを記録します
+func ConvertSQLiteError(err error, operation string, entity string, id string) error {
+	if IsSQLiteUniqueViolation(err) {
+		return EntityConflictError(
+			entity,
+			id,
+			"は既に存在しています",
+		).WithContext(Context{
+			"constraint": err.Error(),
+			"operation":  operation,
+		})
+	}
+
+	if IsSQLiteForeignKeyViolation(err) {
+		if operation == "delete" {
+			return EntityConflictError(
+				entity,
+				id,
+				"は関連するデータが存在するため削除できません",
+			).WithContext(Context{
+				"constraint": err.Error(),
+				"operation":  operation,
+			})
+		}
+		return NewValidationError(
+			"関連するリソースが存在しません",
+			map[string]string{
+				"id":         id,
+				"constraint": err.Error(),
+			},
+		).WithContext(Context{
+			"operation": operation,
+			"entity":    entity,
+		})
+	}
+
+	if IsSQLiteCheckViolation(err) || IsSQLiteNotNullViolation(err) {
+		return NewValidationError(
+			"入力値がデータベースの制約を満たしていません",
+			map[string]string{
+				"id":         id,
+				"constraint": err.Error(),
+			},
+		).WithContext(Context{
+			"operation": operation,
+			"entity":    entity,
+		})
+	}
+
+	if err == sql.ErrNoRows {
+		return EntityNotFoundError(entity, id)
+	}
+
+	return DatabaseError(operation, entity, err).WithContext(Context{
+		"id": id,
+	})
+}